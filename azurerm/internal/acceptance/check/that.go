@@ -134,3 +134,47 @@ func (t thatWithKeyType) MatchesOtherKey(other thatWithKeyType) resource.TestChe
 func (t thatWithKeyType) MatchesRegex(r *regexp.Regexp) resource.TestCheckFunc {
 	return resource.TestMatchResourceAttr(t.resourceName, t.key, r)
 }
+
+// StoresValueIn returns a TestCheckFunc which stores the value of the specific key on the
+// resource in the specified variable, so that it can be compared in a later Test Step
+func (t thatWithKeyType) StoresValueIn(value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		actual, err := t.value(s)
+		if err != nil {
+			return err
+		}
+
+		*value = actual
+		return nil
+	}
+}
+
+// DiffersFromValueIn returns a TestCheckFunc which validates that the specific key on the resource
+// differs from the value in the specified variable (e.g. one stored by `StoresValueIn` in an earlier Test Step)
+func (t thatWithKeyType) DiffersFromValueIn(value *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		actual, err := t.value(s)
+		if err != nil {
+			return err
+		}
+
+		if actual == *value {
+			return fmt.Errorf("expected %q on %q to have changed from %q", t.key, t.resourceName, *value)
+		}
+		return nil
+	}
+}
+
+func (t thatWithKeyType) value(s *terraform.State) (string, error) {
+	rs, ok := s.RootModule().Resources[t.resourceName]
+	if !ok {
+		return "", fmt.Errorf("%q was not found in the state", t.resourceName)
+	}
+
+	value, ok := rs.Primary.Attributes[t.key]
+	if !ok {
+		return "", fmt.Errorf("%q was not found on %q", t.key, t.resourceName)
+	}
+
+	return value, nil
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
@@ -62,9 +63,20 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		t.Fatalf("Error retrieving Environment: %+v", err)
 	}
 
+	if recording.Enabled() {
+		if err := recording.Start(t.Name()); err != nil {
+			t.Fatalf("Error starting Recording: %+v", err)
+		}
+		t.Cleanup(func() {
+			if err := recording.Stop(); err != nil {
+				t.Errorf("Error stopping Recording: %+v", err)
+			}
+		})
+	}
+
 	testData := TestData{
-		RandomInteger:   RandTimeInt(),
-		RandomString:    acctest.RandString(5),
+		RandomInteger:   randomIntegerVariable("RandomInteger", RandTimeInt),
+		RandomString:    recording.Variable("RandomString", func() string { return acctest.RandString(5) }),
 		ResourceName:    fmt.Sprintf("%s.%s", resourceType, resourceLabel),
 		Environment:     *env,
 		EnvironmentName: EnvironmentName(),
@@ -84,9 +96,30 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	// the locations are part of the recorded requests, so need to be consistent when replaying
+	testData.Locations = Regions{
+		Primary:   recording.Variable("Locations.Primary", func() string { return testData.Locations.Primary }),
+		Secondary: recording.Variable("Locations.Secondary", func() string { return testData.Locations.Secondary }),
+		Ternary:   recording.Variable("Locations.Ternary", func() string { return testData.Locations.Ternary }),
+	}
+
 	return testData
 }
 
+// randomIntegerVariable returns a random integer which is consistent between recording and replaying a test
+func randomIntegerVariable(name string, generate func() int) int {
+	value := recording.Variable(name, func() string {
+		return strconv.Itoa(generate())
+	})
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		panic(fmt.Sprintf("parsing the recorded value %q for %q as an integer: %+v", value, name, err))
+	}
+
+	return i
+}
+
 // RandomIntOfLength is a random 8 to 18 digit integer which is unique to this test case
 func (td *TestData) RandomIntOfLength(len int) int {
 	// len should not be
@@ -122,5 +155,7 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
-	return acctest.RandString(len)
+	return recording.Variable("RandomStringOfLength", func() string {
+		return acctest.RandString(len)
+	})
 }
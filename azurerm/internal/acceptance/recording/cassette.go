@@ -0,0 +1,277 @@
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Cassette is a recording of each of the requests and responses made during a single test
+type Cassette struct {
+	// Name is the name of the test this Cassette was recorded for
+	Name string `json:"name"`
+
+	// Variables is a map of the name to the values of each Variable requested during the test, in order
+	Variables map[string][]string `json:"variables"`
+
+	// Interactions is a list of the requests and responses made during the test, in order
+	Interactions []Interaction `json:"interactions"`
+
+	filePath     string
+	replacements []replacement
+
+	lock            *sync.Mutex
+	used            []bool
+	variableIndexes map[string]int
+}
+
+// Interaction is a single (sanitized) request and the response returned for it
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a sanitized HTTP Request
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a sanitized HTTP Response
+type Response struct {
+	StatusCode int               `json:"statusCode"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body,omitempty"`
+}
+
+// recordedResponseHeaders are the Response Headers which are recorded, the remaining headers are either
+// not used by the Provider or can contain sensitive information, so are omitted.
+//
+// NOTE: `Retry-After` is intentionally omitted so that Long Running Operations are polled immediately when replaying
+var recordedResponseHeaders = []string{
+	"Azure-AsyncOperation",
+	"Content-Type",
+	"ETag",
+	"Location",
+	"Operation-Location",
+}
+
+// pollingResponseHeaders are the Response Headers containing the URL used to poll a Long Running Operation
+var pollingResponseHeaders = []string{
+	"Azure-AsyncOperation",
+	"Location",
+	"Operation-Location",
+}
+
+func newCassette(name, filePath string, replacements []replacement) *Cassette {
+	return &Cassette{
+		Name:            name,
+		Variables:       make(map[string][]string),
+		Interactions:    make([]Interaction, 0),
+		filePath:        filePath,
+		replacements:    replacements,
+		lock:            &sync.Mutex{},
+		used:            make([]bool, 0),
+		variableIndexes: make(map[string]int),
+	}
+}
+
+func loadCassette(filePath string, replacements []replacement) (*Cassette, error) {
+	contents, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", filePath, err)
+	}
+
+	cassette := newCassette("", filePath, replacements)
+	if err := json.Unmarshal(contents, cassette); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", filePath, err)
+	}
+	if cassette.Variables == nil {
+		cassette.Variables = make(map[string][]string)
+	}
+	cassette.used = make([]bool, len(cassette.Interactions))
+
+	return cassette, nil
+}
+
+func (c *Cassette) save() error {
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing: %+v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.filePath), 0755); err != nil {
+		return fmt.Errorf("creating directory for %q: %+v", c.filePath, err)
+	}
+
+	return ioutil.WriteFile(c.filePath, append(contents, '\n'), 0644)
+}
+
+func (c *Cassette) variable(name string, generate func() string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	index := c.variableIndexes[name]
+	c.variableIndexes[name] = index + 1
+
+	if existing := c.Variables[name]; index < len(existing) {
+		return existing[index]
+	}
+
+	value := generate()
+	c.Variables[name] = append(c.Variables[name], value)
+	return value
+}
+
+// record sanitizes and records the specified request and response
+func (c *Cassette) record(req *http.Request, requestBody []byte, resp *http.Response, responseBody []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	headers := make(map[string]string)
+	for _, header := range recordedResponseHeaders {
+		if v := resp.Header.Get(header); v != "" {
+			headers[header] = redactSignatures(sanitize(v, c.replacements))
+		}
+	}
+
+	interaction := Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    sanitizeURL(req.URL.String(), c.replacements),
+			Body:   sanitize(redactSecrets(string(requestBody)), c.replacements),
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Headers:    headers,
+			Body:       sanitize(redactSecrets(string(responseBody)), c.replacements),
+		},
+	}
+
+	// polling a Long Running Operation returns the same response until it completes - since there's no delay when
+	// replaying there's no need to record each poll, so identical consecutive polls of an operation are collapsed
+	// into one. All other requests are recorded, since the same request can return a different response over time
+	if c.isPollingRequest(interaction.Request) {
+		if previous := c.lastInteractionFor(interaction.Request); previous != nil && previous.Response.StatusCode == interaction.Response.StatusCode && previous.Response.Body == interaction.Response.Body {
+			return
+		}
+	}
+
+	c.Interactions = append(c.Interactions, interaction)
+	c.used = append(c.used, false)
+}
+
+// replay returns the response for the next unused Interaction matching this request, such that the
+// responses for the same request are returned in the order they were recorded
+func (c *Cassette) replay(req *http.Request) (*http.Response, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	request := Request{
+		Method: req.Method,
+		URL:    sanitizeURL(req.URL.String(), c.replacements),
+	}
+
+	for i, interaction := range c.Interactions {
+		if !interaction.Request.matches(request) || c.used[i] {
+			continue
+		}
+
+		c.used[i] = true
+		return interaction.Response.httpResponse(req, c.replacements), nil
+	}
+
+	// identical polls of a Long Running Operation are collapsed when recording, so these are replayed using the last response
+	if c.isPollingRequest(request) {
+		if previous := c.lastInteractionFor(request); previous != nil {
+			return previous.Response.httpResponse(req, c.replacements), nil
+		}
+	}
+
+	return nil, fmt.Errorf("no unused recorded interaction was found in %q for %s %s", c.filePath, request.Method, request.URL)
+}
+
+// isPollingRequest returns whether the specified request polls a Long Running Operation, that is a GET
+// to a URL returned in the polling headers of a previously recorded response
+func (c *Cassette) isPollingRequest(request Request) bool {
+	if request.Method != http.MethodGet {
+		return false
+	}
+
+	for _, interaction := range c.Interactions {
+		for _, header := range pollingResponseHeaders {
+			if v, ok := interaction.Response.Headers[header]; ok && normalizeURL(v) == request.URL {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// lastInteractionFor returns the last recorded Interaction matching the specified request, if any
+func (c *Cassette) lastInteractionFor(request Request) *Interaction {
+	for i := len(c.Interactions) - 1; i >= 0; i-- {
+		if c.Interactions[i].Request.matches(request) {
+			return &c.Interactions[i]
+		}
+	}
+
+	return nil
+}
+
+func (c *Cassette) unusedInteractions() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	unused := 0
+	for _, used := range c.used {
+		if !used {
+			unused++
+		}
+	}
+	return unused
+}
+
+// matches returns whether this Request has the same Method and URL as the other (sanitized) Request
+func (r Request) matches(other Request) bool {
+	return r.Method == other.Method && r.URL == other.URL
+}
+
+func (r Response) httpResponse(req *http.Request, replacements []replacement) *http.Response {
+	body := desanitize(r.Body, replacements)
+	headers := http.Header{}
+	for k, v := range r.Headers {
+		headers.Set(k, desanitize(v, replacements))
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        headers,
+		Body:          ioutil.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// normalizeURL sorts the Query String so that URL's can be compared
+func normalizeURL(input string) string {
+	parsed, err := url.Parse(input)
+	if err != nil {
+		return input
+	}
+
+	parsed.RawQuery = parsed.Query().Encode()
+	return parsed.String()
+}
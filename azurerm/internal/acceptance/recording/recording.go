@@ -0,0 +1,170 @@
+package recording
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Mode is the mode in which HTTP requests are sent
+type Mode string
+
+const (
+	// LiveMode sends requests to Azure without recording them
+	LiveMode Mode = "live"
+
+	// RecordMode sends requests to Azure, recording each request and response into a Cassette
+	RecordMode Mode = "record"
+
+	// ReplayMode replays the responses from a previously recorded Cassette, without sending requests to Azure
+	ReplayMode Mode = "replay"
+)
+
+// ModeEnvVar is the Environment Variable used to specify the Mode
+const ModeEnvVar = "ARM_TEST_RECORDING_MODE"
+
+// CurrentMode returns the Mode specified in the Environment, defaulting to LiveMode
+func CurrentMode() Mode {
+	switch Mode(strings.ToLower(os.Getenv(ModeEnvVar))) {
+	case RecordMode:
+		return RecordMode
+	case ReplayMode:
+		return ReplayMode
+	}
+
+	return LiveMode
+}
+
+// Enabled returns whether requests are being either recorded or replayed
+func Enabled() bool {
+	return CurrentMode() != LiveMode
+}
+
+var (
+	activeCassette *Cassette
+	activeLock     = &sync.Mutex{}
+)
+
+// Start loads (when replaying) or creates (when recording) the Cassette for the specified test
+// and makes it the active Cassette, which requests are recorded into or replayed from
+//
+// NOTE: since there's a single active Cassette, tests have to be run sequentially when recording or replaying
+func Start(testName string) error {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	if activeCassette != nil {
+		// the same test can build multiple sets of Test Data
+		if activeCassette.Name == testName {
+			return nil
+		}
+
+		return fmt.Errorf("the Cassette for %q is already active - tests must be run sequentially when recording or replaying", activeCassette.Name)
+	}
+
+	filePath := cassetteFilePath(testName)
+	switch CurrentMode() {
+	case RecordMode:
+		activeCassette = newCassette(testName, filePath, defaultReplacements())
+
+	case ReplayMode:
+		cassette, err := loadCassette(filePath, defaultReplacements())
+		if err != nil {
+			return fmt.Errorf("loading the Cassette for %q: %+v", testName, err)
+		}
+		activeCassette = cassette
+
+	default:
+		return fmt.Errorf("recording isn't enabled - set %q to either %q or %q", ModeEnvVar, RecordMode, ReplayMode)
+	}
+
+	return nil
+}
+
+// Stop saves the active Cassette (when recording) and then clears the active Cassette
+func Stop() error {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	if activeCassette == nil {
+		return nil
+	}
+
+	cassette := activeCassette
+	activeCassette = nil
+
+	if CurrentMode() == RecordMode {
+		if err := cassette.save(); err != nil {
+			return fmt.Errorf("saving the Cassette for %q: %+v", cassette.Name, err)
+		}
+	}
+
+	if CurrentMode() == ReplayMode {
+		if remaining := cassette.unusedInteractions(); remaining > 0 {
+			return fmt.Errorf("%d recorded interaction(s) weren't replayed for %q", remaining, cassette.Name)
+		}
+	}
+
+	return nil
+}
+
+// Variable returns a value which is consistent between recording and replaying a test, such as a
+// random value used in the Test Configuration. When recording the value is generated and stored in
+// the active Cassette, when replaying the stored value is returned and otherwise it's generated.
+//
+// Since the same name can be requested multiple times (e.g. for multiple random strings) values
+// are stored in the order they're requested.
+func Variable(name string, generate func() string) string {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	if activeCassette == nil {
+		return generate()
+	}
+
+	return activeCassette.variable(name, generate)
+}
+
+// objectIdPlaceholder is the Object ID used for the authenticated Principal when replaying outside of a test
+const objectIdPlaceholder = "00000000-0000-0000-0000-000000000003"
+
+// AuthenticatedObjectID wraps the function used to look up the Object ID of the authenticated Principal, which
+// sends a request to Azure Active Directory rather than Resource Manager, so isn't recorded by the Cassette.
+//
+// When recording the Object ID is looked up and stored in the active Cassette, when replaying the stored value
+// (or a placeholder) is returned without sending a request - and otherwise it's looked up as normal.
+func AuthenticatedObjectID(lookup func(ctx context.Context) (string, error)) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		if CurrentMode() == ReplayMode {
+			return Variable("ObjectId", func() string { return objectIdPlaceholder }), nil
+		}
+
+		var err error
+		objectId := Variable("ObjectId", func() string {
+			var v string
+			v, err = lookup(ctx)
+			return v
+		})
+		return objectId, err
+	}
+}
+
+func active() *Cassette {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	return activeCassette
+}
+
+// cassetteDirectory is the directory containing the Cassettes, which is relative to the package being
+// tested (since `go test` runs within the package directory)
+var cassetteDirectory = filepath.Join("testdata", "recordings")
+
+// cassetteFilePath returns the path to the Cassette for this test
+func cassetteFilePath(testName string) string {
+	fileName := strings.NewReplacer("/", "_", " ", "_", ":", "_").Replace(testName)
+	return filepath.Join(cassetteDirectory, fmt.Sprintf("%s.json", fileName))
+}
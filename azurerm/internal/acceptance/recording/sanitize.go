@@ -0,0 +1,150 @@
+package recording
+
+import (
+	"encoding/json"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// replacement is a sensitive value which is replaced by a placeholder in a Cassette
+type replacement struct {
+	value       string
+	placeholder string
+}
+
+// Placeholders is a map of the Environment Variables which are sanitized in a Cassette to the placeholder
+// used in their place - when replaying these are used as the values for any unset Environment Variables
+var Placeholders = map[string]string{
+	"ARM_CLIENT_ID":       "00000000-0000-0000-0000-000000000002",
+	"ARM_CLIENT_SECRET":   "replayed",
+	"ARM_SUBSCRIPTION_ID": "00000000-0000-0000-0000-000000000000",
+	"ARM_TENANT_ID":       "00000000-0000-0000-0000-000000000001",
+}
+
+// redactedValue is used in place of secrets returned from the API, which is valid base64
+// since some keys (e.g. Storage Account Keys) are decoded by the Provider
+const redactedValue = "cmVkYWN0ZWQ="
+
+func defaultReplacements() []replacement {
+	replacements := make([]replacement, 0)
+	for _, variable := range []string{"ARM_SUBSCRIPTION_ID", "ARM_TENANT_ID", "ARM_CLIENT_ID"} {
+		value := os.Getenv(variable)
+		placeholder := Placeholders[variable]
+		if value == "" || strings.EqualFold(value, placeholder) {
+			continue
+		}
+
+		replacements = append(replacements, replacement{
+			value:       value,
+			placeholder: placeholder,
+		})
+	}
+	return replacements
+}
+
+// sanitize replaces each of the sensitive values with their placeholders
+func sanitize(input string, replacements []replacement) string {
+	for _, r := range replacements {
+		input = strings.ReplaceAll(input, r.value, r.placeholder)
+		input = strings.ReplaceAll(input, strings.ToLower(r.value), r.placeholder)
+		input = strings.ReplaceAll(input, strings.ToUpper(r.value), r.placeholder)
+	}
+	return input
+}
+
+// desanitize replaces each of the placeholders with their sensitive values
+func desanitize(input string, replacements []replacement) string {
+	for _, r := range replacements {
+		input = strings.ReplaceAll(input, r.placeholder, r.value)
+	}
+	return input
+}
+
+// sasSignatureRegex matches the signature of a Shared Access Signature (e.g. `sig=abc123`), which is
+// used to authenticate requests to Storage and can be present within URL's, headers and bodies
+var sasSignatureRegex = regexp.MustCompile(`(?i)([?&]sig=)[^&"'\s]+`)
+
+// redactSignatures redacts the signature of any Shared Access Signatures within the input
+func redactSignatures(input string) string {
+	return sasSignatureRegex.ReplaceAllString(input, "${1}"+redactedValue)
+}
+
+// sanitizeURL sanitizes the specified URL, sorting the Query String so that URL's can be compared
+func sanitizeURL(input string, replacements []replacement) string {
+	return normalizeURL(redactSignatures(sanitize(input, replacements)))
+}
+
+// redactSecrets redacts any secrets within a JSON request or response body, such as the
+// password sent when creating a resource or the keys returned from an action (e.g. `listKeys`)
+func redactSecrets(body string) string {
+	if body == "" {
+		return body
+	}
+
+	// numbers are decoded as-is so that large integers aren't re-encoded in exponent form
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+
+	var decoded interface{}
+	if err := decoder.Decode(&decoded); err != nil {
+		return redactSignatures(body)
+	}
+
+	// bodies without any secrets are recorded as-is
+	redacted := false
+	value := redactValue("", decoded, &redacted)
+	if !redacted {
+		return redactSignatures(body)
+	}
+
+	contents, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+
+	return redactSignatures(string(contents))
+}
+
+func redactValue(key string, input interface{}, redacted *bool) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{})
+		for k, val := range v {
+			out[k] = redactValue(k, val, redacted)
+		}
+		return out
+
+	case []interface{}:
+		out := make([]interface{}, 0)
+		for _, val := range v {
+			out = append(out, redactValue(key, val, redacted))
+		}
+		return out
+
+	case string:
+		if isSecretKey(key) {
+			*redacted = true
+			return redactedValue
+		}
+	}
+
+	return input
+}
+
+// isSecretKey returns whether the specified JSON key is likely to contain a secret
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, suffix := range []string{"id", "name", "permissions", "source", "type"} {
+		if strings.HasSuffix(key, suffix) {
+			return false
+		}
+	}
+
+	for _, v := range []string{"connectionstring", "key", "password", "secret", "token"} {
+		if strings.Contains(key, v) {
+			return true
+		}
+	}
+	return key == "value"
+}
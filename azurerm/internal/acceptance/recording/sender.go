@@ -0,0 +1,102 @@
+package recording
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ConfigureClient configures the specified autorest Client to record or replay requests
+// using the active Cassette, when recording is enabled
+func ConfigureClient(c *autorest.Client) {
+	mode := CurrentMode()
+	if mode == LiveMode {
+		return
+	}
+
+	c.Sender = NewSender(mode, c.Sender)
+
+	if mode == ReplayMode {
+		// requests aren't sent to Azure so there's no need to authenticate
+		c.Authorizer = autorest.NullAuthorizer{}
+
+		// Long Running Operations are polled (and failed requests retried) immediately
+		c.PollingDelay = 0
+		c.RetryDuration = 0
+	}
+}
+
+// NewSender returns an autorest.Sender which records requests sent using the specified Sender
+// into the active Cassette, or replays the requests from the active Cassette, depending on the Mode
+func NewSender(mode Mode, sender autorest.Sender) autorest.Sender {
+	return &recordingSender{
+		mode:   mode,
+		sender: sender,
+	}
+}
+
+type recordingSender struct {
+	mode   Mode
+	sender autorest.Sender
+}
+
+func (s *recordingSender) Do(req *http.Request) (*http.Response, error) {
+	cassette := active()
+
+	switch s.mode {
+	case RecordMode:
+		if cassette == nil {
+			// requests made outside of a test (e.g. when building a shared client) aren't recorded
+			return s.sender.Do(req)
+		}
+
+		return s.record(cassette, req)
+
+	case ReplayMode:
+		if cassette == nil {
+			return nil, fmt.Errorf("replaying %s %s: no Cassette is active", req.Method, req.URL.String())
+		}
+
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return cassette.replay(req)
+	}
+
+	return s.sender.Do(req)
+}
+
+func (s *recordingSender) record(cassette *Cassette, req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading request body: %+v", err)
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		requestBody = body
+	}
+
+	resp, err := s.sender.Do(req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	var responseBody []byte
+	if resp.Body != nil {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("reading response body: %+v", err)
+		}
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		responseBody = body
+	}
+
+	cassette.record(req, requestBody, resp, responseBody)
+	return resp, nil
+}
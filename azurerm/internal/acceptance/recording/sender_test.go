@@ -0,0 +1,277 @@
+package recording
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const (
+	testSubscriptionId = "11111111-1111-1111-1111-111111111111"
+	testPassword       = "P@ssw0rd1234!"
+	testSasSignature   = "c2lnbmF0dXJl"
+)
+
+// testApi is a fake API which creates a resource via a Long Running Operation
+type testApi struct {
+	polls int
+}
+
+func (a *testApi) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", "secret")
+
+	switch {
+	case r.Method == http.MethodPut:
+		w.Header().Set("Azure-AsyncOperation", fmt.Sprintf("http://%s/subscriptions/%s/operations/op1?api-version=2020-01-01", r.Host, testSubscriptionId))
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"id":"/subscriptions/%s/resourceGroups/group1"}`, testSubscriptionId)
+
+	case strings.HasSuffix(r.URL.Path, "/operations/op1"):
+		a.polls++
+		status := "InProgress"
+		if a.polls >= 3 {
+			status = "Succeeded"
+		}
+		fmt.Fprintf(w, `{"status":%q}`, status)
+
+	case strings.HasSuffix(r.URL.Path, "/listKeys"):
+		fmt.Fprint(w, `{"keys":[{"keyName":"key1","value":"c3VwZXJzZWNyZXQ=","permissions":"FULL"}]}`)
+	}
+}
+
+func sendTestRequests(t *testing.T, client autorest.Client, baseUrl string) []string {
+	bodies := make([]string, 0)
+	send := func(method, path, requestBody string) {
+		req, err := http.NewRequest(method, fmt.Sprintf("%s/subscriptions/%s%s", baseUrl, testSubscriptionId, path), strings.NewReader(requestBody))
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		resp, err := client.Send(req.WithContext(context.TODO()))
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+		defer resp.Body.Close()
+
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("reading response: %+v", err)
+		}
+		bodies = append(bodies, string(body))
+	}
+
+	send(http.MethodPut, "/resourceGroups/group1?api-version=2020-01-01", fmt.Sprintf(`{"properties":{"administratorLogin":"admin","administratorLoginPassword":%q}}`, testPassword))
+	send(http.MethodGet, "/operations/op1?api-version=2020-01-01", "")
+	send(http.MethodGet, "/operations/op1?api-version=2020-01-01", "")
+	send(http.MethodGet, "/operations/op1?api-version=2020-01-01", "")
+	send(http.MethodPost, "/resourceGroups/group1/listKeys?api-version=2020-01-01", "{}")
+	send(http.MethodGet, fmt.Sprintf("/resourceGroups/group1/blob?sv=2019-12-12&sig=%s", testSasSignature), "")
+	return bodies
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "recording")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cassetteDirectory = dir
+	os.Setenv("ARM_SUBSCRIPTION_ID", testSubscriptionId)
+	defer os.Unsetenv("ARM_SUBSCRIPTION_ID")
+	defer os.Unsetenv(ModeEnvVar)
+
+	server := httptest.NewServer(&testApi{})
+
+	// first record the requests
+	os.Setenv(ModeEnvVar, string(RecordMode))
+	if err := Start(t.Name()); err != nil {
+		t.Fatalf("starting: %+v", err)
+	}
+	randomValue := Variable("RandomString", func() string { return "recorded" })
+
+	recordClient := autorest.NewClientWithUserAgent("")
+	recordClient.Sender = &http.Client{}
+	ConfigureClient(&recordClient)
+	recorded := sendTestRequests(t, recordClient, server.URL)
+
+	if err := Stop(); err != nil {
+		t.Fatalf("stopping: %+v", err)
+	}
+	server.Close()
+
+	// check the Cassette was sanitized
+	contents, err := ioutil.ReadFile(cassetteFilePath(t.Name()))
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	var cassette Cassette
+	if err := json.Unmarshal(contents, &cassette); err != nil {
+		t.Fatalf("parsing cassette: %+v", err)
+	}
+	for _, secret := range []string{testSubscriptionId, testPassword, testSasSignature, "c3VwZXJzZWNyZXQ=", "Set-Cookie", "Retry-After"} {
+		if strings.Contains(string(contents), secret) {
+			t.Fatalf("expected %q to be removed from the cassette but it wasn't", secret)
+		}
+	}
+
+	// the two identical polls of the Long Running Operation should be collapsed into one
+	if len(cassette.Interactions) != 5 {
+		t.Fatalf("expected 5 interactions but got %d", len(cassette.Interactions))
+	}
+
+	// the password within the request body is redacted, but the rest of the request body is kept
+	if body := cassette.Interactions[0].Request.Body; !strings.Contains(body, `"administratorLogin":"admin"`) || !strings.Contains(body, redactedValue) {
+		t.Fatalf("expected the password in the request body to be redacted but got %q", body)
+	}
+
+	// then replay them, with the server shut down
+	os.Setenv(ModeEnvVar, string(ReplayMode))
+	if err := Start(t.Name()); err != nil {
+		t.Fatalf("starting: %+v", err)
+	}
+	if v := Variable("RandomString", func() string { return "replayed" }); v != randomValue {
+		t.Fatalf("expected the variable to be %q but got %q", randomValue, v)
+	}
+
+	replayClient := autorest.NewClientWithUserAgent("")
+	replayClient.Sender = &http.Client{}
+	ConfigureClient(&replayClient)
+	replayed := sendTestRequests(t, replayClient, server.URL)
+
+	if err := Stop(); err != nil {
+		t.Fatalf("stopping: %+v", err)
+	}
+
+	// since the identical polling requests were collapsed, the operation completes a poll sooner when replaying
+	expected := []string{
+		recorded[0],
+		recorded[1],
+		recorded[3],
+		recorded[3],
+	}
+	for i, v := range expected {
+		if replayed[i] != v {
+			t.Fatalf("expected the replayed response %d to be %q but got %q", i, v, replayed[i])
+		}
+	}
+
+	// the keys are redacted
+	if !strings.Contains(replayed[4], redactedValue) || strings.Contains(replayed[4], "c3VwZXJzZWNyZXQ=") {
+		t.Fatalf("expected the replayed keys to be redacted but got %q", replayed[4])
+	}
+
+	// the request with a Shared Access Signature is matched even though the signature was redacted
+	if replayed[5] != recorded[5] {
+		t.Fatalf("expected the replayed response for the Shared Access Signature to be %q but got %q", recorded[5], replayed[5])
+	}
+}
+
+func TestReplayInOrder(t *testing.T) {
+	cassette := newCassette("test", "test.json", nil)
+
+	request := func(method string) *http.Request {
+		req, err := http.NewRequest(method, "https://management.azure.com/subscriptions/1234/resourceGroups/group1?api-version=2020-01-01", nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		return req
+	}
+	record := func(method, body string) {
+		cassette.record(request(method), nil, &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}, []byte(body))
+	}
+
+	// identical requests which aren't polling a Long Running Operation are all recorded, even when the response is the same
+	record(http.MethodGet, `{"tags":{"env":"A"}}`)
+	record(http.MethodGet, `{"tags":{"env":"A"}}`)
+	record(http.MethodPut, `{"tags":{"env":"B"}}`)
+	record(http.MethodGet, `{"tags":{"env":"B"}}`)
+	if len(cassette.Interactions) != 4 {
+		t.Fatalf("expected 4 interactions but got %d", len(cassette.Interactions))
+	}
+	cassette.used = make([]bool, len(cassette.Interactions))
+
+	expected := []struct {
+		method string
+		body   string
+	}{
+		{method: http.MethodGet, body: `{"tags":{"env":"A"}}`},
+		{method: http.MethodGet, body: `{"tags":{"env":"A"}}`},
+		{method: http.MethodPut, body: `{"tags":{"env":"B"}}`},
+		{method: http.MethodGet, body: `{"tags":{"env":"B"}}`},
+	}
+	for i, v := range expected {
+		resp, err := cassette.replay(request(v.method))
+		if err != nil {
+			t.Fatalf("replaying request %d: %+v", i, err)
+		}
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("reading response %d: %+v", i, err)
+		}
+		if string(body) != v.body {
+			t.Fatalf("expected the replayed response %d to be %q but got %q", i, v.body, string(body))
+		}
+	}
+
+	// once each of the recorded responses has been replayed, the request can't be replayed again
+	if _, err := cassette.replay(request(http.MethodGet)); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestRedactSecrets(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "",
+			expected: "",
+		},
+		{
+			input:    "not json",
+			expected: "not json",
+		},
+		{
+			// bodies without secrets are left as-is, including large numbers
+			input:    `{"name": "example", "sizeInBytes": 1099511627776}`,
+			expected: `{"name": "example", "sizeInBytes": 1099511627776}`,
+		},
+		{
+			input:    `{"properties":{"adminPassword":"secret","sizeInBytes":1099511627776}}`,
+			expected: fmt.Sprintf(`{"properties":{"adminPassword":%q,"sizeInBytes":1099511627776}}`, redactedValue),
+		},
+		{
+			input:    `{"url":"https://example.blob.core.windows.net/container?sv=2019-12-12&sig=abc%2B123&se=2021"}`,
+			expected: fmt.Sprintf(`{"url":"https://example.blob.core.windows.net/container?sv=2019-12-12&sig=%s&se=2021"}`, redactedValue),
+		},
+	}
+
+	for _, v := range testData {
+		if actual := redactSecrets(v.input); actual != v.expected {
+			t.Fatalf("expected %q to be redacted to %q but got %q", v.input, v.expected, actual)
+		}
+	}
+}
+
+func TestReplayUnknownRequest(t *testing.T) {
+	cassette := newCassette("test", "test.json", nil)
+
+	req, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/1234", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	if _, err := cassette.replay(req); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
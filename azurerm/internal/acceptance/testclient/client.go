@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
@@ -43,6 +44,7 @@ func Build() (*clients.Client, error) {
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:               config,
 			SkipProviderRegistration: true,
+			RecordingEnabled:         recording.Enabled(),
 			TerraformVersion:         os.Getenv("TERRAFORM_CORE_VERSION"),
 			Features:                 features.Default(),
 			StorageUseAzureAD:        false,
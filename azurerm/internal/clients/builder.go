@@ -10,10 +10,12 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
 type ClientBuilder struct {
@@ -21,10 +23,14 @@ type ClientBuilder struct {
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
 	PartnerId                   string
+	ReadOnly                    bool
+	RecordingEnabled            bool
 	SkipProviderRegistration    bool
 	StorageUseAzureAD           bool
 	TerraformVersion            string
 	Features                    features.UserFeatures
+	Tags                        tags.ProviderConfiguration
+	Throttling                  common.ThrottlingOptions
 }
 
 const azureStackEnvironmentError = `
@@ -55,14 +61,21 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, err
 	}
 
+	authConfig := *builder.AuthConfig
+	if builder.RecordingEnabled && authConfig.GetAuthenticatedObjectID != nil {
+		// the Object ID is looked up from Azure Active Directory, so needs to be recorded separately to be replayed offline
+		authConfig.GetAuthenticatedObjectID = recording.AuthenticatedObjectID(authConfig.GetAuthenticatedObjectID)
+	}
+
 	// client declarations:
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
+	account, err := NewResourceManagerAccount(ctx, authConfig, *env, builder.SkipProviderRegistration)
 	if err != nil {
 		return nil, fmt.Errorf("Error building account: %+v", err)
 	}
 
 	client := Client{
 		Account: account,
+		Tags:    builder.Tags,
 	}
 
 	oauthConfig, err := builder.AuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
@@ -123,12 +136,15 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		ResourceManagerEndpoint:     endpoint,
 		StorageAuthorizer:           storageAuth,
 		SynapseAuthorizer:           synapseAuth,
+		ReadOnly:                    builder.ReadOnly,
+		RecordingEnabled:            builder.RecordingEnabled,
 		SkipProviderReg:             builder.SkipProviderRegistration,
 		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
 		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
 		Environment:                 *env,
 		Features:                    builder.Features,
 		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		Throttling:                  builder.Throttling,
 	}
 
 	if err := client.Build(ctx, o); err != nil {
@@ -137,7 +153,7 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 
 	if features.EnhancedValidationEnabled() {
 		location.CacheSupportedLocations(ctx, env)
-		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
+		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient, env)
 	}
 
 	return &client, nil
@@ -0,0 +1,78 @@
+package clients
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+func TestBuildReplaysOffline(t *testing.T) {
+	// any request which isn't replayed from the Cassette is sent via a proxy which doesn't exist, and so fails
+	for variable, value := range map[string]string{
+		recording.ModeEnvVar: string(recording.ReplayMode),
+		"HTTP_PROXY":         "http://127.0.0.1:1",
+		"HTTPS_PROXY":        "http://127.0.0.1:1",
+		"NO_PROXY":           "",
+	} {
+		existing, exists := os.LookupEnv(variable)
+		os.Setenv(variable, value)
+		if exists {
+			defer os.Setenv(variable, existing)
+		} else {
+			defer os.Unsetenv(variable)
+		}
+	}
+
+	if err := recording.Start(t.Name()); err != nil {
+		t.Fatalf("starting: %+v", err)
+	}
+
+	builder := authentication.Builder{
+		SubscriptionID:           recording.Placeholders["ARM_SUBSCRIPTION_ID"],
+		ClientID:                 recording.Placeholders["ARM_CLIENT_ID"],
+		TenantID:                 recording.Placeholders["ARM_TENANT_ID"],
+		ClientSecret:             recording.Placeholders["ARM_CLIENT_SECRET"],
+		Environment:              "public",
+		SupportsClientSecretAuth: true,
+	}
+	config, err := builder.Build()
+	if err != nil {
+		t.Fatalf("building auth config: %+v", err)
+	}
+
+	// requests sent via the proxy are retried, so fail fast rather than waiting for the test to time out
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	client, err := Build(ctx, ClientBuilder{
+		AuthConfig:               config,
+		RecordingEnabled:         true,
+		SkipProviderRegistration: true,
+		Features:                 features.Default(),
+	})
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	// the Object ID is looked up from Azure Active Directory when recording, so is stored in the Cassette
+	if expected := "00000000-0000-0000-0000-000000000004"; client.Account.ObjectId != expected {
+		t.Fatalf("expected the Object ID to be %q but got %q", expected, client.Account.ObjectId)
+	}
+
+	group, err := client.Resource.GroupsClient.Get(ctx, "group1")
+	if err != nil {
+		t.Fatalf("retrieving Resource Group: %+v", err)
+	}
+	if group.Location == nil || *group.Location != "westeurope" {
+		t.Fatalf("expected the recorded Resource Group to be returned but got %+v", group)
+	}
+
+	if err := recording.Stop(); err != nil {
+		t.Fatalf("stopping: %+v", err)
+	}
+}
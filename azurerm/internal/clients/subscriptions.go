@@ -0,0 +1,119 @@
+package clients
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+// subscriptionClients contains the Clients for each Subscription, which are built (using the same
+// credentials as the Provider) the first time a resource within that Subscription is used
+type subscriptionClients struct {
+	lock    *sync.Mutex
+	options common.ClientOptions
+	clients map[string]*Client
+}
+
+func newSubscriptionClients(options common.ClientOptions, client *Client) *subscriptionClients {
+	return &subscriptionClients{
+		lock:    &sync.Mutex{},
+		options: options,
+		clients: map[string]*Client{
+			strings.ToLower(options.SubscriptionId): client,
+		},
+	}
+}
+
+// ForSubscription returns a Client for the specified Subscription, using the same credentials as this Client
+func (client *Client) ForSubscription(subscriptionId string) (*Client, error) {
+	if subscriptionId == "" || client.subscriptions == nil || strings.EqualFold(subscriptionId, client.Account.SubscriptionId) {
+		return client, nil
+	}
+
+	return client.subscriptions.forSubscription(client, subscriptionId)
+}
+
+// ForResourceID returns a Client for the Subscription which the specified Resource ID is within - or
+// this Client when the Resource isn't bound to a Subscription (or the Resource ID can't be parsed)
+func (client *Client) ForResourceID(id string) (*Client, error) {
+	if !isSubscriptionBoundResourceID(id) {
+		return client, nil
+	}
+
+	return client.ForSubscription(subscriptionIdFromResourceID(id))
+}
+
+func (s *subscriptionClients) forSubscription(client *Client, subscriptionId string) (*Client, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	if existing, ok := s.clients[key]; ok {
+		return existing, nil
+	}
+
+	log.Printf("[DEBUG] Building the Clients for Subscription %q..", subscriptionId)
+
+	options := s.options
+	options.SubscriptionId = subscriptionId
+
+	account := *client.Account
+	account.SubscriptionId = subscriptionId
+
+	subscriptionClient := &Client{
+		Account:       &account,
+		Tags:          client.Tags,
+		subscriptions: s,
+	}
+	if err := subscriptionClient.Build(client.StopContext, &options); err != nil {
+		return nil, fmt.Errorf("building the Clients for Subscription %q: %+v", subscriptionId, err)
+	}
+
+	s.clients[key] = subscriptionClient
+	return subscriptionClient, nil
+}
+
+// scopedResourceProviders are the Resource Providers whose Resources (such as Role Assignments, Policy
+// Assignments and Management Locks) are assigned to a scope, the clients for which aren't bound to a Subscription
+var scopedResourceProviders = map[string]struct{}{
+	"microsoft.authorization":  {},
+	"microsoft.management":     {},
+	"microsoft.policyinsights": {},
+}
+
+// isSubscriptionBoundResourceID returns whether the specified Resource ID is for a Resource which is managed using
+// the clients for it's Subscription - that is either a Resource Group, or a Resource within a Resource Group which
+// isn't assigned to a scope (e.g. a Role Assignment) or an extension of another Resource (e.g. a Diagnostic Setting)
+func isSubscriptionBoundResourceID(id string) bool {
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(segments) < 4 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") {
+		return false
+	}
+
+	// e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}`
+	if len(segments) == 4 {
+		return true
+	}
+
+	// e.g. `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/{resourceProvider}/...`
+	if len(segments) < 6 || !strings.EqualFold(segments[4], "providers") || strings.Count(strings.ToLower(id), "/providers/") > 1 {
+		return false
+	}
+
+	_, scoped := scopedResourceProviders[strings.ToLower(segments[5])]
+	return !scoped
+}
+
+// subscriptionIdFromResourceID returns the Subscription ID from a Resource ID in the
+// format `/subscriptions/{subscriptionId}/...` - or an empty string if it isn't
+func subscriptionIdFromResourceID(id string) string {
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return ""
+	}
+
+	return segments[1]
+}
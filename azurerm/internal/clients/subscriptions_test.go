@@ -0,0 +1,111 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func TestSubscriptionIdFromResourceID(t *testing.T) {
+	testData := map[string]string{
+		"": "",
+		"/providers/Microsoft.Management/managementGroups/group1":                                           "",
+		"/subscriptions/11111111-1111-1111-1111-111111111111":                                               "11111111-1111-1111-1111-111111111111",
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1":                         "11111111-1111-1111-1111-111111111111",
+		"/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Foo": "11111111-1111-1111-1111-111111111111",
+	}
+
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Testing %q..", input)
+
+		if actual := subscriptionIdFromResourceID(input); actual != expected {
+			t.Fatalf("Expected %q but got %q", expected, actual)
+		}
+	}
+}
+
+func TestIsSubscriptionBoundResourceID(t *testing.T) {
+	testData := map[string]bool{
+		"": false,
+		"/providers/Microsoft.Management/managementGroups/group1": false,
+		"/subscriptions/11111111-1111-1111-1111-111111111111":     false,
+		"/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000":                                         false,
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1":                                                                                                          true,
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/virtualNetworkPeerings/peering1":                     true,
+		"/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111/RESOURCEGROUPS/group1/PROVIDERS/Microsoft.Network/privateDnsZones/zone1/virtualNetworkLinks/link1":                              true,
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000":                   false,
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Authorization/policyAssignments/assignment1":                                          false,
+		"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/providers/Microsoft.Authorization/roleAssignments/1": false,
+	}
+
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Testing %q..", input)
+
+		if actual := isSubscriptionBoundResourceID(input); actual != expected {
+			t.Fatalf("Expected %t but got %t", expected, actual)
+		}
+	}
+}
+
+func TestForSubscription(t *testing.T) {
+	primarySubscriptionId := "11111111-1111-1111-1111-111111111111"
+	otherSubscriptionId := "22222222-2222-2222-2222-222222222222"
+
+	client := &Client{
+		Account: &ResourceManagerAccount{
+			SubscriptionId: primarySubscriptionId,
+		},
+	}
+	if err := client.Build(context.TODO(), &common.ClientOptions{SubscriptionId: primarySubscriptionId}); err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	same, err := client.ForResourceID("/subscriptions/" + primarySubscriptionId + "/resourceGroups/group1")
+	if err != nil {
+		t.Fatalf("retrieving client: %+v", err)
+	}
+	if same != client {
+		t.Fatalf("expected the same client to be returned for the Provider's Subscription")
+	}
+
+	other, err := client.ForResourceID("/subscriptions/" + otherSubscriptionId + "/resourceGroups/group1")
+	if err != nil {
+		t.Fatalf("retrieving client: %+v", err)
+	}
+	if other == client || other.Account.SubscriptionId != otherSubscriptionId {
+		t.Fatalf("expected a client for Subscription %q but got one for %q", otherSubscriptionId, other.Account.SubscriptionId)
+	}
+	if other.Resource.GroupsClient.SubscriptionID != otherSubscriptionId {
+		t.Fatalf("expected the service clients to use Subscription %q but got %q", otherSubscriptionId, other.Resource.GroupsClient.SubscriptionID)
+	}
+	if client.Account.SubscriptionId != primarySubscriptionId {
+		t.Fatalf("expected the Provider's Subscription to be unchanged but got %q", client.Account.SubscriptionId)
+	}
+
+	// resources which are assigned to a scope within another Subscription use the Provider's clients
+	scoped, err := client.ForResourceID("/subscriptions/" + otherSubscriptionId + "/resourceGroups/group1/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000")
+	if err != nil {
+		t.Fatalf("retrieving client: %+v", err)
+	}
+	if scoped != client {
+		t.Fatalf("expected the Provider's client to be returned for a Role Assignment")
+	}
+
+	// the clients are cached and shared
+	again, err := client.ForSubscription(otherSubscriptionId)
+	if err != nil {
+		t.Fatalf("retrieving client: %+v", err)
+	}
+	if again != other {
+		t.Fatalf("expected the client for Subscription %q to be cached", otherSubscriptionId)
+	}
+
+	primary, err := other.ForSubscription(primarySubscriptionId)
+	if err != nil {
+		t.Fatalf("retrieving client: %+v", err)
+	}
+	if primary != client {
+		t.Fatalf("expected the client for the Provider's Subscription to be returned")
+	}
+}
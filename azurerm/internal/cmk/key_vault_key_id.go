@@ -0,0 +1,161 @@
+package cmk
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+const keysNestedItemType = "keys"
+
+var (
+	keyNameRegex    = regexp.MustCompile(`^[0-9a-zA-Z-]{1,127}$`)
+	keyVersionRegex = regexp.MustCompile(`^[0-9a-fA-F]{32}$`)
+)
+
+// KeyVaultKeyId is the ID of a Key within either a Key Vault or a Managed HSM, which is
+// used as a Customer Managed Key
+type KeyVaultKeyId struct {
+	// KeyVaultBaseUrl is the Base URL of the Key Vault or Managed HSM, for example `https://example.vault.azure.net/`
+	KeyVaultBaseUrl string
+
+	// Name is the name of the Key
+	Name string
+
+	// Version is the version of the Key - or empty when this is a Versionless ID
+	Version string
+}
+
+func NewKeyVaultKeyID(keyVaultBaseUrl, name, version string) (*KeyVaultKeyId, error) {
+	keyVaultUrl, err := url.Parse(keyVaultBaseUrl)
+	if err != nil || keyVaultBaseUrl == "" {
+		return nil, fmt.Errorf("parsing Key Vault Base URL %q: %+v", keyVaultBaseUrl, err)
+	}
+	if keyVaultUrl.Scheme == "" || keyVaultUrl.Host == "" {
+		return nil, fmt.Errorf("expected the Key Vault Base URL %q to contain a scheme and host", keyVaultBaseUrl)
+	}
+
+	return &KeyVaultKeyId{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", keyVaultUrl.Scheme, hostWithoutPort(keyVaultUrl.Host)),
+		Name:            name,
+		Version:         version,
+	}, nil
+}
+
+// ID returns the ID of this Key - which includes the Version when it's specified
+func (id KeyVaultKeyId) ID() string {
+	// example: https://example.vault.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217
+	if id.Version == "" {
+		return id.VersionlessID()
+	}
+
+	return fmt.Sprintf("%s/%s", id.VersionlessID(), id.Version)
+}
+
+// VersionlessID returns the ID of this Key without the Version
+func (id KeyVaultKeyId) VersionlessID() string {
+	// example: https://example.vault.azure.net/keys/key1
+	return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), keysNestedItemType, id.Name)
+}
+
+// IsManagedHSM returns whether this Key is within a Managed HSM rather than a Key Vault
+func (id KeyVaultKeyId) IsManagedHSM() bool {
+	return IsManagedHSMBaseUrl(id.KeyVaultBaseUrl)
+}
+
+// IsManagedHSMBaseUrl returns whether the specified Base URL is for a Managed HSM rather than a Key Vault
+func IsManagedHSMBaseUrl(baseUrl string) bool {
+	keyVaultUrl, err := url.Parse(baseUrl)
+	if err != nil {
+		return false
+	}
+
+	// e.g. `example.managedhsm.azure.net`
+	segments := strings.Split(strings.ToLower(keyVaultUrl.Host), ".")
+	return len(segments) > 2 && segments[1] == "managedhsm"
+}
+
+// ParseKeyVaultKeyID parses a Key Vault (or Managed HSM) Key ID which must contain a Version
+func ParseKeyVaultKeyID(input string) (*KeyVaultKeyId, error) {
+	id, err := parseKeyVaultKeyID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id.Version == "" {
+		return nil, fmt.Errorf("expected a versioned Key ID but no version was found in %q", input)
+	}
+
+	return id, nil
+}
+
+// ParseVersionlessKeyVaultKeyID parses a Key Vault (or Managed HSM) Key ID which mustn't contain a Version
+func ParseVersionlessKeyVaultKeyID(input string) (*KeyVaultKeyId, error) {
+	id, err := parseKeyVaultKeyID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if id.Version != "" {
+		return nil, fmt.Errorf("expected a versionless Key ID but a version was found in %q", input)
+	}
+
+	return id, nil
+}
+
+// ParseOptionallyVersionedKeyVaultKeyID parses a Key Vault (or Managed HSM) Key ID which can optionally contain a Version
+func ParseOptionallyVersionedKeyVaultKeyID(input string) (*KeyVaultKeyId, error) {
+	return parseKeyVaultKeyID(input)
+}
+
+func parseKeyVaultKeyID(input string) (*KeyVaultKeyId, error) {
+	// versioned example: https://example.vault.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217
+	// versionless example: https://example.managedhsm.azure.net/keys/key1
+	keyUrl, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Key ID %q: %+v", input, err)
+	}
+
+	if !strings.EqualFold(keyUrl.Scheme, "https") {
+		return nil, fmt.Errorf("expected the Key ID %q to use the `https` scheme but got %q", input, keyUrl.Scheme)
+	}
+	host := hostWithoutPort(keyUrl.Host)
+	if host == "" {
+		return nil, fmt.Errorf("expected the Key ID %q to contain a host", input)
+	}
+
+	path := strings.TrimSuffix(strings.TrimPrefix(keyUrl.Path, "/"), "/")
+	segments := strings.Split(path, "/")
+	if len(segments) != 2 && len(segments) != 3 {
+		return nil, fmt.Errorf("expected the Key ID %q to contain 2 or 3 segments but got %d", input, len(segments))
+	}
+
+	if segments[0] != keysNestedItemType {
+		return nil, fmt.Errorf("expected the Key ID %q to be for a Key (`%s`) but got %q", input, keysNestedItemType, segments[0])
+	}
+
+	name := segments[1]
+	if !keyNameRegex.MatchString(name) {
+		return nil, fmt.Errorf("expected the Key Name %q to be between 1 and 127 characters and only contain alphanumeric characters and dashes", name)
+	}
+
+	version := ""
+	if len(segments) == 3 {
+		version = segments[2]
+		if !keyVersionRegex.MatchString(version) {
+			return nil, fmt.Errorf("expected the Key Version %q to be a 32 character hexadecimal string", version)
+		}
+	}
+
+	return &KeyVaultKeyId{
+		KeyVaultBaseUrl: fmt.Sprintf("%s://%s/", keyUrl.Scheme, host),
+		Name:            name,
+		Version:         version,
+	}, nil
+}
+
+// hostWithoutPort returns the host without the port, since some API's (e.g. Log Analytics) return this
+func hostWithoutPort(input string) string {
+	return strings.Split(input, ":")[0]
+}
@@ -0,0 +1,150 @@
+package cmk
+
+import (
+	"testing"
+)
+
+func TestParseOptionallyVersionedKeyVaultKeyID(t *testing.T) {
+	testData := []struct {
+		Input        string
+		Expected     *KeyVaultKeyId
+		IsManagedHSM bool
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// not a url
+			Input: "pandas",
+		},
+		{
+			// http
+			Input: "http://example.vault.azure.net/keys/key1",
+		},
+		{
+			// missing the name
+			Input: "https://example.vault.azure.net/keys",
+		},
+		{
+			// secret rather than a key
+			Input: "https://example.vault.azure.net/secrets/secret1",
+		},
+		{
+			// invalid name
+			Input: "https://example.vault.azure.net/keys/key_1",
+		},
+		{
+			// invalid version
+			Input: "https://example.vault.azure.net/keys/key1/version1",
+		},
+		{
+			// too many segments
+			Input: "https://example.vault.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217/extra",
+		},
+		{
+			// versionless
+			Input: "https://example.vault.azure.net/keys/key1",
+			Expected: &KeyVaultKeyId{
+				KeyVaultBaseUrl: "https://example.vault.azure.net/",
+				Name:            "key1",
+			},
+		},
+		{
+			// versionless with a trailing slash
+			Input: "https://example.vault.azure.net/keys/key1/",
+			Expected: &KeyVaultKeyId{
+				KeyVaultBaseUrl: "https://example.vault.azure.net/",
+				Name:            "key1",
+			},
+		},
+		{
+			// versioned
+			Input: "https://example.vault.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217",
+			Expected: &KeyVaultKeyId{
+				KeyVaultBaseUrl: "https://example.vault.azure.net/",
+				Name:            "key1",
+				Version:         "fdf067c93bbb4b22bff4d8b7a9a56217",
+			},
+		},
+		{
+			// versioned with a port
+			Input: "https://example.vault.azure.net:443/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217",
+			Expected: &KeyVaultKeyId{
+				KeyVaultBaseUrl: "https://example.vault.azure.net/",
+				Name:            "key1",
+				Version:         "fdf067c93bbb4b22bff4d8b7a9a56217",
+			},
+		},
+		{
+			// managed hsm
+			Input: "https://example.managedhsm.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217",
+			Expected: &KeyVaultKeyId{
+				KeyVaultBaseUrl: "https://example.managedhsm.azure.net/",
+				Name:            "key1",
+				Version:         "fdf067c93bbb4b22bff4d8b7a9a56217",
+			},
+			IsManagedHSM: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual, err := ParseOptionallyVersionedKeyVaultKeyID(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but got %+v", actual)
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+		if actual.IsManagedHSM() != v.IsManagedHSM {
+			t.Fatalf("Expected IsManagedHSM to be %t but got %t", v.IsManagedHSM, actual.IsManagedHSM())
+		}
+	}
+}
+
+func TestParseKeyVaultKeyIDRequiresVersion(t *testing.T) {
+	if _, err := ParseKeyVaultKeyID("https://example.vault.azure.net/keys/key1"); err == nil {
+		t.Fatalf("Expected an error for a versionless ID but didn't get one")
+	}
+
+	if _, err := ParseKeyVaultKeyID("https://example.vault.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217"); err != nil {
+		t.Fatalf("Expected no error for a versioned ID but got: %+v", err)
+	}
+}
+
+func TestParseVersionlessKeyVaultKeyIDRejectsVersion(t *testing.T) {
+	if _, err := ParseVersionlessKeyVaultKeyID("https://example.vault.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217"); err == nil {
+		t.Fatalf("Expected an error for a versioned ID but didn't get one")
+	}
+
+	if _, err := ParseVersionlessKeyVaultKeyID("https://example.vault.azure.net/keys/key1"); err != nil {
+		t.Fatalf("Expected no error for a versionless ID but got: %+v", err)
+	}
+}
+
+func TestKeyVaultKeyIDFormatter(t *testing.T) {
+	id, err := NewKeyVaultKeyID("https://example.vault.azure.net:443", "key1", "fdf067c93bbb4b22bff4d8b7a9a56217")
+	if err != nil {
+		t.Fatalf("building ID: %+v", err)
+	}
+
+	expected := "https://example.vault.azure.net/keys/key1/fdf067c93bbb4b22bff4d8b7a9a56217"
+	if actual := id.ID(); actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+
+	expected = "https://example.vault.azure.net/keys/key1"
+	if actual := id.VersionlessID(); actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
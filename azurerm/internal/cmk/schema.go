@@ -0,0 +1,146 @@
+package cmk
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	msivalidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+)
+
+// CustomerManagedKey is the Customer Managed Key used to encrypt a Resource
+type CustomerManagedKey struct {
+	// KeyVaultKeyId is the Key which should be used - when this is Versionless
+	// the latest version of the Key is used (e.g. the Key is automatically rotated)
+	KeyVaultKeyId KeyVaultKeyId
+
+	// CurrentKeyVaultKeyId is the (versioned) Key which is currently being used, when this is known
+	CurrentKeyVaultKeyId *KeyVaultKeyId
+
+	// UserAssignedIdentityId is the ID of the User Assigned Identity used to access the Key Vault, if any
+	UserAssignedIdentityId *string
+}
+
+// AutoRotationEnabled returns whether the latest version of the Key is used, which is the case when
+// the Key Vault Key ID is Versionless - in which case the Key is rotated by the Service when a new
+// version of the Key is created, rather than requiring the Key Vault Key ID to be updated
+func (c CustomerManagedKey) AutoRotationEnabled() bool {
+	return c.KeyVaultKeyId.Version == ""
+}
+
+// Schema returns the Schema for the `customer_managed_key` block
+func Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault_key_id": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: OptionallyVersionedKeyVaultKeyID,
+				},
+
+				"user_assigned_identity_id": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: msivalidate.UserAssignedIdentityID,
+				},
+
+				"current_key_vault_key_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// SchemaDataSource returns the Schema for the `customer_managed_key` block within a Data Source
+func SchemaDataSource() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_vault_key_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"user_assigned_identity_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"current_key_vault_key_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// Expand expands the `customer_managed_key` block - returning nil if it's not specified
+func Expand(input []interface{}) (*CustomerManagedKey, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	keyId, err := ParseOptionallyVersionedKeyVaultKeyID(raw["key_vault_key_id"].(string))
+	if err != nil {
+		return nil, err
+	}
+
+	output := CustomerManagedKey{
+		KeyVaultKeyId: *keyId,
+	}
+	if v, ok := raw["user_assigned_identity_id"].(string); ok && v != "" {
+		output.UserAssignedIdentityId = &v
+	}
+
+	return &output, nil
+}
+
+// Flatten flattens the Customer Managed Key returned from the API into the `customer_managed_key` block.
+//
+// Since some API's return the (versioned) Key which is currently in use even when the latest version of
+// the Key should be used, the existing `customer_managed_key` block is used to determine whether the
+// Versionless Key Vault Key ID was configured (e.g. the Key should be automatically rotated) - in which
+// case the Versionless Key Vault Key ID is returned, with the current version in `current_key_vault_key_id`
+func Flatten(input *CustomerManagedKey, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	keyId := input.KeyVaultKeyId
+	currentKeyId := ""
+	if input.CurrentKeyVaultKeyId != nil {
+		currentKeyId = input.CurrentKeyVaultKeyId.ID()
+	} else if keyId.Version != "" {
+		currentKeyId = keyId.ID()
+	}
+
+	if existingConfig, err := Expand(existing); err == nil && existingConfig != nil {
+		configuredKeyId := existingConfig.KeyVaultKeyId
+		if configuredKeyId.Version == "" && strings.EqualFold(configuredKeyId.VersionlessID(), keyId.VersionlessID()) {
+			keyId = configuredKeyId
+		}
+	}
+
+	userAssignedIdentityId := ""
+	if input.UserAssignedIdentityId != nil {
+		userAssignedIdentityId = *input.UserAssignedIdentityId
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":          keyId.ID(),
+			"user_assigned_identity_id": userAssignedIdentityId,
+			"current_key_vault_key_id":  currentKeyId,
+		},
+	}
+}
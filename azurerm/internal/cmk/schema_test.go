@@ -0,0 +1,108 @@
+package cmk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExpand(t *testing.T) {
+	actual, err := Expand([]interface{}{
+		map[string]interface{}{
+			"key_vault_key_id":          "https://example.vault.azure.net/keys/key1",
+			"user_assigned_identity_id": "",
+		},
+	})
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	if !actual.AutoRotationEnabled() {
+		t.Fatalf("expected Auto Rotation to be enabled for a Versionless Key ID")
+	}
+	if actual.UserAssignedIdentityId != nil {
+		t.Fatalf("expected no User Assigned Identity but got %q", *actual.UserAssignedIdentityId)
+	}
+
+	if actual, err := Expand([]interface{}{}); err != nil || actual != nil {
+		t.Fatalf("expected nothing for an empty block but got %+v / %+v", actual, err)
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	versioned := KeyVaultKeyId{
+		KeyVaultBaseUrl: "https://example.vault.azure.net/",
+		Name:            "key1",
+		Version:         "fdf067c93bbb4b22bff4d8b7a9a56217",
+	}
+
+	testData := []struct {
+		Name     string
+		Input    *CustomerManagedKey
+		Existing []interface{}
+		Expected []interface{}
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "Versioned",
+			Input: &CustomerManagedKey{
+				KeyVaultKeyId: versioned,
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"key_vault_key_id":          versioned.ID(),
+					"user_assigned_identity_id": "",
+					"current_key_vault_key_id":  versioned.ID(),
+				},
+			},
+		},
+		{
+			Name: "Versioned Key returned when Versionless Key configured",
+			Input: &CustomerManagedKey{
+				KeyVaultKeyId: versioned,
+			},
+			Existing: []interface{}{
+				map[string]interface{}{
+					"key_vault_key_id": "https://EXAMPLE.vault.azure.net/keys/key1",
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"key_vault_key_id":          "https://EXAMPLE.vault.azure.net/keys/key1",
+					"user_assigned_identity_id": "",
+					"current_key_vault_key_id":  versioned.ID(),
+				},
+			},
+		},
+		{
+			Name: "Versioned Key returned when a different Versionless Key configured",
+			Input: &CustomerManagedKey{
+				KeyVaultKeyId: versioned,
+			},
+			Existing: []interface{}{
+				map[string]interface{}{
+					"key_vault_key_id": "https://example.vault.azure.net/keys/key2",
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"key_vault_key_id":          versioned.ID(),
+					"user_assigned_identity_id": "",
+					"current_key_vault_key_id":  versioned.ID(),
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := Flatten(v.Input, v.Existing)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package cmk
+
+import (
+	"fmt"
+)
+
+// KeyVaultKeyID validates that the specified value is a Key Vault (or Managed HSM) Key ID containing a Version
+func KeyVaultKeyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateKeyVaultKeyID(i, k, ParseKeyVaultKeyID)
+}
+
+// VersionlessKeyVaultKeyID validates that the specified value is a Key Vault (or Managed HSM) Key ID without a Version
+func VersionlessKeyVaultKeyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateKeyVaultKeyID(i, k, ParseVersionlessKeyVaultKeyID)
+}
+
+// OptionallyVersionedKeyVaultKeyID validates that the specified value is a Key Vault (or Managed HSM) Key ID,
+// which can optionally contain a Version
+func OptionallyVersionedKeyVaultKeyID(i interface{}, k string) (warnings []string, errors []error) {
+	return validateKeyVaultKeyID(i, k, ParseOptionallyVersionedKeyVaultKeyID)
+}
+
+func validateKeyVaultKeyID(i interface{}, k string, parser func(input string) (*KeyVaultKeyId, error)) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+		return
+	}
+
+	if v == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	if _, err := parser(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %+v", k, err))
+	}
+
+	return
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
@@ -27,22 +28,40 @@ type ClientOptions struct {
 	StorageAuthorizer         autorest.Authorizer
 	SynapseAuthorizer         autorest.Authorizer
 
+	ReadOnly                    bool
+	RecordingEnabled            bool
 	SkipProviderReg             bool
 	DisableCorrelationRequestID bool
 	DisableTerraformPartnerID   bool
 	Environment                 azure.Environment
 	Features                    features.UserFeatures
 	StorageUseAzureAD           bool
+	Throttling                  ThrottlingOptions
 }
 
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
-	c.SkipResourceProviderRegistration = o.SkipProviderReg
+	c.Sender = throttlerForSubscription(o.SubscriptionId, o.ResourceManagerEndpoint, o.Throttling).sender(sender.BuildSender("AzureRM"))
+	c.SkipResourceProviderRegistration = o.SkipProviderReg || o.ReadOnly
+
+	inspectors := make([]autorest.PrepareDecorator, 0)
+	if o.ReadOnly {
+		inspectors = append(inspectors, ReadOnlyRequestInspector(o.ResourceManagerEndpoint))
+	}
 	if !o.DisableCorrelationRequestID {
-		c.RequestInspector = withCorrelationRequestID(correlationRequestID())
+		inspectors = append(inspectors, withCorrelationRequestID(correlationRequestID()))
+	}
+	if len(inspectors) > 0 {
+		c.RequestInspector = func(p autorest.Preparer) autorest.Preparer {
+			return autorest.DecoratePreparer(p, inspectors...)
+		}
+	}
+
+	// when running the acceptance tests requests can be recorded and then replayed offline
+	if o.RecordingEnabled {
+		recording.ConfigureClient(c)
 	}
 }
 
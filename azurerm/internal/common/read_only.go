@@ -0,0 +1,122 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ReadOnlyRequestInspector returns a PrepareDecorator which rejects any request which could modify
+// a resource - meaning only GET and HEAD requests and POST requests to Resource Manager `list` actions
+// (e.g. `listKeys`) are allowed. This is used when the Provider is running in read-only mode.
+func ReadOnlyRequestInspector(resourceManagerEndpoint string) autorest.PrepareDecorator {
+	host := resourceManagerEndpoint
+	if parsed, err := url.Parse(resourceManagerEndpoint); err == nil && parsed.Host != "" {
+		host = parsed.Host
+	}
+
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			if !isReadOnlyRequest(r, host) {
+				return r, fmt.Errorf("the Provider is running in read-only mode (`read_only` is enabled) so the %s request to %q was blocked, since this could modify a resource", r.Method, r.URL.Path)
+			}
+
+			return r, nil
+		})
+	}
+}
+
+// readOnlyListActions are the Resource Manager actions which list information without modifying anything
+// (for example `listKeys` or `listConnectionStrings`), which are sent as POST requests
+var readOnlyListActions = map[string]struct{}{
+	"list":                                   {},
+	"listaccountsas":                         {},
+	"listadmincredentials":                   {},
+	"listadminkeys":                          {},
+	"listagreements":                         {},
+	"listapplicable":                         {},
+	"listapplicableschedules":                {},
+	"listauthkeys":                           {},
+	"listauthserviceproviders":               {},
+	"listbackups":                            {},
+	"listbuildsourceuploadurl":               {},
+	"listcallbackurl":                        {},
+	"listchannelwithkeys":                    {},
+	"listclusteradmincredential":             {},
+	"listclustermonitoringusercredential":    {},
+	"listclusterusercredential":              {},
+	"listconnectiondetails":                  {},
+	"listconnectioninfo":                     {},
+	"listconnectionstrings":                  {},
+	"listcontainersas":                       {},
+	"listcontentcallbackurl":                 {},
+	"listcontentkeys":                        {},
+	"listcredential":                         {},
+	"listcredentials":                        {},
+	"listdeployments":                        {},
+	"listdetails":                            {},
+	"listdomainrecommendations":              {},
+	"listedgepolicies":                       {},
+	"listevents":                             {},
+	"listexpressiontraces":                   {},
+	"listfollowerdatabases":                  {},
+	"listfunctionappsettings":                {},
+	"listgatewaystatus":                      {},
+	"listhosts":                              {},
+	"listkeys":                               {},
+	"listkeyvalue":                           {},
+	"listkeyvaultkeys":                       {},
+	"listlanguageextensions":                 {},
+	"listlogsasurl":                          {},
+	"listnodes":                              {},
+	"listpaths":                              {},
+	"listprincipals":                         {},
+	"listquerykeys":                          {},
+	"listsastokens":                          {},
+	"listsecrets":                            {},
+	"listservicesas":                         {},
+	"listsitesassignedtohostname":            {},
+	"listsourcesharesynchronizationsettings": {},
+	"liststreaminglocators":                  {},
+	"listswagger":                            {},
+	"listsyncfunctiontriggerstatus":          {},
+	"listsynchronizationdetails":             {},
+	"listsynchronizations":                   {},
+	"listsyncstatus":                         {},
+	"listtestkeys":                           {},
+	"listupgradenotifications":               {},
+	"listusages":                             {},
+	"listusers":                              {},
+	"listvalue":                              {},
+	"listvhds":                               {},
+	"listvolumes":                            {},
+	"listwithsecrets":                        {},
+}
+
+func isReadOnlyRequest(r *http.Request, resourceManagerHost string) bool {
+	switch strings.ToUpper(r.Method) {
+	case http.MethodGet, http.MethodHead:
+		return true
+
+	case http.MethodPost:
+		// only Resource Manager actions are allowed, since a POST to a Data Plane API (for example inserting
+		// an Entity into a Storage Table) can modify a resource regardless of the path
+		if !strings.EqualFold(r.URL.Host, resourceManagerHost) || !strings.Contains(strings.ToLower(r.URL.Path), "/providers/") {
+			return false
+		}
+
+		_, ok := readOnlyListActions[strings.ToLower(path.Base(r.URL.Path))]
+		return ok
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestReadOnlyRequestInspector(t *testing.T) {
+	testData := []struct {
+		Method   string
+		Url      string
+		Expected bool
+	}{
+		{
+			Method:   http.MethodGet,
+			Url:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodHead,
+			Url:      "https://account1.blob.core.windows.net/container1/blob1",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Storage/storageAccounts/account1/listKeys",
+			Expected: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Compute/register",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Web/sites/site1/listsyncfunctiontriggerstatus",
+			Expected: true,
+		},
+		{
+			// an unknown action, which happens to start with `list`
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1/listenAndUpdate",
+			Expected: false,
+		},
+		{
+			// a `list` action outside of a Resource Provider
+			Method:   http.MethodPost,
+			Url:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/listKeys",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPost,
+			Url:      "https://vault1.vault.azure.net/keys/key1/encrypt",
+			Expected: false,
+		},
+		{
+			// inserting an Entity into a Storage Table named `listitems`
+			Method:   http.MethodPost,
+			Url:      "https://account1.table.core.windows.net/listitems",
+			Expected: false,
+		},
+		{
+			// a `list` action on a Data Plane API
+			Method:   http.MethodPost,
+			Url:      "https://account1.table.core.windows.net/providers/Microsoft.Foo/listKeys",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPut,
+			Url:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodPatch,
+			Url:      "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			Expected: false,
+		},
+		{
+			Method:   http.MethodDelete,
+			Url:      "https://vault1.vault.azure.net/secrets/secret1",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s %s..", v.Method, v.Url)
+
+		req, err := http.NewRequest(v.Method, v.Url, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+
+		_, err = autorest.Prepare(req, ReadOnlyRequestInspector("https://management.azure.com/"))
+		if v.Expected && err != nil {
+			t.Fatalf("expected the request to be allowed but got: %+v", err)
+		}
+		if !v.Expected && err == nil {
+			t.Fatalf("expected the request to be rejected but it wasn't")
+		}
+	}
+}
@@ -0,0 +1,369 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ThrottlingOptions configures the client-side throttling of requests sent to Azure Resource Manager,
+// which is shared across all of the clients for a Subscription
+type ThrottlingOptions struct {
+	// RequestsPerSecond is the rate at which the token bucket is refilled, where 0 disables
+	// client-side rate limiting (requests are then only delayed when ARM is throttling)
+	RequestsPerSecond int
+
+	// Burst is the maximum number of requests which can be sent at once
+	Burst int
+
+	// MaxRetries is the number of times a request which is throttled by ARM (e.g. returns a 429)
+	// is retried, once the delay specified in the `Retry-After` header has passed - which includes
+	// the retries made by autorest for the same request
+	MaxRetries int
+}
+
+// DefaultThrottlingOptions returns the ThrottlingOptions used when these aren't configured
+func DefaultThrottlingOptions() ThrottlingOptions {
+	// these match the size and refill rate of ARM's per-Subscription token bucket
+	return ThrottlingOptions{
+		RequestsPerSecond: 25,
+		Burst:             250,
+		MaxRetries:        10,
+	}
+}
+
+const (
+	// remainingQuotaThreshold is the number of remaining requests (as returned in the
+	// `x-ms-ratelimit-remaining-subscription-*` headers) below which requests are delayed
+	remainingQuotaThreshold = 50
+
+	// maxQuotaDelay is the delay used once the remaining quota has been exhausted
+	maxQuotaDelay = 10 * time.Second
+
+	// remainingQuotaExpiry is the duration after which the remaining quota is no longer
+	// used, since ARM will have refilled the quota in the meantime
+	remainingQuotaExpiry = time.Minute
+
+	// maxRetryBackoff is the maximum delay used when a throttled response doesn't contain a `Retry-After` header
+	maxRetryBackoff = time.Minute
+
+	// retryBudgetExpiry is the duration after which the retries for a throttled request are forgotten, since
+	// the request has either been retried by autorest in the meantime or autorest has given up retrying
+	retryBudgetExpiry = 10 * time.Minute
+)
+
+var (
+	throttlers     = map[string]*throttler{}
+	throttlersLock = &sync.Mutex{}
+)
+
+// throttlerForSubscription returns the throttler shared by all of the clients for the specified Subscription
+// which send requests to the specified Resource Manager Endpoint using the same ThrottlingOptions
+func throttlerForSubscription(subscriptionId string, resourceManagerEndpoint string, options ThrottlingOptions) *throttler {
+	throttlersLock.Lock()
+	defer throttlersLock.Unlock()
+
+	host := resourceManagerEndpoint
+	if parsed, err := url.Parse(resourceManagerEndpoint); err == nil && parsed.Host != "" {
+		host = parsed.Host
+	}
+
+	key := strings.ToLower(fmt.Sprintf("%s|%s|%+v", subscriptionId, host, options))
+	if existing, ok := throttlers[key]; ok {
+		return existing
+	}
+
+	t := newThrottler(subscriptionId, host, options)
+	throttlers[key] = t
+	return t
+}
+
+type remainingQuota struct {
+	remaining  int
+	observedAt time.Time
+}
+
+type retryBudget struct {
+	retries       int
+	lastThrottled time.Time
+}
+
+type throttlingCounters struct {
+	requests  int64
+	delayed   int64
+	throttled int64
+	retries   int64
+	waited    time.Duration
+}
+
+func (c throttlingCounters) String() string {
+	return fmt.Sprintf("%d requests, %d delayed, %d throttled, %d retried, waited %s in total", c.requests, c.delayed, c.throttled, c.retries, c.waited)
+}
+
+type throttler struct {
+	subscriptionId string
+	host           string
+	options        ThrottlingOptions
+
+	lock        *sync.Mutex
+	tokens      float64
+	lastRefill  time.Time
+	pausedUntil time.Time
+	quotas      map[string]remainingQuota
+	counters    throttlingCounters
+
+	// autorest also retries throttled requests (using the same *http.Request), so the retries for each
+	// request are tracked to ensure these count towards the same budget rather than multiplying it
+	retries map[*http.Request]retryBudget
+
+	// these are overridden in the tests
+	now   func() time.Time
+	sleep func(ctx context.Context, duration time.Duration) error
+}
+
+func newThrottler(subscriptionId string, host string, options ThrottlingOptions) *throttler {
+	return &throttler{
+		subscriptionId: subscriptionId,
+		host:           host,
+		options:        options,
+		lock:           &sync.Mutex{},
+		tokens:         float64(options.Burst),
+		lastRefill:     time.Now(),
+		quotas:         map[string]remainingQuota{},
+		retries:        map[*http.Request]retryBudget{},
+		now:            time.Now,
+		sleep:          sleepWithContext,
+	}
+}
+
+// sender returns an autorest.Sender which throttles the requests sent to Resource Manager using the specified
+// Sender - requests to other endpoints (e.g. the Key Vault or Storage data planes) are sent as-is
+func (t *throttler) sender(s autorest.Sender) autorest.Sender {
+	return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+		if !strings.EqualFold(r.URL.Host, t.host) {
+			return s.Do(r)
+		}
+
+		rr := autorest.NewRetriableRequest(r)
+		for {
+			if err := rr.Prepare(); err != nil {
+				return nil, err
+			}
+
+			if err := t.wait(r.Context(), r.Method); err != nil {
+				return nil, err
+			}
+
+			resp, err := s.Do(rr.Request())
+			if err != nil || resp == nil {
+				t.complete(r)
+				return resp, err
+			}
+
+			t.observe(r.Method, resp)
+			if resp.StatusCode != http.StatusTooManyRequests {
+				t.complete(r)
+				return resp, err
+			}
+
+			attempt, ok := t.retry(r)
+			if !ok {
+				// autorest may retry this request again, but since the budget has been used these won't be retried here
+				return resp, err
+			}
+
+			t.pause(retryAfter(resp, attempt))
+
+			// the response is discarded since the request is retried
+			if err := autorest.Respond(resp, autorest.ByDiscardingBody(), autorest.ByClosing()); err != nil {
+				return resp, err
+			}
+		}
+	})
+}
+
+// wait blocks until the specified request can be sent
+func (t *throttler) wait(ctx context.Context, method string) error {
+	delay := t.reserve(method)
+	if delay <= 0 {
+		return nil
+	}
+
+	return t.sleep(ctx, delay)
+}
+
+// reserve reserves a token for a request, returning the duration to wait before sending the request
+func (t *throttler) reserve(method string) time.Duration {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	t.counters.requests++
+
+	delay := time.Duration(0)
+	if t.pausedUntil.After(now) {
+		delay = t.pausedUntil.Sub(now)
+	}
+
+	if rate := float64(t.options.RequestsPerSecond); rate > 0 {
+		elapsed := now.Sub(t.lastRefill).Seconds()
+		t.tokens = math.Min(float64(t.options.Burst), t.tokens+elapsed*rate)
+		t.lastRefill = now
+
+		// tokens are reserved up-front (and so can go negative) so that concurrent requests queue up
+		t.tokens--
+		if t.tokens < 0 {
+			if tokenDelay := time.Duration(-t.tokens / rate * float64(time.Second)); tokenDelay > delay {
+				delay = tokenDelay
+			}
+		}
+	}
+
+	if quota, ok := t.quotas[quotaKind(method)]; ok && now.Sub(quota.observedAt) < remainingQuotaExpiry && quota.remaining < remainingQuotaThreshold {
+		// back off further the closer we get to exhausting the quota
+		remaining := math.Max(float64(quota.remaining), 0)
+		quotaDelay := time.Duration((1 - remaining/remainingQuotaThreshold) * float64(maxQuotaDelay))
+		if quotaDelay > delay {
+			delay = quotaDelay
+		}
+	}
+
+	if delay > 0 {
+		t.counters.delayed++
+		t.counters.waited += delay
+		log.Printf("[DEBUG] Delaying %s request for %s in Subscription %q (%s)", method, delay, t.subscriptionId, t.counters)
+	}
+
+	return delay
+}
+
+// observe records whether the request was throttled and the remaining quota returned by ARM for this type of request
+func (t *throttler) observe(method string, resp *http.Response) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		t.counters.throttled++
+	}
+
+	kind := quotaKind(method)
+	header := resp.Header.Get(fmt.Sprintf("x-ms-ratelimit-remaining-subscription-%s", kind))
+	if header == "" {
+		return
+	}
+
+	remaining, err := strconv.Atoi(header)
+	if err != nil {
+		return
+	}
+
+	t.quotas[kind] = remainingQuota{
+		remaining:  remaining,
+		observedAt: t.now(),
+	}
+}
+
+// retry returns the number of times the specified request has already been retried and whether it can be retried
+// again - since autorest also retries throttled requests, these count towards the same budget
+func (t *throttler) retry(r *http.Request) (int, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	now := t.now()
+	budget, ok := t.retries[r]
+	if !ok {
+		// requests which autorest has stopped retrying are never completed, so are forgotten once they've expired
+		for k, v := range t.retries {
+			if now.Sub(v.lastThrottled) > retryBudgetExpiry {
+				delete(t.retries, k)
+			}
+		}
+	}
+
+	attempt := budget.retries
+	budget.lastThrottled = now
+	if attempt < t.options.MaxRetries {
+		budget.retries++
+	}
+	t.retries[r] = budget
+
+	return attempt, attempt < t.options.MaxRetries
+}
+
+// complete forgets the retries for the specified request, once it's no longer being throttled
+func (t *throttler) complete(r *http.Request) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.retries, r)
+}
+
+// pause pauses all requests for this Subscription for the specified duration, prior to retrying a throttled request
+func (t *throttler) pause(delay time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	t.counters.retries++
+
+	if until := t.now().Add(delay); until.After(t.pausedUntil) {
+		t.pausedUntil = until
+	}
+
+	log.Printf("[DEBUG] Requests to Subscription %q were throttled by ARM - retrying after %s (%s)", t.subscriptionId, delay, t.counters)
+}
+
+// quotaKind returns the kind of ARM quota which the specified HTTP Method counts towards
+func quotaKind(method string) string {
+	switch strings.ToUpper(method) {
+	case http.MethodGet, http.MethodHead:
+		return "reads"
+	case http.MethodDelete:
+		return "deletes"
+	}
+
+	return "writes"
+}
+
+// retryAfter returns the duration specified in the `Retry-After` header, which can either be a number
+// of seconds or a HTTP Date - falling back to an exponential backoff when this isn't specified
+func retryAfter(resp *http.Response, attempt int) time.Duration {
+	if v := resp.Header.Get("Retry-After"); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+			return time.Duration(seconds) * time.Second
+		}
+
+		if date, err := http.ParseTime(v); err == nil {
+			if delay := time.Until(date); delay > 0 {
+				return delay
+			}
+			return 0
+		}
+	}
+
+	backoff := time.Duration(math.Pow(2, float64(attempt))) * time.Second
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+func sleepWithContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
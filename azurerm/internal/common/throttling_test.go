@@ -0,0 +1,297 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func newTestThrottler(host string, options ThrottlingOptions) (*throttler, *[]time.Duration) {
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	delays := make([]time.Duration, 0)
+
+	t := newThrottler("00000000-0000-0000-0000-000000000000", host, options)
+	t.lastRefill = now
+	t.now = func() time.Time {
+		return now
+	}
+	t.sleep = func(_ context.Context, duration time.Duration) error {
+		delays = append(delays, duration)
+		now = now.Add(duration)
+		return nil
+	}
+	return t, &delays
+}
+
+func TestThrottlerTokenBucket(t *testing.T) {
+	throttler, delays := newTestThrottler("management.azure.com", ThrottlingOptions{
+		RequestsPerSecond: 2,
+		Burst:             2,
+	})
+
+	for i := 0; i < 4; i++ {
+		if err := throttler.wait(context.TODO(), http.MethodGet); err != nil {
+			t.Fatalf("waiting: %+v", err)
+		}
+	}
+
+	// the first two requests use the burst, the others wait for a token to be refilled
+	expected := []time.Duration{500 * time.Millisecond, 500 * time.Millisecond}
+	if len(*delays) != len(expected) {
+		t.Fatalf("expected %d delays but got %+v", len(expected), *delays)
+	}
+	for i, v := range expected {
+		if (*delays)[i] != v {
+			t.Fatalf("expected delay %d to be %s but got %s", i, v, (*delays)[i])
+		}
+	}
+}
+
+func TestThrottlerRemainingQuota(t *testing.T) {
+	throttler, delays := newTestThrottler("management.azure.com", ThrottlingOptions{})
+
+	throttler.observe(http.MethodPut, &http.Response{
+		StatusCode: http.StatusOK,
+		Header: http.Header{
+			"X-Ms-Ratelimit-Remaining-Subscription-Writes": []string{"0"},
+		},
+	})
+
+	// reads aren't affected by the write quota
+	if err := throttler.wait(context.TODO(), http.MethodGet); err != nil {
+		t.Fatalf("waiting: %+v", err)
+	}
+	if len(*delays) != 0 {
+		t.Fatalf("expected no delays for a read but got %+v", *delays)
+	}
+
+	if err := throttler.wait(context.TODO(), http.MethodPut); err != nil {
+		t.Fatalf("waiting: %+v", err)
+	}
+	if len(*delays) != 1 || (*delays)[0] != maxQuotaDelay {
+		t.Fatalf("expected a delay of %s for a write but got %+v", maxQuotaDelay, *delays)
+	}
+}
+
+func TestThrottlerRetriesThrottledRequests(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= 2 {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	throttler, delays := newTestThrottler(serverHost(t, server), ThrottlingOptions{
+		MaxRetries: 5,
+	})
+	sender := throttler.sender(&http.Client{})
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	resp, err := autorest.SendWithSender(sender, req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected a 200 but got %d", resp.StatusCode)
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests but got %d", requests)
+	}
+	if len(*delays) != 2 || (*delays)[0] != 7*time.Second || (*delays)[1] != 7*time.Second {
+		t.Fatalf("expected two delays of 7s but got %+v", *delays)
+	}
+	if throttler.counters.throttled != 2 || throttler.counters.retries != 2 {
+		t.Fatalf("expected 2 throttled requests and 2 retries but got %s", throttler.counters)
+	}
+	if len(throttler.retries) != 0 {
+		t.Fatalf("expected the retries for the completed request to be forgotten but got %+v", throttler.retries)
+	}
+}
+
+func TestThrottlerMaxRetries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	throttler, _ := newTestThrottler(serverHost(t, server), ThrottlingOptions{
+		MaxRetries: 2,
+	})
+	sender := throttler.sender(&http.Client{})
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	resp, err := autorest.SendWithSender(sender, req)
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected a 429 but got %d", resp.StatusCode)
+	}
+	if requests != 3 {
+		t.Fatalf("expected 3 requests but got %d", requests)
+	}
+}
+
+func TestThrottlerMaxRetriesIncludesAutorestRetries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	throttler, _ := newTestThrottler(serverHost(t, server), ThrottlingOptions{
+		MaxRetries: 2,
+	})
+	sender := throttler.sender(&http.Client{})
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	resp, err := autorest.SendWithSender(sender, req, autorest.DoRetryForStatusCodes(3, 0, autorest.StatusCodesForRetry...))
+	if err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+	defer resp.Body.Close()
+
+	// the first attempt is retried twice by the throttler, then the 3 retries made by autorest are sent once each
+	if requests != 6 {
+		t.Fatalf("expected 6 requests but got %d", requests)
+	}
+	if throttler.counters.retries != 2 {
+		t.Fatalf("expected 2 retries but got %s", throttler.counters)
+	}
+}
+
+func TestThrottlerOnlyThrottlesResourceManager(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	throttler, delays := newTestThrottler("management.azure.com", ThrottlingOptions{
+		RequestsPerSecond: 1,
+		Burst:             1,
+		MaxRetries:        2,
+	})
+	sender := throttler.sender(&http.Client{})
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		resp, err := autorest.SendWithSender(sender, req)
+		if err != nil {
+			t.Fatalf("sending request: %+v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected 2 requests but got %d", requests)
+	}
+	if len(*delays) != 0 || throttler.counters.requests != 0 {
+		t.Fatalf("expected requests to other endpoints not to be throttled but got %+v (%s)", *delays, throttler.counters)
+	}
+}
+
+func TestThrottlerForSubscription(t *testing.T) {
+	options := DefaultThrottlingOptions()
+
+	first := throttlerForSubscription("00000000-0000-0000-0000-000000000000", "https://management.azure.com/", options)
+	if first.host != "management.azure.com" {
+		t.Fatalf("expected the host to be %q but got %q", "management.azure.com", first.host)
+	}
+	if second := throttlerForSubscription("00000000-0000-0000-0000-000000000000", "https://management.azure.com/", options); first != second {
+		t.Fatalf("expected the same throttler to be returned for the same Subscription")
+	}
+
+	options.RequestsPerSecond = 1
+	if other := throttlerForSubscription("00000000-0000-0000-0000-000000000000", "https://management.azure.com/", options); first == other {
+		t.Fatalf("expected a different throttler to be returned for different options")
+	}
+	if other := throttlerForSubscription("00000000-0000-0000-0000-000000000000", "https://management.chinacloudapi.cn/", DefaultThrottlingOptions()); first == other {
+		t.Fatalf("expected a different throttler to be returned for a different endpoint")
+	}
+}
+
+func serverHost(t *testing.T, server *httptest.Server) string {
+	parsed, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parsing %q: %+v", server.URL, err)
+	}
+	return parsed.Host
+}
+
+func TestRetryAfter(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Header   string
+		Attempt  int
+		Expected time.Duration
+	}{
+		{
+			Name:     "Seconds",
+			Header:   "30",
+			Expected: 30 * time.Second,
+		},
+		{
+			Name:     "Missing",
+			Attempt:  3,
+			Expected: 8 * time.Second,
+		},
+		{
+			Name:     "Missing with a large number of attempts",
+			Attempt:  10,
+			Expected: maxRetryBackoff,
+		},
+		{
+			Name:     "Date in the past",
+			Header:   "Wed, 21 Oct 2015 07:28:00 GMT",
+			Expected: 0,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		if v.Header != "" {
+			resp.Header.Set("Retry-After", v.Header)
+		}
+
+		if actual := retryAfter(resp, v.Attempt); actual != v.Expected {
+			t.Fatalf("Expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package enhancedvalidation
+
+import (
+	// embed is used to bundle the snapshot into the binary
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+)
+
+// SnapshotFileEnvVar is the Environment Variable which can be used to specify the path to a custom
+// JSON file containing the Locations and Resource Providers to use for each Azure Environment
+const SnapshotFileEnvVar = "ARM_PROVIDER_ENHANCED_VALIDATION_SNAPSHOT"
+
+//go:embed snapshot.json
+var bundledSnapshot []byte
+
+// Snapshot is a point-in-time copy of the Locations and Resource Providers which are
+// available within each Azure Environment, used for Enhanced Validation when these
+// can't be retrieved from Azure (for example when running offline)
+type Snapshot struct {
+	// Version is the version of this snapshot, which is the date it was generated
+	Version string `json:"version"`
+
+	// Environments is a map of the Azure Environment Name (e.g. `AzurePublicCloud`) to the Snapshot for that Environment
+	Environments map[string]EnvironmentSnapshot `json:"environments"`
+}
+
+type EnvironmentSnapshot struct {
+	// Version is the version of the Snapshot this Environment was retrieved from
+	Version string `json:"-"`
+
+	// Source is either `bundled` or the path to the custom Snapshot this Environment was retrieved from
+	Source string `json:"-"`
+
+	Locations         []string `json:"locations"`
+	ResourceProviders []string `json:"resourceProviders"`
+}
+
+// ForEnvironment returns the Snapshot for the specified Azure Environment, using the custom Snapshot
+// specified in the Environment Variable `ARM_PROVIDER_ENHANCED_VALIDATION_SNAPSHOT` if it contains
+// this Environment, else the Snapshot bundled into the Provider - or nil if neither contain it
+func ForEnvironment(environmentName string) (*EnvironmentSnapshot, error) {
+	if path := os.Getenv(SnapshotFileEnvVar); path != "" {
+		contents, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading the Enhanced Validation Snapshot from %q: %+v", path, err)
+		}
+
+		custom, err := parseSnapshot(contents, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if environment := custom.forEnvironment(environmentName, path); environment != nil {
+			return environment, nil
+		}
+	}
+
+	bundled, err := parseSnapshot(bundledSnapshot, "bundled")
+	if err != nil {
+		return nil, err
+	}
+
+	return bundled.forEnvironment(environmentName, "bundled"), nil
+}
+
+func parseSnapshot(contents []byte, source string) (*Snapshot, error) {
+	var snapshot Snapshot
+	if err := json.Unmarshal(contents, &snapshot); err != nil {
+		return nil, fmt.Errorf("parsing the %s Enhanced Validation Snapshot: %+v", source, err)
+	}
+
+	return &snapshot, nil
+}
+
+func (s Snapshot) forEnvironment(environmentName, source string) *EnvironmentSnapshot {
+	for name, environment := range s.Environments {
+		if !strings.EqualFold(name, environmentName) {
+			continue
+		}
+
+		environment.Version = s.Version
+		environment.Source = source
+		return &environment
+	}
+
+	return nil
+}
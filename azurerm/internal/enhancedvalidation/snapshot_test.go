@@ -0,0 +1,88 @@
+package enhancedvalidation
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+func TestBundledSnapshotContainsEnvironments(t *testing.T) {
+	for _, env := range []azure.Environment{azure.ChinaCloud, azure.GermanCloud, azure.PublicCloud, azure.USGovernmentCloud} {
+		t.Logf("[DEBUG] Testing %q..", env.Name)
+
+		snapshot, err := ForEnvironment(env.Name)
+		if err != nil {
+			t.Fatalf("loading snapshot: %+v", err)
+		}
+		if snapshot == nil {
+			t.Fatalf("expected a snapshot for %q but didn't get one", env.Name)
+		}
+		if len(snapshot.Locations) == 0 || len(snapshot.ResourceProviders) == 0 {
+			t.Fatalf("expected the snapshot for %q to contain Locations and Resource Providers", env.Name)
+		}
+		if snapshot.Source != "bundled" || snapshot.Version == "" {
+			t.Fatalf("expected a versioned bundled snapshot but got source %q and version %q", snapshot.Source, snapshot.Version)
+		}
+	}
+
+	snapshot, err := ForEnvironment("SomeCustomCloud")
+	if err != nil {
+		t.Fatalf("loading snapshot: %+v", err)
+	}
+	if snapshot != nil {
+		t.Fatalf("expected no snapshot for a custom environment but got %+v", *snapshot)
+	}
+}
+
+func TestCustomSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "snapshot")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "snapshot.json")
+	contents := `{
+  "version": "2021-05-01",
+  "environments": {
+    "SomeCustomCloud": {
+      "locations": ["customlocation"],
+      "resourceProviders": ["Microsoft.Compute"]
+    }
+  }
+}`
+	if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatalf("writing snapshot: %+v", err)
+	}
+
+	os.Setenv(SnapshotFileEnvVar, path)
+	defer os.Unsetenv(SnapshotFileEnvVar)
+
+	snapshot, err := ForEnvironment("somecustomcloud")
+	if err != nil {
+		t.Fatalf("loading snapshot: %+v", err)
+	}
+	if snapshot == nil || len(snapshot.Locations) != 1 || snapshot.Locations[0] != "customlocation" {
+		t.Fatalf("expected the custom snapshot but got %+v", snapshot)
+	}
+	if snapshot.Source != path || snapshot.Version != "2021-05-01" {
+		t.Fatalf("expected the source to be %q and version to be %q but got %q and %q", path, "2021-05-01", snapshot.Source, snapshot.Version)
+	}
+
+	// environments not in the custom snapshot fall back to the bundled snapshot
+	snapshot, err = ForEnvironment(azure.PublicCloud.Name)
+	if err != nil {
+		t.Fatalf("loading snapshot: %+v", err)
+	}
+	if snapshot == nil || snapshot.Source != "bundled" {
+		t.Fatalf("expected the bundled snapshot but got %+v", snapshot)
+	}
+
+	os.Setenv(SnapshotFileEnvVar, filepath.Join(dir, "does-not-exist.json"))
+	if _, err := ForEnvironment(azure.PublicCloud.Name); err == nil {
+		t.Fatalf("expected an error for a missing custom snapshot but didn't get one")
+	}
+}
@@ -1,27 +1,13 @@
 package features
 
+// Default returns the Features used when the `features` block is empty, which are
+// the Default values (or the values of the Environment Variables) from the registry
 func Default() UserFeatures {
-	return UserFeatures{
-		// NOTE: ensure all nested objects are fully populated
-		KeyVault: KeyVaultFeatures{
-			PurgeSoftDeleteOnDestroy:    true,
-			RecoverSoftDeletedKeyVaults: true,
-		},
-		LogAnalyticsWorkspace: LogAnalyticsWorkspaceFeatures{
-			PermanentlyDeleteOnDestroy: false,
-		},
-		Network: NetworkFeatures{
-			RelaxedLocking: false,
-		},
-		TemplateDeployment: TemplateDeploymentFeatures{
-			DeleteNestedItemsDuringDeletion: true,
-		},
-		VirtualMachine: VirtualMachineFeatures{
-			DeleteOSDiskOnDeletion: true,
-			GracefulShutdown:       false,
-		},
-		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
-			RollInstancesWhenRequired: true,
-		},
+	output := UserFeatures{}
+	for _, block := range Blocks() {
+		for _, feature := range block.Features {
+			feature.Apply(&output, feature.DefaultValue())
+		}
 	}
+	return output
 }
@@ -0,0 +1,106 @@
+package features
+
+// NOTE: when adding a new Feature a field should be added to `UserFeatures` (or the nested struct for
+// the block) and the Feature should be added to the registry below - the Schema, Defaults and
+// Documentation for the `features` block are generated from the registry.
+//
+// Feature Flags which are only intended for use by the Provider's own tests (such as `ARM_PROVIDER_DYNAMIC_TEST`)
+// or which aren't yet supported (such as 3.0 mode) are intentionally not defined here, so they aren't documented.
+
+var (
+	enhancedValidation = Feature{
+		Name:                "enhanced_validation",
+		Description:         "Should the Locations and Resource Providers available within the Azure Environment be used to validate the `location` field and the Resource Providers which are registered? When these can't be retrieved from Azure (for example when running offline) the Snapshot bundled into the Provider (or the JSON file specified in the `ARM_PROVIDER_ENHANCED_VALIDATION_SNAPSHOT` Environment Variable) is used instead.",
+		EnvironmentVariable: "ARM_PROVIDER_ENHANCED_VALIDATION",
+		Default:             true,
+	}
+)
+
+var registry = []Feature{
+	enhancedValidation,
+
+	{
+		Block:       "key_vault",
+		Name:        "purge_soft_delete_on_destroy",
+		Description: "Should the `azurerm_key_vault`, `azurerm_key_vault_certificate`, `azurerm_key_vault_key` and `azurerm_key_vault_secret` resources be permanently deleted (e.g. purged) when destroyed?",
+		Notes: []string{
+			"When purge protection is enabled, a key vault or an object in the deleted state cannot be purged until the retention period (7-90 days) has passed.",
+		},
+		Default: true,
+		set: func(input *UserFeatures, value bool) {
+			input.KeyVault.PurgeSoftDeleteOnDestroy = value
+		},
+	},
+	{
+		Block:       "key_vault",
+		Name:        "recover_soft_deleted_key_vaults",
+		Description: "Should the `azurerm_key_vault`, `azurerm_key_vault_certificate`, `azurerm_key_vault_key` and `azurerm_key_vault_secret` resources recover a Soft-Deleted Key Vault/Item?",
+		Notes: []string{
+			"When recovering soft-deleted Key Vault items (Keys, Certificates, and Secrets) the Principal used by Terraform needs the `\"recover\"` permission.",
+		},
+		Default: true,
+		set: func(input *UserFeatures, value bool) {
+			input.KeyVault.RecoverSoftDeletedKeyVaults = value
+		},
+	},
+	{
+		Block:       "log_analytics_workspace",
+		Name:        "permanently_delete_on_destroy",
+		Description: "Should the `azurerm_log_analytics_workspace` be permanently deleted (e.g. purged) when destroyed?",
+		Default:     false,
+		set: func(input *UserFeatures, value bool) {
+			input.LogAnalyticsWorkspace.PermanentlyDeleteOnDestroy = value
+		},
+	},
+	{
+		Block:       "network",
+		Name:        "relaxed_locking",
+		Description: "Should the `azurerm_network_security_rule` resource skip locking the parent Network Security Group when creating or updating a rule, allowing multiple rules to be created or updated in parallel?",
+		Default:     false,
+		set: func(input *UserFeatures, value bool) {
+			input.Network.RelaxedLocking = value
+		},
+	},
+	{
+		Block:       "template_deployment",
+		Name:        "delete_nested_items_during_deletion",
+		Description: "Should the `azurerm_resource_group_template_deployment` resource attempt to delete resources that have been provisioned by the ARM Template, when the Resource Group Template Deployment is deleted?",
+		Default:     true,
+		set: func(input *UserFeatures, value bool) {
+			input.TemplateDeployment.DeleteNestedItemsDuringDeletion = value
+		},
+	},
+	{
+		Block:       "virtual_machine",
+		Name:        "delete_os_disk_on_deletion",
+		Description: "Should the `azurerm_linux_virtual_machine` and `azurerm_windows_virtual_machine` resources delete the OS Disk attached to the Virtual Machine when the Virtual Machine is destroyed?",
+		Notes: []string{
+			"This does not affect the older `azurerm_virtual_machine` resource, which has its own flags for managing this within the resource.",
+		},
+		Default: true,
+		set: func(input *UserFeatures, value bool) {
+			input.VirtualMachine.DeleteOSDiskOnDeletion = value
+		},
+	},
+	{
+		Block:       "virtual_machine",
+		Name:        "graceful_shutdown",
+		Description: "Should the `azurerm_linux_virtual_machine` and `azurerm_windows_virtual_machine` request a graceful shutdown when the Virtual Machine is destroyed?",
+		Notes: []string{
+			"When using a graceful shutdown, Azure gives the Virtual Machine a 5 minutes window in which to complete the shutdown process, at which point the machine will be force powered off - [more information can be found in this blog post](https://azure.microsoft.com/en-us/blog/linux-and-graceful-shutdowns-2/).",
+		},
+		Default: false,
+		set: func(input *UserFeatures, value bool) {
+			input.VirtualMachine.GracefulShutdown = value
+		},
+	},
+	{
+		Block:       "virtual_machine_scale_set",
+		Name:        "roll_instances_when_required",
+		Description: "Should the `azurerm_linux_virtual_machine_scale_set` and `azurerm_windows_virtual_machine_scale_set` resources automatically roll the instances in the Scale Set when Required (for example when updating the Sku/Image)?",
+		Default:     true,
+		set: func(input *UserFeatures, value bool) {
+			input.VirtualMachineScaleSet.RollInstancesWhenRequired = value
+		},
+	},
+}
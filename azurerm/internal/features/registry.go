@@ -0,0 +1,116 @@
+package features
+
+import (
+	"os"
+	"sort"
+	"strings"
+)
+
+// Feature is a Feature Flag which can be used to customize the behaviour of the Provider - either
+// configured within a block in the `features` block of the Provider, or using an Environment Variable
+type Feature struct {
+	// Block is the name of the block within the `features` block which this Feature is configured
+	// within - or empty when this Feature can only be configured using an Environment Variable
+	Block string
+
+	// Name is the name of the field within the Block which configures this Feature
+	Name string
+
+	// Description is a description of this Feature, used in both the Schema and the Documentation
+	Description string
+
+	// Notes are any additional notes about this Feature, which are output in the Documentation
+	Notes []string
+
+	// Default is the value used when this Feature isn't configured
+	Default bool
+
+	// EnvironmentVariable is the name of the Environment Variable which can be used to override the Default
+	EnvironmentVariable string
+
+	// Deprecated is the deprecation message for this Feature, if it's Deprecated
+	Deprecated string
+
+	set func(input *UserFeatures, value bool)
+}
+
+// Block is a block within the `features` block of the Provider
+type Block struct {
+	Name     string
+	Features []Feature
+}
+
+// DefaultValue returns the value of this Feature when it's not configured in the `features` block - which is
+// the value of the Environment Variable for this Feature (when set), otherwise the Default value
+func (f Feature) DefaultValue() bool {
+	if f.EnvironmentVariable != "" {
+		if v := os.Getenv(f.EnvironmentVariable); v != "" {
+			return strings.EqualFold(v, "true")
+		}
+	}
+
+	return f.Default
+}
+
+// Apply sets the value of this Feature within the specified UserFeatures
+func (f Feature) Apply(input *UserFeatures, value bool) {
+	if f.set != nil {
+		f.set(input, value)
+	}
+}
+
+// All returns each of the Features defined in the Registry
+func All() []Feature {
+	output := make([]Feature, len(registry))
+	copy(output, registry)
+	return output
+}
+
+// Blocks returns each of the blocks within the `features` block (and the Features configured
+// within them) - both sorted alphabetically
+func Blocks() []Block {
+	features := make(map[string][]Feature)
+	for _, v := range registry {
+		if v.Block == "" {
+			continue
+		}
+
+		features[v.Block] = append(features[v.Block], v)
+	}
+
+	names := make([]string, 0)
+	for name := range features {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	output := make([]Block, 0)
+	for _, name := range names {
+		items := features[name]
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].Name < items[j].Name
+		})
+
+		output = append(output, Block{
+			Name:     name,
+			Features: items,
+		})
+	}
+	return output
+}
+
+// EnvironmentVariableFeatures returns the Features which can only be configured using an Environment
+// Variable, sorted by the name of the Environment Variable
+func EnvironmentVariableFeatures() []Feature {
+	output := make([]Feature, 0)
+	for _, v := range registry {
+		if v.Block == "" {
+			output = append(output, v)
+		}
+	}
+
+	sort.Slice(output, func(i, j int) bool {
+		return output[i].EnvironmentVariable < output[j].EnvironmentVariable
+	})
+	return output
+}
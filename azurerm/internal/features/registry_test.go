@@ -0,0 +1,123 @@
+package features
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestRegistryIsValid(t *testing.T) {
+	names := make(map[string]struct{})
+	environmentVariables := make(map[string]struct{})
+
+	for _, feature := range All() {
+		key := feature.Block + "." + feature.Name
+		t.Logf("[DEBUG] Validating %q..", key)
+
+		if feature.Name == "" {
+			t.Fatalf("the Feature %q has no Name", key)
+		}
+		if feature.Description == "" {
+			t.Fatalf("the Feature %q has no Description", key)
+		}
+
+		if _, exists := names[key]; exists {
+			t.Fatalf("the Feature %q is defined multiple times", key)
+		}
+		names[key] = struct{}{}
+
+		if feature.EnvironmentVariable != "" {
+			if _, exists := environmentVariables[feature.EnvironmentVariable]; exists {
+				t.Fatalf("the Environment Variable %q is used by multiple Features", feature.EnvironmentVariable)
+			}
+			environmentVariables[feature.EnvironmentVariable] = struct{}{}
+		}
+
+		if feature.Block == "" {
+			if feature.EnvironmentVariable == "" {
+				t.Fatalf("the Feature %q must be configured in a block or using an Environment Variable", key)
+			}
+			if feature.set != nil {
+				t.Fatalf("the Feature %q can only be configured using an Environment Variable but sets a field", key)
+			}
+			continue
+		}
+
+		if feature.set == nil {
+			t.Fatalf("the Feature %q doesn't set a field within UserFeatures", key)
+		}
+
+		// each Feature within a block must set a different field
+		enabled := UserFeatures{}
+		feature.Apply(&enabled, true)
+		if reflect.DeepEqual(enabled, UserFeatures{}) {
+			t.Fatalf("the Feature %q doesn't set a field within UserFeatures", key)
+		}
+		for _, other := range All() {
+			if other.Block == "" || (other.Block == feature.Block && other.Name == feature.Name) {
+				continue
+			}
+
+			otherEnabled := UserFeatures{}
+			other.Apply(&otherEnabled, true)
+			if reflect.DeepEqual(enabled, otherEnabled) {
+				t.Fatalf("the Features %q and %q set the same field within UserFeatures", key, other.Block+"."+other.Name)
+			}
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	expected := UserFeatures{
+		KeyVault: KeyVaultFeatures{
+			PurgeSoftDeleteOnDestroy:    true,
+			RecoverSoftDeletedKeyVaults: true,
+		},
+		LogAnalyticsWorkspace: LogAnalyticsWorkspaceFeatures{
+			PermanentlyDeleteOnDestroy: false,
+		},
+		Network: NetworkFeatures{
+			RelaxedLocking: false,
+		},
+		TemplateDeployment: TemplateDeploymentFeatures{
+			DeleteNestedItemsDuringDeletion: true,
+		},
+		VirtualMachine: VirtualMachineFeatures{
+			DeleteOSDiskOnDeletion: true,
+			GracefulShutdown:       false,
+		},
+		VirtualMachineScaleSet: VirtualMachineScaleSetFeatures{
+			RollInstancesWhenRequired: true,
+		},
+	}
+
+	if actual := Default(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFeatureDefaultValueFromEnvironmentVariable(t *testing.T) {
+	feature := Feature{
+		Name:                "example",
+		EnvironmentVariable: "ARM_PROVIDER_EXAMPLE_FEATURE",
+		Default:             true,
+	}
+
+	testData := map[string]bool{
+		"":       true,
+		"true":   true,
+		"TRUE":   true,
+		"false":  false,
+		"pandas": false,
+	}
+
+	for value, expected := range testData {
+		t.Logf("[DEBUG] Testing %q..", value)
+
+		os.Setenv(feature.EnvironmentVariable, value)
+		if actual := feature.DefaultValue(); actual != expected {
+			t.Fatalf("Expected %t but got %t", expected, actual)
+		}
+	}
+	os.Unsetenv(feature.EnvironmentVariable)
+}
@@ -1,15 +1,18 @@
 package identity
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	msiparse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
 )
 
 const none = "None"
 const systemAssigned = "SystemAssigned"
 const userAssigned = "UserAssigned"
-
-// TODO: support SystemAssigned, UserAssigned
-// const systemAssignedUserAssigned = "SystemAssigned, UserAssigned"
+const systemAssignedUserAssigned = "SystemAssigned, UserAssigned"
 
 type ExpandedConfig struct {
 	// Type is the type of User Assigned Identity, either `None`, `SystemAssigned`, `UserAssigned`
@@ -25,3 +28,98 @@ type Identity interface {
 	Flatten(input *ExpandedConfig) []interface{}
 	Schema() *schema.Schema
 }
+
+// HasSystemAssigned returns whether this Managed Identity includes a System Assigned Identity
+func (c ExpandedConfig) HasSystemAssigned() bool {
+	return strings.EqualFold(c.Type, systemAssigned) || isSystemAssignedUserAssigned(c.Type)
+}
+
+// HasUserAssigned returns whether this Managed Identity includes User Assigned Identities
+func (c ExpandedConfig) HasUserAssigned() bool {
+	return strings.EqualFold(c.Type, userAssigned) || isSystemAssignedUserAssigned(c.Type)
+}
+
+// isSystemAssignedUserAssigned returns whether the specified type is `SystemAssigned, UserAssigned` - since
+// this is returned by some API's without the space (or with different casing)
+func isSystemAssignedUserAssigned(input string) bool {
+	return strings.EqualFold(strings.ReplaceAll(input, " ", ""), strings.ReplaceAll(systemAssignedUserAssigned, " ", ""))
+}
+
+// normalizeType returns the type of Managed Identity in the casing/format used within Terraform
+func normalizeType(input string) string {
+	for _, v := range []string{none, systemAssigned, userAssigned} {
+		if strings.EqualFold(input, v) {
+			return v
+		}
+	}
+
+	if isSystemAssignedUserAssigned(input) {
+		return systemAssignedUserAssigned
+	}
+
+	return input
+}
+
+// validateUserAssignedIdentityIds ensures that `identity_ids` are only specified (and are required)
+// when the type of Managed Identity includes User Assigned Identities
+func validateUserAssignedIdentityIds(config ExpandedConfig) error {
+	identityIds := 0
+	if config.UserAssignedIdentityIds != nil {
+		identityIds = len(*config.UserAssignedIdentityIds)
+	}
+
+	if config.HasUserAssigned() && identityIds == 0 {
+		return fmt.Errorf("`identity_ids` must be specified when `type` includes `UserAssigned`")
+	}
+
+	if !config.HasUserAssigned() && identityIds > 0 {
+		return fmt.Errorf("`identity_ids` can only be specified when `type` includes `UserAssigned`")
+	}
+
+	return nil
+}
+
+func expandIdentityIds(input interface{}) *[]string {
+	var raw []interface{}
+	switch v := input.(type) {
+	case *schema.Set:
+		raw = v.List()
+	case []interface{}:
+		raw = v
+	}
+
+	output := make([]string, 0)
+	for _, v := range raw {
+		if s, ok := v.(string); ok && s != "" {
+			output = append(output, s)
+		}
+	}
+	return &output
+}
+
+// flattenIdentityIds returns the User Assigned Identity ID's in a consistent (sorted) order, normalizing
+// the casing of the ID's since some API's return these in a different casing than was specified
+func flattenIdentityIds(input *[]string) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, v := range *input {
+		if id, err := msiparse.UserAssignedIdentityIDInsensitively(v); err == nil {
+			v = id.ID()
+		}
+		output = append(output, v)
+	}
+	sort.Strings(output)
+
+	return output
+}
+
+func coalesce(input *string) string {
+	if input == nil {
+		return ""
+	}
+
+	return *input
+}
@@ -9,6 +9,13 @@ var _ Identity = SystemAssigned{}
 
 type SystemAssigned struct{}
 
+// ModelSystemAssigned is the model for the `identity` block for use within Typed Resources
+type ModelSystemAssigned struct {
+	Type        string `tfschema:"type"`
+	PrincipalId string `tfschema:"principal_id"`
+	TenantId    string `tfschema:"tenant_id"`
+}
+
 func (s SystemAssigned) Expand(input []interface{}) (*ExpandedConfig, error) {
 	if len(input) == 0 || input[0] == nil {
 		return &ExpandedConfig{
@@ -21,28 +28,46 @@ func (s SystemAssigned) Expand(input []interface{}) (*ExpandedConfig, error) {
 	}, nil
 }
 
-func (s SystemAssigned) Flatten(input *ExpandedConfig) []interface{} {
-	if input == nil || input.Type == none {
-		return []interface{}{}
+func (s SystemAssigned) ExpandModel(input []ModelSystemAssigned) (*ExpandedConfig, error) {
+	if len(input) == 0 {
+		return &ExpandedConfig{
+			Type: none,
+		}, nil
 	}
 
-	var coalesce = func(input *string) string {
-		if input == nil {
-			return ""
-		}
+	return &ExpandedConfig{
+		Type: systemAssigned,
+	}, nil
+}
 
-		return *input
+func (s SystemAssigned) Flatten(input *ExpandedConfig) []interface{} {
+	if input == nil || normalizeType(input.Type) == none {
+		return []interface{}{}
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"type":         input.Type,
+			"type":         normalizeType(input.Type),
 			"principal_id": coalesce(input.PrincipalId),
 			"tenant_id":    coalesce(input.TenantId),
 		},
 	}
 }
 
+func (s SystemAssigned) FlattenModel(input *ExpandedConfig) []ModelSystemAssigned {
+	if input == nil || normalizeType(input.Type) == none {
+		return []ModelSystemAssigned{}
+	}
+
+	return []ModelSystemAssigned{
+		{
+			Type:        normalizeType(input.Type),
+			PrincipalId: coalesce(input.PrincipalId),
+			TenantId:    coalesce(input.TenantId),
+		},
+	}
+}
+
 func (s SystemAssigned) Schema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -69,3 +94,26 @@ func (s SystemAssigned) Schema() *schema.Schema {
 		},
 	}
 }
+
+func (s SystemAssigned) SchemaDataSource() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
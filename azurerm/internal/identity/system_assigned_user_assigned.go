@@ -0,0 +1,155 @@
+package identity
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	msivalidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
+)
+
+var _ Identity = SystemAssignedUserAssigned{}
+
+type SystemAssignedUserAssigned struct{}
+
+// ModelSystemAssignedUserAssigned is the model for the `identity` block for use within Typed Resources
+type ModelSystemAssignedUserAssigned struct {
+	Type        string   `tfschema:"type"`
+	IdentityIds []string `tfschema:"identity_ids"`
+	PrincipalId string   `tfschema:"principal_id"`
+	TenantId    string   `tfschema:"tenant_id"`
+}
+
+func (s SystemAssignedUserAssigned) Expand(input []interface{}) (*ExpandedConfig, error) {
+	if len(input) == 0 || input[0] == nil {
+		return &ExpandedConfig{
+			Type: none,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	config := ExpandedConfig{
+		Type:                    normalizeType(raw["type"].(string)),
+		UserAssignedIdentityIds: expandIdentityIds(raw["identity_ids"]),
+	}
+	if err := validateUserAssignedIdentityIds(config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func (s SystemAssignedUserAssigned) ExpandModel(input []ModelSystemAssignedUserAssigned) (*ExpandedConfig, error) {
+	if len(input) == 0 {
+		return &ExpandedConfig{
+			Type: none,
+		}, nil
+	}
+
+	identityIds := make([]string, 0)
+	identityIds = append(identityIds, input[0].IdentityIds...)
+	config := ExpandedConfig{
+		Type:                    normalizeType(input[0].Type),
+		UserAssignedIdentityIds: &identityIds,
+	}
+	if err := validateUserAssignedIdentityIds(config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func (s SystemAssignedUserAssigned) Flatten(input *ExpandedConfig) []interface{} {
+	if input == nil || normalizeType(input.Type) == none {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":         normalizeType(input.Type),
+			"identity_ids": flattenIdentityIds(input.UserAssignedIdentityIds),
+			"principal_id": coalesce(input.PrincipalId),
+			"tenant_id":    coalesce(input.TenantId),
+		},
+	}
+}
+
+func (s SystemAssignedUserAssigned) FlattenModel(input *ExpandedConfig) []ModelSystemAssignedUserAssigned {
+	if input == nil || normalizeType(input.Type) == none {
+		return []ModelSystemAssignedUserAssigned{}
+	}
+
+	return []ModelSystemAssignedUserAssigned{
+		{
+			Type:        normalizeType(input.Type),
+			IdentityIds: flattenIdentityIds(input.UserAssignedIdentityIds),
+			PrincipalId: coalesce(input.PrincipalId),
+			TenantId:    coalesce(input.TenantId),
+		},
+	}
+}
+
+func (s SystemAssignedUserAssigned) Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						systemAssigned,
+						userAssigned,
+						systemAssignedUserAssigned,
+					}, false),
+				},
+				"identity_ids": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: msivalidate.UserAssignedIdentityID,
+					},
+				},
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func (s SystemAssignedUserAssigned) SchemaDataSource() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"identity_ids": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"principal_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"tenant_id": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
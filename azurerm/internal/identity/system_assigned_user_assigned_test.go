@@ -0,0 +1,192 @@
+package identity
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+const (
+	testIdentityId1 = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1"
+	testIdentityId2 = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity2"
+)
+
+func TestSystemAssignedUserAssignedExpand(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected *ExpandedConfig
+		Error    bool
+	}{
+		{
+			Name:  "Empty",
+			Input: []interface{}{},
+			Expected: &ExpandedConfig{
+				Type: none,
+			},
+		},
+		{
+			Name: "System Assigned",
+			Input: []interface{}{
+				map[string]interface{}{
+					"type":         systemAssigned,
+					"identity_ids": schema.NewSet(schema.HashString, []interface{}{}),
+				},
+			},
+			Expected: &ExpandedConfig{
+				Type:                    systemAssigned,
+				UserAssignedIdentityIds: &[]string{},
+			},
+		},
+		{
+			Name: "System Assigned with Identity IDs",
+			Input: []interface{}{
+				map[string]interface{}{
+					"type":         systemAssigned,
+					"identity_ids": schema.NewSet(schema.HashString, []interface{}{testIdentityId1}),
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "User Assigned without Identity IDs",
+			Input: []interface{}{
+				map[string]interface{}{
+					"type":         userAssigned,
+					"identity_ids": schema.NewSet(schema.HashString, []interface{}{}),
+				},
+			},
+			Error: true,
+		},
+		{
+			Name: "System Assigned, User Assigned",
+			Input: []interface{}{
+				map[string]interface{}{
+					"type":         systemAssignedUserAssigned,
+					"identity_ids": schema.NewSet(schema.HashString, []interface{}{testIdentityId1}),
+				},
+			},
+			Expected: &ExpandedConfig{
+				Type:                    systemAssignedUserAssigned,
+				UserAssignedIdentityIds: &[]string{testIdentityId1},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := SystemAssignedUserAssigned{}.Expand(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestSystemAssignedUserAssignedFlatten(t *testing.T) {
+	principalId := "11111111-1111-1111-1111-111111111111"
+	tenantId := "22222222-2222-2222-2222-222222222222"
+
+	testData := []struct {
+		Name     string
+		Input    *ExpandedConfig
+		Expected []interface{}
+	}{
+		{
+			Name:     "Nil",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "None",
+			Input: &ExpandedConfig{
+				Type: "none",
+			},
+			Expected: []interface{}{},
+		},
+		{
+			Name: "System Assigned, User Assigned without a space",
+			Input: &ExpandedConfig{
+				Type:        "SystemAssigned,UserAssigned",
+				PrincipalId: &principalId,
+				TenantId:    &tenantId,
+				UserAssignedIdentityIds: &[]string{
+					testIdentityId2,
+					"/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+				},
+			},
+			Expected: []interface{}{
+				map[string]interface{}{
+					"type":         systemAssignedUserAssigned,
+					"identity_ids": []string{testIdentityId1, testIdentityId2},
+					"principal_id": principalId,
+					"tenant_id":    tenantId,
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := SystemAssignedUserAssigned{}.Flatten(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestSystemAssignedUserAssignedModel(t *testing.T) {
+	input := []ModelSystemAssignedUserAssigned{
+		{
+			Type:        userAssigned,
+			IdentityIds: []string{testIdentityId1},
+		},
+	}
+
+	config, err := SystemAssignedUserAssigned{}.ExpandModel(input)
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	actual := SystemAssignedUserAssigned{}.FlattenModel(config)
+	if !reflect.DeepEqual(actual, input) {
+		t.Fatalf("Expected %+v but got %+v", input, actual)
+	}
+
+	if _, err := (SystemAssignedUserAssigned{}).ExpandModel([]ModelSystemAssignedUserAssigned{{Type: userAssigned}}); err == nil {
+		t.Fatalf("Expected an error when no Identity IDs are specified for a User Assigned Identity")
+	}
+}
+
+func TestUserAssignedExpand(t *testing.T) {
+	actual, err := UserAssigned{}.Expand([]interface{}{
+		map[string]interface{}{
+			"type":         userAssigned,
+			"identity_ids": schema.NewSet(schema.HashString, []interface{}{testIdentityId1}),
+		},
+	})
+	if err != nil {
+		t.Fatalf("expanding: %+v", err)
+	}
+
+	expected := &ExpandedConfig{
+		Type:                    userAssigned,
+		UserAssignedIdentityIds: &[]string{testIdentityId1},
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
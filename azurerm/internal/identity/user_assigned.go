@@ -3,12 +3,19 @@ package identity
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	msivalidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
 )
 
 var _ Identity = UserAssigned{}
 
 type UserAssigned struct{}
 
+// ModelUserAssigned is the model for the `identity` block for use within Typed Resources
+type ModelUserAssigned struct {
+	Type        string   `tfschema:"type"`
+	IdentityIds []string `tfschema:"identity_ids"`
+}
+
 func (u UserAssigned) Expand(input []interface{}) (*ExpandedConfig, error) {
 	if len(input) == 0 || input[0] == nil {
 		return &ExpandedConfig{
@@ -16,29 +23,60 @@ func (u UserAssigned) Expand(input []interface{}) (*ExpandedConfig, error) {
 		}, nil
 	}
 
-	return &ExpandedConfig{
-		Type: systemAssigned,
-	}, nil
+	raw := input[0].(map[string]interface{})
+	config := ExpandedConfig{
+		Type:                    userAssigned,
+		UserAssignedIdentityIds: expandIdentityIds(raw["identity_ids"]),
+	}
+	if err := validateUserAssignedIdentityIds(config); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
 }
 
-func (u UserAssigned) Flatten(input *ExpandedConfig) []interface{} {
-	if input == nil || input.Type == none {
-		return []interface{}{}
+func (u UserAssigned) ExpandModel(input []ModelUserAssigned) (*ExpandedConfig, error) {
+	if len(input) == 0 {
+		return &ExpandedConfig{
+			Type: none,
+		}, nil
 	}
 
-	var coalesce = func(input *string) string {
-		if input == nil {
-			return ""
-		}
+	identityIds := make([]string, 0)
+	identityIds = append(identityIds, input[0].IdentityIds...)
+	config := ExpandedConfig{
+		Type:                    userAssigned,
+		UserAssignedIdentityIds: &identityIds,
+	}
+	if err := validateUserAssignedIdentityIds(config); err != nil {
+		return nil, err
+	}
 
-		return *input
+	return &config, nil
+}
+
+func (u UserAssigned) Flatten(input *ExpandedConfig) []interface{} {
+	if input == nil || normalizeType(input.Type) == none {
+		return []interface{}{}
 	}
 
 	return []interface{}{
 		map[string]interface{}{
-			"type":         input.Type,
-			"principal_id": coalesce(input.PrincipalId),
-			"tenant_id":    coalesce(input.TenantId),
+			"type":         normalizeType(input.Type),
+			"identity_ids": flattenIdentityIds(input.UserAssignedIdentityIds),
+		},
+	}
+}
+
+func (u UserAssigned) FlattenModel(input *ExpandedConfig) []ModelUserAssigned {
+	if input == nil || normalizeType(input.Type) == none {
+		return []ModelUserAssigned{}
+	}
+
+	return []ModelUserAssigned{
+		{
+			Type:        normalizeType(input.Type),
+			IdentityIds: flattenIdentityIds(input.UserAssignedIdentityIds),
 		},
 	}
 }
@@ -58,11 +96,12 @@ func (u UserAssigned) Schema() *schema.Schema {
 					}, false),
 				},
 				"identity_ids": {
-					Type:     schema.TypeList,
+					Type:     schema.TypeSet,
 					Required: true,
+					MinItems: 1,
 					Elem: &schema.Schema{
 						Type:         schema.TypeString,
-						ValidateFunc: validation.NoZeroValues,
+						ValidateFunc: msivalidate.UserAssignedIdentityID,
 					},
 				},
 			},
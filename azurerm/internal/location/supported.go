@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/enhancedvalidation"
 )
 
 // supportedLocations can be (validly) nil - as such this shouldn't be relied on
@@ -12,12 +13,32 @@ var supportedLocations *[]string
 
 // CacheSupportedLocations attempts to retrieve the supported locations from the Azure MetaData Service
 // and caches them, for used in enhanced validation
+//
+// When these can't be retrieved (for example when offline) the locations from the Enhanced Validation
+// Snapshot for this Azure Environment are used instead, where available
 func CacheSupportedLocations(ctx context.Context, env *azure.Environment) {
+	snapshot, err := enhancedvalidation.ForEnvironment(env.Name)
+	if err != nil {
+		log.Printf("[DEBUG] error loading the Enhanced Validation Snapshot: %s", err)
+	}
+	usingSnapshot := snapshot != nil && len(snapshot.Locations) > 0
+	if usingSnapshot {
+		locations := snapshot.Locations
+		supportedLocations = &locations
+	}
+
 	locs, err := availableAzureLocations(ctx, env)
 	if err != nil {
+		if usingSnapshot {
+			log.Printf("[DEBUG] error retrieving locations: %s. Enhanced validation will use the %s Snapshot (version %s)", err, snapshot.Source, snapshot.Version)
+			return
+		}
+
 		log.Printf("[DEBUG] error retrieving locations: %s. Enhanced validation will be unavailable", err)
 		return
 	}
 
-	supportedLocations = locs.Locations
+	if locs.Locations != nil {
+		supportedLocations = locs.Locations
+	}
 }
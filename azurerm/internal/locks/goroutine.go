@@ -0,0 +1,25 @@
+package locks
+
+import (
+	"bytes"
+	"runtime"
+	"strconv"
+)
+
+// currentGoroutineID returns the ID of the current goroutine, which is only used to
+// show which goroutine holds (or is waiting on) each lock when debugging
+func currentGoroutineID() int64 {
+	// the stack trace begins `goroutine 123 [running]:`
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, []byte("goroutine "))
+	if i := bytes.IndexByte(buf, ' '); i > 0 {
+		buf = buf[:i]
+	}
+
+	id, err := strconv.ParseInt(string(buf), 10, 64)
+	if err != nil {
+		return -1
+	}
+	return id
+}
@@ -0,0 +1,104 @@
+package locks
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Inversion is a pair of locks which have been acquired in both orders, which can deadlock
+// should two goroutines acquire them at the same time
+type Inversion struct {
+	// First is the lock which was held when Second was locked
+	First string
+
+	// Second is the lock which was locked whilst holding First, which has also been held when locking First
+	Second string
+}
+
+func (i Inversion) String() string {
+	return fmt.Sprintf("%q was locked whilst holding %q, but these have also been locked in the opposite order", i.Second, i.First)
+}
+
+// InversionDetector records the order in which locks are acquired, to detect locks which are
+// acquired in an inconsistent order (a lock-order inversion) - which is intended for use in tests
+type InversionDetector struct {
+	lock       sync.Mutex
+	orderings  map[string]map[string]struct{}
+	inversions []Inversion
+	seen       map[Inversion]struct{}
+}
+
+var (
+	inversionDetector     *InversionDetector
+	inversionDetectorLock = &sync.Mutex{}
+)
+
+// StartInversionDetection starts recording the order in which locks are acquired, until Stop
+// is called on the returned InversionDetector - this is intended for use in tests
+func StartInversionDetection() *InversionDetector {
+	inversionDetectorLock.Lock()
+	defer inversionDetectorLock.Unlock()
+
+	inversionDetector = &InversionDetector{
+		orderings: make(map[string]map[string]struct{}),
+		seen:      make(map[Inversion]struct{}),
+	}
+	return inversionDetector
+}
+
+// Stop stops recording the order in which locks are acquired, returning any lock-order inversions
+func (d *InversionDetector) Stop() []Inversion {
+	inversionDetectorLock.Lock()
+	if inversionDetector == d {
+		inversionDetector = nil
+	}
+	inversionDetectorLock.Unlock()
+
+	return d.Inversions()
+}
+
+// Inversions returns the lock-order inversions detected so far
+func (d *InversionDetector) Inversions() []Inversion {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return append([]Inversion{}, d.inversions...)
+}
+
+func activeInversionDetector() *InversionDetector {
+	inversionDetectorLock.Lock()
+	defer inversionDetectorLock.Unlock()
+
+	return inversionDetector
+}
+
+// record records that key is being locked whilst holding the locks for held
+func (d *InversionDetector) record(held []string, key string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for _, first := range held {
+		if first == key {
+			continue
+		}
+
+		if _, ok := d.orderings[first]; !ok {
+			d.orderings[first] = make(map[string]struct{})
+		}
+		d.orderings[first][key] = struct{}{}
+
+		if _, inverted := d.orderings[key][first]; !inverted {
+			continue
+		}
+
+		inversion := Inversion{
+			First:  first,
+			Second: key,
+		}
+		if _, ok := d.seen[inversion]; ok {
+			continue
+		}
+		d.seen[inversion] = struct{}{}
+		d.inversions = append(d.inversions, inversion)
+	}
+}
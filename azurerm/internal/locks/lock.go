@@ -1,5 +1,10 @@
 package locks
 
+import (
+	"context"
+	"sort"
+)
+
 // armMutexKV is the instance of MutexKV for ARM resources
 var armMutexKV = NewMutexKV()
 
@@ -7,20 +12,50 @@ func ByID(id string) {
 	armMutexKV.Lock(id)
 }
 
+// ByIDWithContext locks the specified ID, returning an error if the context is cancelled (or
+// times out) before the lock is acquired - the lock must only be unlocked when this succeeds
+func ByIDWithContext(ctx context.Context, id string) error {
+	return armMutexKV.LockWithContext(ctx, id)
+}
+
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
 	armMutexKV.Lock(updatedName)
 }
 
-func MultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
+// ByNameWithContext locks the specified name for this type of resource, returning an error if the context
+// is cancelled (or times out) before the lock is acquired - the lock must only be unlocked when this succeeds
+func ByNameWithContext(ctx context.Context, name string, resourceType string) error {
+	updatedName := resourceType + "." + name
+	return armMutexKV.LockWithContext(ctx, updatedName)
+}
 
-	for _, name := range newSlice {
+// MultipleByName locks each of the specified names for this type of resource, which are locked
+// in a consistent (sorted) order to avoid deadlocking with another caller locking the same names
+func MultipleByName(names *[]string, resourceType string) {
+	for _, name := range sortedUniqueNames(names) {
 		ByName(name, resourceType)
 	}
 }
 
+// MultipleByNameWithContext locks each of the specified names for this type of resource in a consistent
+// (sorted) order, returning an error if the context is cancelled (or times out) before all of the locks
+// are acquired - in which case any locks which were acquired are unlocked
+func MultipleByNameWithContext(ctx context.Context, names *[]string, resourceType string) error {
+	locked := make([]string, 0)
+	for _, name := range sortedUniqueNames(names) {
+		if err := ByNameWithContext(ctx, name, resourceType); err != nil {
+			UnlockMultipleByName(&locked, resourceType)
+			return err
+		}
+
+		locked = append(locked, name)
+	}
+
+	return nil
+}
+
 func UnlockByID(id string) {
 	armMutexKV.Unlock(id)
 }
@@ -30,10 +65,22 @@ func UnlockByName(name string, resourceType string) {
 	armMutexKV.Unlock(updatedName)
 }
 
+// UnlockMultipleByName unlocks each of the specified names for this type of resource, in the
+// opposite order to which they were locked
 func UnlockMultipleByName(names *[]string, resourceType string) {
-	newSlice := removeDuplicatesFromStringArray(*names)
-
-	for _, name := range newSlice {
-		UnlockByName(name, resourceType)
+	sorted := sortedUniqueNames(names)
+	for i := len(sorted) - 1; i >= 0; i-- {
+		UnlockByName(sorted[i], resourceType)
 	}
 }
+
+// Dump returns a description of the locks which are held and waited on by each goroutine, for debugging
+func Dump() string {
+	return armMutexKV.Dump()
+}
+
+func sortedUniqueNames(names *[]string) []string {
+	newSlice := removeDuplicatesFromStringArray(*names)
+	sort.Strings(newSlice)
+	return newSlice
+}
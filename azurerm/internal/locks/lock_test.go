@@ -0,0 +1,40 @@
+package locks
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMultipleByNameLocksInASortedOrder(t *testing.T) {
+	detector := StartInversionDetection()
+
+	MultipleByName(&[]string{"b", "a", "c", "a"}, "subnet")
+	UnlockMultipleByName(&[]string{"b", "a", "c", "a"}, "subnet")
+
+	MultipleByName(&[]string{"c", "b", "a"}, "subnet")
+	UnlockMultipleByName(&[]string{"c", "b", "a"}, "subnet")
+
+	if inversions := detector.Stop(); len(inversions) != 0 {
+		t.Fatalf("expected no inversions but got %+v", inversions)
+	}
+}
+
+func TestMultipleByNameWithContextUnlocksOnFailure(t *testing.T) {
+	ByName("b", "subnet")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	if err := MultipleByNameWithContext(ctx, &[]string{"a", "b"}, "subnet"); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	UnlockByName("b", "subnet")
+
+	// "a" should have been unlocked, so this shouldn't time out
+	ctx, cancel = context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+	if err := MultipleByNameWithContext(ctx, &[]string{"a", "b"}, "subnet"); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+	UnlockMultipleByName(&[]string{"a", "b"}, "subnet")
+}
@@ -1,48 +1,220 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 )
 
+// waitLogInterval is how often the held and waiting locks are logged whilst waiting for a lock
+const waitLogInterval = 5 * time.Minute
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*lockEntry
+}
+
+// lockEntry is the mutex for a single key, alongside which goroutines are holding/waiting on it
+type lockEntry struct {
+	// mutex is a channel with a buffer of 1, which is locked by sending to it - allowing
+	// the lock to be acquired (or given up on) using a select
+	mutex chan struct{}
+
+	owner   *lockHolder
+	waiting map[int64]time.Time
+}
+
+type lockHolder struct {
+	goroutine int64
+	since     time.Time
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
+	// this can't fail since the context is never cancelled
+	_ = m.LockWithContext(context.Background(), key)
+}
+
+// LockWithContext locks the mutex for the given key, returning an error if the context is
+// cancelled (or times out) before the lock is acquired. Caller is responsible for calling
+// Unlock for the same key when this doesn't return an error
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
 	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	goroutine := currentGoroutineID()
+	entry := m.startWaiting(key, goroutine)
+
+	ticker := time.NewTicker(waitLogInterval)
+	defer ticker.Stop()
+
+	started := time.Now()
+	for {
+		select {
+		case entry.mutex <- struct{}{}:
+			m.acquired(key, goroutine)
+			log.Printf("[DEBUG] Locked %q", key)
+			return nil
+
+		case <-ticker.C:
+			log.Printf("[DEBUG] Still waiting to lock %q after %s:\n%s", key, time.Since(started).Round(time.Second), m.Dump())
+
+		case <-ctx.Done():
+			m.stopWaiting(key, goroutine)
+			return fmt.Errorf("waiting to lock %q: %+v\n\n%s", key, ctx.Err(), m.Dump())
+		}
+	}
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
-	m.get(key).Unlock()
+
+	m.lock.Lock()
+	entry := m.get(key)
+	entry.owner = nil
+	m.lock.Unlock()
+
+	select {
+	case <-entry.mutex:
+	default:
+		panic(fmt.Sprintf("unlocking %q which isn't locked", key))
+	}
+
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
-// Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+// Dump returns a description of the locks which are held and waited on by each goroutine
+func (m *mutexKV) Dump() string {
 	m.lock.Lock()
 	defer m.lock.Unlock()
-	mutex, ok := m.store[key]
+
+	type lockState struct {
+		key     string
+		holding bool
+		since   time.Time
+	}
+	goroutines := make(map[int64][]lockState)
+
+	for key, entry := range m.store {
+		if entry.owner != nil {
+			goroutines[entry.owner.goroutine] = append(goroutines[entry.owner.goroutine], lockState{
+				key:     key,
+				holding: true,
+				since:   entry.owner.since,
+			})
+		}
+
+		for goroutine, since := range entry.waiting {
+			goroutines[goroutine] = append(goroutines[goroutine], lockState{
+				key:   key,
+				since: since,
+			})
+		}
+	}
+
+	if len(goroutines) == 0 {
+		return "No locks are held or waited on."
+	}
+
+	ids := make([]int64, 0)
+	for id := range goroutines {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	now := time.Now()
+	lines := []string{"Locks held or waited on by each goroutine:"}
+	for _, id := range ids {
+		states := goroutines[id]
+		sort.Slice(states, func(i, j int) bool {
+			if states[i].holding != states[j].holding {
+				return states[i].holding
+			}
+			return states[i].key < states[j].key
+		})
+
+		lines = append(lines, fmt.Sprintf("goroutine %d:", id))
+		for _, state := range states {
+			action := "waiting on"
+			if state.holding {
+				action = "holding"
+			}
+			lines = append(lines, fmt.Sprintf("  %s %q (for %s)", action, state.key, now.Sub(state.since).Round(time.Millisecond)))
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// startWaiting records that the goroutine is waiting on the lock for the given key, returning the lock
+func (m *mutexKV) startWaiting(key string, goroutine int64) *lockEntry {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entry := m.get(key)
+	entry.waiting[goroutine] = time.Now()
+
+	if detector := activeInversionDetector(); detector != nil {
+		detector.record(m.heldBy(goroutine), key)
+	}
+
+	return entry
+}
+
+func (m *mutexKV) stopWaiting(key string, goroutine int64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	delete(m.get(key).waiting, goroutine)
+}
+
+func (m *mutexKV) acquired(key string, goroutine int64) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entry := m.get(key)
+	delete(entry.waiting, goroutine)
+	entry.owner = &lockHolder{
+		goroutine: goroutine,
+		since:     time.Now(),
+	}
+}
+
+// heldBy returns the keys currently held by the specified goroutine - the caller must hold m.lock
+func (m *mutexKV) heldBy(goroutine int64) []string {
+	keys := make([]string, 0)
+	for key, entry := range m.store {
+		if entry.owner != nil && entry.owner.goroutine == goroutine {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Returns a mutex for the given key, no guarantee of its lock status - the caller must hold m.lock
+func (m *mutexKV) get(key string) *lockEntry {
+	entry, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
-		m.store[key] = mutex
+		entry = &lockEntry{
+			mutex:   make(chan struct{}, 1),
+			waiting: make(map[int64]time.Time),
+		}
+		m.store[key] = entry
 	}
-	return mutex
+	return entry
 }
 
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*lockEntry),
 	}
 }
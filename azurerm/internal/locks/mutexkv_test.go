@@ -0,0 +1,126 @@
+package locks
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLockWithContextTimesOut(t *testing.T) {
+	kv := NewMutexKV()
+	kv.Lock("example")
+	defer kv.Unlock("example")
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+
+	err := kv.LockWithContext(ctx, "example")
+	if err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `holding "example"`) {
+		t.Fatalf("expected the error to contain the held locks but got: %+v", err)
+	}
+
+	// the goroutine should no longer be waiting
+	if dump := kv.Dump(); strings.Contains(dump, "waiting on") {
+		t.Fatalf("expected no goroutines to be waiting but got:\n%s", dump)
+	}
+}
+
+func TestLockWithContextAcquiresOnceUnlocked(t *testing.T) {
+	kv := NewMutexKV()
+	kv.Lock("example")
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		kv.Unlock("example")
+	}()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), time.Minute)
+	defer cancel()
+
+	if err := kv.LockWithContext(ctx, "example"); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+	kv.Unlock("example")
+}
+
+func TestDump(t *testing.T) {
+	kv := NewMutexKV()
+	if dump := kv.Dump(); dump != "No locks are held or waited on." {
+		t.Fatalf("expected no locks but got:\n%s", dump)
+	}
+
+	kv.Lock("first")
+	kv.Lock("second")
+	defer kv.Unlock("first")
+	defer kv.Unlock("second")
+
+	waiting := make(chan struct{})
+	go func() {
+		close(waiting)
+		kv.Lock("first")
+		kv.Unlock("first")
+	}()
+	<-waiting
+
+	// wait for the goroutine to start waiting on the lock
+	for i := 0; i < 100 && !strings.Contains(kv.Dump(), "waiting on"); i++ {
+		time.Sleep(time.Millisecond)
+	}
+
+	dump := kv.Dump()
+	for _, expected := range []string{`holding "first"`, `holding "second"`, `waiting on "first"`} {
+		if !strings.Contains(dump, expected) {
+			t.Fatalf("expected the dump to contain %q but got:\n%s", expected, dump)
+		}
+	}
+}
+
+func TestUnlockWhenNotLockedPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected a panic but didn't get one")
+		}
+	}()
+
+	NewMutexKV().Unlock("example")
+}
+
+func TestInversionDetector(t *testing.T) {
+	kv := NewMutexKV()
+	detector := StartInversionDetection()
+
+	kv.Lock("subnet.a")
+	kv.Lock("virtualNetwork.b")
+	kv.Unlock("virtualNetwork.b")
+	kv.Unlock("subnet.a")
+
+	if inversions := detector.Inversions(); len(inversions) != 0 {
+		t.Fatalf("expected no inversions but got %+v", inversions)
+	}
+
+	kv.Lock("virtualNetwork.b")
+	kv.Lock("subnet.a")
+	kv.Unlock("subnet.a")
+	kv.Unlock("virtualNetwork.b")
+
+	inversions := detector.Stop()
+	if len(inversions) != 1 {
+		t.Fatalf("expected 1 inversion but got %+v", inversions)
+	}
+	if inversions[0].First != "virtualNetwork.b" || inversions[0].Second != "subnet.a" {
+		t.Fatalf("expected the inversion to be locking %q whilst holding %q but got %+v", "subnet.a", "virtualNetwork.b", inversions[0])
+	}
+
+	// once stopped, nothing further is recorded
+	kv.Lock("virtualNetwork.c")
+	kv.Lock("subnet.a")
+	kv.Unlock("subnet.a")
+	kv.Unlock("virtualNetwork.c")
+	if inversions := detector.Inversions(); len(inversions) != 1 {
+		t.Fatalf("expected 1 inversion but got %+v", inversions)
+	}
+}
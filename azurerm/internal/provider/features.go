@@ -5,101 +5,10 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 )
 
-func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
-	// NOTE: if there's only one nested field these want to be Required (since there's no point
-	//       specifying the block otherwise) - however for 2+ they should be optional
-	features := map[string]*schema.Schema{
-		"key_vault": {
-			Type:     schema.TypeList,
-			Optional: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"recover_soft_deleted_key_vaults": {
-						Type:     schema.TypeBool,
-						Optional: true,
-					},
-					"purge_soft_delete_on_destroy": {
-						Type:     schema.TypeBool,
-						Optional: true,
-					},
-				},
-			},
-		},
-		"log_analytics_workspace": {
-			Type:     schema.TypeList,
-			Optional: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"permanently_delete_on_destroy": {
-						Type:     schema.TypeBool,
-						Required: true,
-					},
-				},
-			},
-		},
-
-		"network": {
-			Type:     schema.TypeList,
-			Optional: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"relaxed_locking": {
-						Type:     schema.TypeBool,
-						Required: true,
-					},
-				},
-			},
-		},
+//go:generate go run ../tools/generator-features/main.go -path=../../../
 
-		"template_deployment": {
-			Type:     schema.TypeList,
-			Optional: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"delete_nested_items_during_deletion": {
-						Type:     schema.TypeBool,
-						Required: true,
-					},
-				},
-			},
-		},
-
-		"virtual_machine": {
-			Type:     schema.TypeList,
-			Optional: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"delete_os_disk_on_deletion": {
-						Type:     schema.TypeBool,
-						Optional: true,
-					},
-					"graceful_shutdown": {
-						Type:     schema.TypeBool,
-						Optional: true,
-					},
-				},
-			},
-		},
-
-		"virtual_machine_scale_set": {
-			Type:     schema.TypeList,
-			Optional: true,
-			MaxItems: 1,
-			Elem: &schema.Resource{
-				Schema: map[string]*schema.Schema{
-					"roll_instances_when_required": {
-						Type:     schema.TypeBool,
-						Required: true,
-					},
-				},
-			},
-		},
-	}
+func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
+	features := schemaFeatureBlocks()
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
 	// rather than doing it as a big-bang and breaking all open PR's
@@ -124,81 +33,67 @@ func schemaFeatures(supportLegacyTestSuite bool) *schema.Schema {
 	}
 }
 
-func expandFeatures(input []interface{}) features.UserFeatures {
-	// these are the defaults if omitted from the config
-	features := features.Default()
-
-	if len(input) == 0 || input[0] == nil {
-		return features
-	}
-
-	val := input[0].(map[string]interface{})
-
-	if raw, ok := val["key_vault"]; ok {
-		items := raw.([]interface{})
-		if len(items) > 0 {
-			keyVaultRaw := items[0].(map[string]interface{})
-			if v, ok := keyVaultRaw["purge_soft_delete_on_destroy"]; ok {
-				features.KeyVault.PurgeSoftDeleteOnDestroy = v.(bool)
-			}
-			if v, ok := keyVaultRaw["recover_soft_deleted_key_vaults"]; ok {
-				features.KeyVault.RecoverSoftDeletedKeyVaults = v.(bool)
+// schemaFeatureBlocks returns the Schema for each block within the `features` block, which is generated from the Features registry
+func schemaFeatureBlocks() map[string]*schema.Schema {
+	output := make(map[string]*schema.Schema)
+
+	for _, block := range features.Blocks() {
+		// NOTE: if there's only one nested field these want to be Required (since there's no point
+		//       specifying the block otherwise) - however for 2+ they should be optional
+		required := len(block.Features) == 1
+
+		fields := make(map[string]*schema.Schema)
+		for _, feature := range block.Features {
+			fields[feature.Name] = &schema.Schema{
+				Type:        schema.TypeBool,
+				Required:    required,
+				Optional:    !required,
+				Description: feature.Description,
+				Deprecated:  feature.Deprecated,
 			}
 		}
-	}
 
-	if raw, ok := val["log_analytics_workspace"]; ok {
-		items := raw.([]interface{})
-		if len(items) > 0 {
-			logAnalyticsWorkspaceRaw := items[0].(map[string]interface{})
-			if v, ok := logAnalyticsWorkspaceRaw["permanently_delete_on_destroy"]; ok {
-				features.LogAnalyticsWorkspace.PermanentlyDeleteOnDestroy = v.(bool)
-			}
+		output[block.Name] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: fields,
+			},
 		}
 	}
 
-	if raw, ok := val["network"]; ok {
-		items := raw.([]interface{})
-		if len(items) > 0 {
-			networkRaw := items[0].(map[string]interface{})
-			if v, ok := networkRaw["relaxed_locking"]; ok {
-				features.Network.RelaxedLocking = v.(bool)
-			}
-		}
+	return output
+}
+
+func expandFeatures(input []interface{}) features.UserFeatures {
+	// these are the defaults if omitted from the config
+	output := features.Default()
+
+	if len(input) == 0 || input[0] == nil {
+		return output
 	}
 
-	if raw, ok := val["template_deployment"]; ok {
-		items := raw.([]interface{})
-		if len(items) > 0 {
-			networkRaw := items[0].(map[string]interface{})
-			if v, ok := networkRaw["delete_nested_items_during_deletion"]; ok {
-				features.TemplateDeployment.DeleteNestedItemsDuringDeletion = v.(bool)
-			}
+	val := input[0].(map[string]interface{})
+
+	for _, block := range features.Blocks() {
+		raw, ok := val[block.Name]
+		if !ok {
+			continue
 		}
-	}
 
-	if raw, ok := val["virtual_machine"]; ok {
 		items := raw.([]interface{})
-		if len(items) > 0 {
-			virtualMachinesRaw := items[0].(map[string]interface{})
-			if v, ok := virtualMachinesRaw["delete_os_disk_on_deletion"]; ok {
-				features.VirtualMachine.DeleteOSDiskOnDeletion = v.(bool)
-			}
-			if v, ok := virtualMachinesRaw["graceful_shutdown"]; ok {
-				features.VirtualMachine.GracefulShutdown = v.(bool)
-			}
+		if len(items) == 0 || items[0] == nil {
+			continue
 		}
-	}
 
-	if raw, ok := val["virtual_machine_scale_set"]; ok {
-		items := raw.([]interface{})
-		if len(items) > 0 {
-			scaleSetRaw := items[0].(map[string]interface{})
-			if v, ok := scaleSetRaw["roll_instances_when_required"]; ok {
-				features.VirtualMachineScaleSet.RollInstancesWhenRequired = v.(bool)
+		blockRaw := items[0].(map[string]interface{})
+		for _, feature := range block.Features {
+			if v, ok := blockRaw[feature.Name]; ok {
+				feature.Apply(&output, v.(bool))
 			}
 		}
 	}
 
-	return features
+	return output
 }
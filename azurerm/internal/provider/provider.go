@@ -12,9 +12,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -61,6 +64,24 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 			dataSources[key] = dataSource
 		}
 
+		if v, ok := service.(sdk.TypedServiceRegistrationWithListDataSources); ok {
+			debugLog("[DEBUG] Registering List Data Sources for %q..", service.Name())
+			for _, ds := range v.ListDataSources() {
+				key := ds.ResourceType()
+				if existing := dataSources[key]; existing != nil {
+					panic(fmt.Sprintf("An existing Data Source exists for %q", key))
+				}
+
+				wrapper := sdk.NewListDataSourceWrapper(ds)
+				dataSource, err := wrapper.DataSource()
+				if err != nil {
+					panic(fmt.Errorf("creating Wrapper for List Data Source %q: %+v", key, err))
+				}
+
+				dataSources[key] = dataSource
+			}
+		}
+
 		debugLog("[DEBUG] Registering Resources for %q..", service.Name())
 		for _, r := range service.Resources() {
 			key := r.ResourceType()
@@ -98,6 +119,23 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
+	// then add the `tags_all` field to Resources which support Tags, so that Default Tags are shown in the diff
+	for k, v := range resources {
+		wrapResourceForDefaultTags(k, v)
+	}
+
+	// then allow Resources within other Subscriptions to be managed using the Clients for that Subscription
+	for _, v := range resources {
+		wrapResourceForSubscriptions(v)
+	}
+
+	// then register the Resource ID Parsers, so that Resource ID's can be mapped back to the Resource which manages them
+	for _, service := range supportedResourceIDServices() {
+		if err := resourceid.Register(service.ResourceIDs()...); err != nil {
+			panic(fmt.Errorf("registering Resource ID's: %+v", err))
+		}
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -214,6 +252,14 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"default_tags": schemaDefaultTags(),
+
+			"ignore_tags": schemaIgnoreTags(),
+
+			"throttling": schemaThrottling(),
+
+			"timeouts": schemaTimeouts(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -222,6 +268,13 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				Description: "Should the AzureRM Provider skip registering all of the Resource Providers that it supports, if they're not already registered?",
 			},
 
+			"read_only": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_READ_ONLY", false),
+				Description: "Should the AzureRM Provider refuse to send any requests which could modify resources? This also skips registering Resource Providers.",
+			},
+
 			"storage_use_azuread": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -243,12 +296,12 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
-	p.ConfigureFunc = providerConfigure(p)
+	p.ConfigureFunc = providerConfigure(p, supportLegacyTestSuite)
 
 	return p
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
+func providerConfigure(p *schema.Provider, supportLegacyTestSuite bool) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -305,16 +358,34 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
-		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+		resourceTimeouts, err := expandTimeouts(d.Get("timeouts").([]interface{}), p.ResourcesMap)
+		if err != nil {
+			return nil, err
+		}
+		if err := timeouts.ConfigureResources(p.ResourcesMap, resourceTimeouts); err != nil {
+			return nil, err
+		}
+
+		// Resource Providers can't be registered when running in read-only mode
+		readOnly := d.Get("read_only").(bool)
+		skipProviderRegistration := d.Get("skip_provider_registration").(bool) || readOnly
+
+		// requests are only recorded/replayed by the Provider used in the acceptance tests
+		recordingEnabled := supportLegacyTestSuite && recording.Enabled()
+
 		clientBuilder := clients.ClientBuilder{
 			AuthConfig:                  config,
 			SkipProviderRegistration:    skipProviderRegistration,
 			TerraformVersion:            terraformVersion,
 			PartnerId:                   d.Get("partner_id").(string),
+			ReadOnly:                    readOnly,
+			RecordingEnabled:            recordingEnabled,
 			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
 			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
 			Features:                    expandFeatures(d.Get("features").([]interface{})),
 			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
+			Throttling:                  expandThrottling(d.Get("throttling").([]interface{})),
+			Tags:                        expandTagsConfiguration(d.Get("default_tags").(map[string]interface{}), d.Get("ignore_tags").([]interface{})),
 		}
 		client, err := clients.Build(p.StopContext(), clientBuilder)
 		if err != nil {
@@ -196,3 +196,19 @@ func SupportedUntypedServices() []sdk.UntypedServiceRegistration {
 		web.Registration{},
 	}
 }
+
+// supportedResourceIDServices returns each of the Typed and Untyped Services which register Resource ID Parsers
+func supportedResourceIDServices() []sdk.ServiceRegistrationWithResourceIDs {
+	out := make([]sdk.ServiceRegistrationWithResourceIDs, 0)
+	for _, service := range SupportedTypedServices() {
+		if v, ok := service.(sdk.ServiceRegistrationWithResourceIDs); ok {
+			out = append(out, v)
+		}
+	}
+	for _, service := range SupportedUntypedServices() {
+		if v, ok := service.(sdk.ServiceRegistrationWithResourceIDs); ok {
+			out = append(out, v)
+		}
+	}
+	return out
+}
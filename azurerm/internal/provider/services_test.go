@@ -3,6 +3,7 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
 )
 
@@ -31,3 +32,43 @@ func TestTypedResourcesContainValidModelObjects(t *testing.T) {
 		}
 	}
 }
+
+func TestTypedDataSourcesAreConsistentWithTheirModelObjects(t *testing.T) {
+	for _, service := range SupportedTypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, dataSource := range service.DataSources() {
+			t.Logf("- DataSources %q..", dataSource.ResourceType())
+			if err := sdk.ValidateDataSourceConsistency(dataSource); err != nil {
+				t.Fatalf("validating consistency of %q: %+v", dataSource.ResourceType(), err)
+			}
+		}
+	}
+}
+
+func TestTypedResourcesAreConsistentWithTheirModelObjects(t *testing.T) {
+	for _, service := range SupportedTypedServices() {
+		t.Logf("Service %q..", service.Name())
+		for _, resource := range service.Resources() {
+			t.Logf("- Resource %q..", resource.ResourceType())
+			if err := sdk.ValidateResourceConsistency(resource); err != nil {
+				t.Fatalf("validating consistency of %q: %+v", resource.ResourceType(), err)
+			}
+		}
+	}
+}
+
+func TestResourceIDRegistrationsAreForSupportedResources(t *testing.T) {
+	resources := TestAzureProvider().(*schema.Provider).ResourcesMap
+
+	registered := make(map[string]struct{})
+	for _, service := range supportedResourceIDServices() {
+		for _, registration := range service.ResourceIDs() {
+			if _, ok := resources[registration.TerraformResourceType]; !ok {
+				t.Fatalf("the Resource ID %q is registered for %q which isn't a supported Resource", registration.ResourceType, registration.TerraformResourceType)
+			}
+			registered[registration.TerraformResourceType] = struct{}{}
+		}
+	}
+
+	t.Logf("Resource ID's are registered for %d of %d Resources", len(registered), len(resources))
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+// wrapResourceForSubscriptions wraps the Read, Update and Delete functions (and the Importer) for the
+// specified Resource, so that a Resource whose ID is within a Resource Group in another Subscription uses
+// the Clients for that Subscription (which are built the first time they're needed) rather than the
+// Provider's Subscription. Resources which are assigned to a scope (such as Role Assignments) continue to
+// use the Provider's Clients, since these aren't bound to a Subscription.
+//
+// NOTE: Create and the Data Sources use the Provider's Subscription, since the Subscription can only be
+// determined from the ID of the Resource - which isn't known until it's created
+func wrapResourceForSubscriptions(resource *schema.Resource) {
+	wrap := func(f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if f == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			client, err := clientForResource(d, meta)
+			if err != nil {
+				return err
+			}
+
+			return f(d, client)
+		}
+	}
+
+	resource.Read = wrap(resource.Read)
+	resource.Update = wrap(resource.Update)
+	resource.Delete = wrap(resource.Delete)
+
+	if resource.Importer != nil && resource.Importer.State != nil {
+		importer := resource.Importer.State
+		resource.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			client, err := clientForResource(d, meta)
+			if err != nil {
+				return nil, err
+			}
+
+			return importer(d, client)
+		}
+	}
+}
+
+func clientForResource(d *schema.ResourceData, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*clients.Client)
+	if !ok || d.Id() == "" {
+		return meta, nil
+	}
+
+	return client.ForResourceID(d.Id())
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+)
+
+// resourcesWithoutDefaultTags are Resources which support Tags but don't assign the Default Tags,
+// since the Tags aren't (or are no longer) sent to the API in the usual format
+var resourcesWithoutDefaultTags = map[string]struct{}{
+	"azurerm_log_analytics_saved_search": {},
+	"azurerm_media_streaming_endpoint":   {},
+	"azurerm_netapp_snapshot":            {},
+}
+
+func schemaDefaultTags() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeMap,
+		Optional:     true,
+		ValidateFunc: tags.Validate,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+		Description: "A mapping of tags which should be assigned to every resource which supports tags.",
+	}
+}
+
+func schemaIgnoreTags() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of tag keys which should be ignored on every resource.",
+				},
+				"key_prefixes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of tag key prefixes which should be ignored on every resource.",
+				},
+			},
+		},
+		Description: "Tags which should be ignored on every resource, for example tags assigned by Azure Policy.",
+	}
+}
+
+func expandTagsConfiguration(defaultTags map[string]interface{}, ignoreTags []interface{}) tags.ProviderConfiguration {
+	config := tags.ProviderConfiguration{}
+
+	if len(defaultTags) > 0 {
+		config.DefaultTags = make(map[string]string, len(defaultTags))
+		for k, v := range defaultTags {
+			config.DefaultTags[k] = v.(string)
+		}
+	}
+
+	if len(ignoreTags) == 0 || ignoreTags[0] == nil {
+		return config
+	}
+
+	val := ignoreTags[0].(map[string]interface{})
+	if raw, ok := val["keys"]; ok {
+		config.IgnoreKeys = expandSortedStringSet(raw.(*schema.Set))
+	}
+	if raw, ok := val["key_prefixes"]; ok {
+		config.IgnoreKeyPrefixes = expandSortedStringSet(raw.(*schema.Set))
+	}
+
+	return config
+}
+
+func expandSortedStringSet(input *schema.Set) []string {
+	if input.Len() == 0 {
+		return nil
+	}
+
+	output := make([]string, 0)
+	for _, v := range input.List() {
+		output = append(output, v.(string))
+	}
+	sort.Strings(output)
+	return output
+}
+
+// wrapResourceForDefaultTags adds a computed `tags_all` field to Resources which support Tags, containing all
+// of the Tags assigned to the Resource (including any Default Tags) so that changes to the Default Tags, or a
+// Default Tag being removed outside of Terraform, are shown in the diff and sent to the API during an Update
+func wrapResourceForDefaultTags(name string, resource *schema.Resource) {
+	if _, ok := resourcesWithoutDefaultTags[name]; ok {
+		return
+	}
+
+	v, ok := resource.Schema["tags"]
+	if !ok || v.Type != schema.TypeMap || !v.Optional || v.Computed {
+		return
+	}
+
+	resource.Schema["tags_all"] = tags.SchemaAll()
+
+	diff := customizeDiffForDefaultTags(v.ForceNew)
+	if resource.CustomizeDiff != nil {
+		diff = customdiff.Sequence(resource.CustomizeDiff, diff)
+	}
+	resource.CustomizeDiff = diff
+}
+
+func customizeDiffForDefaultTags(forceNew bool) schema.CustomizeDiffFunc {
+	return func(d *schema.ResourceDiff, meta interface{}) error {
+		client, ok := meta.(*clients.Client)
+		if !ok {
+			return nil
+		}
+
+		if !d.NewValueKnown("tags") {
+			return d.SetNewComputed("tags_all")
+		}
+
+		old, _ := d.GetChange("tags_all")
+		all := tags.ExpandAllWithDefaults(client.Tags, d.Get("tags").(map[string]interface{}))
+		if reflect.DeepEqual(old, all) {
+			return nil
+		}
+
+		if err := d.SetNew("tags_all", all); err != nil {
+			return err
+		}
+
+		if forceNew && d.Id() != "" {
+			return d.ForceNew("tags_all")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+)
+
+func TestExpandTagsConfiguration(t *testing.T) {
+	testData := []struct {
+		Name        string
+		DefaultTags map[string]interface{}
+		IgnoreTags  []interface{}
+		Expected    tags.ProviderConfiguration
+	}{
+		{
+			Name:        "Empty",
+			DefaultTags: map[string]interface{}{},
+			IgnoreTags:  []interface{}{},
+			Expected:    tags.ProviderConfiguration{},
+		},
+		{
+			Name: "Default Tags",
+			DefaultTags: map[string]interface{}{
+				"cost-center": "1234",
+			},
+			IgnoreTags: []interface{}{},
+			Expected: tags.ProviderConfiguration{
+				DefaultTags: map[string]string{
+					"cost-center": "1234",
+				},
+			},
+		},
+		{
+			Name:        "Ignore Tags",
+			DefaultTags: map[string]interface{}{},
+			IgnoreTags: []interface{}{
+				map[string]interface{}{
+					"keys":         schema.NewSet(schema.HashString, []interface{}{"owner", "CreatedBy"}),
+					"key_prefixes": schema.NewSet(schema.HashString, []interface{}{"policy:"}),
+				},
+			},
+			Expected: tags.ProviderConfiguration{
+				IgnoreKeys:        []string{"CreatedBy", "owner"},
+				IgnoreKeyPrefixes: []string{"policy:"},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := expandTagsConfiguration(v.DefaultTags, v.IgnoreTags)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestWrapResourceForDefaultTags(t *testing.T) {
+	meta := &clients.Client{
+		Tags: tags.ProviderConfiguration{
+			DefaultTags: map[string]string{
+				"cost-center": "1234",
+			},
+		},
+	}
+
+	testData := []struct {
+		Name            string
+		ForceNew        bool
+		State           map[string]string
+		ExpectedAll     map[string]string
+		ExpectedReplace bool
+	}{
+		{
+			Name: "New Resource",
+			ExpectedAll: map[string]string{
+				"tags_all.%":           "2",
+				"tags_all.cost-center": "1234",
+				"tags_all.environment": "production",
+			},
+		},
+		{
+			Name: "Default Tag Added",
+			State: map[string]string{
+				"tags_all.%":           "1",
+				"tags_all.environment": "production",
+			},
+			ExpectedAll: map[string]string{
+				"tags_all.%":           "2",
+				"tags_all.cost-center": "1234",
+			},
+		},
+		{
+			Name: "Default Tag Added requiring Replacement",
+			State: map[string]string{
+				"tags_all.%":           "1",
+				"tags_all.environment": "production",
+			},
+			ForceNew: true,
+			ExpectedAll: map[string]string{
+				"tags_all.%":           "2",
+				"tags_all.cost-center": "1234",
+			},
+			ExpectedReplace: true,
+		},
+		{
+			Name: "Default Tag Unchanged",
+			State: map[string]string{
+				"tags_all.%":           "2",
+				"tags_all.cost-center": "1234",
+				"tags_all.environment": "production",
+			},
+			ExpectedAll: map[string]string{},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		tagsSchema := tags.Schema()
+		if v.ForceNew {
+			tagsSchema = tags.ForceNewSchema()
+		}
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": tagsSchema,
+			},
+		}
+		wrapResourceForDefaultTags("azurerm_example", resource)
+
+		var state *terraform.InstanceState
+		if v.State != nil {
+			state = &terraform.InstanceState{
+				ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+				Attributes: map[string]string{
+					"tags.%":           "1",
+					"tags.environment": "production",
+				},
+			}
+			for k, val := range v.State {
+				state.Attributes[k] = val
+			}
+		}
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"tags": map[string]interface{}{
+				"environment": "production",
+			},
+		})
+
+		diff, err := resource.Diff(state, config, meta)
+		if err != nil {
+			t.Fatalf("diffing: %+v", err)
+		}
+
+		actualAll := make(map[string]string)
+		replace := false
+		if diff != nil {
+			for k, attr := range diff.Attributes {
+				if strings.HasPrefix(k, "tags_all.") && attr.New != attr.Old {
+					actualAll[k] = attr.New
+				}
+				replace = replace || attr.RequiresNew
+			}
+		}
+		if !reflect.DeepEqual(actualAll, v.ExpectedAll) {
+			t.Fatalf("Expected `tags_all` diff %+v but got %+v", v.ExpectedAll, actualAll)
+		}
+		if replace != v.ExpectedReplace {
+			t.Fatalf("Expected replacement to be %t but got %t", v.ExpectedReplace, replace)
+		}
+	}
+}
+
+func TestWrapResourceForDefaultTagsSkipsUnsupportedResources(t *testing.T) {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tags.Schema(),
+		},
+	}
+	wrapResourceForDefaultTags("azurerm_netapp_snapshot", resource)
+	if _, ok := resource.Schema["tags_all"]; ok {
+		t.Fatalf("Expected `tags_all` not to be added to a Resource which doesn't assign Default Tags")
+	}
+}
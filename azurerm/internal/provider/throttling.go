@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func schemaThrottling() *schema.Schema {
+	defaults := common.DefaultThrottlingOptions()
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"requests_per_second": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaults.RequestsPerSecond,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of requests per second which can be sent to Azure Resource Manager for each Subscription. Setting this to `0` disables client-side rate limiting.",
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaults.Burst,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "The maximum number of requests which can be sent to Azure Resource Manager at once for each Subscription.",
+				},
+				"max_retries": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      defaults.MaxRetries,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The number of times a request which is throttled by Azure Resource Manager should be retried, including any retries made by the underlying Azure SDK.",
+				},
+			},
+		},
+	}
+}
+
+func expandThrottling(input []interface{}) common.ThrottlingOptions {
+	// these are the defaults if omitted from the config
+	options := common.DefaultThrottlingOptions()
+
+	if len(input) == 0 || input[0] == nil {
+		return options
+	}
+
+	val := input[0].(map[string]interface{})
+	if v, ok := val["requests_per_second"]; ok {
+		options.RequestsPerSecond = v.(int)
+	}
+	if v, ok := val["burst"]; ok {
+		options.Burst = v.(int)
+	}
+	if v, ok := val["max_retries"]; ok {
+		options.MaxRetries = v.(int)
+	}
+
+	return options
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func schemaTimeouts() *schema.Schema {
+	durationSchema := func(operation string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateDuration,
+			Description:  fmt.Sprintf("The Timeout used when %s this type of Resource, unless a Timeout is specified in the `timeouts` block for the Resource.", operation),
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+					Description:  "The type of Resource which these Timeouts should be used for, for example `azurerm_kubernetes_cluster`.",
+				},
+				"create": durationSchema("creating"),
+				"read":   durationSchema("retrieving"),
+				"update": durationSchema("updating"),
+				"delete": durationSchema("deleting"),
+			},
+		},
+	}
+}
+
+func validateDuration(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	duration, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a duration (for example `90m` or `2h`): %+v", k, err))
+		return
+	}
+	if duration <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be greater than 0", k))
+	}
+
+	return
+}
+
+func expandTimeouts(input []interface{}, resources map[string]*schema.Resource) (map[string]timeouts.ResourceTimeouts, error) {
+	output := make(map[string]timeouts.ResourceTimeouts)
+
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		val := raw.(map[string]interface{})
+
+		resourceType := val["resource_type"].(string)
+		if _, ok := resources[resourceType]; !ok {
+			return nil, fmt.Errorf("`timeouts` were specified for %q which isn't a Resource supported by this Provider", resourceType)
+		}
+		if _, exists := output[resourceType]; exists {
+			return nil, fmt.Errorf("`timeouts` were specified for %q multiple times", resourceType)
+		}
+
+		resourceTimeouts := timeouts.ResourceTimeouts{}
+		for key, target := range map[string]**time.Duration{
+			"create": &resourceTimeouts.Create,
+			"read":   &resourceTimeouts.Read,
+			"update": &resourceTimeouts.Update,
+			"delete": &resourceTimeouts.Delete,
+		} {
+			v, ok := val[key].(string)
+			if !ok || v == "" {
+				continue
+			}
+
+			duration, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the `%s` timeout for %q: %+v", key, resourceType, err)
+			}
+			*target = &duration
+		}
+
+		output[resourceType] = resourceTimeouts
+	}
+
+	return output, nil
+}
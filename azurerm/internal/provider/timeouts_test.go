@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestExpandTimeouts(t *testing.T) {
+	resources := map[string]*schema.Resource{
+		"azurerm_kubernetes_cluster": {},
+	}
+
+	testData := []struct {
+		Name        string
+		Input       []interface{}
+		ShouldError bool
+		Expected    map[string]time.Duration
+	}{
+		{
+			Name:     "Empty",
+			Input:    []interface{}{},
+			Expected: map[string]time.Duration{},
+		},
+		{
+			Name: "Create Timeout",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_kubernetes_cluster",
+					"create":        "2h",
+					"read":          "",
+				},
+			},
+			Expected: map[string]time.Duration{
+				"create": 2 * time.Hour,
+			},
+		},
+		{
+			Name: "Unsupported Resource",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_does_not_exist",
+					"create":        "2h",
+				},
+			},
+			ShouldError: true,
+		},
+		{
+			Name: "Duplicate Resource",
+			Input: []interface{}{
+				map[string]interface{}{
+					"resource_type": "azurerm_kubernetes_cluster",
+					"create":        "2h",
+				},
+				map[string]interface{}{
+					"resource_type": "azurerm_kubernetes_cluster",
+					"delete":        "2h",
+				},
+			},
+			ShouldError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := expandTimeouts(v.Input, resources)
+		if err != nil {
+			if v.ShouldError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.ShouldError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		for key, expected := range v.Expected {
+			timeouts := actual["azurerm_kubernetes_cluster"]
+			var value *time.Duration
+			switch key {
+			case "create":
+				value = timeouts.Create
+			case "read":
+				value = timeouts.Read
+			}
+
+			if value == nil || *value != expected {
+				t.Fatalf("Expected the %s timeout to be %s but got %v", key, expected, value)
+			}
+		}
+
+		if len(v.Expected) > 0 && actual["azurerm_kubernetes_cluster"].Read != nil {
+			t.Fatalf("Expected the read timeout to be unset")
+		}
+	}
+}
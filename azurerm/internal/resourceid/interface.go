@@ -1,5 +1,17 @@
 package resourceid
 
+// Formatter is a Resource ID which can be formatted as a string
 type Formatter interface {
 	ID() string
 }
+
+// SegmentedID is a Resource ID which is parsed using a list of Segments
+type SegmentedID interface {
+	Formatter
+
+	// Matches returns whether the specified Resource ID refers to this Resource
+	Matches(input string) bool
+
+	// Segments returns the list of Segments which make up this Resource ID
+	Segments() []Segment
+}
@@ -0,0 +1,250 @@
+package resourceid
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Parser parses the specified Resource ID, returning a Formatter for this Resource ID
+type Parser func(input string) (Formatter, error)
+
+// Registration maps an Azure Resource Manager Resource Type to the Terraform Resource
+// Type which manages it, and the Parser for it's Resource ID
+type Registration struct {
+	// ResourceType is the Azure Resource Manager Resource Type (e.g. `Microsoft.Network/virtualNetworks`)
+	// for nested resources this contains each of the types, e.g. `Microsoft.Network/virtualNetworks/subnets`
+	ResourceType string
+
+	// TerraformResourceType is the Terraform Resource Type (e.g. `azurerm_virtual_network`)
+	TerraformResourceType string
+
+	// Parser parses the Resource ID for this Resource
+	Parser Parser
+}
+
+// Match is a Terraform Resource Type which can manage a Resource ID
+type Match struct {
+	// TerraformResourceType is the Terraform Resource Type (e.g. `azurerm_virtual_network`)
+	TerraformResourceType string
+
+	// ID is the parsed Resource ID
+	ID Formatter
+}
+
+// Registry is a lookup of Azure Resource Manager Resource Types to the Terraform Resource
+// Types (and the Parsers for their Resource ID's) which manage them
+type Registry struct {
+	lock sync.RWMutex
+
+	// registrations is a map of the Terraform Resource Type to the Registration
+	registrations map[string]Registration
+
+	// resourceTypes is a map of the (lower-cased) Resource Manager Resource Type to the Terraform Resource Types
+	resourceTypes map[string][]string
+}
+
+// NewRegistry returns an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		registrations: make(map[string]Registration),
+		resourceTypes: make(map[string][]string),
+	}
+}
+
+var defaultRegistry = NewRegistry()
+
+// Register registers the specified Registrations in the default Registry
+func Register(registrations ...Registration) error {
+	return defaultRegistry.Register(registrations...)
+}
+
+// Lookup looks up the Terraform Resource Type which manages the specified Resource ID in the default Registry
+func Lookup(id string) (string, Formatter, error) {
+	return defaultRegistry.Lookup(id)
+}
+
+// LookupAll looks up each of the Terraform Resource Types which can manage the specified
+// Resource ID in the default Registry
+func LookupAll(id string) ([]Match, error) {
+	return defaultRegistry.LookupAll(id)
+}
+
+// SuggestResourceType returns a hint describing the Terraform Resource Type which manages the
+// specified Resource ID in the default Registry, when this differs from the Terraform Resource Type specified
+func SuggestResourceType(id string, terraformResourceType string) (string, bool) {
+	return defaultRegistry.SuggestResourceType(id, terraformResourceType)
+}
+
+// Register registers the specified Registrations in this Registry
+// NOTE: registering an existing Terraform Resource Type replaces the existing Registration
+func (r *Registry) Register(registrations ...Registration) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for _, registration := range registrations {
+		if registration.TerraformResourceType == "" {
+			return fmt.Errorf("a Terraform Resource Type must be specified for %q", registration.ResourceType)
+		}
+		if registration.ResourceType == "" {
+			return fmt.Errorf("a Resource Type must be specified for %q", registration.TerraformResourceType)
+		}
+		if registration.Parser == nil {
+			return fmt.Errorf("a Parser must be specified for %q", registration.TerraformResourceType)
+		}
+
+		if existing, ok := r.registrations[registration.TerraformResourceType]; ok {
+			r.removeResourceType(existing)
+		}
+
+		key := strings.ToLower(registration.ResourceType)
+		r.registrations[registration.TerraformResourceType] = registration
+		r.resourceTypes[key] = append(r.resourceTypes[key], registration.TerraformResourceType)
+		sort.Strings(r.resourceTypes[key])
+	}
+
+	return nil
+}
+
+func (r *Registry) removeResourceType(registration Registration) {
+	key := strings.ToLower(registration.ResourceType)
+	out := make([]string, 0)
+	for _, v := range r.resourceTypes[key] {
+		if v != registration.TerraformResourceType {
+			out = append(out, v)
+		}
+	}
+	r.resourceTypes[key] = out
+}
+
+// Lookup looks up the Terraform Resource Type which manages the specified Resource ID, returning
+// the Terraform Resource Type and the parsed Resource ID
+//
+// An error is returned if no Terraform Resource Type can parse this Resource ID, or if more than
+// one can - in which case LookupAll can be used to retrieve each of them
+func (r *Registry) Lookup(id string) (string, Formatter, error) {
+	matches, err := r.LookupAll(id)
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(matches) > 1 {
+		types := make([]string, 0)
+		for _, match := range matches {
+			types = append(types, match.TerraformResourceType)
+		}
+		return "", nil, fmt.Errorf("the Resource ID %q could be managed by any of the Resources: %s", id, strings.Join(types, ", "))
+	}
+
+	return matches[0].TerraformResourceType, matches[0].ID, nil
+}
+
+// LookupAll looks up each of the Terraform Resource Types which can manage the specified Resource ID
+// returning an error if none are found
+func (r *Registry) LookupAll(id string) ([]Match, error) {
+	resourceType, err := ResourceTypeFromID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	matches := make([]Match, 0)
+	errors := make([]string, 0)
+	for _, terraformResourceType := range r.resourceTypes[strings.ToLower(resourceType)] {
+		registration := r.registrations[terraformResourceType]
+		parsed, err := registration.Parser(id)
+		if err != nil {
+			errors = append(errors, fmt.Sprintf("%s: %+v", terraformResourceType, err))
+			continue
+		}
+
+		matches = append(matches, Match{
+			TerraformResourceType: terraformResourceType,
+			ID:                    parsed,
+		})
+	}
+
+	if len(matches) == 0 {
+		if len(errors) > 0 {
+			return nil, fmt.Errorf("parsing the Resource ID %q as a %q: %s", id, resourceType, strings.Join(errors, ", "))
+		}
+
+		return nil, fmt.Errorf("no Resources are registered for the Resource Type %q", resourceType)
+	}
+
+	return matches, nil
+}
+
+// SuggestResourceType returns a hint describing the Terraform Resource Type(s) which manage the
+// specified Resource ID, when this differs from the Terraform Resource Type specified - which is
+// used to provide a more helpful error when the ID for a different type of Resource is specified
+func (r *Registry) SuggestResourceType(id string, terraformResourceType string) (string, bool) {
+	matches, err := r.LookupAll(id)
+	if err != nil {
+		return "", false
+	}
+
+	types := make([]string, 0)
+	for _, match := range matches {
+		if match.TerraformResourceType == terraformResourceType {
+			return "", false
+		}
+
+		types = append(types, fmt.Sprintf("`%s`", match.TerraformResourceType))
+	}
+
+	if len(types) == 1 {
+		return fmt.Sprintf("this looks like the ID of a %s resource rather than a `%s` resource", types[0], terraformResourceType), true
+	}
+
+	return fmt.Sprintf("this looks like the ID of one of the resources %s rather than a `%s` resource", strings.Join(types, ", "), terraformResourceType), true
+}
+
+// ResourceTypeFromID returns the Azure Resource Manager Resource Type for the specified Resource ID
+// for example `/subscriptions/{id}/resourceGroups/{group}/providers/Microsoft.Network/virtualNetworks/{name}/subnets/{name}`
+// returns `Microsoft.Network/virtualNetworks/subnets`
+func ResourceTypeFromID(id string) (string, error) {
+	path := strings.Trim(id, "/")
+	if path == "" {
+		return "", fmt.Errorf("the Resource ID was empty")
+	}
+
+	segments := strings.Split(path, "/")
+	if len(segments)%2 != 0 {
+		return "", fmt.Errorf("the number of segments in the Resource ID %q is not divisible by 2", id)
+	}
+
+	// extension resources can be nested within other resources, so it's the last provider which matters
+	providerIndex := -1
+	for i := 0; i < len(segments); i += 2 {
+		if strings.EqualFold(segments[i], "providers") {
+			providerIndex = i
+		}
+	}
+
+	if providerIndex == -1 {
+		switch {
+		case len(segments) == 4 && strings.EqualFold(segments[0], "subscriptions") && strings.EqualFold(segments[2], "resourceGroups"):
+			return "Microsoft.Resources/resourceGroups", nil
+
+		case len(segments) == 2 && strings.EqualFold(segments[0], "subscriptions"):
+			return "Microsoft.Resources/subscriptions", nil
+		}
+
+		return "", fmt.Errorf("the Resource ID %q doesn't contain a Resource Provider", id)
+	}
+
+	if providerIndex+2 >= len(segments) {
+		return "", fmt.Errorf("the Resource ID %q doesn't contain a Resource Type", id)
+	}
+
+	types := []string{segments[providerIndex+1]}
+	for i := providerIndex + 2; i < len(segments); i += 2 {
+		types = append(types, segments[i])
+	}
+
+	return strings.Join(types, "/"), nil
+}
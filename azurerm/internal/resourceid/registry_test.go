@@ -0,0 +1,307 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type testId struct {
+	id string
+}
+
+func (id testId) ID() string {
+	return id.id
+}
+
+func testParser(segments int, resourceGroupScoped bool) Parser {
+	return func(input string) (Formatter, error) {
+		split := strings.Split(strings.Trim(input, "/"), "/")
+		if len(split) != segments {
+			return nil, fmt.Errorf("expected %d segments but got %d", segments, len(split))
+		}
+		if resourceGroupScoped && !strings.EqualFold(split[2], "resourceGroups") {
+			return nil, fmt.Errorf("expected a Resource Group scoped ID")
+		}
+
+		return testId{id: input}, nil
+	}
+}
+
+func testRegistry(t *testing.T) *Registry {
+	registry := NewRegistry()
+	err := registry.Register(
+		Registration{
+			ResourceType:          "Microsoft.Resources/resourceGroups",
+			TerraformResourceType: "azurerm_resource_group",
+			Parser:                testParser(4, true),
+		},
+		Registration{
+			ResourceType:          "Microsoft.Network/virtualNetworks",
+			TerraformResourceType: "azurerm_virtual_network",
+			Parser:                testParser(8, true),
+		},
+		Registration{
+			ResourceType:          "Microsoft.Network/virtualNetworks/subnets",
+			TerraformResourceType: "azurerm_subnet",
+			Parser:                testParser(10, true),
+		},
+		Registration{
+			ResourceType:          "Microsoft.Resources/deployments",
+			TerraformResourceType: "azurerm_resource_group_template_deployment",
+			Parser:                testParser(8, true),
+		},
+		Registration{
+			ResourceType:          "Microsoft.Resources/deployments",
+			TerraformResourceType: "azurerm_subscription_template_deployment",
+			Parser:                testParser(6, false),
+		},
+		Registration{
+			ResourceType:          "Microsoft.Resources/deployments",
+			TerraformResourceType: "azurerm_template_deployment",
+			Parser:                testParser(8, true),
+		},
+	)
+	if err != nil {
+		t.Fatalf("registering: %+v", err)
+	}
+	return registry
+}
+
+func TestResourceTypeFromID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "/subscriptions",
+			Error: true,
+		},
+		{
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111",
+			Expected: "Microsoft.Resources/subscriptions",
+		},
+		{
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			Expected: "Microsoft.Resources/resourceGroups",
+		},
+		{
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network",
+			Error: true,
+		},
+		{
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: "Microsoft.Network/virtualNetworks",
+		},
+		{
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: "Microsoft.Network/virtualNetworks/subnets",
+		},
+		{
+			// extension resources use the last provider
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: "Microsoft.Authorization/locks",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual, err := ResourceTypeFromID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestRegistryLookup(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+		Error    bool
+	}{
+		{
+			// nothing registered for this type
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/disks/disk1",
+			Error: true,
+		},
+		{
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			Expected: "azurerm_resource_group",
+		},
+		{
+			// case-insensitive resource type
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/microsoft.network/VirtualNetworks/network1",
+			Expected: "azurerm_virtual_network",
+		},
+		{
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: "azurerm_subnet",
+		},
+		{
+			// parsed by only one of the registrations for this type
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Resources/deployments/deployment1",
+			Expected: "azurerm_subscription_template_deployment",
+		},
+		{
+			// ambiguous
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Resources/deployments/deployment1",
+			Error: true,
+		},
+	}
+
+	registry := testRegistry(t)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		actual, id, err := registry.Lookup(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+		if id.ID() != v.Input {
+			t.Fatalf("expected the ID %q but got %q", v.Input, id.ID())
+		}
+	}
+}
+
+func TestRegistryLookupAll(t *testing.T) {
+	registry := testRegistry(t)
+	matches, err := registry.LookupAll("/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Resources/deployments/deployment1")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	expected := []string{
+		"azurerm_resource_group_template_deployment",
+		"azurerm_template_deployment",
+	}
+	if len(matches) != len(expected) {
+		t.Fatalf("expected %d matches but got %d", len(expected), len(matches))
+	}
+	for i, v := range expected {
+		if matches[i].TerraformResourceType != v {
+			t.Fatalf("expected match %d to be %q but got %q", i, v, matches[i].TerraformResourceType)
+		}
+	}
+}
+
+func TestRegistryRegisterReplacesExisting(t *testing.T) {
+	registry := testRegistry(t)
+	err := registry.Register(Registration{
+		ResourceType:          "Microsoft.Network/networkInterfaces",
+		TerraformResourceType: "azurerm_subnet",
+		Parser:                testParser(8, true),
+	})
+	if err != nil {
+		t.Fatalf("registering: %+v", err)
+	}
+
+	if _, _, err := registry.Lookup("/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"); err == nil {
+		t.Fatalf("expected an error since the previous registration was replaced but didn't get one")
+	}
+
+	actual, _, err := registry.Lookup("/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1")
+	if err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if actual != "azurerm_subnet" {
+		t.Fatalf("expected %q but got %q", "azurerm_subnet", actual)
+	}
+}
+
+func TestRegistryRegisterInvalid(t *testing.T) {
+	testData := []Registration{
+		{
+			ResourceType: "Microsoft.Network/virtualNetworks",
+			Parser:       testParser(8, true),
+		},
+		{
+			TerraformResourceType: "azurerm_virtual_network",
+			Parser:                testParser(8, true),
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualNetworks",
+			TerraformResourceType: "azurerm_virtual_network",
+		},
+	}
+
+	for _, v := range testData {
+		if err := NewRegistry().Register(v); err == nil {
+			t.Fatalf("expected an error for %+v but didn't get one", v)
+		}
+	}
+}
+
+func TestRegistrySuggestResourceType(t *testing.T) {
+	testData := []struct {
+		Input                 string
+		TerraformResourceType string
+		Expected              bool
+	}{
+		{
+			// same type
+			Input:                 "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			TerraformResourceType: "azurerm_virtual_network",
+			Expected:              false,
+		},
+		{
+			Input:                 "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			TerraformResourceType: "azurerm_virtual_network",
+			Expected:              true,
+		},
+		{
+			// one of the possible types
+			Input:                 "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Resources/deployments/deployment1",
+			TerraformResourceType: "azurerm_template_deployment",
+			Expected:              false,
+		},
+		{
+			Input:                 "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Resources/deployments/deployment1",
+			TerraformResourceType: "azurerm_resource_group",
+			Expected:              true,
+		},
+		{
+			// unknown
+			Input:                 "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/disks/disk1",
+			TerraformResourceType: "azurerm_virtual_network",
+			Expected:              false,
+		},
+	}
+
+	registry := testRegistry(t)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Input)
+
+		hint, ok := registry.SuggestResourceType(v.Input, v.TerraformResourceType)
+		if ok != v.Expected {
+			t.Fatalf("expected %t but got %t (%q)", v.Expected, ok, hint)
+		}
+	}
+}
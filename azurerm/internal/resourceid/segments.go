@@ -0,0 +1,211 @@
+package resourceid
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// SegmentType is the type of a Segment within a Resource ID
+type SegmentType string
+
+const (
+	// ResourceGroupSegmentType is the name of a Resource Group
+	ResourceGroupSegmentType SegmentType = "ResourceGroup"
+
+	// ResourceProviderSegmentType is the namespace of a Resource Provider (e.g. `Microsoft.Network`)
+	ResourceProviderSegmentType SegmentType = "ResourceProvider"
+
+	// StaticSegmentType is a fixed value (e.g. `resourceGroups` or `virtualNetworks`)
+	StaticSegmentType SegmentType = "Static"
+
+	// SubscriptionIdSegmentType is the ID of a Subscription
+	SubscriptionIdSegmentType SegmentType = "SubscriptionId"
+
+	// UserSpecifiedSegmentType is a user-specified value, such as the name of a Resource
+	UserSpecifiedSegmentType SegmentType = "UserSpecified"
+)
+
+// Segment is a single segment within a Resource ID
+type Segment struct {
+	// Type is the type of Segment
+	Type SegmentType
+
+	// Name is the name of this Segment, which is used as the key for the parsed value
+	Name string
+
+	// FixedValue is the expected value for Static and Resource Provider segments
+	FixedValue string
+
+	// CaseInsensitive specifies whether the FixedValue should be compared case-insensitively
+	// NOTE: Resource Provider segments are always compared case-insensitively
+	CaseInsensitive bool
+}
+
+// ResourceGroupSegment returns a Segment containing the name of a Resource Group
+func ResourceGroupSegment(name string) Segment {
+	return Segment{
+		Type: ResourceGroupSegmentType,
+		Name: name,
+	}
+}
+
+// ResourceProviderSegment returns a Segment containing the namespace of a Resource Provider
+func ResourceProviderSegment(name, resourceProvider string) Segment {
+	return Segment{
+		Type:            ResourceProviderSegmentType,
+		Name:            name,
+		FixedValue:      resourceProvider,
+		CaseInsensitive: true,
+	}
+}
+
+// StaticSegment returns a Segment containing a fixed value
+func StaticSegment(name, value string, caseInsensitive bool) Segment {
+	return Segment{
+		Type:            StaticSegmentType,
+		Name:            name,
+		FixedValue:      value,
+		CaseInsensitive: caseInsensitive,
+	}
+}
+
+// SubscriptionIdSegment returns a Segment containing the ID of a Subscription
+func SubscriptionIdSegment(name string) Segment {
+	return Segment{
+		Type: SubscriptionIdSegmentType,
+		Name: name,
+	}
+}
+
+// UserSpecifiedSegment returns a Segment containing a user-specified value
+func UserSpecifiedSegment(name string) Segment {
+	return Segment{
+		Type: UserSpecifiedSegmentType,
+		Name: name,
+	}
+}
+
+// hasFixedValue returns whether this Segment must match it's FixedValue
+func (s Segment) hasFixedValue() bool {
+	return s.Type == StaticSegmentType || s.Type == ResourceProviderSegmentType
+}
+
+// String returns the placeholder used for this Segment in a Resource ID
+func (s Segment) String() string {
+	if s.hasFixedValue() {
+		return s.FixedValue
+	}
+
+	return fmt.Sprintf("{%s}", s.Name)
+}
+
+// DescribeSegments returns the format of the Resource ID described by these Segments
+// for example `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}`
+func DescribeSegments(segments []Segment) string {
+	out := make([]string, 0)
+	for _, segment := range segments {
+		out = append(out, segment.String())
+	}
+	return fmt.Sprintf("/%s", strings.Join(out, "/"))
+}
+
+// ParseSegments parses the specified Resource ID using these Segments, returning a map of the
+// name of each (non-fixed) Segment to it's value
+//
+// When insensitively is true each of the fixed-value Segments are compared case-insensitively,
+// otherwise this is determined by the CaseInsensitive field on each Segment
+func ParseSegments(input string, segments []Segment, insensitively bool) (map[string]string, error) {
+	values, err := parseSegments(input, segments, insensitively)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v - expected a Resource ID in the format %q", input, err, DescribeSegments(segments))
+	}
+
+	return values, nil
+}
+
+func parseSegments(input string, segments []Segment, insensitively bool) (map[string]string, error) {
+	if input == "" {
+		return nil, fmt.Errorf("the Resource ID was empty")
+	}
+
+	uri, err := url.ParseRequestURI(input)
+	if err != nil {
+		return nil, fmt.Errorf("the Resource ID wasn't a valid URI: %+v", err)
+	}
+
+	path := strings.TrimSuffix(strings.TrimPrefix(uri.Path, "/"), "/")
+	split := strings.Split(path, "/")
+
+	values := make(map[string]string)
+	for i, segment := range segments {
+		if i >= len(split) {
+			return nil, fmt.Errorf("the Resource ID ended before segment %d (%s), expected %q", i+1, segment.Name, segment.String())
+		}
+
+		value := split[i]
+		if value == "" {
+			return nil, fmt.Errorf("segment %d (%s) was empty, expected %q", i+1, segment.Name, segment.String())
+		}
+
+		if segment.hasFixedValue() {
+			matches := value == segment.FixedValue
+			if insensitively || segment.CaseInsensitive {
+				matches = strings.EqualFold(value, segment.FixedValue)
+			}
+			if !matches {
+				return nil, fmt.Errorf("segment %d (%s) was %q, expected %q", i+1, segment.Name, value, segment.FixedValue)
+			}
+
+			continue
+		}
+
+		values[segment.Name] = value
+	}
+
+	if len(split) > len(segments) {
+		extra := strings.Join(split[len(segments):], "/")
+		return nil, fmt.Errorf("the Resource ID contained %d more segment(s) than expected: %q", len(split)-len(segments), extra)
+	}
+
+	return values, nil
+}
+
+// SegmentsMatch returns whether the Resource ID specified as the input refers to the same Resource
+// as the expected Resource ID, using these Segments
+//
+// The fixed-value Segments, Subscription ID and Resource Group are compared case-insensitively
+// since these are case-insensitive in Azure, however other user-specified values are compared exactly
+func SegmentsMatch(expected, input string, segments []Segment) bool {
+	expectedValues, err := parseSegments(expected, segments, true)
+	if err != nil {
+		return false
+	}
+
+	inputValues, err := parseSegments(input, segments, true)
+	if err != nil {
+		return false
+	}
+
+	for _, segment := range segments {
+		if segment.hasFixedValue() {
+			continue
+		}
+
+		expectedValue := expectedValues[segment.Name]
+		inputValue := inputValues[segment.Name]
+		if segment.Type == SubscriptionIdSegmentType || segment.Type == ResourceGroupSegmentType {
+			if !strings.EqualFold(expectedValue, inputValue) {
+				return false
+			}
+
+			continue
+		}
+
+		if expectedValue != inputValue {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,181 @@
+package resourceid
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testSegments() []Segment {
+	return []Segment{
+		StaticSegment("staticSubscriptions", "subscriptions", false),
+		SubscriptionIdSegment("subscriptionId"),
+		StaticSegment("staticResourceGroups", "resourceGroups", true),
+		ResourceGroupSegment("resourceGroup"),
+		StaticSegment("staticProviders", "providers", false),
+		ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		StaticSegment("staticVirtualNetworks", "virtualNetworks", false),
+		UserSpecifiedSegment("virtualNetworkName"),
+	}
+}
+
+func TestDescribeSegments(t *testing.T) {
+	expected := "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetworkName}"
+	if actual := DescribeSegments(testSegments()); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseSegments(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Input         string
+		Insensitively bool
+		Expected      map[string]string
+		ErrorContains string
+	}{
+		{
+			Name:          "Empty",
+			Input:         "",
+			ErrorContains: "the Resource ID was empty",
+		},
+		{
+			Name:          "Missing Leading Slash",
+			Input:         "subscriptions/11111111-1111-1111-1111-111111111111",
+			ErrorContains: "valid URI",
+		},
+		{
+			Name:          "Too Short",
+			Input:         "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks",
+			ErrorContains: "segment 8 (virtualNetworkName)",
+		},
+		{
+			Name:          "Too Long",
+			Input:         "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			ErrorContains: "2 more segment(s) than expected",
+		},
+		{
+			Name:          "Wrong Static Segment",
+			Input:         "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1",
+			ErrorContains: `segment 7 (staticVirtualNetworks) was "networkInterfaces", expected "virtualNetworks"`,
+		},
+		{
+			Name:          "Wrong Resource Provider",
+			Input:         "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Compute/virtualNetworks/network1",
+			ErrorContains: "segment 6 (staticMicrosoftNetwork)",
+		},
+		{
+			Name:  "Valid",
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: map[string]string{
+				"subscriptionId":     "11111111-1111-1111-1111-111111111111",
+				"resourceGroup":      "group1",
+				"virtualNetworkName": "network1",
+			},
+		},
+		{
+			Name:  "Valid with Trailing Slash",
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/",
+			Expected: map[string]string{
+				"subscriptionId":     "11111111-1111-1111-1111-111111111111",
+				"resourceGroup":      "group1",
+				"virtualNetworkName": "network1",
+			},
+		},
+		{
+			Name:  "Case-Insensitive Segments",
+			Input: "/subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/group1/providers/microsoft.network/virtualNetworks/network1",
+			Expected: map[string]string{
+				"subscriptionId":     "11111111-1111-1111-1111-111111111111",
+				"resourceGroup":      "group1",
+				"virtualNetworkName": "network1",
+			},
+		},
+		{
+			Name:          "Case-Sensitive Segment",
+			Input:         "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualnetworks/network1",
+			ErrorContains: "segment 7 (staticVirtualNetworks)",
+		},
+		{
+			Name:          "Insensitively",
+			Input:         "/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualnetworks/network1",
+			Insensitively: true,
+			Expected: map[string]string{
+				"subscriptionId":     "11111111-1111-1111-1111-111111111111",
+				"resourceGroup":      "group1",
+				"virtualNetworkName": "network1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := ParseSegments(v.Input, testSegments(), v.Insensitively)
+		if err != nil {
+			if v.ErrorContains == "" {
+				t.Fatalf("unexpected error: %+v", err)
+			}
+			if !strings.Contains(err.Error(), v.ErrorContains) {
+				t.Fatalf("expected the error to contain %q but got %q", v.ErrorContains, err.Error())
+			}
+
+			continue
+		}
+		if v.ErrorContains != "" {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestSegmentsMatch(t *testing.T) {
+	expected := "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Name:     "Same",
+			Input:    expected,
+			Expected: true,
+		},
+		{
+			Name:     "Different Casing for Fixed Segments",
+			Input:    "/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111/resourcegroups/group1/providers/microsoft.network/virtualnetworks/network1",
+			Expected: true,
+		},
+		{
+			Name:     "Different Casing for Resource Group",
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/GROUP1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: true,
+		},
+		{
+			Name:     "Different Casing for Name",
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/NETWORK1",
+			Expected: false,
+		},
+		{
+			Name:     "Different Resource Group",
+			Input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group2/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		if actual := SegmentsMatch(expected, v.Input, testSegments()); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
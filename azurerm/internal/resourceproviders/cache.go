@@ -5,6 +5,8 @@ import (
 	"log"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/enhancedvalidation"
 )
 
 // cachedResourceProviders can be (validly) nil - as such this shouldn't be relied on
@@ -12,9 +14,27 @@ var cachedResourceProviders *[]string
 
 // CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
 // and caches them, for used in enhanced validation
-func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersClient) {
+//
+// When these can't be retrieved (for example when offline) the Resource Providers from the Enhanced
+// Validation Snapshot for this Azure Environment are used instead, where available
+func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersClient, env *azure.Environment) {
+	snapshot, err := enhancedvalidation.ForEnvironment(env.Name)
+	if err != nil {
+		log.Printf("[DEBUG] error loading the Enhanced Validation Snapshot: %s", err)
+	}
+	usingSnapshot := snapshot != nil && len(snapshot.ResourceProviders) > 0
+	if usingSnapshot {
+		providers := snapshot.ResourceProviders
+		cachedResourceProviders = &providers
+	}
+
 	providers, err := availableResourceProviders(ctx, client)
 	if err != nil {
+		if usingSnapshot {
+			log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will use the %s Snapshot (version %s)", err, snapshot.Source, snapshot.Version)
+			return
+		}
+
 		log.Printf("[DEBUG] error retrieving providers: %s. Enhanced validation will be unavailable", err)
 		return
 	}
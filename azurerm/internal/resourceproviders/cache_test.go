@@ -0,0 +1,26 @@
+package resourceproviders
+
+import (
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/enhancedvalidation"
+)
+
+func TestSnapshotContainsRequiredResourceProviders(t *testing.T) {
+	snapshot, err := enhancedvalidation.ForEnvironment(azure.PublicCloud.Name)
+	if err != nil {
+		t.Fatalf("loading snapshot: %+v", err)
+	}
+
+	available := make(map[string]struct{})
+	for _, v := range snapshot.ResourceProviders {
+		available[v] = struct{}{}
+	}
+
+	for v := range Required() {
+		if _, ok := available[v]; !ok {
+			t.Errorf("the Resource Provider %q is required but isn't in the Enhanced Validation Snapshot", v)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package sdk
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// A List Data Source is an object which looks up a list of existing resources, optionally
+// filtering these, and returns information about each for use elsewhere
+//
+// The SDK handles the Filters, generating a deterministic ID and setting the list of items
+// into the State - as such the implementation only needs to retrieve each page of items
+type ListDataSource interface {
+	// Arguments is a list of user-configurable arguments which are used to scope the
+	// lookup (e.g. `resource_group_name`)
+	// NOTE: the arguments for each Filter are added automatically so shouldn't be specified here
+	Arguments() map[string]*schema.Schema
+
+	// ModelObject is an instance of the object the Arguments are decoded into
+	ModelObject() interface{}
+
+	// ResourceType is the exposed name of this data source (e.g. `azurerm_examples`)
+	ResourceType() string
+
+	// Filters is a list of Filters which can be used to filter the items returned
+	Filters() []ListFilter
+
+	// ItemsAttributeName is the name of the list attribute containing the items (e.g. `public_ips`)
+	ItemsAttributeName() string
+
+	// ItemAttributes is the Schema for each item within the list
+	ItemAttributes() map[string]*schema.Schema
+
+	// ItemModelObject is an instance of the object each item is encoded from
+	ItemModelObject() interface{}
+
+	// List is a ListFunc which retrieves each page of items
+	List() ListFunc
+}
+
+// ListPageFunc is called with each page of items retrieved by a ListRunFunc
+// each item must be an instance (or a pointer to an instance) of the ItemModelObject
+type ListPageFunc func(items []interface{}) error
+
+// ListRunFunc is the function which retrieves each page of items
+// ctx provides a Context instance with the user-provided timeout
+// metadata is a reference to an object containing the Client, ResourceData and a Logger
+// handlePage should be called with each page of items as they're retrieved
+type ListRunFunc func(ctx context.Context, metadata ResourceMetaData, handlePage ListPageFunc) error
+
+type ListFunc struct {
+	// Func is the function which retrieves each page of items
+	Func ListRunFunc
+
+	// Timeout is the default timeout, which can be overridden by users
+	Timeout time.Duration
+}
+
+// ListFilter is a user-configurable argument which is used to filter the items
+// returned from a List Data Source
+type ListFilter struct {
+	// Name is the name of the argument for this filter (e.g. `name_prefix`)
+	Name string
+
+	// Schema is the Schema for the argument for this filter - which must be Optional
+	Schema *schema.Schema
+
+	// Matches returns whether the item matches the value specified for this filter
+	// NOTE: this is only called when a value has been specified for this filter
+	Matches func(value interface{}, item interface{}) bool
+}
+
+// NewStringEqualsFilter returns a ListFilter which matches items where the value
+// returned from the getter is equal to the specified value
+func NewStringEqualsFilter(name string, validateFunc schema.SchemaValidateFunc, getter func(item interface{}) string) ListFilter {
+	return ListFilter{
+		Name: name,
+		Schema: &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateFunc,
+		},
+		Matches: func(value interface{}, item interface{}) bool {
+			return getter(item) == value.(string)
+		},
+	}
+}
+
+// NewStringPrefixFilter returns a ListFilter which matches items where the value
+// returned from the getter begins with the specified value
+func NewStringPrefixFilter(name string, getter func(item interface{}) string) ListFilter {
+	return ListFilter{
+		Name: name,
+		Schema: &schema.Schema{
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		Matches: func(value interface{}, item interface{}) bool {
+			return strings.HasPrefix(getter(item), value.(string))
+		},
+	}
+}
+
+// NewBoolFilter returns a ListFilter which matches items where the value
+// returned from the getter is equal to the specified value
+func NewBoolFilter(name string, getter func(item interface{}) bool) ListFilter {
+	return ListFilter{
+		Name: name,
+		Schema: &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+		},
+		Matches: func(value interface{}, item interface{}) bool {
+			return getter(item) == value.(bool)
+		},
+	}
+}
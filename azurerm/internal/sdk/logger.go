@@ -1,7 +1,20 @@
 package sdk
 
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
 // Logger is an interface for switching out the Logger implementation
 type Logger interface {
+	// Debug prints out a message prefixed with `[DEBUG]` verbatim
+	Debug(message string)
+
+	// Debugf prints out a message prefixed with `[DEBUG]` formatted
+	// with the specified arguments
+	Debugf(format string, args ...interface{})
+
 	// Info prints out a message prefixed with `[INFO]` verbatim
 	Info(message string)
 
@@ -15,4 +28,73 @@ type Logger interface {
 	// Warnf prints out a message prefixed with `[WARN]` formatted
 	// with the specified arguments
 	Warnf(format string, args ...interface{})
+
+	// Error prints out a message prefixed with `[ERROR]` verbatim
+	Error(message string)
+
+	// Errorf prints out a message prefixed with `[ERROR]` formatted
+	// with the specified arguments
+	Errorf(format string, args ...interface{})
+
+	// WithFields returns a copy of this Logger which includes the specified
+	// fields (in addition to any existing fields) in each message
+	WithFields(fields LogFields) Logger
+}
+
+// LogFields is a set of key-value pairs which are included in each log message
+type LogFields map[string]interface{}
+
+const (
+	// LogFieldAttempt is the field containing the attempt number, for example when retrying
+	LogFieldAttempt = "attempt"
+
+	// LogFieldOperation is the field containing the operation being performed (e.g. `create`)
+	LogFieldOperation = "operation"
+
+	// LogFieldResourceID is the field containing the ID of the Resource
+	LogFieldResourceID = "resource_id"
+
+	// LogFieldResourceType is the field containing the Terraform Resource Type (e.g. `azurerm_example`)
+	LogFieldResourceType = "resource_type"
+)
+
+// merge returns a copy of these fields combined with the specified fields
+// where the specified fields take precedence
+func (f LogFields) merge(other LogFields) LogFields {
+	out := make(LogFields, len(f)+len(other))
+	for k, v := range f {
+		out[k] = v
+	}
+	for k, v := range other {
+		out[k] = v
+	}
+	return out
+}
+
+// String returns these fields as a string, sorted by the key - such that log messages are consistent
+func (f LogFields) String() string {
+	keys := make([]string, 0, len(f))
+	for k := range f {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s=%v", k, f[k]))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// scopedLogger returns a Logger which includes the Resource Type, Operation
+// and (where available) the Resource ID in each message
+func scopedLogger(logger Logger, resourceType, operation, resourceId string) Logger {
+	fields := LogFields{
+		LogFieldOperation:    operation,
+		LogFieldResourceType: resourceType,
+	}
+	if resourceId != "" {
+		fields[LogFieldResourceID] = resourceId
+	}
+	return logger.WithFields(fields)
 }
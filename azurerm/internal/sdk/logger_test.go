@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLogFieldsString(t *testing.T) {
+	testData := []struct {
+		Input    LogFields
+		Expected string
+	}{
+		{
+			Input:    LogFields{},
+			Expected: "",
+		},
+		{
+			Input: LogFields{
+				LogFieldResourceType: "azurerm_example",
+			},
+			Expected: "resource_type=azurerm_example",
+		},
+		{
+			Input: LogFields{
+				LogFieldResourceType: "azurerm_example",
+				LogFieldOperation:    "create",
+				LogFieldAttempt:      2,
+			},
+			Expected: "attempt=2 operation=create resource_type=azurerm_example",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %+v..", v.Input)
+
+		if actual := v.Input.String(); actual != v.Expected {
+			t.Fatalf("expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestTestLoggerWithFields(t *testing.T) {
+	logger := NewTestLogger()
+	logger.Debug("first")
+
+	scoped := logger.WithFields(LogFields{
+		LogFieldOperation: "create",
+	})
+	scoped.Errorf("second %d", 2)
+
+	// fields on the scoped logger shouldn't be added to the parent
+	scoped.WithFields(LogFields{
+		LogFieldAttempt: 3,
+	}).Warn("third")
+	logger.Info("fourth")
+
+	expected := []LogEntry{
+		{
+			Level:   "DEBUG",
+			Message: "first",
+			Fields:  LogFields{},
+		},
+		{
+			Level:   "ERROR",
+			Message: "second 2",
+			Fields: LogFields{
+				LogFieldOperation: "create",
+			},
+		},
+		{
+			Level:   "WARN",
+			Message: "third",
+			Fields: LogFields{
+				LogFieldOperation: "create",
+				LogFieldAttempt:   3,
+			},
+		},
+		{
+			Level:   "INFO",
+			Message: "fourth",
+			Fields:  LogFields{},
+		},
+	}
+	if actual := logger.Entries(); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("\nExpected: %+v\n\n Received %+v\n\n", expected, actual)
+	}
+}
+
+func TestRefreshFuncWithAttempts(t *testing.T) {
+	logger := NewTestLogger()
+	metadata := ResourceMetaData{
+		Logger: logger,
+	}
+
+	states := []string{"Processing", "Processing", "Succeeded"}
+	calls := 0
+	refresh := metadata.RefreshFuncWithAttempts(func() (interface{}, string, error) {
+		state := states[calls]
+		calls++
+		return state, state, nil
+	})
+
+	for range states {
+		if _, _, err := refresh(); err != nil {
+			t.Fatalf("refreshing: %+v", err)
+		}
+	}
+
+	entries := logger.Entries()
+	if len(entries) != len(states) {
+		t.Fatalf("expected %d entries but got %d", len(states), len(entries))
+	}
+	for i, entry := range entries {
+		if v := entry.Fields[LogFieldAttempt]; v != i+1 {
+			t.Fatalf("expected entry %d to be for attempt %d but got %v", i, i+1, v)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package sdk
+
+import (
+	"fmt"
+	"sync"
+)
+
+// LogEntry is a single message captured by the TestLogger
+type LogEntry struct {
+	// Level is the level of this message, e.g. `DEBUG` or `INFO`
+	Level string
+
+	// Message is the (formatted) message
+	Message string
+
+	// Fields are the fields associated with this message
+	Fields LogFields
+}
+
+// TestLogger is a Logger implementation which captures each message
+// and is intended to be used to make assertions in tests
+type TestLogger struct {
+	fields LogFields
+	store  *testLoggerStore
+}
+
+type testLoggerStore struct {
+	sync.Mutex
+	entries []LogEntry
+}
+
+// NewTestLogger returns a TestLogger which captures the messages logged
+// to this Logger, and to any Logger returned from WithFields
+func NewTestLogger() *TestLogger {
+	return &TestLogger{
+		fields: LogFields{},
+		store:  &testLoggerStore{},
+	}
+}
+
+// Entries returns each of the messages captured by this Logger
+func (l *TestLogger) Entries() []LogEntry {
+	l.store.Lock()
+	defer l.store.Unlock()
+
+	out := make([]LogEntry, len(l.store.entries))
+	copy(out, l.store.entries)
+	return out
+}
+
+// Debug captures a message with the level `DEBUG`
+func (l *TestLogger) Debug(message string) {
+	l.capture("DEBUG", message)
+}
+
+// Debugf captures a message with the level `DEBUG` formatted
+// with the specified arguments
+func (l *TestLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
+
+// Info captures a message with the level `INFO`
+func (l *TestLogger) Info(message string) {
+	l.capture("INFO", message)
+}
+
+// Infof captures a message with the level `INFO` formatted
+// with the specified arguments
+func (l *TestLogger) Infof(format string, args ...interface{}) {
+	l.Info(fmt.Sprintf(format, args...))
+}
+
+// Warn captures a message with the level `WARN`
+func (l *TestLogger) Warn(message string) {
+	l.capture("WARN", message)
+}
+
+// Warnf captures a message with the level `WARN` formatted
+// with the specified arguments
+func (l *TestLogger) Warnf(format string, args ...interface{}) {
+	l.Warn(fmt.Sprintf(format, args...))
+}
+
+// Error captures a message with the level `ERROR`
+func (l *TestLogger) Error(message string) {
+	l.capture("ERROR", message)
+}
+
+// Errorf captures a message with the level `ERROR` formatted
+// with the specified arguments
+func (l *TestLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+// WithFields returns a copy of this Logger which includes the specified fields
+// in each message - messages logged to the copy are captured by this Logger
+func (l *TestLogger) WithFields(fields LogFields) Logger {
+	return &TestLogger{
+		fields: l.fields.merge(fields),
+		store:  l.store,
+	}
+}
+
+func (l *TestLogger) capture(level, message string) {
+	l.store.Lock()
+	defer l.store.Unlock()
+
+	l.store.entries = append(l.store.entries, LogEntry{
+		Level:   level,
+		Message: message,
+		Fields:  l.fields,
+	})
+}
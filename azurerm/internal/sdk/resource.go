@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
@@ -62,9 +63,31 @@ type Resource interface {
 	IDValidationFunc() schema.SchemaValidateFunc
 }
 
-// TODO: ResourceWithCustomizeDiff
-// TODO: ResourceWithStateMigration
-// TODO: a generic state migration for updating ID's
+// ResourceWithCustomizeDiff is an optional interface
+//
+// Resources implementing this interface can inspect and modify the
+// Plan for this Resource - for example to validate combinations of
+// fields, or to conditionally mark a field as ForceNew.
+type ResourceWithCustomizeDiff interface {
+	Resource
+
+	// CustomizeDiff returns a ResourceDiffFunc which is called during the Plan
+	// NOTE: this is called both during Create and Update, as such the ResourceDiff
+	// may not contain an ID
+	CustomizeDiff() ResourceDiffFunc
+}
+
+// ResourceWithStateMigration is an optional interface
+//
+// Resources implementing this interface can upgrade the State stored for
+// previous versions of the Schema to the current Schema version.
+type ResourceWithStateMigration interface {
+	Resource
+
+	// StateUpgraders returns the current SchemaVersion and the StateUpgrade
+	// for each previous version of the Schema
+	StateUpgraders() StateUpgradeData
+}
 
 type ResourceWithCustomImporter interface {
 	Resource
@@ -136,6 +159,33 @@ func (rmd ResourceMetaData) MarkAsGone() error {
 	return nil
 }
 
+// LoggerForAttempt returns a Logger which includes the specified attempt number in each message, for use
+// within a loop which retries (or polls) an operation
+func (rmd ResourceMetaData) LoggerForAttempt(attempt int) Logger {
+	return rmd.Logger.WithFields(LogFields{
+		LogFieldAttempt: attempt,
+	})
+}
+
+// RefreshFuncWithAttempts wraps the specified StateRefreshFunc (used to poll until an operation completes)
+// such that each attempt is logged with the attempt number, alongside the current state
+func (rmd ResourceMetaData) RefreshFuncWithAttempts(refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	attempt := 0
+	return func() (interface{}, string, error) {
+		attempt++
+		logger := rmd.LoggerForAttempt(attempt)
+
+		result, state, err := refresh()
+		if err != nil {
+			logger.Warnf("polling failed: %+v", err)
+			return result, state, err
+		}
+
+		logger.Debugf("polled - the current state is %q", state)
+		return result, state, nil
+	}
+}
+
 // ResourceRequiresImport returns an error saying that this resource must be imported with instructions
 // on how to do this (namely, using `terraform import`
 func (rmd ResourceMetaData) ResourceRequiresImport(resourceName string, idFormatter resourceid.Formatter) error {
@@ -49,7 +49,7 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 			debugLogger.Infof("Input Type: ", reflect.ValueOf(input).Elem().Field(i).Type())
 
 			fieldName := reflect.ValueOf(input).Elem().Field(i).String()
-			if err := setValue(input, tfschemaValue, i, fieldName, debugLogger); err != nil {
+			if err := setValue(input, tfschemaValue, i, fieldName, val, stateRetriever, debugLogger); err != nil {
 				return err
 			}
 		}
@@ -57,7 +57,9 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 	return nil
 }
 
-func setValue(input, tfschemaValue interface{}, index int, fieldName string, debugLogger Logger) (errOut error) {
+// setValue sets the value of the field at the specified index within input, where path is the
+// address of this field within the State (or empty if the field can't be addressed, e.g. within a Set)
+func setValue(input, tfschemaValue interface{}, index int, fieldName string, path string, stateRetriever stateRetriever, debugLogger Logger) (errOut error) {
 	debugLogger.Infof("setting list value for %q..", fieldName)
 	defer func() {
 		if r := recover(); r != nil {
@@ -71,68 +73,195 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		}
 	}()
 
+	if tfschemaValue == nil {
+		return nil
+	}
+
+	field := reflect.ValueOf(input).Elem().Field(index)
+	switch field.Kind() {
+	case reflect.Struct:
+		// a single nested block (e.g. `MaxItems: 1`) mapped to a struct
+		return setSingleBlockValue(field, fieldName, tfschemaValue, path, stateRetriever, debugLogger)
+
+	case reflect.Ptr:
+		// a single nested block mapped to a pointer to a struct
+		if field.Type().Elem().Kind() == reflect.Struct {
+			return setSingleBlockValue(field, fieldName, tfschemaValue, path, stateRetriever, debugLogger)
+		}
+
+		// otherwise this is a pointer to a primitive type, used to differentiate unset values
+		debugLogger.Infof("[POINTER] Decode %+v", tfschemaValue)
+		ptr := reflect.New(field.Type().Elem())
+		if !setPrimitiveValue(ptr.Elem(), tfschemaValue, debugLogger) {
+			return nil
+		}
+
+		// nested blocks always contain the zero value for fields which aren't set, so unless this
+		// is explicitly set the pointer is left as nil
+		if ptr.Elem().IsZero() && !fieldExists(path, stateRetriever) {
+			debugLogger.Infof("[POINTER] %q is the zero value and isn't set - leaving as nil", path)
+			return nil
+		}
+
+		field.Set(ptr)
+		return nil
+	}
+
+	if setPrimitiveValue(field, tfschemaValue, debugLogger) {
+		return nil
+	}
+
+	if v, ok := tfschemaValue.(*schema.Set); ok {
+		// the items within a Set are addressed by their hash, so can't be looked up by index
+		return setListValue(input, index, fieldName, v.List(), "", stateRetriever, debugLogger)
+	}
+
+	if mapConfig, ok := tfschemaValue.(map[string]interface{}); ok {
+		mapOutput := reflect.MakeMap(field.Type())
+		for key, val := range mapConfig {
+			mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
+		}
+
+		field.Set(mapOutput)
+		return nil
+	}
+
+	if v, ok := tfschemaValue.([]interface{}); ok {
+		return setListValue(input, index, fieldName, v, path, stateRetriever, debugLogger)
+	}
+
+	return nil
+}
+
+// fieldExists returns whether the field at the specified path has been set
+func fieldExists(path string, stateRetriever stateRetriever) bool {
+	if path == "" {
+		return false
+	}
+
+	_, exists := stateRetriever.GetOkExists(path)
+	return exists
+}
+
+// setPrimitiveValue sets the value of the specified field if tfschemaValue is a primitive type
+// returning whether the value was set
+func setPrimitiveValue(field reflect.Value, tfschemaValue interface{}, debugLogger Logger) bool {
 	if v, ok := tfschemaValue.(string); ok {
 		debugLogger.Infof("[String] Decode %+v", v)
-		debugLogger.Infof("Input %+v", reflect.ValueOf(input))
-		debugLogger.Infof("Input Elem %+v", reflect.ValueOf(input).Elem())
-		reflect.ValueOf(input).Elem().Field(index).SetString(v)
-		return nil
+		field.SetString(v)
+		return true
 	}
 
 	if v, ok := tfschemaValue.(int); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
-		return nil
+		field.SetInt(int64(v))
+		return true
 	}
 
 	if v, ok := tfschemaValue.(int32); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
-		return nil
+		field.SetInt(int64(v))
+		return true
 	}
 
 	if v, ok := tfschemaValue.(int64); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(v)
-		return nil
+		field.SetInt(v)
+		return true
 	}
 
 	if v, ok := tfschemaValue.(float64); ok {
 		debugLogger.Infof("[Float] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetFloat(v)
-		return nil
+		field.SetFloat(v)
+		return true
 	}
 
 	// Doesn't work for empty bools?
 	if v, ok := tfschemaValue.(bool); ok {
 		debugLogger.Infof("[BOOL] Decode %+v", v)
-
-		reflect.ValueOf(input).Elem().Field(index).SetBool(v)
-		return nil
+		field.SetBool(v)
+		return true
 	}
 
+	return false
+}
+
+// setSingleBlockValue decodes a nested block containing at most a single item (e.g. `MaxItems: 1`)
+// into the specified field, which is either a struct or a pointer to a struct
+func setSingleBlockValue(field reflect.Value, fieldName string, tfschemaValue interface{}, path string, stateRetriever stateRetriever, debugLogger Logger) error {
+	var items []interface{}
 	if v, ok := tfschemaValue.(*schema.Set); ok {
-		return setListValue(input, index, fieldName, v.List(), debugLogger)
+		items = v.List()
+		path = ""
+	} else if v, ok := tfschemaValue.([]interface{}); ok {
+		items = v
+	} else {
+		return fmt.Errorf("expected %q to be a list or set but got %+v", fieldName, tfschemaValue)
 	}
 
-	if mapConfig, ok := tfschemaValue.(map[string]interface{}); ok {
-		mapOutput := reflect.MakeMap(reflect.ValueOf(input).Elem().Field(index).Type())
-		for key, val := range mapConfig {
-			mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
-		}
+	if len(items) == 0 {
+		return nil
+	}
+	if len(items) > 1 {
+		return fmt.Errorf("expected at most one item for %q but got %d", fieldName, len(items))
+	}
 
-		reflect.ValueOf(input).Elem().Field(index).Set(mapOutput)
+	val, ok := items[0].(map[string]interface{})
+	if !ok || val == nil {
 		return nil
 	}
 
-	if v, ok := tfschemaValue.([]interface{}); ok {
-		return setListValue(input, index, fieldName, v, debugLogger)
+	structType := field.Type()
+	if structType.Kind() == reflect.Ptr {
+		structType = structType.Elem()
+	}
+	elem, err := decodeNestedObject(structType, val, fieldName, nestedPath(path, 0), stateRetriever, debugLogger)
+	if err != nil {
+		return err
 	}
 
+	if field.Kind() == reflect.Ptr {
+		field.Set(elem)
+	} else {
+		field.Set(elem.Elem())
+	}
 	return nil
 }
 
-func setListValue(input interface{}, index int, fieldName string, v []interface{}, debugLogger Logger) error {
+// nestedPath returns the path to the item at the specified index within the nested block at path
+func nestedPath(path string, index int) string {
+	if path == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s.%d", path, index)
+}
+
+// decodeNestedObject decodes the map of values into a new instance of the specified struct type
+// returning a pointer to this object
+func decodeNestedObject(structType reflect.Type, val map[string]interface{}, fieldName string, path string, stateRetriever stateRetriever, debugLogger Logger) (reflect.Value, error) {
+	elem := reflect.New(structType)
+	debugLogger.Infof("element ", elem)
+	for j := 0; j < structType.NumField(); j++ {
+		nestedField := structType.Field(j)
+		debugLogger.Infof("nestedField ", nestedField)
+
+		if key, exists := nestedField.Tag.Lookup("tfschema"); exists {
+			nestedTFSchemaValue := val[key]
+			fieldPath := ""
+			if path != "" {
+				fieldPath = fmt.Sprintf("%s.%s", path, key)
+			}
+			if err := setValue(elem.Interface(), nestedTFSchemaValue, j, fieldName, fieldPath, stateRetriever, debugLogger); err != nil {
+				return elem, err
+			}
+		}
+	}
+
+	return elem, nil
+}
+
+func setListValue(input interface{}, index int, fieldName string, v []interface{}, path string, stateRetriever stateRetriever, debugLogger Logger) error {
 	switch fieldType := reflect.ValueOf(input).Elem().Field(index).Type(); fieldType {
 	case reflect.TypeOf([]string{}):
 		stringSlice := reflect.MakeSlice(reflect.TypeOf([]string{}), len(v), len(v))
@@ -166,40 +295,190 @@ func setListValue(input interface{}, index int, fieldName string, v []interface{
 		valueToSet := reflect.MakeSlice(reflect.ValueOf(input).Elem().Field(index).Type(), 0, 0)
 		debugLogger.Infof("List Type", valueToSet.Type())
 
-		for _, mapVal := range v {
+		// nested blocks can be either a slice of structs, or a slice of pointers to structs
+		elemType := fieldType.Elem()
+		isPointer := elemType.Kind() == reflect.Ptr
+		if isPointer {
+			elemType = elemType.Elem()
+		}
+
+		for i, mapVal := range v {
 			if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
-				elem := reflect.New(fieldType.Elem())
-				debugLogger.Infof("element ", elem)
-				for j := 0; j < elem.Type().Elem().NumField(); j++ {
-					nestedField := elem.Type().Elem().Field(j)
-					debugLogger.Infof("nestedField ", nestedField)
-
-					if val, exists := nestedField.Tag.Lookup("tfschema"); exists {
-						nestedTFSchemaValue := test[val]
-						if err := setValue(elem.Interface(), nestedTFSchemaValue, j, fieldName, debugLogger); err != nil {
-							return err
-						}
-					}
+				elem, err := decodeNestedObject(elemType, test, fieldName, nestedPath(path, i), stateRetriever, debugLogger)
+				if err != nil {
+					return err
 				}
 
-				if !elem.CanSet() {
+				if !isPointer {
 					elem = elem.Elem()
 				}
-
-				if valueToSet.Kind() == reflect.Ptr {
-					valueToSet.Elem().Set(reflect.Append(valueToSet.Elem(), elem))
-				} else {
-					valueToSet = reflect.Append(valueToSet, elem)
-				}
+				valueToSet = reflect.Append(valueToSet, elem)
 
 				debugLogger.Infof("value to set type after changes", valueToSet.Type())
 			}
 		}
 
-		valueToSet = reflect.Indirect(valueToSet)
 		fieldToSet := reflect.ValueOf(input).Elem().Field(index)
-		fieldToSet.Set(reflect.Indirect(valueToSet))
+		fieldToSet.Set(valueToSet)
 	}
 
 	return nil
 }
+
+// DecodeDiff will decode the previous (e.g. State) and updated (e.g. Config) values for this
+// Resource into the specified objects - returning a ModelChanges object which can be used to
+// determine which fields have changed.
+// NOTE: both objects must be passed by value, be of the same type - and must contain `tfschema`
+// struct tags for all fields
+//
+// Example Usage:
+//
+// var old, new Person
+// changes, err := metadata.DecodeDiff(&old, &new)
+// if err != nil { .. }
+// if changes.HasChange(&new.Name) { .. }
+func (rmd ResourceMetaData) DecodeDiff(old interface{}, new interface{}) (*ModelChanges, error) {
+	retriever := &resourceDataChangeRetriever{
+		ResourceData: rmd.ResourceData,
+	}
+	return decodeReflectedTypeChanges(old, new, retriever, rmd.serializationDebugLogger)
+}
+
+// changeRetriever is a convenience wrapper around the Plugin SDK to be able to test it more accurately
+type changeRetriever interface {
+	stateRetriever
+
+	GetChange(key string) (interface{}, interface{})
+	HasChange(key string) bool
+
+	// ExistsInPriorState returns whether the specified key was present in the previous State
+	ExistsInPriorState(key string) bool
+}
+
+// resourceDataChangeRetriever is a changeRetriever for the Plugin SDK's ResourceData
+type resourceDataChangeRetriever struct {
+	*schema.ResourceData
+
+	priorState map[string]string
+}
+
+func (r *resourceDataChangeRetriever) ExistsInPriorState(key string) bool {
+	if r.priorState == nil {
+		r.priorState = make(map[string]string)
+
+		// the Plugin SDK doesn't expose the previous State directly, however in Partial mode the
+		// State is built from the previous values for any keys which haven't been marked as Partial
+		r.ResourceData.Partial(true)
+		if state := r.ResourceData.State(); state != nil {
+			r.priorState = state.Attributes
+		}
+		r.ResourceData.Partial(false)
+	}
+
+	for _, suffix := range []string{"", ".#", ".%"} {
+		if _, ok := r.priorState[key+suffix]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ModelChanges contains the fields of a Model which have changed between the previous and updated values
+type ModelChanges struct {
+	oldVal reflect.Value
+	newVal reflect.Value
+
+	// changedFields is a map of the index of the field within the Model to the `tfschema` key
+	changedFields map[int]string
+}
+
+// ChangedFields returns the `tfschema` keys of the fields which have changed
+func (mc ModelChanges) ChangedFields() []string {
+	out := make([]string, 0)
+	for i := 0; i < mc.newVal.NumField(); i++ {
+		if key, ok := mc.changedFields[i]; ok {
+			out = append(out, key)
+		}
+	}
+	return out
+}
+
+// HasChanges returns whether any of the fields in the Model have changed
+func (mc ModelChanges) HasChanges() bool {
+	return len(mc.changedFields) > 0
+}
+
+// HasChange returns whether the field which the specified pointer references has changed
+// this pointer must reference a field in either the old or new Model passed to DecodeDiff
+func (mc ModelChanges) HasChange(field interface{}) bool {
+	fieldVal := reflect.ValueOf(field)
+	if fieldVal.Kind() != reflect.Ptr {
+		return false
+	}
+
+	address := fieldVal.Pointer()
+	for i := range mc.changedFields {
+		if mc.newVal.Field(i).Addr().Pointer() == address || mc.oldVal.Field(i).Addr().Pointer() == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func decodeReflectedTypeChanges(old interface{}, new interface{}, changeRetriever changeRetriever, debugLogger Logger) (*ModelChanges, error) {
+	if reflect.TypeOf(old).Kind() != reflect.Ptr || reflect.TypeOf(new).Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("need a pointer")
+	}
+	if reflect.TypeOf(old) != reflect.TypeOf(new) {
+		return nil, fmt.Errorf("the old and new objects must be the same type but got %q and %q", reflect.TypeOf(old), reflect.TypeOf(new))
+	}
+
+	if err := decodeReflectedType(old, oldStateRetriever{changeRetriever}, debugLogger); err != nil {
+		return nil, fmt.Errorf("decoding previous values: %+v", err)
+	}
+	if err := decodeReflectedType(new, changeRetriever, debugLogger); err != nil {
+		return nil, fmt.Errorf("decoding updated values: %+v", err)
+	}
+
+	changes := ModelChanges{
+		oldVal:        reflect.ValueOf(old).Elem(),
+		newVal:        reflect.ValueOf(new).Elem(),
+		changedFields: make(map[int]string),
+	}
+	objType := reflect.TypeOf(new).Elem()
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		if val, exists := field.Tag.Lookup("tfschema"); exists {
+			if changeRetriever.HasChange(val) {
+				debugLogger.Infof("Field %q has changed", val)
+				changes.changedFields[i] = val
+			}
+		}
+	}
+
+	return &changes, nil
+}
+
+// oldStateRetriever exposes the previous values of a changeRetriever as a stateRetriever
+type oldStateRetriever struct {
+	changeRetriever changeRetriever
+}
+
+func (r oldStateRetriever) Get(key string) interface{} {
+	old, _ := r.changeRetriever.GetChange(key)
+	return old
+}
+
+func (r oldStateRetriever) GetOk(key string) (interface{}, bool) {
+	if !r.changeRetriever.ExistsInPriorState(key) {
+		return nil, false
+	}
+
+	return r.Get(key), true
+}
+
+func (r oldStateRetriever) GetOkExists(key string) (interface{}, bool) {
+	return r.GetOk(key)
+}
@@ -1,8 +1,12 @@
 package sdk
 
 import (
+	"fmt"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
 type decodeTestData struct {
@@ -696,6 +700,424 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceDecode_PointerFields(t *testing.T) {
+	type Type struct {
+		String  *string  `tfschema:"string"`
+		Number  *int     `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+		Unset   *string  `tfschema:"unset"`
+	}
+	str := "world"
+	number := 42
+	price := 129.99
+	enabled := false
+	decodeTestData{
+		State: map[string]interface{}{
+			"string":  "world",
+			"number":  42,
+			"price":   129.99,
+			"enabled": false,
+		},
+		Input: &Type{},
+		Expected: &Type{
+			String:  &str,
+			Number:  &number,
+			Price:   &price,
+			Enabled: &enabled,
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_NestedPointerFields(t *testing.T) {
+	type Inner struct {
+		Name    string  `tfschema:"name"`
+		String  *string `tfschema:"string"`
+		Number  *int    `tfschema:"number"`
+		Enabled *bool   `tfschema:"enabled"`
+		Unset   *string `tfschema:"unset"`
+	}
+	type Type struct {
+		List   []Inner `tfschema:"list"`
+		Single *Inner  `tfschema:"single"`
+	}
+	str := "world"
+	zero := 0
+	enabled := false
+	decodeTestData{
+		State: map[string]interface{}{
+			// nested blocks contain the zero value for every field, regardless of whether it's set
+			"list": []interface{}{
+				map[string]interface{}{
+					"name":    "first",
+					"string":  "world",
+					"number":  0,
+					"enabled": false,
+					"unset":   "",
+				},
+				map[string]interface{}{
+					"name":    "second",
+					"string":  "",
+					"number":  0,
+					"enabled": false,
+					"unset":   "",
+				},
+			},
+			"single": []interface{}{
+				map[string]interface{}{
+					"name":    "single",
+					"string":  "",
+					"number":  0,
+					"enabled": false,
+					"unset":   "",
+				},
+			},
+
+			// whereas only the fields which are set exist
+			"list.0.number":    0,
+			"single.0.enabled": false,
+		},
+		Input: &Type{},
+		Expected: &Type{
+			List: []Inner{
+				{
+					Name:   "first",
+					String: &str,
+					Number: &zero,
+				},
+				{
+					Name: "second",
+				},
+			},
+			Single: &Inner{
+				Name:    "single",
+				Enabled: &enabled,
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_MapOfInterfaces(t *testing.T) {
+	type Type struct {
+		Map map[string]interface{} `tfschema:"map"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"map": map[string]interface{}{
+				"hello": "world",
+				"value": 42,
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Map: map[string]interface{}{
+				"hello": "world",
+				"value": 42,
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_SingleBlockStruct(t *testing.T) {
+	type Inner struct {
+		Value    string  `tfschema:"value"`
+		Optional *string `tfschema:"optional"`
+	}
+	type Type struct {
+		Name  string `tfschema:"name"`
+		Inner Inner  `tfschema:"inner"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"name": "hello",
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "world",
+				},
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Name: "hello",
+			Inner: Inner{
+				Value: "world",
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_SingleBlockPointer(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		Name  string `tfschema:"name"`
+		Inner *Inner `tfschema:"inner"`
+		Empty *Inner `tfschema:"empty"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"name": "hello",
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "world",
+				},
+			},
+			"empty": []interface{}{},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Name: "hello",
+			Inner: &Inner{
+				Value: "world",
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_SingleBlockMultipleItems(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		Inner *Inner `tfschema:"inner"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "first",
+				},
+				map[string]interface{}{
+					"value": "second",
+				},
+			},
+		},
+		Input:       &Type{},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceDecode_NestedSliceOfPointers(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		Inner []*Inner `tfschema:"inner"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "first",
+				},
+				map[string]interface{}{
+					"value": "second",
+				},
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Inner: []*Inner{
+				{
+					Value: "first",
+				},
+				{
+					Value: "second",
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestDecodeDiff_TopLevelFields(t *testing.T) {
+	type SimpleType struct {
+		String        string            `tfschema:"string"`
+		Number        int               `tfschema:"number"`
+		Enabled       bool              `tfschema:"enabled"`
+		ListOfStrings []string          `tfschema:"list_of_strings"`
+		MapOfStrings  map[string]string `tfschema:"map_of_strings"`
+	}
+	var old, new SimpleType
+	testData := decodeDiffTestData{
+		OldState: map[string]interface{}{
+			"string":          "hello",
+			"number":          int64(21),
+			"enabled":         true,
+			"list_of_strings": []interface{}{"have", "you"},
+			"map_of_strings": map[string]interface{}{
+				"hello": "there",
+			},
+		},
+		NewState: map[string]interface{}{
+			"string":          "world",
+			"number":          int64(21),
+			"enabled":         false,
+			"list_of_strings": []interface{}{"have", "you"},
+			"map_of_strings": map[string]interface{}{
+				"hello": "there",
+			},
+		},
+		Old: &old,
+		New: &new,
+		ExpectedOld: &SimpleType{
+			String:        "hello",
+			Number:        21,
+			Enabled:       true,
+			ListOfStrings: []string{"have", "you"},
+			MapOfStrings: map[string]string{
+				"hello": "there",
+			},
+		},
+		ExpectedNew: &SimpleType{
+			String:        "world",
+			Number:        21,
+			Enabled:       false,
+			ListOfStrings: []string{"have", "you"},
+			MapOfStrings: map[string]string{
+				"hello": "there",
+			},
+		},
+		ExpectedChanges: []string{"string", "enabled"},
+	}
+	changes := testData.test(t)
+
+	if !changes.HasChange(&new.String) || !changes.HasChange(&old.String) {
+		t.Fatalf("expected `String` to have changes but it didn't")
+	}
+	if changes.HasChange(&new.Number) {
+		t.Fatalf("expected `Number` to have no changes but it did")
+	}
+	if changes.HasChange(new.String) {
+		t.Fatalf("expected a non-pointer to have no changes but it did")
+	}
+}
+
+func TestDecodeDiff_NoChanges(t *testing.T) {
+	type SimpleType struct {
+		String string `tfschema:"string"`
+	}
+	changes := decodeDiffTestData{
+		OldState: map[string]interface{}{
+			"string": "hello",
+		},
+		NewState: map[string]interface{}{
+			"string": "hello",
+		},
+		Old: &SimpleType{},
+		New: &SimpleType{},
+		ExpectedOld: &SimpleType{
+			String: "hello",
+		},
+		ExpectedNew: &SimpleType{
+			String: "hello",
+		},
+		ExpectedChanges: []string{},
+	}.test(t)
+
+	if changes.HasChanges() {
+		t.Fatalf("expected no changes but got %+v", changes.ChangedFields())
+	}
+}
+
+func TestDecodeDiff_NestedFields(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		Name  string  `tfschema:"name"`
+		Inner []Inner `tfschema:"inner"`
+	}
+	decodeDiffTestData{
+		OldState: map[string]interface{}{
+			"name": "hello",
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "first",
+				},
+			},
+		},
+		NewState: map[string]interface{}{
+			"name": "hello",
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "second",
+				},
+			},
+		},
+		Old: &Type{},
+		New: &Type{},
+		ExpectedOld: &Type{
+			Name: "hello",
+			Inner: []Inner{
+				{
+					Value: "first",
+				},
+			},
+		},
+		ExpectedNew: &Type{
+			Name: "hello",
+			Inner: []Inner{
+				{
+					Value: "second",
+				},
+			},
+		},
+		ExpectedChanges: []string{"inner"},
+	}.test(t)
+}
+
+func TestDecodeDiff_PointerFields(t *testing.T) {
+	type Type struct {
+		Name   string  `tfschema:"name"`
+		Number *int    `tfschema:"number"`
+		Value  *string `tfschema:"value"`
+	}
+	zero := 0
+	world := "world"
+	changes := decodeDiffTestData{
+		OldState: map[string]interface{}{
+			"name": "hello",
+		},
+		NewState: map[string]interface{}{
+			"name":   "hello",
+			"number": 0,
+			"value":  "world",
+		},
+		Old: &Type{},
+		New: &Type{},
+		ExpectedOld: &Type{
+			Name: "hello",
+		},
+		ExpectedNew: &Type{
+			Name:   "hello",
+			Number: &zero,
+			Value:  &world,
+		},
+		ExpectedChanges: []string{"number", "value"},
+	}.test(t)
+
+	if !changes.HasChanges() {
+		t.Fatalf("expected changes but didn't get any")
+	}
+}
+
+func TestDecodeDiff_DifferentTypes(t *testing.T) {
+	type First struct {
+		Name string `tfschema:"name"`
+	}
+	type Second struct {
+		Name string `tfschema:"name"`
+	}
+	decodeDiffTestData{
+		OldState:    map[string]interface{}{},
+		NewState:    map[string]interface{}{},
+		Old:         &First{},
+		New:         &Second{},
+		ExpectError: true,
+	}.test(t)
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
@@ -740,3 +1162,141 @@ func (td testDataGetter) GetOkExists(key string) (interface{}, bool) {
 	val, ok := td.values[key]
 	return val, ok
 }
+
+type decodeDiffTestData struct {
+	OldState        map[string]interface{}
+	NewState        map[string]interface{}
+	Old             interface{}
+	New             interface{}
+	ExpectedOld     interface{}
+	ExpectedNew     interface{}
+	ExpectedChanges []string
+	ExpectError     bool
+}
+
+func (testData decodeDiffTestData) test(t *testing.T) *ModelChanges {
+	debugLogger := ConsoleLogger{}
+	state := testChangeGetter{
+		testDataGetter: testDataGetter{
+			values: testData.NewState,
+		},
+		oldValues: testData.OldState,
+	}
+	changes, err := decodeReflectedTypeChanges(testData.Old, testData.New, state, debugLogger)
+	if err != nil {
+		if testData.ExpectError {
+			// we're good
+			return nil
+		}
+
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if testData.ExpectError {
+		t.Fatalf("expected an error but didn't get one!")
+	}
+
+	if !reflect.DeepEqual(testData.Old, testData.ExpectedOld) {
+		t.Fatalf("\nExpected Old: %+v\n\n Received %+v\n\n", testData.ExpectedOld, testData.Old)
+	}
+	if !reflect.DeepEqual(testData.New, testData.ExpectedNew) {
+		t.Fatalf("\nExpected New: %+v\n\n Received %+v\n\n", testData.ExpectedNew, testData.New)
+	}
+	if actual := changes.ChangedFields(); !reflect.DeepEqual(actual, testData.ExpectedChanges) {
+		t.Fatalf("\nExpected Changes: %+v\n\n Received %+v\n\n", testData.ExpectedChanges, actual)
+	}
+
+	return changes
+}
+
+type testChangeGetter struct {
+	testDataGetter
+
+	oldValues map[string]interface{}
+}
+
+func (td testChangeGetter) GetChange(key string) (interface{}, interface{}) {
+	return td.oldValues[key], td.values[key]
+}
+
+func (td testChangeGetter) HasChange(key string) bool {
+	return !reflect.DeepEqual(td.oldValues[key], td.values[key])
+}
+
+func (td testChangeGetter) ExistsInPriorState(key string) bool {
+	_, ok := td.oldValues[key]
+	return ok
+}
+
+func TestResourceDataChangeRetriever_ExistsInPriorState(t *testing.T) {
+	var actual map[string]bool
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"number": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"list": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		Update: func(d *schema.ResourceData, _ interface{}) error {
+			retriever := &resourceDataChangeRetriever{
+				ResourceData: d,
+			}
+			actual = map[string]bool{
+				"name":   retriever.ExistsInPriorState("name"),
+				"number": retriever.ExistsInPriorState("number"),
+				"list":   retriever.ExistsInPriorState("list"),
+			}
+
+			// the new values should remain available
+			if v := d.Get("number").(int); v != 0 {
+				return fmt.Errorf("expected `number` to be 0 but got %d", v)
+			}
+			if v := d.Get("list").([]interface{}); len(v) != 1 {
+				return fmt.Errorf("expected `list` to have a single item but got %+v", v)
+			}
+			return nil
+		},
+		Read: func(d *schema.ResourceData, _ interface{}) error {
+			return nil
+		},
+	}
+
+	state := &terraform.InstanceState{
+		ID: "some-id",
+		Attributes: map[string]string{
+			"id":   "some-id",
+			"name": "hello",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":   "hello",
+		"number": 0,
+		"list":   []interface{}{"world"},
+	})
+	diff, err := resource.Diff(state, config, nil)
+	if err != nil {
+		t.Fatalf("diffing: %+v", err)
+	}
+	if _, err := resource.Apply(state, diff, nil); err != nil {
+		t.Fatalf("applying: %+v", err)
+	}
+
+	expected := map[string]bool{
+		"name":   true,
+		"number": false,
+		"list":   false,
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, actual)
+	}
+}
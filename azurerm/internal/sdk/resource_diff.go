@@ -0,0 +1,66 @@
+package sdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+// ResourceDiffRunFunc is the function which is run during the Plan for a Resource
+// ctx provides a Context instance with the specified timeout
+// metadata is a reference to an object containing the Client, ResourceDiff and a Logger
+type ResourceDiffRunFunc func(ctx context.Context, metadata ResourceDiffMetaData) error
+
+type ResourceDiffFunc struct {
+	// Func is the function which should be called to customize the Diff for this Resource
+	Func ResourceDiffRunFunc
+
+	// Timeout is the timeout for this function - since this is run during the Plan
+	// this cannot be overridden by users and should be kept short
+	Timeout time.Duration
+}
+
+type ResourceDiffMetaData struct {
+	// Client is a reference to the Azure Providers Client - providing a typed reference to this object
+	Client *clients.Client
+
+	// Logger provides a logger for debug purposes
+	Logger Logger
+
+	// ResourceDiff is a reference to the ResourceDiff object from Terraform's Plugin SDK
+	// This is used to be able to call operations directly should Decode be insufficient
+	// for example, to determine if a field has changes or to set a computed value
+	ResourceDiff *schema.ResourceDiff
+
+	// serializationDebugLogger is used for testing purposes
+	serializationDebugLogger Logger
+}
+
+// Decode will decode the planned values for this Resource into the specified object
+// NOTE: this object must be passed by value - and must contain `tfschema`
+// struct tags for all fields
+func (rdmd ResourceDiffMetaData) Decode(input interface{}) error {
+	return decodeReflectedType(input, rdmd.ResourceDiff, rdmd.serializationDebugLogger)
+}
+
+// ForceNew marks the specified field as requiring this Resource to be recreated
+// NOTE: this can only be used for fields which have changes
+func (rdmd ResourceDiffMetaData) ForceNew(key string) error {
+	return rdmd.ResourceDiff.ForceNew(key)
+}
+
+func runDiffArgs(d *schema.ResourceDiff, meta interface{}, logger Logger, resourceType string) (context.Context, ResourceDiffMetaData) {
+	// NOTE: this is wrapped as a result of this function, so this is "fine" being unwrapped
+	stopContext := meta.(*clients.Client).StopContext
+	client := meta.(*clients.Client)
+	metaData := ResourceDiffMetaData{
+		Client:                   client,
+		Logger:                   scopedLogger(logger, resourceType, "plan", d.Id()),
+		ResourceDiff:             d,
+		serializationDebugLogger: NullLogger{},
+	}
+
+	return stopContext, metaData
+}
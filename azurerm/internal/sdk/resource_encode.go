@@ -78,6 +78,40 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 				}
 				output[tfschemaTag] = attr
 
+			case reflect.Struct:
+				// a single nested block (e.g. `MaxItems: 1`) mapped to a struct
+				debugLogger.Infof("[STRUCT] Setting %q to %+v", tfschemaTag, fieldVal.Interface())
+				serialized, err := recurse(field.Type, fieldVal, field.Name, debugLogger)
+				if err != nil {
+					return nil, fmt.Errorf("serializing nested object %q: %+v", field.Type, err)
+				}
+				output[tfschemaTag] = []interface{}{serialized}
+
+			case reflect.Ptr:
+				if fieldVal.IsNil() {
+					debugLogger.Infof("[POINTER] Setting %q to nil", tfschemaTag)
+					if field.Type.Elem().Kind() == reflect.Struct {
+						output[tfschemaTag] = make([]interface{}, 0)
+					} else {
+						output[tfschemaTag] = nil
+					}
+					continue
+				}
+
+				if field.Type.Elem().Kind() == reflect.Struct {
+					// a single nested block mapped to a pointer to a struct
+					debugLogger.Infof("[POINTER] Setting %q to %+v", tfschemaTag, fieldVal.Elem().Interface())
+					serialized, err := recurse(field.Type.Elem(), fieldVal.Elem(), field.Name, debugLogger)
+					if err != nil {
+						return nil, fmt.Errorf("serializing nested object %q: %+v", field.Type, err)
+					}
+					output[tfschemaTag] = []interface{}{serialized}
+					continue
+				}
+
+				debugLogger.Infof("[POINTER] Setting %q to %+v", tfschemaTag, fieldVal.Elem().Interface())
+				output[tfschemaTag] = fieldVal.Elem().Interface()
+
 			case reflect.Slice:
 				sv := fieldVal.Slice(0, fieldVal.Len())
 				attr := make([]interface{}, sv.Len())
@@ -118,13 +152,14 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 					for i := 0; i < sv.Len(); i++ {
 						debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
 						debugLogger.Infof("[SLICE] Type %+v", sv.Type())
-						nestedType := sv.Index(i).Type()
-						nestedValue := sv.Index(i)
+						// nested blocks can be either a slice of structs, or a slice of pointers to structs
+						nestedValue := reflect.Indirect(sv.Index(i))
+						nestedType := nestedValue.Type()
 
 						fieldName := field.Name
 						serialized, err := recurse(nestedType, nestedValue, fieldName, debugLogger)
 						if err != nil {
-							return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), exists)
+							return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), err)
 						}
 						attr[i] = serialized
 					}
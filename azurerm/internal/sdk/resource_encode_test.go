@@ -645,6 +645,140 @@ func TestResourceEncode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceEncode_PointerFields(t *testing.T) {
+	type Type struct {
+		String  *string  `tfschema:"string"`
+		Number  *int     `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+		Unset   *string  `tfschema:"unset"`
+	}
+	str := "world"
+	number := 42
+	price := 129.99
+	enabled := false
+	encodeTestData{
+		Input: &Type{
+			String:  &str,
+			Number:  &number,
+			Price:   &price,
+			Enabled: &enabled,
+		},
+		Expected: map[string]interface{}{
+			"string":  "world",
+			"number":  42,
+			"price":   129.99,
+			"enabled": false,
+			"unset":   nil,
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_MapOfInterfaces(t *testing.T) {
+	type Type struct {
+		Map map[string]interface{} `tfschema:"map"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Map: map[string]interface{}{
+				"hello": "world",
+				"value": 42,
+			},
+		},
+		Expected: map[string]interface{}{
+			"map": map[string]interface{}{
+				"hello": "world",
+				"value": 42,
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_SingleBlockStruct(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		Name  string `tfschema:"name"`
+		Inner Inner  `tfschema:"inner"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Name: "hello",
+			Inner: Inner{
+				Value: "world",
+			},
+		},
+		Expected: map[string]interface{}{
+			"name": "hello",
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "world",
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_SingleBlockPointer(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		Name  string `tfschema:"name"`
+		Inner *Inner `tfschema:"inner"`
+		Empty *Inner `tfschema:"empty"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Name: "hello",
+			Inner: &Inner{
+				Value: "world",
+			},
+		},
+		Expected: map[string]interface{}{
+			"name": "hello",
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "world",
+				},
+			},
+			"empty": []interface{}{},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NestedSliceOfPointers(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Type struct {
+		Inner []*Inner `tfschema:"inner"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Inner: []*Inner{
+				{
+					Value: "first",
+				},
+				{
+					Value: "second",
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"value": "first",
+				},
+				map[string]interface{}{
+					"value": "second",
+				},
+			},
+		},
+	}.test(t)
+}
+
 func (testData encodeTestData) test(t *testing.T) {
 	objType := reflect.TypeOf(testData.Input).Elem()
 	objVal := reflect.ValueOf(testData.Input).Elem()
@@ -0,0 +1,118 @@
+package sdk
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type StateUpgradeData struct {
+	// SchemaVersion is the current version of the Schema for this Resource
+	SchemaVersion int
+
+	// Upgraders is a map of the Schema Version to the StateUpgrade which
+	// upgrades State from that version to the next version
+	// NOTE: an upgrader must be specified for each version prior to the SchemaVersion
+	Upgraders map[int]StateUpgrade
+}
+
+// StateUpgrade is an interface for upgrading the State for a single Schema Version
+type StateUpgrade interface {
+	// Schema is the Schema of the Resource at the version being upgraded from
+	Schema() map[string]*schema.Schema
+
+	// UpgradeFunc is the function which upgrades the raw State to the next version
+	UpgradeFunc() schema.StateUpgradeFunc
+}
+
+// ResourceIDParser parses the specified Resource ID, returning a Formatter
+// which can be used to build the canonical Resource ID
+type ResourceIDParser func(input string) (resourceid.Formatter, error)
+
+// NewResourceIDStateUpgrade returns a StateUpgrade which rewrites the Resource ID
+// (and optionally, other fields containing a Resource ID) into the canonical format
+//
+// This is intended for fixing the casing of Resource ID's - where the parser should
+// parse the existing Resource ID insensitively, with the Formatter returning the
+// correctly-cased Resource ID
+func NewResourceIDStateUpgrade(oldSchema map[string]*schema.Schema, parser ResourceIDParser, fields ...string) StateUpgrade {
+	return resourceIDStateUpgrade{
+		fields: append([]string{"id"}, fields...),
+		parser: parser,
+		schema: oldSchema,
+	}
+}
+
+type resourceIDStateUpgrade struct {
+	fields []string
+	parser ResourceIDParser
+	schema map[string]*schema.Schema
+}
+
+func (r resourceIDStateUpgrade) Schema() map[string]*schema.Schema {
+	return r.schema
+}
+
+func (r resourceIDStateUpgrade) UpgradeFunc() schema.StateUpgradeFunc {
+	return func(rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+		for _, field := range r.fields {
+			raw, ok := rawState[field]
+			if !ok || raw == nil {
+				continue
+			}
+
+			oldId, ok := raw.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected %q to be a string but got %+v", field, raw)
+			}
+			if oldId == "" {
+				continue
+			}
+
+			id, err := r.parser(oldId)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q: %+v", field, err)
+			}
+			newId := id.ID()
+
+			log.Printf("[DEBUG] Updating %q from %q to %q", field, oldId, newId)
+			rawState[field] = newId
+		}
+
+		return rawState, nil
+	}
+}
+
+// buildStateUpgraders converts the StateUpgradeData into the StateUpgraders used by the Plugin SDK
+func buildStateUpgraders(data StateUpgradeData) ([]schema.StateUpgrader, error) {
+	if data.SchemaVersion <= 0 {
+		return nil, fmt.Errorf("`SchemaVersion` must be greater than 0")
+	}
+
+	upgraders := make([]schema.StateUpgrader, 0)
+	for version := 0; version < data.SchemaVersion; version++ {
+		upgrader, ok := data.Upgraders[version]
+		if !ok || upgrader == nil {
+			return nil, fmt.Errorf("a StateUpgrade is missing for Schema Version %d", version)
+		}
+
+		oldResource := schema.Resource{
+			Schema: upgrader.Schema(),
+		}
+		upgraders = append(upgraders, schema.StateUpgrader{
+			Version: version,
+			Type:    oldResource.CoreConfigSchema().ImpliedType(),
+			Upgrade: upgrader.UpgradeFunc(),
+		})
+	}
+
+	for version := range data.Upgraders {
+		if version < 0 || version >= data.SchemaVersion {
+			return nil, fmt.Errorf("a StateUpgrade was specified for Schema Version %d but the current Schema Version is %d", version, data.SchemaVersion)
+		}
+	}
+
+	return upgraders, nil
+}
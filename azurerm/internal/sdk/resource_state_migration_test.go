@@ -0,0 +1,202 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type stateUpgradeTestData struct {
+	State       map[string]interface{}
+	Upgrade     StateUpgrade
+	Expected    map[string]interface{}
+	ExpectError bool
+}
+
+type testResourceGroupId struct {
+	SubscriptionId string
+	Name           string
+}
+
+func (id testResourceGroupId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", id.SubscriptionId, id.Name)
+}
+
+func testResourceGroupIDInsensitively(input string) (resourceid.Formatter, error) {
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(segments) != 4 || !strings.EqualFold(segments[0], "subscriptions") || !strings.EqualFold(segments[2], "resourceGroups") {
+		return nil, fmt.Errorf("%q is not a Resource Group ID", input)
+	}
+
+	return testResourceGroupId{
+		SubscriptionId: segments[1],
+		Name:           segments[3],
+	}, nil
+}
+
+func testStateUpgradeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"parent_id": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func TestResourceIDStateUpgrade_Id(t *testing.T) {
+	stateUpgradeTestData{
+		State: map[string]interface{}{
+			"id":   "/subscriptions/1234/resourcegroups/group1",
+			"name": "group1",
+		},
+		Upgrade: NewResourceIDStateUpgrade(testStateUpgradeSchema(), testResourceGroupIDInsensitively),
+		Expected: map[string]interface{}{
+			"id":   "/subscriptions/1234/resourceGroups/group1",
+			"name": "group1",
+		},
+	}.test(t)
+}
+
+func TestResourceIDStateUpgrade_AdditionalFields(t *testing.T) {
+	stateUpgradeTestData{
+		State: map[string]interface{}{
+			"id":        "/subscriptions/1234/resourcegroups/group1",
+			"name":      "group1",
+			"parent_id": "/SUBSCRIPTIONS/1234/RESOURCEGROUPS/group2",
+		},
+		Upgrade: NewResourceIDStateUpgrade(testStateUpgradeSchema(), testResourceGroupIDInsensitively, "parent_id"),
+		Expected: map[string]interface{}{
+			"id":        "/subscriptions/1234/resourceGroups/group1",
+			"name":      "group1",
+			"parent_id": "/subscriptions/1234/resourceGroups/group2",
+		},
+	}.test(t)
+}
+
+func TestResourceIDStateUpgrade_AdditionalFieldsEmpty(t *testing.T) {
+	stateUpgradeTestData{
+		State: map[string]interface{}{
+			"id":        "/subscriptions/1234/resourcegroups/group1",
+			"name":      "group1",
+			"parent_id": "",
+		},
+		Upgrade: NewResourceIDStateUpgrade(testStateUpgradeSchema(), testResourceGroupIDInsensitively, "parent_id"),
+		Expected: map[string]interface{}{
+			"id":        "/subscriptions/1234/resourceGroups/group1",
+			"name":      "group1",
+			"parent_id": "",
+		},
+	}.test(t)
+}
+
+func TestResourceIDStateUpgrade_InvalidId(t *testing.T) {
+	stateUpgradeTestData{
+		State: map[string]interface{}{
+			"id":   "/subscriptions/1234",
+			"name": "group1",
+		},
+		Upgrade:     NewResourceIDStateUpgrade(testStateUpgradeSchema(), testResourceGroupIDInsensitively),
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestBuildStateUpgraders(t *testing.T) {
+	upgrade := NewResourceIDStateUpgrade(testStateUpgradeSchema(), testResourceGroupIDInsensitively)
+	testData := []struct {
+		Name        string
+		Input       StateUpgradeData
+		ExpectError bool
+	}{
+		{
+			Name: "No Schema Version",
+			Input: StateUpgradeData{
+				Upgraders: map[int]StateUpgrade{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Missing Upgrader",
+			Input: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Upgrader for a future version",
+			Input: StateUpgradeData{
+				SchemaVersion: 1,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+					1: upgrade,
+				},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Valid",
+			Input: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]StateUpgrade{
+					0: upgrade,
+					1: upgrade,
+				},
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := buildStateUpgraders(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if len(actual) != v.Input.SchemaVersion {
+			t.Fatalf("expected %d upgraders but got %d", v.Input.SchemaVersion, len(actual))
+		}
+		for i, upgrader := range actual {
+			if upgrader.Version != i {
+				t.Fatalf("expected upgrader %d to have version %d but got %d", i, i, upgrader.Version)
+			}
+		}
+	}
+}
+
+func (testData stateUpgradeTestData) test(t *testing.T) {
+	actual, err := testData.Upgrade.UpgradeFunc()(testData.State, nil)
+	if err != nil {
+		if testData.ExpectError {
+			// we're good
+			return
+		}
+
+		t.Fatalf("unexpected error: %+v", err)
+	}
+	if testData.ExpectError {
+		t.Fatalf("expected an error but didn't get one!")
+	}
+
+	if !reflect.DeepEqual(actual, testData.Expected) {
+		t.Fatalf("\nExpected: %+v\n\n Received %+v\n\n", testData.Expected, actual)
+	}
+}
@@ -1,6 +1,9 @@
 package sdk
 
-import "github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
 
 // TypedServiceRegistration is a Service Registration using Types
 // meaning that we can abstract on top of the Plugin SDK and use
@@ -22,6 +25,27 @@ type TypedServiceRegistration interface {
 	WebsiteCategories() []string
 }
 
+// TypedServiceRegistrationWithListDataSources is an optional interface
+//
+// Service Registrations implementing this interface can also register
+// List Data Sources, which look up a (filtered) list of existing resources.
+type TypedServiceRegistrationWithListDataSources interface {
+	TypedServiceRegistration
+
+	// ListDataSources returns a list of List Data Sources supported by this Service
+	ListDataSources() []ListDataSource
+}
+
+// ServiceRegistrationWithResourceIDs is an optional interface
+//
+// Both Typed and Untyped Service Registrations can implement this interface
+// to register the Resource ID Parsers for the Resources within this Service,
+// allowing a Resource ID to be mapped back to the Resource which manages it.
+type ServiceRegistrationWithResourceIDs interface {
+	// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+	ResourceIDs() []resourceid.Registration
+}
+
 // UntypedServiceRegistration is the interface used for untyped/raw Plugin SDK resources
 // in the future this'll be superseded by the TypedServiceRegistration which allows for
 // stronger Typed resources to be used.
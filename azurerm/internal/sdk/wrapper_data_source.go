@@ -30,8 +30,8 @@ func (rw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 		return nil, fmt.Errorf("building Schema: %+v", err)
 	}
 
-	modelObj := rw.dataSource.ModelObject()
-	if err := ValidateModelObject(&modelObj); err != nil {
+	modelObj := modelObjectPointer(rw.dataSource.ModelObject())
+	if err := ValidateModelObjectAgainstSchema(modelObj, *resourceSchema); err != nil {
 		return nil, fmt.Errorf("validating model for %q: %+v", rw.dataSource.ResourceType(), err)
 	}
 
@@ -42,7 +42,7 @@ func (rw *DataSourceWrapper) DataSource() (*schema.Resource, error) {
 	resource := schema.Resource{
 		Schema: *resourceSchema,
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.dataSource.ResourceType(), "read")
 			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 			defer cancel()
 			return rw.dataSource.Read().Func(wrappedCtx, metaData)
@@ -45,13 +45,13 @@ func combineSchema(arguments map[string]*schema.Schema, attributes map[string]*s
 	return &out, nil
 }
 
-func runArgs(d *schema.ResourceData, meta interface{}, logger Logger) (context.Context, ResourceMetaData) {
+func runArgs(d *schema.ResourceData, meta interface{}, logger Logger, resourceType, operation string) (context.Context, ResourceMetaData) {
 	// NOTE: this is wrapped as a result of this function, so this is "fine" being unwrapped
 	stopContext := meta.(*clients.Client).StopContext
 	client := meta.(*clients.Client)
 	metaData := ResourceMetaData{
 		Client:                   client,
-		Logger:                   logger,
+		Logger:                   scopedLogger(logger, resourceType, operation, d.Id()),
 		ResourceData:             d,
 		serializationDebugLogger: NullLogger{},
 	}
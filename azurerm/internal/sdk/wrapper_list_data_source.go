@@ -0,0 +1,205 @@
+package sdk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+// ListDataSourceWrapper is a wrapper for converting a ListDataSource implementation
+// into the object used by the Terraform Plugin SDK
+type ListDataSourceWrapper struct {
+	dataSource ListDataSource
+	logger     Logger
+}
+
+// NewListDataSourceWrapper returns a ListDataSourceWrapper for this List Data Source implementation
+func NewListDataSourceWrapper(dataSource ListDataSource) ListDataSourceWrapper {
+	return ListDataSourceWrapper{
+		dataSource: dataSource,
+		logger:     ConsoleLogger{},
+	}
+}
+
+// DataSource returns the Terraform Plugin SDK type for this ListDataSource implementation
+func (rw *ListDataSourceWrapper) DataSource() (*schema.Resource, error) {
+	resourceSchema, err := rw.schema()
+	if err != nil {
+		return nil, fmt.Errorf("building Schema: %+v", err)
+	}
+
+	modelObj := modelObjectPointer(rw.dataSource.ModelObject())
+	if err := ValidateModelObjectAgainstSchema(modelObj, rw.dataSource.Arguments()); err != nil {
+		return nil, fmt.Errorf("validating model for %q: %+v", rw.dataSource.ResourceType(), err)
+	}
+
+	itemModelObj := modelObjectPointer(rw.dataSource.ItemModelObject())
+	if err := ValidateModelObjectAgainstSchema(itemModelObj, rw.dataSource.ItemAttributes()); err != nil {
+		return nil, fmt.Errorf("validating item model for %q: %+v", rw.dataSource.ResourceType(), err)
+	}
+
+	var d = func(duration time.Duration) *time.Duration {
+		return &duration
+	}
+
+	resource := schema.Resource{
+		Schema: resourceSchema,
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.dataSource.ResourceType(), "read")
+			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
+			defer cancel()
+
+			items := make([]interface{}, 0)
+			err := rw.dataSource.List().Func(wrappedCtx, metaData, func(page []interface{}) error {
+				for _, item := range page {
+					if rw.matchesFilters(d, item) {
+						items = append(items, item)
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			return rw.setItems(d, items)
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Read: d(rw.dataSource.List().Timeout),
+		},
+	}
+
+	return &resource, nil
+}
+
+// schema combines the Arguments, the Filters and the list of Items into a single Schema
+func (rw *ListDataSourceWrapper) schema() (map[string]*schema.Schema, error) {
+	out := make(map[string]*schema.Schema)
+	for k, v := range rw.dataSource.Arguments() {
+		if v.Computed && !(v.Optional || v.Required) {
+			return nil, fmt.Errorf("%q is a Computed-only field - this should be specified as an Item Attribute", k)
+		}
+
+		out[k] = v
+	}
+
+	for _, filter := range rw.dataSource.Filters() {
+		if _, alreadyExists := out[filter.Name]; alreadyExists {
+			return nil, fmt.Errorf("%q already exists in the schema", filter.Name)
+		}
+		if filter.Schema == nil || !filter.Schema.Optional || filter.Schema.Required {
+			return nil, fmt.Errorf("the filter %q must be Optional", filter.Name)
+		}
+		if filter.Matches == nil {
+			return nil, fmt.Errorf("the filter %q must specify a Matches function", filter.Name)
+		}
+
+		out[filter.Name] = filter.Schema
+	}
+
+	itemsKey := rw.dataSource.ItemsAttributeName()
+	if _, alreadyExists := out[itemsKey]; alreadyExists {
+		return nil, fmt.Errorf("%q already exists in the schema", itemsKey)
+	}
+
+	itemSchema := make(map[string]*schema.Schema)
+	for k, v := range rw.dataSource.ItemAttributes() {
+		if v.Optional || v.Required {
+			return nil, fmt.Errorf("the item attribute %q is a user-specifyable field - this should be specified as an Argument", k)
+		}
+
+		// every attribute has to be computed - since the schema may be shared (e.g. with a Resource)
+		// this is set on a copy
+		item := *v
+		item.Computed = true
+		itemSchema[k] = &item
+	}
+	out[itemsKey] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: itemSchema,
+		},
+	}
+
+	return out, nil
+}
+
+// matchesFilters returns whether the item matches each of the Filters which have a value specified
+func (rw *ListDataSourceWrapper) matchesFilters(d *schema.ResourceData, item interface{}) bool {
+	for _, filter := range rw.dataSource.Filters() {
+		value, ok := filterValue(d, filter)
+		if !ok {
+			continue
+		}
+
+		if !filter.Matches(value, item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// filterValue returns the value specified for this Filter, and whether a value was specified
+func filterValue(d *schema.ResourceData, filter ListFilter) (interface{}, bool) {
+	// since the zero value for a bool is meaningful we need to check if this has been specified
+	if filter.Schema.Type == schema.TypeBool {
+		return d.GetOkExists(filter.Name)
+	}
+
+	return d.GetOk(filter.Name)
+}
+
+// setItems sets the ID and the list of items into the State
+func (rw *ListDataSourceWrapper) setItems(d *schema.ResourceData, items []interface{}) error {
+	itemsKey := rw.dataSource.ItemsAttributeName()
+	output := make([]interface{}, 0)
+	for _, item := range items {
+		itemVal := reflect.Indirect(reflect.ValueOf(item))
+		serialized, err := recurse(itemVal.Type(), itemVal, itemsKey, NullLogger{})
+		if err != nil {
+			return fmt.Errorf("serializing item for %q: %+v", itemsKey, err)
+		}
+
+		output = append(output, serialized)
+	}
+
+	d.SetId(rw.id(d))
+
+	if err := d.Set(itemsKey, output); err != nil {
+		return fmt.Errorf("setting %q: %+v", itemsKey, err)
+	}
+
+	return nil
+}
+
+// id returns a deterministic ID for this Data Source, based on the values of the Arguments and Filters
+func (rw *ListDataSourceWrapper) id(d *schema.ResourceData) string {
+	values := make([]string, 0)
+	for k := range rw.dataSource.Arguments() {
+		value := d.Get(k)
+		if v, ok := value.(*schema.Set); ok {
+			value = v.List()
+		}
+		values = append(values, fmt.Sprintf("%s=%v", k, value))
+	}
+	for _, filter := range rw.dataSource.Filters() {
+		// filters without a value are omitted, so that unset values can be differentiated from zero values
+		if value, ok := filterValue(d, filter); ok {
+			values = append(values, fmt.Sprintf("%s=%v", filter.Name, value))
+		}
+	}
+	sort.Strings(values)
+	values = append([]string{rw.dataSource.ResourceType()}, values...)
+
+	hash := sha256.Sum256([]byte(strings.Join(values, ";")))
+	return hex.EncodeToString(hash[:])
+}
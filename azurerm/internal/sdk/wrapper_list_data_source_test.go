@@ -0,0 +1,248 @@
+package sdk
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+type listTestArguments struct {
+	ResourceGroup string `tfschema:"resource_group_name"`
+}
+
+type listTestItem struct {
+	Name     string `tfschema:"name"`
+	Attached bool   `tfschema:"attached"`
+}
+
+type listTestDataSource struct {
+}
+
+func (listTestDataSource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"resource_group_name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+func (listTestDataSource) ModelObject() interface{} {
+	return listTestArguments{}
+}
+
+func (listTestDataSource) ResourceType() string {
+	return "validator_list"
+}
+
+func (listTestDataSource) Filters() []ListFilter {
+	return []ListFilter{
+		NewStringPrefixFilter("name_prefix", func(item interface{}) string {
+			return item.(listTestItem).Name
+		}),
+		NewBoolFilter("attached", func(item interface{}) bool {
+			return item.(listTestItem).Attached
+		}),
+	}
+}
+
+func (listTestDataSource) ItemsAttributeName() string {
+	return "items"
+}
+
+func (listTestDataSource) ItemAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type: schema.TypeString,
+		},
+		"attached": {
+			Type: schema.TypeBool,
+		},
+	}
+}
+
+func (listTestDataSource) ItemModelObject() interface{} {
+	return listTestItem{}
+}
+
+func (listTestDataSource) List() ListFunc {
+	return ListFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData, handlePage ListPageFunc) error {
+			var args listTestArguments
+			if err := metadata.Decode(&args); err != nil {
+				return err
+			}
+
+			pages := [][]interface{}{
+				{
+					listTestItem{Name: "first", Attached: true},
+					listTestItem{Name: "second", Attached: false},
+				},
+				{
+					listTestItem{Name: args.ResourceGroup, Attached: true},
+				},
+			}
+			for _, page := range pages {
+				if err := handlePage(page); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func TestListDataSourceWrapper(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Config   map[string]interface{}
+		Expected []interface{}
+	}{
+		{
+			Name: "No Filters",
+			Config: map[string]interface{}{
+				"resource_group_name": "group",
+			},
+			Expected: []interface{}{
+				map[string]interface{}{"name": "first", "attached": true},
+				map[string]interface{}{"name": "second", "attached": false},
+				map[string]interface{}{"name": "group", "attached": true},
+			},
+		},
+		{
+			Name: "Prefix Filter",
+			Config: map[string]interface{}{
+				"resource_group_name": "group",
+				"name_prefix":         "fir",
+			},
+			Expected: []interface{}{
+				map[string]interface{}{"name": "first", "attached": true},
+			},
+		},
+		{
+			Name: "Bool Filter",
+			Config: map[string]interface{}{
+				"resource_group_name": "group",
+				"attached":            false,
+			},
+			Expected: []interface{}{
+				map[string]interface{}{"name": "second", "attached": false},
+			},
+		},
+		{
+			Name: "No Matches",
+			Config: map[string]interface{}{
+				"resource_group_name": "group",
+				"name_prefix":         "none",
+			},
+			Expected: []interface{}{},
+		},
+	}
+
+	wrapper := NewListDataSourceWrapper(listTestDataSource{})
+	dataSource, err := wrapper.DataSource()
+	if err != nil {
+		t.Fatalf("building Data Source: %+v", err)
+	}
+
+	client := &clients.Client{
+		StopContext: context.TODO(),
+	}
+	ids := make(map[string]string)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		d := schema.TestResourceDataRaw(t, dataSource.Schema, v.Config)
+		if err := dataSource.Read(d, client); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+
+		if actual := d.Get("items").([]interface{}); !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("\nExpected: %+v\n\n Received %+v\n\n", v.Expected, actual)
+		}
+
+		if d.Id() == "" {
+			t.Fatalf("expected an ID to be set but it wasn't")
+		}
+		if existing, ok := ids[d.Id()]; ok {
+			t.Fatalf("expected the ID to be unique but it's the same as %q", existing)
+		}
+		ids[d.Id()] = v.Name
+
+		// the ID should be deterministic
+		d2 := schema.TestResourceDataRaw(t, dataSource.Schema, v.Config)
+		if err := dataSource.Read(d2, client); err != nil {
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if d.Id() != d2.Id() {
+			t.Fatalf("expected the ID %q to match %q", d2.Id(), d.Id())
+		}
+	}
+}
+
+func TestListDataSourceWrapperInvalidFilter(t *testing.T) {
+	wrapper := NewListDataSourceWrapper(listTestDataSourceWithRequiredFilter{})
+	if _, err := wrapper.DataSource(); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+type listTestDataSourceWithRequiredFilter struct {
+	listTestDataSource
+}
+
+func (listTestDataSourceWithRequiredFilter) Filters() []ListFilter {
+	return []ListFilter{
+		{
+			Name: "name",
+			Schema: &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			Matches: func(value interface{}, item interface{}) bool {
+				return true
+			},
+		},
+	}
+}
+
+func TestListDataSourceWrapperSharedItemSchema(t *testing.T) {
+	wrapper := NewListDataSourceWrapper(listTestDataSourceWithSharedSchema{})
+	dataSource, err := wrapper.DataSource()
+	if err != nil {
+		t.Fatalf("building Data Source: %+v", err)
+	}
+
+	items := dataSource.Schema["items"].Elem.(*schema.Resource).Schema
+	if !items["name"].Computed {
+		t.Fatalf("expected the item attribute `name` to be Computed but it wasn't")
+	}
+
+	// the schema returned from ItemAttributes shouldn't be modified
+	if listTestSharedNameSchema.Computed {
+		t.Fatalf("expected the shared schema not to be modified but it was marked as Computed")
+	}
+}
+
+var listTestSharedNameSchema = &schema.Schema{
+	Type: schema.TypeString,
+}
+
+type listTestDataSourceWithSharedSchema struct {
+	listTestDataSource
+}
+
+func (listTestDataSourceWithSharedSchema) ItemAttributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": listTestSharedNameSchema,
+		"attached": {
+			Type: schema.TypeBool,
+		},
+	}
+}
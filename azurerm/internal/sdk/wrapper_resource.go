@@ -1,10 +1,12 @@
 package sdk
 
 import (
+	"context"
 	"fmt"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	azSchema "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
@@ -31,8 +33,8 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		return nil, fmt.Errorf("building Schema: %+v", err)
 	}
 
-	modelObj := rw.resource.ModelObject()
-	if err := ValidateModelObject(&modelObj); err != nil {
+	modelObj := modelObjectPointer(rw.resource.ModelObject())
+	if err := ValidateModelObjectAgainstSchema(modelObj, *resourceSchema); err != nil {
 		return nil, fmt.Errorf("validating model for %q: %+v", rw.resource.ResourceType(), err)
 	}
 
@@ -44,7 +46,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		Schema: *resourceSchema,
 
 		Create: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "create")
 			wrappedCtx, cancel := timeouts.ForCreate(ctx, d)
 			defer cancel()
 			err := rw.resource.Create().Func(wrappedCtx, metaData)
@@ -59,13 +61,13 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		// looks like these could be reused, easiest if they're not
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "read")
 			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 			defer cancel()
 			return rw.resource.Read().Func(wrappedCtx, metaData)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "delete")
 			wrappedCtx, cancel := timeouts.ForDelete(ctx, d)
 			defer cancel()
 			return rw.resource.Delete().Func(wrappedCtx, metaData)
@@ -81,7 +83,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			warnings, errors := fn(id, "id")
 			if len(warnings) > 0 {
 				for _, warning := range warnings {
-					rw.logger.Warn(warning)
+					scopedLogger(rw.logger, rw.resource.ResourceType(), "import", id).Warn(warning)
 				}
 			}
 			if len(errors) > 0 {
@@ -89,13 +91,19 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 				for _, error := range errors {
 					out += error.Error()
 				}
+
+				// if this is the ID of another type of resource, point the user in the right direction
+				if hint, ok := resourceid.SuggestResourceType(id, rw.resource.ResourceType()); ok {
+					out += fmt.Sprintf(" - %s", hint)
+				}
+
 				return fmt.Errorf(out)
 			}
 
 			return nil
 		}, func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				ctx, metaData := runArgs(d, meta, rw.logger)
+				ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "import")
 				wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 				defer cancel()
 
@@ -115,7 +123,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), "update")
 			wrappedCtx, cancel := timeouts.ForUpdate(ctx, d)
 			defer cancel()
 
@@ -140,8 +148,30 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.DeprecationMessage = message
 	}
 
-	// TODO: CustomizeDiff
-	// TODO: State Migrations
+	if v, ok := rw.resource.(ResourceWithCustomizeDiff); ok {
+		customizeDiff := v.CustomizeDiff()
+		if customizeDiff.Timeout == 0 {
+			return nil, fmt.Errorf("Resource %q must specify a Timeout for CustomizeDiff if implementing ResourceWithCustomizeDiff", rw.resource.ResourceType())
+		}
+
+		resource.CustomizeDiff = func(d *schema.ResourceDiff, meta interface{}) error {
+			ctx, metaData := runDiffArgs(d, meta, rw.logger, rw.resource.ResourceType())
+			wrappedCtx, cancel := context.WithTimeout(ctx, customizeDiff.Timeout)
+			defer cancel()
+			return customizeDiff.Func(wrappedCtx, metaData)
+		}
+	}
+
+	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
+		upgradeData := v.StateUpgraders()
+		upgraders, err := buildStateUpgraders(upgradeData)
+		if err != nil {
+			return nil, fmt.Errorf("building State Upgraders for %q: %+v", rw.resource.ResourceType(), err)
+		}
+
+		resource.SchemaVersion = upgradeData.SchemaVersion
+		resource.StateUpgraders = upgraders
+	}
 
 	return &resource, nil
 }
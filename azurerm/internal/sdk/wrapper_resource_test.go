@@ -0,0 +1,175 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+)
+
+type customizeDiffModel struct {
+	Name string `tfschema:"name"`
+	Sku  string `tfschema:"sku"`
+}
+
+type customizeDiffResource struct {
+}
+
+func (customizeDiffResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"sku": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+func (customizeDiffResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
+}
+
+func (customizeDiffResource) ModelObject() interface{} {
+	return customizeDiffModel{}
+}
+
+func (customizeDiffResource) ResourceType() string {
+	return "validator_customize_diff"
+}
+
+func (customizeDiffResource) Create() ResourceFunc {
+	return ResourceFunc{Timeout: time.Minute}
+}
+
+func (customizeDiffResource) Read() ResourceFunc {
+	return ResourceFunc{Timeout: time.Minute}
+}
+
+func (customizeDiffResource) Delete() ResourceFunc {
+	return ResourceFunc{Timeout: time.Minute}
+}
+
+func (customizeDiffResource) IDValidationFunc() schema.SchemaValidateFunc {
+	return nil
+}
+
+func (customizeDiffResource) CustomizeDiff() ResourceDiffFunc {
+	return ResourceDiffFunc{
+		Func: func(ctx context.Context, metadata ResourceDiffMetaData) error {
+			metadata.Logger.Info("customizing diff..")
+
+			var model customizeDiffModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			if model.Name == "free" && model.Sku != "" {
+				return fmt.Errorf("`sku` cannot be specified when `name` is %q", model.Name)
+			}
+
+			return nil
+		},
+		Timeout: time.Minute,
+	}
+}
+
+func TestResourceWrapperCustomizeDiff(t *testing.T) {
+	testData := []struct {
+		Name        string
+		Config      map[string]interface{}
+		ExpectError bool
+	}{
+		{
+			Name: "Valid",
+			Config: map[string]interface{}{
+				"name": "standard",
+				"sku":  "Premium",
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Invalid",
+			Config: map[string]interface{}{
+				"name": "free",
+				"sku":  "Premium",
+			},
+			ExpectError: true,
+		},
+	}
+
+	wrapper := NewResourceWrapper(customizeDiffResource{})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+	if resource.CustomizeDiff == nil {
+		t.Fatalf("expected CustomizeDiff to be set but it wasn't")
+	}
+
+	client := &clients.Client{
+		StopContext: context.TODO(),
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		config := terraform.NewResourceConfigRaw(v.Config)
+		_, err := resource.Diff(nil, config, client)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestResourceWrapperScopesLogger(t *testing.T) {
+	logger := NewTestLogger()
+	wrapper := ResourceWrapper{
+		logger:   logger,
+		resource: customizeDiffResource{},
+	}
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	client := &clients.Client{
+		StopContext: context.TODO(),
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "standard",
+	})
+	if _, err := resource.Diff(nil, config, client); err != nil {
+		t.Fatalf("unexpected error: %+v", err)
+	}
+
+	entries := logger.Entries()
+	if len(entries) != 1 {
+		t.Fatalf("expected 1 log entry but got %d", len(entries))
+	}
+	entry := entries[0]
+	if entry.Level != "INFO" || entry.Message != "customizing diff.." {
+		t.Fatalf("unexpected log entry: %+v", entry)
+	}
+	if v := entry.Fields[LogFieldOperation]; v != "plan" {
+		t.Fatalf("expected the operation to be %q but got %q", "plan", v)
+	}
+	if v := entry.Fields[LogFieldResourceType]; v != "validator_customize_diff" {
+		t.Fatalf("expected the resource type to be %q but got %q", "validator_customize_diff", v)
+	}
+	if _, ok := entry.Fields[LogFieldResourceID]; ok {
+		t.Fatalf("expected no resource id since this is a new resource")
+	}
+}
@@ -3,7 +3,11 @@ package sdk
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 // ValidateModelObject validates that the object contains the specified `tfschema` tags
@@ -13,13 +17,28 @@ func ValidateModelObject(input interface{}) error {
 		return fmt.Errorf("need a pointer")
 	}
 
-	// TODO: could we also validate that each `tfschema` tag exists in the schema?
-
 	objType := reflect.TypeOf(input).Elem()
 	objVal := reflect.ValueOf(input).Elem()
 	return validateModelObjectRecursively("", objType, objVal)
 }
 
+// ValidateModelObjectAgainstSchema validates that the object contains the specified `tfschema` tags
+// and that each of these exists within the specified Schema with a compatible type - such that
+// the object can be used with the Encode and Decode functions
+func ValidateModelObjectAgainstSchema(input interface{}, resourceSchema map[string]*schema.Schema) error {
+	if err := ValidateModelObject(input); err != nil {
+		return err
+	}
+
+	objType := reflect.TypeOf(input).Elem()
+	errors := validateModelObjectAgainstSchemaRecursively("", objType, resourceSchema, false)
+	if len(errors) > 0 {
+		return multierror.Append(nil, errors...)
+	}
+
+	return nil
+}
+
 func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal reflect.Value) (errOut error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -34,23 +53,178 @@ func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal
 
 	for i := 0; i < objType.NumField(); i++ {
 		field := objType.Field(i)
-		fieldVal := objVal.Field(i)
+		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 
-		if field.Type.Kind() == reflect.Slice {
-			sv := fieldVal.Slice(0, fieldVal.Len())
-			innerType := sv.Type().Elem()
+		if innerType := nestedObjectType(field.Type); innerType != nil {
 			innerVal := reflect.Indirect(reflect.New(innerType))
-			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 			if err := validateModelObjectRecursively(fieldName, innerType, innerVal); err != nil {
 				return err
 			}
 		}
 
 		if _, exists := field.Tag.Lookup("tfschema"); !exists {
-			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
 			return fmt.Errorf("field %q is missing an `tfschema` label", fieldName)
 		}
 	}
 
 	return nil
 }
+
+// validateModelObjectAgainstSchemaRecursively validates the fields in the object against the Schema
+// returning all of the errors found - when requireAllFields is set, each field in the Schema must also
+// be present in the object
+func validateModelObjectAgainstSchemaRecursively(prefix string, objType reflect.Type, resourceSchema map[string]*schema.Schema, requireAllFields bool) []error {
+	errors := make([]error, 0)
+	keysInModel := make(map[string]struct{})
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+
+		key, exists := field.Tag.Lookup("tfschema")
+		if !exists {
+			errors = append(errors, fmt.Errorf("field %q is missing an `tfschema` label", fieldName))
+			continue
+		}
+		keysInModel[key] = struct{}{}
+
+		fieldSchema, ok := resourceSchema[key]
+		if !ok {
+			errors = append(errors, fmt.Errorf("field %q has the `tfschema` label %q which doesn't exist in the Schema", fieldName, key))
+			continue
+		}
+
+		errors = append(errors, validateFieldAgainstSchema(fieldName, field.Type, fieldSchema, requireAllFields)...)
+	}
+
+	if requireAllFields {
+		keys := make([]string, 0)
+		for key := range resourceSchema {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			if _, ok := keysInModel[key]; !ok {
+				name := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, key), ".")
+				errors = append(errors, fmt.Errorf("the Schema field %q has no corresponding field with a `tfschema` label in the Model", name))
+			}
+		}
+	}
+
+	return errors
+}
+
+func validateFieldAgainstSchema(fieldName string, fieldType reflect.Type, fieldSchema *schema.Schema, requireAllFields bool) []error {
+	// single nested blocks are mapped to either a struct or a pointer to a struct
+	if innerType := nestedObjectType(fieldType); innerType != nil && fieldType.Kind() != reflect.Slice {
+		resource, ok := fieldSchema.Elem.(*schema.Resource)
+		if !isListOrSet(fieldSchema.Type) || !ok {
+			return []error{fmt.Errorf("field %q is a nested object but the Schema is a %s", fieldName, fieldSchema.Type)}
+		}
+		if fieldSchema.MaxItems != 1 {
+			return []error{fmt.Errorf("field %q is a single nested object but the Schema doesn't specify `MaxItems: 1`", fieldName)}
+		}
+
+		return validateModelObjectAgainstSchemaRecursively(fieldName, innerType, resource.Schema, requireAllFields)
+	}
+
+	// pointers to primitive types are used to differentiate unset values
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Slice:
+		if !isListOrSet(fieldSchema.Type) {
+			return []error{fmt.Errorf("field %q is a slice but the Schema is a %s", fieldName, fieldSchema.Type)}
+		}
+
+		if innerType := nestedObjectType(fieldType); innerType != nil {
+			resource, ok := fieldSchema.Elem.(*schema.Resource)
+			if !ok {
+				return []error{fmt.Errorf("field %q is a slice of nested objects but the Schema doesn't contain a nested Resource", fieldName)}
+			}
+
+			return validateModelObjectAgainstSchemaRecursively(fieldName, innerType, resource.Schema, requireAllFields)
+		}
+
+		elem, ok := fieldSchema.Elem.(*schema.Schema)
+		if !ok {
+			return []error{fmt.Errorf("field %q is a slice of primitives but the Schema doesn't contain a primitive Elem", fieldName)}
+		}
+
+		return validateFieldAgainstSchema(fieldName, fieldType.Elem(), elem, requireAllFields)
+
+	case reflect.Map:
+		if fieldSchema.Type != schema.TypeMap {
+			return []error{fmt.Errorf("field %q is a map but the Schema is a %s", fieldName, fieldSchema.Type)}
+		}
+
+		// a map of interfaces can contain any value
+		if fieldType.Elem().Kind() == reflect.Interface {
+			return nil
+		}
+
+		// the Plugin SDK defaults the Elem for a Map to a String
+		elem := &schema.Schema{Type: schema.TypeString}
+		if v, ok := fieldSchema.Elem.(*schema.Schema); ok {
+			elem = v
+		}
+
+		return validateFieldAgainstSchema(fieldName, fieldType.Elem(), elem, requireAllFields)
+	}
+
+	expected, ok := primitiveValueTypes[fieldType.Kind()]
+	if !ok {
+		return []error{fmt.Errorf("field %q has the unsupported type %s", fieldName, fieldType)}
+	}
+	if expected != fieldSchema.Type {
+		return []error{fmt.Errorf("field %q is a %s but the Schema is a %s", fieldName, fieldType, fieldSchema.Type)}
+	}
+
+	return nil
+}
+
+var primitiveValueTypes = map[reflect.Kind]schema.ValueType{
+	reflect.Bool:    schema.TypeBool,
+	reflect.Float32: schema.TypeFloat,
+	reflect.Float64: schema.TypeFloat,
+	reflect.Int:     schema.TypeInt,
+	reflect.Int8:    schema.TypeInt,
+	reflect.Int16:   schema.TypeInt,
+	reflect.Int32:   schema.TypeInt,
+	reflect.Int64:   schema.TypeInt,
+	reflect.String:  schema.TypeString,
+}
+
+// nestedObjectType returns the struct type for a nested object - that is a struct, a pointer
+// to a struct, a slice of structs or a slice of pointers to structs - otherwise nil
+func nestedObjectType(input reflect.Type) reflect.Type {
+	if input.Kind() == reflect.Slice {
+		input = input.Elem()
+	}
+	if input.Kind() == reflect.Ptr {
+		input = input.Elem()
+	}
+	if input.Kind() == reflect.Struct {
+		return input
+	}
+
+	return nil
+}
+
+func isListOrSet(input schema.ValueType) bool {
+	return input == schema.TypeList || input == schema.TypeSet
+}
+
+// modelObjectPointer returns a pointer to the specified Model Object, which is
+// required for the Model Object to be validated
+func modelObjectPointer(input interface{}) interface{} {
+	if reflect.TypeOf(input).Kind() == reflect.Ptr {
+		return input
+	}
+
+	ptr := reflect.New(reflect.TypeOf(input))
+	ptr.Elem().Set(reflect.ValueOf(input))
+	return ptr.Interface()
+}
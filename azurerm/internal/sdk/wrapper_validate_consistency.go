@@ -0,0 +1,79 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ValidateDataSourceConsistency validates that the Model Object for this Data Source is consistent
+// with the Arguments and Attributes - returning all of the inconsistencies found
+func ValidateDataSourceConsistency(dataSource DataSource) error {
+	return validateConsistency(dataSource)
+}
+
+// ValidateResourceConsistency validates that the Model Object for this Resource is consistent
+// with the Arguments and Attributes - returning all of the inconsistencies found
+func ValidateResourceConsistency(resource Resource) error {
+	return validateConsistency(resource)
+}
+
+// validateConsistency checks that:
+//
+// * Computed-only fields are specified as Attributes, and user-configurable fields as Arguments
+// * each field in the Schema has a corresponding field in the Model Object (and vice versa)
+// * the type of each field in the Model Object is compatible with the type in the Schema
+func validateConsistency(input resourceBase) error {
+	var errors *multierror.Error
+
+	arguments := input.Arguments()
+	attributes := input.Attributes()
+	for _, k := range sortedSchemaKeys(arguments) {
+		v := arguments[k]
+		if v.Computed && !(v.Optional || v.Required) {
+			errors = multierror.Append(errors, fmt.Errorf("%q is a Computed-only field - this should be specified as an Attribute", k))
+		}
+		if _, exists := attributes[k]; exists {
+			errors = multierror.Append(errors, fmt.Errorf("%q is specified as both an Argument and an Attribute", k))
+		}
+	}
+	for _, k := range sortedSchemaKeys(attributes) {
+		v := attributes[k]
+		if v.Optional || v.Required {
+			errors = multierror.Append(errors, fmt.Errorf("%q is a user-specifyable field - this should be specified as an Argument", k))
+		}
+	}
+
+	resourceSchema := make(map[string]*schema.Schema)
+	for k, v := range arguments {
+		resourceSchema[k] = v
+	}
+	for k, v := range attributes {
+		resourceSchema[k] = v
+	}
+
+	modelObj := modelObjectPointer(input.ModelObject())
+	objType := nestedObjectType(reflect.TypeOf(modelObj))
+	if objType == nil {
+		errors = multierror.Append(errors, fmt.Errorf("the Model Object must be a struct"))
+		return errors.ErrorOrNil()
+	}
+
+	if errs := validateModelObjectAgainstSchemaRecursively("", objType, resourceSchema, true); len(errs) > 0 {
+		errors = multierror.Append(errors, errs...)
+	}
+
+	return errors.ErrorOrNil()
+}
+
+func sortedSchemaKeys(input map[string]*schema.Schema) []string {
+	keys := make([]string, 0)
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,211 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+type consistencyTestDataSource struct {
+	arguments  map[string]*schema.Schema
+	attributes map[string]*schema.Schema
+	model      interface{}
+}
+
+func (ds consistencyTestDataSource) Arguments() map[string]*schema.Schema {
+	return ds.arguments
+}
+
+func (ds consistencyTestDataSource) Attributes() map[string]*schema.Schema {
+	return ds.attributes
+}
+
+func (ds consistencyTestDataSource) ModelObject() interface{} {
+	return ds.model
+}
+
+func (ds consistencyTestDataSource) ResourceType() string {
+	return "validator_consistency"
+}
+
+func (ds consistencyTestDataSource) Read() ResourceFunc {
+	return ResourceFunc{}
+}
+
+func TestValidateConsistency(t *testing.T) {
+	type Inner struct {
+		Value string `tfschema:"value"`
+	}
+	type Valid struct {
+		Name   string  `tfschema:"name"`
+		Output string  `tfschema:"output"`
+		Inner  []Inner `tfschema:"inner"`
+	}
+	type Simple struct {
+		Name   string `tfschema:"name"`
+		Output string `tfschema:"output"`
+	}
+	type MissingTag struct {
+		Name   string `tfschema:"name"`
+		Output string
+	}
+	type ExtraTag struct {
+		Name   string `tfschema:"name"`
+		Output string `tfschema:"output"`
+		Extra  string `tfschema:"extra"`
+	}
+	type TypeMismatch struct {
+		Name   int    `tfschema:"name"`
+		Output string `tfschema:"output"`
+	}
+	type NestedMissingField struct {
+		Name   string `tfschema:"name"`
+		Output string `tfschema:"output"`
+		Inner  []struct {
+			Other string `tfschema:"other"`
+		} `tfschema:"inner"`
+	}
+
+	arguments := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		}
+	}
+	attributes := func() map[string]*schema.Schema {
+		return map[string]*schema.Schema{
+			"output": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		}
+	}
+	argumentsWithInner := func() map[string]*schema.Schema {
+		out := arguments()
+		out["inner"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"value": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		}
+		return out
+	}
+
+	testData := []struct {
+		Name        string
+		Input       consistencyTestDataSource
+		ExpectError bool
+	}{
+		{
+			Name: "Valid",
+			Input: consistencyTestDataSource{
+				arguments:  argumentsWithInner(),
+				attributes: attributes(),
+				model:      Valid{},
+			},
+			ExpectError: false,
+		},
+		{
+			Name: "Field missing from the Model",
+			Input: consistencyTestDataSource{
+				arguments:  argumentsWithInner(),
+				attributes: attributes(),
+				model:      Simple{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Field missing a tag",
+			Input: consistencyTestDataSource{
+				arguments:  arguments(),
+				attributes: attributes(),
+				model:      MissingTag{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Tag missing from the Schema",
+			Input: consistencyTestDataSource{
+				arguments:  arguments(),
+				attributes: attributes(),
+				model:      ExtraTag{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Type Mismatch",
+			Input: consistencyTestDataSource{
+				arguments:  arguments(),
+				attributes: attributes(),
+				model:      TypeMismatch{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Nested Field missing from the Model",
+			Input: consistencyTestDataSource{
+				arguments:  argumentsWithInner(),
+				attributes: attributes(),
+				model:      NestedMissingField{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Computed-only field in the Arguments",
+			Input: consistencyTestDataSource{
+				arguments: func() map[string]*schema.Schema {
+					out := arguments()
+					out["output"] = &schema.Schema{
+						Type:     schema.TypeString,
+						Computed: true,
+					}
+					return out
+				}(),
+				attributes: map[string]*schema.Schema{},
+				model:      Simple{},
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "Optional field in the Attributes",
+			Input: consistencyTestDataSource{
+				arguments: map[string]*schema.Schema{},
+				attributes: func() map[string]*schema.Schema {
+					out := attributes()
+					out["name"] = &schema.Schema{
+						Type:     schema.TypeString,
+						Optional: true,
+					}
+					return out
+				}(),
+				model: Simple{},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		err := ValidateDataSourceConsistency(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				t.Logf("[DEBUG] Error: %+v", err)
+				continue
+			}
+
+			t.Fatalf("unexpected error: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package sdk
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
 
 func TestValidateTopLevelObjectValid(t *testing.T) {
 	type Person struct {
@@ -56,3 +60,164 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateModelObjectAgainstSchemaValid(t *testing.T) {
+	type Address struct {
+		Street string  `tfschema:"street"`
+		Number *int    `tfschema:"number"`
+		Flat   *string `tfschema:"flat"`
+	}
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Name      string                 `tfschema:"name"`
+		Age       *int                   `tfschema:"age"`
+		Address   *Address               `tfschema:"address"`
+		Pets      []Pet                  `tfschema:"pets"`
+		Nicknames []string               `tfschema:"nicknames"`
+		Tags      map[string]string      `tfschema:"tags"`
+		Metadata  map[string]interface{} `tfschema:"metadata"`
+	}
+	resourceSchema := map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"age": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"address": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"street": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"number": {
+						Type:     schema.TypeInt,
+						Optional: true,
+					},
+					"flat": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		},
+		"pets": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"nicknames": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"tags": {
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+		"metadata": {
+			Type:     schema.TypeMap,
+			Optional: true,
+		},
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person{}, resourceSchema); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateModelObjectAgainstSchemaInvalid(t *testing.T) {
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+
+	t.Log("Missing from the Schema")
+	type Person1 struct {
+		Name string `tfschema:"name"`
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person1{}, map[string]*schema.Schema{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	t.Log("Type Mismatch")
+	type Person2 struct {
+		Age string `tfschema:"age"`
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person2{}, map[string]*schema.Schema{
+		"age": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+	}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	t.Log("Single Block without MaxItems")
+	type Person3 struct {
+		Pet *Pet `tfschema:"pet"`
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person3{}, map[string]*schema.Schema{
+		"pet": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+	}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	t.Log("Nested Field Missing from the Schema")
+	type Person4 struct {
+		Pets []Pet `tfschema:"pets"`
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person4{}, map[string]*schema.Schema{
+		"pets": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{},
+			},
+		},
+	}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+
+	t.Log("Slice of Primitives Type Mismatch")
+	type Person5 struct {
+		Nicknames []string `tfschema:"nicknames"`
+	}
+	if err := ValidateModelObjectAgainstSchema(&Person5{}, map[string]*schema.Schema{
+		"nicknames": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeInt,
+			},
+		},
+	}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
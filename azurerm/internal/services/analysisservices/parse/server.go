@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerId struct {
@@ -37,32 +37,37 @@ func (id ServerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Server
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ServerId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Server ID
+func (id ServerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftAnalysisServices", "Microsoft.AnalysisServices"),
+		resourceid.StaticSegment("staticServers", "servers", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // ServerID parses a Server ID into an ServerId struct
 func ServerID(input string) (*ServerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ServerId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ServerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
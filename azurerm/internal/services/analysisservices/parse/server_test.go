@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ServerId{}
+var _ resourceid.SegmentedID = ServerId{}
 
 func TestServerIDFormatter(t *testing.T) {
 	actual := NewServerID("12345678-1234-9876-4563-123456789012", "resGroup1", "Server1").ID()
@@ -18,6 +19,43 @@ func TestServerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServerIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.ANALYSISSERVICES/SERVERS/Server1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1other",
+			Expected: false,
+		},
+	}
+
+	id := NewServerID("12345678-1234-9876-4563-123456789012", "resGroup1", "Server1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestServerID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -1,3 +1,6 @@
 package analysisservices
 
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Server -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AnalysisServices/servers/Server1
+
+// Resource ID Registrations, mapping each Resource ID to the Resource which manages it
+//go:generate go run ../../tools/generator-resource-id-registrations/main.go -path=./
@@ -270,7 +270,7 @@ func dataSourceApiManagementRead(d *schema.ResourceData, meta interface{}) error
 
 	d.Set("sku_name", flattenApiManagementServiceSkuName(resp.Sku))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenDataSourceApiManagementHostnameConfigurations(input *[]apimanagement.HostnameConfiguration) []interface{} {
@@ -623,7 +623,7 @@ func resourceApiManagementServiceCreateUpdate(d *schema.ResourceData, meta inter
 			CustomProperties: customProperties,
 			Certificates:     certificates,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		Sku:  sku,
 	}
 
@@ -876,7 +876,7 @@ func resourceApiManagementServiceRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceApiManagementServiceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiId struct {
@@ -25,6 +25,16 @@ func NewApiID(subscriptionId, resourceGroup, serviceName, name string) ApiId {
 	}
 }
 
+// NewApiIDFromParent returns a ApiId for the Api within the specified ApiManagement
+func NewApiIDFromParent(parent ApiManagementId, name string) ApiId {
+	return ApiId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		Name:           name,
+	}
+}
+
 func (id ApiId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id ApiId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Api
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ApiId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Api ID
+func (id ApiId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticApis", "apis", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // ApiID parses a Api ID into an ApiId struct
 func ApiID(input string) (*ApiId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ApiId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
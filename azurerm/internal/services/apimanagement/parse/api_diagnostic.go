@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiDiagnosticId struct {
@@ -27,6 +27,17 @@ func NewApiDiagnosticID(subscriptionId, resourceGroup, serviceName, apiName, dia
 	}
 }
 
+// NewApiDiagnosticIDFromParent returns a ApiDiagnosticId for the ApiDiagnostic within the specified Api
+func NewApiDiagnosticIDFromParent(parent ApiId, diagnosticName string) ApiDiagnosticId {
+	return ApiDiagnosticId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		ApiName:        parent.Name,
+		DiagnosticName: diagnosticName,
+	}
+}
+
 func (id ApiDiagnosticId) String() string {
 	segments := []string{
 		fmt.Sprintf("Diagnostic Name %q", id.DiagnosticName),
@@ -43,38 +54,43 @@ func (id ApiDiagnosticId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.DiagnosticName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ApiDiagnostic
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ApiDiagnosticId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ApiDiagnostic ID
+func (id ApiDiagnosticId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticApis", "apis", false),
+		resourceid.UserSpecifiedSegment("apiName"),
+		resourceid.StaticSegment("staticDiagnostics", "diagnostics", false),
+		resourceid.UserSpecifiedSegment("diagnosticName"),
+	}
+}
+
 // ApiDiagnosticID parses a ApiDiagnostic ID into an ApiDiagnosticId struct
 func ApiDiagnosticID(input string) (*ApiDiagnosticId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ApiDiagnosticId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiDiagnosticId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-	if resourceId.DiagnosticName, err = id.PopSegment("diagnostics"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		ApiName:        values["apiName"],
+		DiagnosticName: values["diagnosticName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiManagementId struct {
@@ -37,32 +37,37 @@ func (id ApiManagementId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ApiManagement
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ApiManagementId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ApiManagement ID
+func (id ApiManagementId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+	}
+}
+
 // ApiManagementID parses a ApiManagement ID into an ApiManagementId struct
 func ApiManagementID(input string) (*ApiManagementId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ApiManagementId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiManagementId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
 	}
 
 	return &resourceId, nil
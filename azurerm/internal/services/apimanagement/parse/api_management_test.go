@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ApiManagementId{}
+var _ resourceid.SegmentedID = ApiManagementId{}
 
 func TestApiManagementIDFormatter(t *testing.T) {
 	actual := NewApiManagementID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1").ID()
@@ -18,6 +19,43 @@ func TestApiManagementIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiManagementIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1other",
+			Expected: false,
+		},
+	}
+
+	id := NewApiManagementID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestApiManagementID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiOperationId struct {
@@ -27,6 +27,17 @@ func NewApiOperationID(subscriptionId, resourceGroup, serviceName, apiName, oper
 	}
 }
 
+// NewApiOperationIDFromParent returns a ApiOperationId for the ApiOperation within the specified Api
+func NewApiOperationIDFromParent(parent ApiId, operationName string) ApiOperationId {
+	return ApiOperationId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		ApiName:        parent.Name,
+		OperationName:  operationName,
+	}
+}
+
 func (id ApiOperationId) String() string {
 	segments := []string{
 		fmt.Sprintf("Operation Name %q", id.OperationName),
@@ -43,38 +54,43 @@ func (id ApiOperationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ApiOperation
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ApiOperationId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ApiOperation ID
+func (id ApiOperationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticApis", "apis", false),
+		resourceid.UserSpecifiedSegment("apiName"),
+		resourceid.StaticSegment("staticOperations", "operations", false),
+		resourceid.UserSpecifiedSegment("operationName"),
+	}
+}
+
 // ApiOperationID parses a ApiOperation ID into an ApiOperationId struct
 func ApiOperationID(input string) (*ApiOperationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ApiOperationId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiOperationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-	if resourceId.OperationName, err = id.PopSegment("operations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		ApiName:        values["apiName"],
+		OperationName:  values["operationName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiOperationPolicyId struct {
@@ -29,6 +29,18 @@ func NewApiOperationPolicyID(subscriptionId, resourceGroup, serviceName, apiName
 	}
 }
 
+// NewApiOperationPolicyIDFromParent returns a ApiOperationPolicyId for the ApiOperationPolicy within the specified ApiOperation
+func NewApiOperationPolicyIDFromParent(parent ApiOperationId, policyName string) ApiOperationPolicyId {
+	return ApiOperationPolicyId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		ApiName:        parent.ApiName,
+		OperationName:  parent.OperationName,
+		PolicyName:     policyName,
+	}
+}
+
 func (id ApiOperationPolicyId) String() string {
 	segments := []string{
 		fmt.Sprintf("Policy Name %q", id.PolicyName),
@@ -46,41 +58,46 @@ func (id ApiOperationPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName, id.PolicyName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ApiOperationPolicy
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ApiOperationPolicyId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ApiOperationPolicy ID
+func (id ApiOperationPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticApis", "apis", false),
+		resourceid.UserSpecifiedSegment("apiName"),
+		resourceid.StaticSegment("staticOperations", "operations", false),
+		resourceid.UserSpecifiedSegment("operationName"),
+		resourceid.StaticSegment("staticPolicies", "policies", false),
+		resourceid.UserSpecifiedSegment("policyName"),
+	}
+}
+
 // ApiOperationPolicyID parses a ApiOperationPolicy ID into an ApiOperationPolicyId struct
 func ApiOperationPolicyID(input string) (*ApiOperationPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ApiOperationPolicyId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiOperationPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-	if resourceId.OperationName, err = id.PopSegment("operations"); err != nil {
-		return nil, err
-	}
-	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		ApiName:        values["apiName"],
+		OperationName:  values["operationName"],
+		PolicyName:     values["policyName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ApiOperationPolicyId{}
+var _ resourceid.SegmentedID = ApiOperationPolicyId{}
 
 func TestApiOperationPolicyIDFormatter(t *testing.T) {
 	actual := NewApiOperationPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1", "policy1").ID()
@@ -18,6 +19,52 @@ func TestApiOperationPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiOperationPolicyIDFromParent(t *testing.T) {
+	parent := NewApiOperationID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1")
+	actual := NewApiOperationPolicyIDFromParent(parent, "policy1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/policies/policy1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestApiOperationPolicyIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/policies/policy1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/APIS/api1/OPERATIONS/operation1/POLICIES/policy1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1/policies/policy1other",
+			Expected: false,
+		},
+	}
+
+	id := NewApiOperationPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1", "policy1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestApiOperationPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ApiOperationId{}
+var _ resourceid.SegmentedID = ApiOperationId{}
 
 func TestApiOperationIDFormatter(t *testing.T) {
 	actual := NewApiOperationID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1").ID()
@@ -18,6 +19,52 @@ func TestApiOperationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiOperationIDFromParent(t *testing.T) {
+	parent := NewApiID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1")
+	actual := NewApiOperationIDFromParent(parent, "operation1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestApiOperationIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/APIS/api1/OPERATIONS/operation1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apis/api1/operations/operation1other",
+			Expected: false,
+		},
+	}
+
+	id := NewApiOperationID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "api1", "operation1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestApiOperationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiPolicyId struct {
@@ -27,6 +27,17 @@ func NewApiPolicyID(subscriptionId, resourceGroup, serviceName, apiName, policyN
 	}
 }
 
+// NewApiPolicyIDFromParent returns a ApiPolicyId for the ApiPolicy within the specified Api
+func NewApiPolicyIDFromParent(parent ApiId, policyName string) ApiPolicyId {
+	return ApiPolicyId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		ApiName:        parent.Name,
+		PolicyName:     policyName,
+	}
+}
+
 func (id ApiPolicyId) String() string {
 	segments := []string{
 		fmt.Sprintf("Policy Name %q", id.PolicyName),
@@ -43,38 +54,43 @@ func (id ApiPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.PolicyName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ApiPolicy
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ApiPolicyId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ApiPolicy ID
+func (id ApiPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticApis", "apis", false),
+		resourceid.UserSpecifiedSegment("apiName"),
+		resourceid.StaticSegment("staticPolicies", "policies", false),
+		resourceid.UserSpecifiedSegment("policyName"),
+	}
+}
+
 // ApiPolicyID parses a ApiPolicy ID into an ApiPolicyId struct
 func ApiPolicyID(input string) (*ApiPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ApiPolicyId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		ApiName:        values["apiName"],
+		PolicyName:     values["policyName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ApiSchemaId struct {
@@ -27,6 +27,17 @@ func NewApiSchemaID(subscriptionId, resourceGroup, serviceName, apiName, schemaN
 	}
 }
 
+// NewApiSchemaIDFromParent returns a ApiSchemaId for the ApiSchema within the specified Api
+func NewApiSchemaIDFromParent(parent ApiId, schemaName string) ApiSchemaId {
+	return ApiSchemaId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		ApiName:        parent.Name,
+		SchemaName:     schemaName,
+	}
+}
+
 func (id ApiSchemaId) String() string {
 	segments := []string{
 		fmt.Sprintf("Schema Name %q", id.SchemaName),
@@ -43,38 +54,43 @@ func (id ApiSchemaId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.SchemaName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ApiSchema
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ApiSchemaId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ApiSchema ID
+func (id ApiSchemaId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticApis", "apis", false),
+		resourceid.UserSpecifiedSegment("apiName"),
+		resourceid.StaticSegment("staticSchemas", "schemas", false),
+		resourceid.UserSpecifiedSegment("schemaName"),
+	}
+}
+
 // ApiSchemaID parses a ApiSchema ID into an ApiSchemaId struct
 func ApiSchemaID(input string) (*ApiSchemaId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ApiSchemaId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ApiSchemaId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
-		return nil, err
-	}
-	if resourceId.SchemaName, err = id.PopSegment("schemas"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		ApiName:        values["apiName"],
+		SchemaName:     values["schemaName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ApiVersionSetId{}
+var _ resourceid.SegmentedID = ApiVersionSetId{}
 
 func TestApiVersionSetIDFormatter(t *testing.T) {
 	actual := NewApiVersionSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "apiVersionSet1").ID()
@@ -18,6 +19,52 @@ func TestApiVersionSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApiVersionSetIDFromParent(t *testing.T) {
+	parent := NewApiManagementID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1")
+	actual := NewApiVersionSetIDFromParent(parent, "apiVersionSet1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apiVersionSets/apiVersionSet1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestApiVersionSetIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apiVersionSets/apiVersionSet1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/APIVERSIONSETS/apiVersionSet1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/apiVersionSets/apiVersionSet1other",
+			Expected: false,
+		},
+	}
+
+	id := NewApiVersionSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "apiVersionSet1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestApiVersionSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
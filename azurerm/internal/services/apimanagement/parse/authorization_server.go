@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AuthorizationServerId struct {
@@ -25,6 +25,16 @@ func NewAuthorizationServerID(subscriptionId, resourceGroup, serviceName, name s
 	}
 }
 
+// NewAuthorizationServerIDFromParent returns a AuthorizationServerId for the AuthorizationServer within the specified ApiManagement
+func NewAuthorizationServerIDFromParent(parent ApiManagementId, name string) AuthorizationServerId {
+	return AuthorizationServerId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		Name:           name,
+	}
+}
+
 func (id AuthorizationServerId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id AuthorizationServerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this AuthorizationServer
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id AuthorizationServerId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this AuthorizationServer ID
+func (id AuthorizationServerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticAuthorizationServers", "authorizationServers", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // AuthorizationServerID parses a AuthorizationServer ID into an AuthorizationServerId struct
 func AuthorizationServerID(input string) (*AuthorizationServerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, AuthorizationServerId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AuthorizationServerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("authorizationServers"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
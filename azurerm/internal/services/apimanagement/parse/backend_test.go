@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = BackendId{}
+var _ resourceid.SegmentedID = BackendId{}
 
 func TestBackendIDFormatter(t *testing.T) {
 	actual := NewBackendID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "backend1").ID()
@@ -18,6 +19,52 @@ func TestBackendIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBackendIDFromParent(t *testing.T) {
+	parent := NewApiManagementID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1")
+	actual := NewBackendIDFromParent(parent, "backend1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/backends/backend1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestBackendIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/backends/backend1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/BACKENDS/backend1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/backends/backend1other",
+			Expected: false,
+		},
+	}
+
+	id := NewBackendID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "backend1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestBackendID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GroupUserId struct {
@@ -27,6 +27,17 @@ func NewGroupUserID(subscriptionId, resourceGroup, serviceName, groupName, userN
 	}
 }
 
+// NewGroupUserIDFromParent returns a GroupUserId for the GroupUser within the specified Group
+func NewGroupUserIDFromParent(parent GroupId, userName string) GroupUserId {
+	return GroupUserId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		GroupName:      parent.Name,
+		UserName:       userName,
+	}
+}
+
 func (id GroupUserId) String() string {
 	segments := []string{
 		fmt.Sprintf("User Name %q", id.UserName),
@@ -43,38 +54,43 @@ func (id GroupUserId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.GroupName, id.UserName)
 }
 
+// Matches returns whether the specified Resource ID refers to this GroupUser
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id GroupUserId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this GroupUser ID
+func (id GroupUserId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticGroups", "groups", false),
+		resourceid.UserSpecifiedSegment("groupName"),
+		resourceid.StaticSegment("staticUsers", "users", false),
+		resourceid.UserSpecifiedSegment("userName"),
+	}
+}
+
 // GroupUserID parses a GroupUser ID into an GroupUserId struct
 func GroupUserID(input string) (*GroupUserId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, GroupUserId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := GroupUserId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.GroupName, err = id.PopSegment("groups"); err != nil {
-		return nil, err
-	}
-	if resourceId.UserName, err = id.PopSegment("users"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		GroupName:      values["groupName"],
+		UserName:       values["userName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = GroupUserId{}
+var _ resourceid.SegmentedID = GroupUserId{}
 
 func TestGroupUserIDFormatter(t *testing.T) {
 	actual := NewGroupUserID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "group1", "user1").ID()
@@ -18,6 +19,52 @@ func TestGroupUserIDFormatter(t *testing.T) {
 	}
 }
 
+func TestGroupUserIDFromParent(t *testing.T) {
+	parent := NewGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "group1")
+	actual := NewGroupUserIDFromParent(parent, "user1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/groups/group1/users/user1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestGroupUserIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/groups/group1/users/user1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/GROUPS/group1/USERS/user1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/groups/group1/users/user1other",
+			Expected: false,
+		},
+	}
+
+	id := NewGroupUserID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "group1", "user1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestGroupUserID(t *testing.T) {
 	testData := []struct {
 		Input    string
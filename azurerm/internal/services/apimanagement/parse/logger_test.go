@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = LoggerId{}
+var _ resourceid.SegmentedID = LoggerId{}
 
 func TestLoggerIDFormatter(t *testing.T) {
 	actual := NewLoggerID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "logger1").ID()
@@ -18,6 +19,52 @@ func TestLoggerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLoggerIDFromParent(t *testing.T) {
+	parent := NewApiManagementID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1")
+	actual := NewLoggerIDFromParent(parent, "logger1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/loggers/logger1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestLoggerIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/loggers/logger1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/LOGGERS/logger1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/loggers/logger1other",
+			Expected: false,
+		},
+	}
+
+	id := NewLoggerID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "logger1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestLoggerID(t *testing.T) {
 	testData := []struct {
 		Input    string
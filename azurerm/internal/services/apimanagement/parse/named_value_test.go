@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = NamedValueId{}
+var _ resourceid.SegmentedID = NamedValueId{}
 
 func TestNamedValueIDFormatter(t *testing.T) {
 	actual := NewNamedValueID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "namedValue1").ID()
@@ -18,6 +19,52 @@ func TestNamedValueIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNamedValueIDFromParent(t *testing.T) {
+	parent := NewApiManagementID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1")
+	actual := NewNamedValueIDFromParent(parent, "namedValue1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/namedValues/namedValue1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNamedValueIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/namedValues/namedValue1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/NAMEDVALUES/namedValue1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/namedValues/namedValue1other",
+			Expected: false,
+		},
+	}
+
+	id := NewNamedValueID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "namedValue1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestNamedValueID(t *testing.T) {
 	testData := []struct {
 		Input    string
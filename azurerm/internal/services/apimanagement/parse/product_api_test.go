@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ProductApiId{}
+var _ resourceid.SegmentedID = ProductApiId{}
 
 func TestProductApiIDFormatter(t *testing.T) {
 	actual := NewProductApiID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "api1").ID()
@@ -18,6 +19,52 @@ func TestProductApiIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProductApiIDFromParent(t *testing.T) {
+	parent := NewProductID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1")
+	actual := NewProductApiIDFromParent(parent, "api1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/apis/api1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestProductApiIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/apis/api1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/PRODUCTS/product1/APIS/api1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/apis/api1other",
+			Expected: false,
+		},
+	}
+
+	id := NewProductApiID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "api1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestProductApiID(t *testing.T) {
 	testData := []struct {
 		Input    string
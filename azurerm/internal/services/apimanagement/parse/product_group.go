@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductGroupId struct {
@@ -27,6 +27,17 @@ func NewProductGroupID(subscriptionId, resourceGroup, serviceName, productName,
 	}
 }
 
+// NewProductGroupIDFromParent returns a ProductGroupId for the ProductGroup within the specified Product
+func NewProductGroupIDFromParent(parent ProductId, groupName string) ProductGroupId {
+	return ProductGroupId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		ProductName:    parent.Name,
+		GroupName:      groupName,
+	}
+}
+
 func (id ProductGroupId) String() string {
 	segments := []string{
 		fmt.Sprintf("Group Name %q", id.GroupName),
@@ -43,38 +54,43 @@ func (id ProductGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.GroupName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ProductGroup
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ProductGroupId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ProductGroup ID
+func (id ProductGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticProducts", "products", false),
+		resourceid.UserSpecifiedSegment("productName"),
+		resourceid.StaticSegment("staticGroups", "groups", false),
+		resourceid.UserSpecifiedSegment("groupName"),
+	}
+}
+
 // ProductGroupID parses a ProductGroup ID into an ProductGroupId struct
 func ProductGroupID(input string) (*ProductGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ProductGroupId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ProductGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
-		return nil, err
-	}
-	if resourceId.GroupName, err = id.PopSegment("groups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		ProductName:    values["productName"],
+		GroupName:      values["groupName"],
 	}
 
 	return &resourceId, nil
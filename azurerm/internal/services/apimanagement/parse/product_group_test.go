@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ProductGroupId{}
+var _ resourceid.SegmentedID = ProductGroupId{}
 
 func TestProductGroupIDFormatter(t *testing.T) {
 	actual := NewProductGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "group1").ID()
@@ -18,6 +19,52 @@ func TestProductGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProductGroupIDFromParent(t *testing.T) {
+	parent := NewProductID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1")
+	actual := NewProductGroupIDFromParent(parent, "group1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/groups/group1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestProductGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/groups/group1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/PRODUCTS/product1/GROUPS/group1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/groups/group1other",
+			Expected: false,
+		},
+	}
+
+	id := NewProductGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "group1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestProductGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ProductPolicyId struct {
@@ -27,6 +27,17 @@ func NewProductPolicyID(subscriptionId, resourceGroup, serviceName, productName,
 	}
 }
 
+// NewProductPolicyIDFromParent returns a ProductPolicyId for the ProductPolicy within the specified Product
+func NewProductPolicyIDFromParent(parent ProductId, policyName string) ProductPolicyId {
+	return ProductPolicyId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		ProductName:    parent.Name,
+		PolicyName:     policyName,
+	}
+}
+
 func (id ProductPolicyId) String() string {
 	segments := []string{
 		fmt.Sprintf("Policy Name %q", id.PolicyName),
@@ -43,38 +54,43 @@ func (id ProductPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName, id.PolicyName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ProductPolicy
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ProductPolicyId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ProductPolicy ID
+func (id ProductPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticProducts", "products", false),
+		resourceid.UserSpecifiedSegment("productName"),
+		resourceid.StaticSegment("staticPolicies", "policies", false),
+		resourceid.UserSpecifiedSegment("policyName"),
+	}
+}
+
 // ProductPolicyID parses a ProductPolicy ID into an ProductPolicyId struct
 func ProductPolicyID(input string) (*ProductPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ProductPolicyId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ProductPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
-		return nil, err
-	}
-	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		ProductName:    values["productName"],
+		PolicyName:     values["policyName"],
 	}
 
 	return &resourceId, nil
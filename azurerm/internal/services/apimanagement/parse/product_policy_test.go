@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ProductPolicyId{}
+var _ resourceid.SegmentedID = ProductPolicyId{}
 
 func TestProductPolicyIDFormatter(t *testing.T) {
 	actual := NewProductPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "policy1").ID()
@@ -18,6 +19,52 @@ func TestProductPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProductPolicyIDFromParent(t *testing.T) {
+	parent := NewProductID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1")
+	actual := NewProductPolicyIDFromParent(parent, "policy1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/policies/policy1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestProductPolicyIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/policies/policy1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/service1/PRODUCTS/product1/POLICIES/policy1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ApiManagement/service/service1/products/product1/policies/policy1other",
+			Expected: false,
+		},
+	}
+
+	id := NewProductPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "product1", "policy1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestProductPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
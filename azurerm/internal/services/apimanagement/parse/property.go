@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PropertyId struct {
@@ -25,6 +25,16 @@ func NewPropertyID(subscriptionId, resourceGroup, serviceName, namedValueName st
 	}
 }
 
+// NewPropertyIDFromParent returns a PropertyId for the Property within the specified ApiManagement
+func NewPropertyIDFromParent(parent ApiManagementId, namedValueName string) PropertyId {
+	return PropertyId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServiceName:    parent.ServiceName,
+		NamedValueName: namedValueName,
+	}
+}
+
 func (id PropertyId) String() string {
 	segments := []string{
 		fmt.Sprintf("Named Value Name %q", id.NamedValueName),
@@ -40,35 +50,40 @@ func (id PropertyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.NamedValueName)
 }
 
+// Matches returns whether the specified Resource ID refers to this Property
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id PropertyId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Property ID
+func (id PropertyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftApiManagement", "Microsoft.ApiManagement"),
+		resourceid.StaticSegment("staticService", "service", false),
+		resourceid.UserSpecifiedSegment("serviceName"),
+		resourceid.StaticSegment("staticNamedValues", "namedValues", false),
+		resourceid.UserSpecifiedSegment("namedValueName"),
+	}
+}
+
 // PropertyID parses a Property ID into an PropertyId struct
 func PropertyID(input string) (*PropertyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, PropertyId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PropertyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
-		return nil, err
-	}
-	if resourceId.NamedValueName, err = id.PopSegment("namedValues"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServiceName:    values["serviceName"],
+		NamedValueName: values["namedValueName"],
 	}
 
 	return &resourceId, nil
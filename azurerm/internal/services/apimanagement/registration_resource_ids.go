@@ -0,0 +1,231 @@
+package apimanagement
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.ApiManagement/service",
+			TerraformResourceType: "azurerm_api_management",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApiManagementID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/apis",
+			TerraformResourceType: "azurerm_api_management_api",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApiID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/apis/diagnostics",
+			TerraformResourceType: "azurerm_api_management_api_diagnostic",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApiDiagnosticID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/apis/operations",
+			TerraformResourceType: "azurerm_api_management_api_operation",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApiOperationID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/apis/operations/policies",
+			TerraformResourceType: "azurerm_api_management_api_operation_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApiOperationPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/apis/policies",
+			TerraformResourceType: "azurerm_api_management_api_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApiPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/apis/schemas",
+			TerraformResourceType: "azurerm_api_management_api_schema",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApiSchemaID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/apiVersionSets",
+			TerraformResourceType: "azurerm_api_management_api_version_set",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApiVersionSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/authorizationServers",
+			TerraformResourceType: "azurerm_api_management_authorization_server",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AuthorizationServerID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/backends",
+			TerraformResourceType: "azurerm_api_management_backend",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BackendID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/certificates",
+			TerraformResourceType: "azurerm_api_management_certificate",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CertificateID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/customDomains",
+			TerraformResourceType: "azurerm_api_management_custom_domain",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CustomDomainID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/diagnostics",
+			TerraformResourceType: "azurerm_api_management_diagnostic",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DiagnosticID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/groups",
+			TerraformResourceType: "azurerm_api_management_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.GroupID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/groups/users",
+			TerraformResourceType: "azurerm_api_management_group_user",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.GroupUserID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/identityProviders",
+			TerraformResourceType: "azurerm_api_management_identity_provider_aad",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IdentityProviderID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/identityProviders",
+			TerraformResourceType: "azurerm_api_management_identity_provider_aadb2c",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IdentityProviderID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/identityProviders",
+			TerraformResourceType: "azurerm_api_management_identity_provider_facebook",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IdentityProviderID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/identityProviders",
+			TerraformResourceType: "azurerm_api_management_identity_provider_google",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IdentityProviderID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/identityProviders",
+			TerraformResourceType: "azurerm_api_management_identity_provider_microsoft",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IdentityProviderID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/identityProviders",
+			TerraformResourceType: "azurerm_api_management_identity_provider_twitter",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IdentityProviderID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/namedValues",
+			TerraformResourceType: "azurerm_api_management_named_value",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NamedValueID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/openidConnectProviders",
+			TerraformResourceType: "azurerm_api_management_openid_connect_provider",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.OpenIDConnectProviderID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/policies",
+			TerraformResourceType: "azurerm_api_management_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/products",
+			TerraformResourceType: "azurerm_api_management_product",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ProductID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/products/apis",
+			TerraformResourceType: "azurerm_api_management_product_api",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ProductApiID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/products/groups",
+			TerraformResourceType: "azurerm_api_management_product_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ProductGroupID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/products/policies",
+			TerraformResourceType: "azurerm_api_management_product_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ProductPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/namedValues",
+			TerraformResourceType: "azurerm_api_management_property",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PropertyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/subscriptions",
+			TerraformResourceType: "azurerm_api_management_subscription",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SubscriptionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ApiManagement/service/users",
+			TerraformResourceType: "azurerm_api_management_user",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.UserID(input)
+			},
+		},
+	}
+}
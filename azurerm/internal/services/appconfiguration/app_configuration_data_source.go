@@ -189,5 +189,5 @@ func dataSourceAppConfigurationRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("secondary_read_key", accessKeys.secondaryReadKey)
 	d.Set("secondary_write_key", accessKeys.secondaryWriteKey)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
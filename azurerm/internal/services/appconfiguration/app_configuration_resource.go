@@ -221,7 +221,7 @@ func resourceAppConfigurationCreate(d *schema.ResourceData, meta interface{}) er
 		Sku: &appconfiguration.Sku{
 			Name: utils.String(d.Get("sku").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	parameters.Identity = expandAppConfigurationIdentity(d.Get("identity").([]interface{}))
@@ -254,7 +254,7 @@ func resourceAppConfigurationUpdate(d *schema.ResourceData, meta interface{}) er
 		Sku: &appconfiguration.Sku{
 			Name: utils.String(d.Get("sku").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if d.HasChange("identity") {
@@ -334,7 +334,7 @@ func resourceAppConfigurationRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceAppConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
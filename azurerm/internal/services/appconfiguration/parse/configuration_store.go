@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConfigurationStoreId struct {
@@ -37,32 +37,37 @@ func (id ConfigurationStoreId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this ConfigurationStore
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ConfigurationStoreId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ConfigurationStore ID
+func (id ConfigurationStoreId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftAppConfiguration", "Microsoft.AppConfiguration"),
+		resourceid.StaticSegment("staticConfigurationStores", "configurationStores", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // ConfigurationStoreID parses a ConfigurationStore ID into an ConfigurationStoreId struct
 func ConfigurationStoreID(input string) (*ConfigurationStoreId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ConfigurationStoreId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ConfigurationStoreId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.Name, err = id.PopSegment("configurationStores"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
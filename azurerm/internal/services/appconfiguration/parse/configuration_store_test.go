@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ConfigurationStoreId{}
+var _ resourceid.SegmentedID = ConfigurationStoreId{}
 
 func TestConfigurationStoreIDFormatter(t *testing.T) {
 	actual := NewConfigurationStoreID("12345678-1234-9876-4563-123456789012", "group1", "store1").ID()
@@ -18,6 +19,43 @@ func TestConfigurationStoreIDFormatter(t *testing.T) {
 	}
 }
 
+func TestConfigurationStoreIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AppConfiguration/configurationStores/store1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.APPCONFIGURATION/CONFIGURATIONSTORES/store1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AppConfiguration/configurationStores/store1other",
+			Expected: false,
+		},
+	}
+
+	id := NewConfigurationStoreID("12345678-1234-9876-4563-123456789012", "group1", "store1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestConfigurationStoreID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -1,3 +1,6 @@
 package appconfiguration
 
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ConfigurationStore -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AppConfiguration/configurationStores/store1
+
+// Resource ID Registrations, mapping each Resource ID to the Resource which manages it
+//go:generate go run ../../tools/generator-resource-id-registrations/main.go -path=./
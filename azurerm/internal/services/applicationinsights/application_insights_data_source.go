@@ -105,5 +105,5 @@ func dataSourceArmApplicationInsightsRead(d *schema.ResourceData, meta interface
 		}
 		d.Set("retention_in_days", retentionInDays)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -177,7 +177,7 @@ func resourceApplicationInsightsCreateUpdate(d *schema.ResourceData, meta interf
 		Location:                               &location,
 		Kind:                                   &applicationType,
 		ApplicationInsightsComponentProperties: &applicationInsightsComponentProperties,
-		Tags:                                   tags.Expand(t),
+		Tags:                                   tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	_, err := client.CreateOrUpdate(ctx, resGroup, name, insightProperties)
@@ -270,7 +270,7 @@ func resourceApplicationInsightsRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("daily_data_cap_notifications_disabled", billingProps.StopSendNotificationWhenHitCap)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceApplicationInsightsDelete(d *schema.ResourceData, meta interface{}) error {
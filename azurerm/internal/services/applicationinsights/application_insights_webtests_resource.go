@@ -189,7 +189,7 @@ func resourceApplicationInsightsWebTestsCreateUpdate(d *schema.ResourceData, met
 				WebTest: &testConf,
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	resp, err := client.CreateOrUpdate(ctx, resGroup, name, webTest)
@@ -260,7 +260,7 @@ func resourceApplicationInsightsWebTestsRead(d *schema.ResourceData, meta interf
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceApplicationInsightsWebTestsDelete(d *schema.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ComponentId{}
+var _ resourceid.SegmentedID = ComponentId{}
 
 func TestComponentIDFormatter(t *testing.T) {
 	actual := NewComponentID("12345678-1234-9876-4563-123456789012", "group1", "component1").ID()
@@ -18,6 +19,43 @@ func TestComponentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestComponentIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/components/component1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.INSIGHTS/COMPONENTS/component1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/components/component1other",
+			Expected: false,
+		},
+	}
+
+	id := NewComponentID("12345678-1234-9876-4563-123456789012", "group1", "component1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestComponentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SmartDetectionRuleId struct {
@@ -25,6 +25,16 @@ func NewSmartDetectionRuleID(subscriptionId, resourceGroup, componentName, smart
 	}
 }
 
+// NewSmartDetectionRuleIDFromParent returns a SmartDetectionRuleId for the SmartDetectionRule within the specified Component
+func NewSmartDetectionRuleIDFromParent(parent ComponentId, smartDetectionRuleName string) SmartDetectionRuleId {
+	return SmartDetectionRuleId{
+		SubscriptionId:         parent.SubscriptionId,
+		ResourceGroup:          parent.ResourceGroup,
+		ComponentName:          parent.Name,
+		SmartDetectionRuleName: smartDetectionRuleName,
+	}
+}
+
 func (id SmartDetectionRuleId) String() string {
 	segments := []string{
 		fmt.Sprintf("Smart Detection Rule Name %q", id.SmartDetectionRuleName),
@@ -40,35 +50,40 @@ func (id SmartDetectionRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ComponentName, id.SmartDetectionRuleName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SmartDetectionRule
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SmartDetectionRuleId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SmartDetectionRule ID
+func (id SmartDetectionRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticmicrosoftinsights", "microsoft.insights"),
+		resourceid.StaticSegment("staticComponents", "components", false),
+		resourceid.UserSpecifiedSegment("componentName"),
+		resourceid.StaticSegment("staticSmartDetectionRule", "SmartDetectionRule", false),
+		resourceid.UserSpecifiedSegment("smartDetectionRuleName"),
+	}
+}
+
 // SmartDetectionRuleID parses a SmartDetectionRule ID into an SmartDetectionRuleId struct
 func SmartDetectionRuleID(input string) (*SmartDetectionRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SmartDetectionRuleId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SmartDetectionRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ComponentName, err = id.PopSegment("components"); err != nil {
-		return nil, err
-	}
-	if resourceId.SmartDetectionRuleName, err = id.PopSegment("SmartDetectionRule"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         values["subscriptionId"],
+		ResourceGroup:          values["resourceGroup"],
+		ComponentName:          values["componentName"],
+		SmartDetectionRuleName: values["smartDetectionRuleName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SmartDetectionRuleId{}
+var _ resourceid.SegmentedID = SmartDetectionRuleId{}
 
 func TestSmartDetectionRuleIDFormatter(t *testing.T) {
 	actual := NewSmartDetectionRuleID("12345678-1234-9876-4563-123456789012", "group1", "component1", "rule1").ID()
@@ -18,6 +19,52 @@ func TestSmartDetectionRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSmartDetectionRuleIDFromParent(t *testing.T) {
+	parent := NewComponentID("12345678-1234-9876-4563-123456789012", "group1", "component1")
+	actual := NewSmartDetectionRuleIDFromParent(parent, "rule1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/components/component1/SmartDetectionRule/rule1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSmartDetectionRuleIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/components/component1/SmartDetectionRule/rule1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.INSIGHTS/COMPONENTS/component1/SMARTDETECTIONRULE/rule1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/components/component1/SmartDetectionRule/rule1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSmartDetectionRuleID("12345678-1234-9876-4563-123456789012", "group1", "component1", "rule1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSmartDetectionRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = WebTestId{}
+var _ resourceid.SegmentedID = WebTestId{}
 
 func TestWebTestIDFormatter(t *testing.T) {
 	actual := NewWebTestID("12345678-1234-9876-4563-123456789012", "group1", "test1").ID()
@@ -18,6 +19,43 @@ func TestWebTestIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWebTestIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/webtests/test1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.INSIGHTS/WEBTESTS/test1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/webtests/test1other",
+			Expected: false,
+		},
+	}
+
+	id := NewWebTestID("12345678-1234-9876-4563-123456789012", "group1", "test1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestWebTestID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,35 @@
+package applicationinsights
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/applicationinsights/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "microsoft.insights/components",
+			TerraformResourceType: "azurerm_application_insights",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ComponentID(input)
+			},
+		},
+		{
+			ResourceType:          "microsoft.insights/components/SmartDetectionRule",
+			TerraformResourceType: "azurerm_application_insights_smart_detection_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SmartDetectionRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "microsoft.insights/webtests",
+			TerraformResourceType: "azurerm_application_insights_web_test",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.WebTestID(input)
+			},
+		},
+	}
+}
@@ -75,5 +75,5 @@ func dataSourceArmAttestationProviderRead(d *schema.ResourceData, meta interface
 		d.Set("trust_model", props.TrustModel)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -99,7 +99,7 @@ func resourceAttestationProviderCreate(d *schema.ResourceData, meta interface{})
 		Properties: &attestation.ServiceCreationSpecificParams{
 			// AttestationPolicy was deprecated in October of 2019
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	// NOTE: This maybe an slice in a future release or even a slice of slices
@@ -154,7 +154,7 @@ func resourceAttestationProviderRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("trust_model", props.TrustModel)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceAttestationProviderUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -168,8 +168,8 @@ func resourceAttestationProviderUpdate(d *schema.ResourceData, meta interface{})
 	}
 
 	updateParams := attestation.ServicePatchParams{}
-	if d.HasChange("tags") {
-		updateParams.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		updateParams.Tags = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.AttestationProviderName, updateParams); err != nil {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ProviderId{}
+var _ resourceid.SegmentedID = ProviderId{}
 
 func TestProviderIDFormatter(t *testing.T) {
 	actual := NewProviderID("12345678-1234-9876-4563-123456789012", "group1", "provider1").ID()
@@ -18,6 +19,43 @@ func TestProviderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProviderIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Attestation/attestationProviders/provider1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.ATTESTATION/ATTESTATIONPROVIDERS/provider1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Attestation/attestationProviders/provider1other",
+			Expected: false,
+		},
+	}
+
+	id := NewProviderID("12345678-1234-9876-4563-123456789012", "group1", "provider1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestProviderID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -108,7 +108,7 @@ func resourceAutomationAccountCreateUpdate(d *schema.ResourceData, meta interfac
 			Sku: &sku,
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters); err != nil {
@@ -185,7 +185,7 @@ func resourceAutomationAccountRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if t := resp.Tags; t != nil {
-		return tags.FlattenAndSet(d, t)
+		return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, t)
 	}
 
 	return nil
@@ -126,7 +126,7 @@ func resourceAutomationDscConfigurationCreateUpdate(d *schema.ResourceData, meta
 			},
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, accName, name, parameters); err != nil {
@@ -197,7 +197,7 @@ func resourceAutomationDscConfigurationRead(d *schema.ResourceData, meta interfa
 
 	d.Set("content_embedded", content)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceAutomationDscConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
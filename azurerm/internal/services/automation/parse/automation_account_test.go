@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AutomationAccountId{}
+var _ resourceid.SegmentedID = AutomationAccountId{}
 
 func TestAutomationAccountIDFormatter(t *testing.T) {
 	actual := NewAutomationAccountID("12345678-1234-9876-4563-123456789012", "group1", "account1").ID()
@@ -18,6 +19,43 @@ func TestAutomationAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAutomationAccountIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/account1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAutomationAccountID("12345678-1234-9876-4563-123456789012", "group1", "account1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAutomationAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConnectionId struct {
@@ -25,6 +25,16 @@ func NewConnectionID(subscriptionId, resourceGroup, automationAccountName, name
 	}
 }
 
+// NewConnectionIDFromParent returns a ConnectionId for the Connection within the specified AutomationAccount
+func NewConnectionIDFromParent(parent AutomationAccountId, name string) ConnectionId {
+	return ConnectionId{
+		SubscriptionId:        parent.SubscriptionId,
+		ResourceGroup:         parent.ResourceGroup,
+		AutomationAccountName: parent.Name,
+		Name:                  name,
+	}
+}
+
 func (id ConnectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id ConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AutomationAccountName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Connection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ConnectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Connection ID
+func (id ConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftAutomation", "Microsoft.Automation"),
+		resourceid.StaticSegment("staticAutomationAccounts", "automationAccounts", false),
+		resourceid.UserSpecifiedSegment("automationAccountName"),
+		resourceid.StaticSegment("staticConnections", "connections", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // ConnectionID parses a Connection ID into an ConnectionId struct
 func ConnectionID(input string) (*ConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ConnectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("connections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        values["subscriptionId"],
+		ResourceGroup:         values["resourceGroup"],
+		AutomationAccountName: values["automationAccountName"],
+		Name:                  values["name"],
 	}
 
 	return &resourceId, nil
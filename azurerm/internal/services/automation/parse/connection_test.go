@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ConnectionId{}
+var _ resourceid.SegmentedID = ConnectionId{}
 
 func TestConnectionIDFormatter(t *testing.T) {
 	actual := NewConnectionID("12345678-1234-9876-4563-123456789012", "group1", "account1", "connection1").ID()
@@ -18,6 +19,52 @@ func TestConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestConnectionIDFromParent(t *testing.T) {
+	parent := NewAutomationAccountID("12345678-1234-9876-4563-123456789012", "group1", "account1")
+	actual := NewConnectionIDFromParent(parent, "connection1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/connections/connection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestConnectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/connections/connection1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/account1/CONNECTIONS/connection1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/connections/connection1other",
+			Expected: false,
+		},
+	}
+
+	id := NewConnectionID("12345678-1234-9876-4563-123456789012", "group1", "account1", "connection1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,42 @@
+package automation
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/automation/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Automation/automationAccounts/connections",
+			TerraformResourceType: "azurerm_automation_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Automation/automationAccounts/connections",
+			TerraformResourceType: "azurerm_automation_connection_certificate",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Automation/automationAccounts/connections",
+			TerraformResourceType: "azurerm_automation_connection_classic_certificate",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Automation/automationAccounts/connections",
+			TerraformResourceType: "azurerm_automation_connection_service_principal",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ConnectionID(input)
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ClusterId{}
+var _ resourceid.SegmentedID = ClusterId{}
 
 func TestClusterIDFormatter(t *testing.T) {
 	actual := NewClusterID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1").ID()
@@ -18,6 +19,43 @@ func TestClusterIDFormatter(t *testing.T) {
 	}
 }
 
+func TestClusterIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AzureStackHCI/clusters/cluster1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.AZURESTACKHCI/CLUSTERS/cluster1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AzureStackHCI/clusters/cluster1other",
+			Expected: false,
+		},
+	}
+
+	id := NewClusterID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestClusterID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -123,5 +123,5 @@ func dataSourceBatchAccountRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
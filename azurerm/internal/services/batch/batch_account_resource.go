@@ -137,7 +137,7 @@ func resourceBatchAccountCreate(d *schema.ResourceData, meta interface{}) error
 		AccountCreateProperties: &batch.AccountCreateProperties{
 			PoolAllocationMode: batch.PoolAllocationMode(poolAllocationMode),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	// if pool allocation mode is UserSubscription, a key vault reference needs to be set
@@ -229,7 +229,7 @@ func resourceBatchAccountRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("secondary_access_key", keys.Secondary)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceBatchAccountUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -253,7 +253,7 @@ func resourceBatchAccountUpdate(d *schema.ResourceData, meta interface{}) error
 				StorageAccountID: &storageAccountId,
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err = client.Update(ctx, id.ResourceGroup, id.BatchAccountName, parameters); err != nil {
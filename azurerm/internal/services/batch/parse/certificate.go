@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CertificateId struct {
@@ -25,6 +25,16 @@ func NewCertificateID(subscriptionId, resourceGroup, batchAccountName, name stri
 	}
 }
 
+// NewCertificateIDFromParent returns a CertificateId for the Certificate within the specified Account
+func NewCertificateIDFromParent(parent AccountId, name string) CertificateId {
+	return CertificateId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		BatchAccountName: parent.BatchAccountName,
+		Name:             name,
+	}
+}
+
 func (id CertificateId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id CertificateId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Certificate
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id CertificateId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Certificate ID
+func (id CertificateId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftBatch", "Microsoft.Batch"),
+		resourceid.StaticSegment("staticBatchAccounts", "batchAccounts", false),
+		resourceid.UserSpecifiedSegment("batchAccountName"),
+		resourceid.StaticSegment("staticCertificates", "certificates", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // CertificateID parses a Certificate ID into an CertificateId struct
 func CertificateID(input string) (*CertificateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, CertificateId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CertificateId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("certificates"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		BatchAccountName: values["batchAccountName"],
+		Name:             values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = CertificateId{}
+var _ resourceid.SegmentedID = CertificateId{}
 
 func TestCertificateIDFormatter(t *testing.T) {
 	actual := NewCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "certificate1").ID()
@@ -18,6 +19,52 @@ func TestCertificateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCertificateIDFromParent(t *testing.T) {
+	parent := NewAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1")
+	actual := NewCertificateIDFromParent(parent, "certificate1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/certificates/certificate1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestCertificateIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/certificates/certificate1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.BATCH/BATCHACCOUNTS/account1/CERTIFICATES/certificate1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Batch/batchAccounts/account1/certificates/certificate1other",
+			Expected: false,
+		},
+	}
+
+	id := NewCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "certificate1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestCertificateID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PoolId struct {
@@ -25,6 +25,16 @@ func NewPoolID(subscriptionId, resourceGroup, batchAccountName, name string) Poo
 	}
 }
 
+// NewPoolIDFromParent returns a PoolId for the Pool within the specified Account
+func NewPoolIDFromParent(parent AccountId, name string) PoolId {
+	return PoolId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		BatchAccountName: parent.BatchAccountName,
+		Name:             name,
+	}
+}
+
 func (id PoolId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id PoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BatchAccountName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Pool
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id PoolId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Pool ID
+func (id PoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftBatch", "Microsoft.Batch"),
+		resourceid.StaticSegment("staticBatchAccounts", "batchAccounts", false),
+		resourceid.UserSpecifiedSegment("batchAccountName"),
+		resourceid.StaticSegment("staticPools", "pools", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // PoolID parses a Pool ID into an PoolId struct
 func PoolID(input string) (*PoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, PoolId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("pools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		BatchAccountName: values["batchAccountName"],
+		Name:             values["name"],
 	}
 
 	return &resourceId, nil
@@ -0,0 +1,42 @@
+package batch
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/batch/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Batch/batchAccounts",
+			TerraformResourceType: "azurerm_batch_account",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AccountID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Batch/batchAccounts/applications",
+			TerraformResourceType: "azurerm_batch_application",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApplicationID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Batch/batchAccounts/certificates",
+			TerraformResourceType: "azurerm_batch_certificate",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CertificateID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Batch/batchAccounts/pools",
+			TerraformResourceType: "azurerm_batch_pool",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PoolID(input)
+			},
+		},
+	}
+}
@@ -186,7 +186,7 @@ func resourceBotWebAppCreate(d *schema.ResourceData, meta interface{}) error {
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindSdk,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Create(ctx, resourceId.ResourceGroup, resourceId.Name, bot); err != nil {
@@ -237,7 +237,7 @@ func resourceBotWebAppRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("luis_app_ids", props.LuisAppIds)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceBotWebAppUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -271,7 +271,7 @@ func resourceBotWebAppUpdate(d *schema.ResourceData, meta interface{}) error {
 			Name: botservice.SkuName(d.Get("sku").(string)),
 		},
 		Kind: botservice.KindSdk,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, bot); err != nil {
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotChannelId struct {
@@ -25,6 +25,16 @@ func NewBotChannelID(subscriptionId, resourceGroup, botServiceName, channelName
 	}
 }
 
+// NewBotChannelIDFromParent returns a BotChannelId for the BotChannel within the specified BotService
+func NewBotChannelIDFromParent(parent BotServiceId, channelName string) BotChannelId {
+	return BotChannelId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		BotServiceName: parent.Name,
+		ChannelName:    channelName,
+	}
+}
+
 func (id BotChannelId) String() string {
 	segments := []string{
 		fmt.Sprintf("Channel Name %q", id.ChannelName),
@@ -40,35 +50,40 @@ func (id BotChannelId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BotServiceName, id.ChannelName)
 }
 
+// Matches returns whether the specified Resource ID refers to this BotChannel
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id BotChannelId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this BotChannel ID
+func (id BotChannelId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftBotService", "Microsoft.BotService"),
+		resourceid.StaticSegment("staticBotServices", "botServices", false),
+		resourceid.UserSpecifiedSegment("botServiceName"),
+		resourceid.StaticSegment("staticChannels", "channels", false),
+		resourceid.UserSpecifiedSegment("channelName"),
+	}
+}
+
 // BotChannelID parses a BotChannel ID into an BotChannelId struct
 func BotChannelID(input string) (*BotChannelId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, BotChannelId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BotChannelId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BotServiceName, err = id.PopSegment("botServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.ChannelName, err = id.PopSegment("channels"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		BotServiceName: values["botServiceName"],
+		ChannelName:    values["channelName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = BotChannelId{}
+var _ resourceid.SegmentedID = BotChannelId{}
 
 func TestBotChannelIDFormatter(t *testing.T) {
 	actual := NewBotChannelID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1", "Discovery1").ID()
@@ -18,6 +19,52 @@ func TestBotChannelIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotChannelIDFromParent(t *testing.T) {
+	parent := NewBotServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1")
+	actual := NewBotChannelIDFromParent(parent, "Discovery1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/channels/Discovery1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestBotChannelIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/channels/Discovery1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.BOTSERVICE/BOTSERVICES/botService1/CHANNELS/Discovery1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/channels/Discovery1other",
+			Expected: false,
+		},
+	}
+
+	id := NewBotChannelID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1", "Discovery1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestBotChannelID(t *testing.T) {
 	testData := []struct {
 		Input    string
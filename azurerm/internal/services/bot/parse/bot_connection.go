@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BotConnectionId struct {
@@ -25,6 +25,16 @@ func NewBotConnectionID(subscriptionId, resourceGroup, botServiceName, connectio
 	}
 }
 
+// NewBotConnectionIDFromParent returns a BotConnectionId for the BotConnection within the specified BotService
+func NewBotConnectionIDFromParent(parent BotServiceId, connectionName string) BotConnectionId {
+	return BotConnectionId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		BotServiceName: parent.Name,
+		ConnectionName: connectionName,
+	}
+}
+
 func (id BotConnectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Connection Name %q", id.ConnectionName),
@@ -40,35 +50,40 @@ func (id BotConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.BotServiceName, id.ConnectionName)
 }
 
+// Matches returns whether the specified Resource ID refers to this BotConnection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id BotConnectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this BotConnection ID
+func (id BotConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftBotService", "Microsoft.BotService"),
+		resourceid.StaticSegment("staticBotServices", "botServices", false),
+		resourceid.UserSpecifiedSegment("botServiceName"),
+		resourceid.StaticSegment("staticConnections", "connections", false),
+		resourceid.UserSpecifiedSegment("connectionName"),
+	}
+}
+
 // BotConnectionID parses a BotConnection ID into an BotConnectionId struct
 func BotConnectionID(input string) (*BotConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, BotConnectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BotConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.BotServiceName, err = id.PopSegment("botServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.ConnectionName, err = id.PopSegment("connections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		BotServiceName: values["botServiceName"],
+		ConnectionName: values["connectionName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = BotConnectionId{}
+var _ resourceid.SegmentedID = BotConnectionId{}
 
 func TestBotConnectionIDFormatter(t *testing.T) {
 	actual := NewBotConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1", "connection1").ID()
@@ -18,6 +19,52 @@ func TestBotConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotConnectionIDFromParent(t *testing.T) {
+	parent := NewBotServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1")
+	actual := NewBotConnectionIDFromParent(parent, "connection1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/connections/connection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestBotConnectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/connections/connection1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.BOTSERVICE/BOTSERVICES/botService1/CONNECTIONS/connection1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1/connections/connection1other",
+			Expected: false,
+		},
+	}
+
+	id := NewBotConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1", "connection1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestBotConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = BotServiceId{}
+var _ resourceid.SegmentedID = BotServiceId{}
 
 func TestBotServiceIDFormatter(t *testing.T) {
 	actual := NewBotServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1").ID()
@@ -18,6 +19,43 @@ func TestBotServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBotServiceIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.BOTSERVICE/BOTSERVICES/botService1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.BotService/botServices/botService1other",
+			Expected: false,
+		},
+	}
+
+	id := NewBotServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "botService1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestBotServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,63 @@
+package bot
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/bot/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.BotService/botServices/channels",
+			TerraformResourceType: "azurerm_bot_channel_directline",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BotChannelID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.BotService/botServices/channels",
+			TerraformResourceType: "azurerm_bot_channel_email",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BotChannelID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.BotService/botServices/channels",
+			TerraformResourceType: "azurerm_bot_channel_ms_teams",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BotChannelID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.BotService/botServices/channels",
+			TerraformResourceType: "azurerm_bot_channel_slack",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BotChannelID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.BotService/botServices",
+			TerraformResourceType: "azurerm_bot_channels_registration",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BotServiceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.BotService/botServices/connections",
+			TerraformResourceType: "azurerm_bot_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BotConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.BotService/botServices",
+			TerraformResourceType: "azurerm_bot_web_app",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BotServiceID(input)
+			},
+		},
+	}
+}
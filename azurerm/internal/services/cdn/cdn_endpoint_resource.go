@@ -255,7 +255,7 @@ func resourceCdnEndpointCreate(d *schema.ResourceData, meta interface{}) error {
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 			OriginHostHeader:           utils.String(originHostHeader),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, ok := d.GetOk("content_types_to_compress"); ok {
@@ -359,7 +359,7 @@ func resourceCdnEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
 			QueryStringCachingBehavior: cdn.QueryStringCachingBehavior(cachingBehaviour),
 			OriginHostHeader:           utils.String(hostHeader),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, ok := d.GetOk("content_types_to_compress"); ok {
@@ -496,7 +496,7 @@ func resourceCdnEndpointRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceCdnEndpointDelete(d *schema.ResourceData, meta interface{}) error {
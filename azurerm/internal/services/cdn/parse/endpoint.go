@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EndpointId struct {
@@ -25,6 +25,16 @@ func NewEndpointID(subscriptionId, resourceGroup, profileName, name string) Endp
 	}
 }
 
+// NewEndpointIDFromParent returns a EndpointId for the Endpoint within the specified Profile
+func NewEndpointIDFromParent(parent ProfileId, name string) EndpointId {
+	return EndpointId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ProfileName:    parent.Name,
+		Name:           name,
+	}
+}
+
 func (id EndpointId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id EndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ProfileName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Endpoint
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id EndpointId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Endpoint ID
+func (id EndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftCdn", "Microsoft.Cdn"),
+		resourceid.StaticSegment("staticProfiles", "profiles", false),
+		resourceid.UserSpecifiedSegment("profileName"),
+		resourceid.StaticSegment("staticEndpoints", "endpoints", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // EndpointID parses a Endpoint ID into an EndpointId struct
 func EndpointID(input string) (*EndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, EndpointId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ProfileName, err = id.PopSegment("profiles"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("endpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ProfileName:    values["profileName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = EndpointId{}
+var _ resourceid.SegmentedID = EndpointId{}
 
 func TestEndpointIDFormatter(t *testing.T) {
 	actual := NewEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "endpoint1").ID()
@@ -18,6 +19,52 @@ func TestEndpointIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEndpointIDFromParent(t *testing.T) {
+	parent := NewProfileID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1")
+	actual := NewEndpointIDFromParent(parent, "endpoint1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/endpoints/endpoint1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestEndpointIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/endpoints/endpoint1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.CDN/PROFILES/profile1/ENDPOINTS/endpoint1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1/endpoints/endpoint1other",
+			Expected: false,
+		},
+	}
+
+	id := NewEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1", "endpoint1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestEndpointID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ProfileId{}
+var _ resourceid.SegmentedID = ProfileId{}
 
 func TestProfileIDFormatter(t *testing.T) {
 	actual := NewProfileID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1").ID()
@@ -18,6 +19,43 @@ func TestProfileIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProfileIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.CDN/PROFILES/profile1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cdn/profiles/profile1other",
+			Expected: false,
+		},
+	}
+
+	id := NewProfileID("12345678-1234-9876-4563-123456789012", "resGroup1", "profile1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestProfileID(t *testing.T) {
 	testData := []struct {
 		Input    string
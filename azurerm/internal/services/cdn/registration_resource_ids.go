@@ -0,0 +1,28 @@
+package cdn
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cdn/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Cdn/profiles/endpoints",
+			TerraformResourceType: "azurerm_cdn_endpoint",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.EndpointID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Cdn/profiles",
+			TerraformResourceType: "azurerm_cdn_profile",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ProfileID(input)
+			},
+		},
+	}
+}
@@ -163,7 +163,7 @@ func resourceCognitiveAccountCreate(d *schema.ResourceData, meta interface{}) er
 		Properties: &cognitiveservices.AccountProperties{
 			APIProperties: &cognitiveservices.AccountAPIProperties{},
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if kind == "QnAMaker" {
@@ -220,7 +220,7 @@ func resourceCognitiveAccountUpdate(d *schema.ResourceData, meta interface{}) er
 		Properties: &cognitiveservices.AccountProperties{
 			APIProperties: &cognitiveservices.AccountAPIProperties{},
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if kind := d.Get("kind"); kind == "QnAMaker" {
@@ -290,7 +290,7 @@ func resourceCognitiveAccountRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceCognitiveAccountDelete(d *schema.ResourceData, meta interface{}) error {
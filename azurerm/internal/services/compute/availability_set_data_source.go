@@ -89,5 +89,5 @@ func dataSourceAvailabilitySetRead(d *schema.ResourceData, meta interface{}) err
 			d.Set("platform_fault_domain_count", strconv.Itoa(int(*v)))
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -68,5 +68,5 @@ func dataSourceDedicatedHostRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("dedicated_host_group_name", hostGroupName)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -86,5 +86,5 @@ func dataSourceDedicatedHostGroupRead(d *schema.ResourceData, meta interface{})
 
 	d.Set("zones", utils.FlattenStringSlice(resp.Zones))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
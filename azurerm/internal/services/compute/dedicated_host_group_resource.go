@@ -95,7 +95,7 @@ func resourceDedicatedHostGroupCreate(d *schema.ResourceData, meta interface{})
 		DedicatedHostGroupProperties: &compute.DedicatedHostGroupProperties{
 			PlatformFaultDomainCount: utils.Int32(int32(platformFaultDomainCount)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 	if zones, ok := d.GetOk("zones"); ok {
 		parameters.Zones = utils.ExpandStringSlice(zones.([]interface{}))
@@ -153,7 +153,7 @@ func resourceDedicatedHostGroupRead(d *schema.ResourceData, meta interface{}) er
 	}
 	d.Set("zones", utils.FlattenStringSlice(resp.Zones))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDedicatedHostGroupUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -166,7 +166,7 @@ func resourceDedicatedHostGroupUpdate(d *schema.ResourceData, meta interface{})
 	t := d.Get("tags").(map[string]interface{})
 
 	parameters := compute.DedicatedHostGroupUpdate{
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Update(ctx, resourceGroupName, name, parameters); err != nil {
@@ -80,7 +80,7 @@ func resourceDiskAccessCreateUpdate(d *schema.ResourceData, meta interface{}) er
 	createDiskAccess := compute.DiskAccess{
 		Name:     &name,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, createDiskAccess)
@@ -132,7 +132,7 @@ func resourceDiskAccessRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDiskAccessDelete(d *schema.ResourceData, meta interface{}) error {
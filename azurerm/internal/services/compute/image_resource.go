@@ -212,7 +212,7 @@ func resourceImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
-	expandedTags := tags.Expand(d.Get("tags").(map[string]interface{}))
+	expandedTags := tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 
 	properties := compute.ImageProperties{
 		HyperVGeneration: compute.HyperVGenerationTypes(hyperVGeneration),
@@ -320,7 +320,7 @@ func resourceImageRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("hyper_v_generation", string(resp.HyperVGeneration))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceImageDelete(d *schema.ResourceData, meta interface{}) error {
@@ -160,6 +160,8 @@ func resourceLinuxVirtualMachineScaleSet() *schema.Resource {
 
 			"identity": VirtualMachineScaleSetIdentitySchema(),
 
+			"instance_roll_policy": VirtualMachineScaleSetInstanceRollPolicySchema(),
+
 			"max_bid_price": {
 				Type:         schema.TypeFloat,
 				Optional:     true,
@@ -491,7 +493,7 @@ func resourceLinuxVirtualMachineScaleSetCreate(d *schema.ResourceData, meta inte
 		},
 		Identity: identity,
 		Plan:     plan,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		VirtualMachineScaleSetProperties: &compute.VirtualMachineScaleSetProperties{
 			AdditionalCapabilities:                 additionalCapabilities,
 			AutomaticRepairsPolicy:                 automaticRepairsPolicy,
@@ -812,15 +814,21 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *schema.ResourceData, meta inte
 		updateProps.VirtualMachineProfile.ExtensionProfile.ExtensionsTimeBudget = utils.String(d.Get("extensions_time_budget").(string))
 	}
 
-	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		update.Tags = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	update.VirtualMachineScaleSetUpdateProperties = &updateProps
 
+	instanceRollPolicy, err := expandVirtualMachineScaleSetInstanceRollPolicy(d.Get("instance_roll_policy").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `instance_roll_policy`: %+v", err)
+	}
+
 	metaData := virtualMachineScaleSetUpdateMetaData{
 		AutomaticOSUpgradeIsEnabled:  automaticOSUpgradeIsEnabled,
 		CanRollInstancesWhenRequired: meta.(*clients.Client).Features.VirtualMachineScaleSet.RollInstancesWhenRequired,
+		InstanceRollPolicy:           *instanceRollPolicy,
 		UpdateInstances:              updateInstances,
 		Client:                       meta.(*clients.Client).Compute,
 		Existing:                     existing,
@@ -1032,7 +1040,7 @@ func resourceLinuxVirtualMachineScaleSetRead(d *schema.ResourceData, meta interf
 		return fmt.Errorf("Error setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceLinuxVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
@@ -145,5 +145,5 @@ func dataSourceManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("zones", utils.FlattenStringSlice(resp.Zones))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
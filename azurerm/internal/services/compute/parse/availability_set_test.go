@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AvailabilitySetId{}
+var _ resourceid.SegmentedID = AvailabilitySetId{}
 
 func TestAvailabilitySetIDFormatter(t *testing.T) {
 	actual := NewAvailabilitySetID("12345678-1234-9876-4563-123456789012", "resGroup1", "set1").ID()
@@ -18,6 +19,43 @@ func TestAvailabilitySetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAvailabilitySetIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/availabilitySets/set1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/AVAILABILITYSETS/set1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/availabilitySets/set1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAvailabilitySetID("12345678-1234-9876-4563-123456789012", "resGroup1", "set1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAvailabilitySetID(t *testing.T) {
 	testData := []struct {
 		Input    string
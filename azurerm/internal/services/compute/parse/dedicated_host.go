@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DedicatedHostId struct {
@@ -25,6 +25,16 @@ func NewDedicatedHostID(subscriptionId, resourceGroup, hostGroupName, hostName s
 	}
 }
 
+// NewDedicatedHostIDFromParent returns a DedicatedHostId for the DedicatedHost within the specified DedicatedHostGroup
+func NewDedicatedHostIDFromParent(parent DedicatedHostGroupId, hostName string) DedicatedHostId {
+	return DedicatedHostId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		HostGroupName:  parent.HostGroupName,
+		HostName:       hostName,
+	}
+}
+
 func (id DedicatedHostId) String() string {
 	segments := []string{
 		fmt.Sprintf("Host Name %q", id.HostName),
@@ -40,35 +50,40 @@ func (id DedicatedHostId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.HostGroupName, id.HostName)
 }
 
+// Matches returns whether the specified Resource ID refers to this DedicatedHost
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DedicatedHostId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DedicatedHost ID
+func (id DedicatedHostId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute"),
+		resourceid.StaticSegment("staticHostGroups", "hostGroups", false),
+		resourceid.UserSpecifiedSegment("hostGroupName"),
+		resourceid.StaticSegment("staticHosts", "hosts", false),
+		resourceid.UserSpecifiedSegment("hostName"),
+	}
+}
+
 // DedicatedHostID parses a DedicatedHost ID into an DedicatedHostId struct
 func DedicatedHostID(input string) (*DedicatedHostId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DedicatedHostId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DedicatedHostId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.HostGroupName, err = id.PopSegment("hostGroups"); err != nil {
-		return nil, err
-	}
-	if resourceId.HostName, err = id.PopSegment("hosts"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		HostGroupName:  values["hostGroupName"],
+		HostName:       values["hostName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DedicatedHostGroupId{}
+var _ resourceid.SegmentedID = DedicatedHostGroupId{}
 
 func TestDedicatedHostGroupIDFormatter(t *testing.T) {
 	actual := NewDedicatedHostGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "hostGroup1").ID()
@@ -18,6 +19,43 @@ func TestDedicatedHostGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDedicatedHostGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/HOSTGROUPS/hostGroup1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/hostGroups/hostGroup1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDedicatedHostGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "hostGroup1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDedicatedHostGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
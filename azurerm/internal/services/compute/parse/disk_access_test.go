@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DiskAccessId{}
+var _ resourceid.SegmentedID = DiskAccessId{}
 
 func TestDiskAccessIDFormatter(t *testing.T) {
 	actual := NewDiskAccessID("12345678-1234-9876-4563-123456789012", "resGroup1", "diskAccess1").ID()
@@ -18,6 +19,43 @@ func TestDiskAccessIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDiskAccessIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/diskAccesses/diskAccess1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/DISKACCESSES/diskAccess1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/diskAccesses/diskAccess1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDiskAccessID("12345678-1234-9876-4563-123456789012", "resGroup1", "diskAccess1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDiskAccessID(t *testing.T) {
 	testData := []struct {
 		Input    string
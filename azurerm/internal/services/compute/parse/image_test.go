@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ImageId{}
+var _ resourceid.SegmentedID = ImageId{}
 
 func TestImageIDFormatter(t *testing.T) {
 	actual := NewImageID("12345678-1234-9876-4563-123456789012", "resGroup1", "image1").ID()
@@ -18,6 +19,43 @@ func TestImageIDFormatter(t *testing.T) {
 	}
 }
 
+func TestImageIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/images/image1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/IMAGES/image1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/images/image1other",
+			Expected: false,
+		},
+	}
+
+	id := NewImageID("12345678-1234-9876-4563-123456789012", "resGroup1", "image1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestImageID(t *testing.T) {
 	testData := []struct {
 		Input    string
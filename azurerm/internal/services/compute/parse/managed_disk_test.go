@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ManagedDiskId{}
+var _ resourceid.SegmentedID = ManagedDiskId{}
 
 func TestManagedDiskIDFormatter(t *testing.T) {
 	actual := NewManagedDiskID("12345678-1234-9876-4563-123456789012", "resGroup1", "disk1").ID()
@@ -18,6 +19,43 @@ func TestManagedDiskIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedDiskIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/disk1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/DISKS/disk1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/disks/disk1other",
+			Expected: false,
+		},
+	}
+
+	id := NewManagedDiskID("12345678-1234-9876-4563-123456789012", "resGroup1", "disk1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestManagedDiskID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ProximityPlacementGroupId{}
+var _ resourceid.SegmentedID = ProximityPlacementGroupId{}
 
 func TestProximityPlacementGroupIDFormatter(t *testing.T) {
 	actual := NewProximityPlacementGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "group1").ID()
@@ -18,6 +19,43 @@ func TestProximityPlacementGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProximityPlacementGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/proximityPlacementGroups/group1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/PROXIMITYPLACEMENTGROUPS/group1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/proximityPlacementGroups/group1other",
+			Expected: false,
+		},
+	}
+
+	id := NewProximityPlacementGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "group1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestProximityPlacementGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SharedImageId struct {
@@ -25,6 +25,16 @@ func NewSharedImageID(subscriptionId, resourceGroup, galleryName, imageName stri
 	}
 }
 
+// NewSharedImageIDFromParent returns a SharedImageId for the SharedImage within the specified SharedImageGallery
+func NewSharedImageIDFromParent(parent SharedImageGalleryId, imageName string) SharedImageId {
+	return SharedImageId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		GalleryName:    parent.GalleryName,
+		ImageName:      imageName,
+	}
+}
+
 func (id SharedImageId) String() string {
 	segments := []string{
 		fmt.Sprintf("Image Name %q", id.ImageName),
@@ -40,35 +50,40 @@ func (id SharedImageId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SharedImage
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SharedImageId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SharedImage ID
+func (id SharedImageId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute"),
+		resourceid.StaticSegment("staticGalleries", "galleries", false),
+		resourceid.UserSpecifiedSegment("galleryName"),
+		resourceid.StaticSegment("staticImages", "images", false),
+		resourceid.UserSpecifiedSegment("imageName"),
+	}
+}
+
 // SharedImageID parses a SharedImage ID into an SharedImageId struct
 func SharedImageID(input string) (*SharedImageId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SharedImageId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SharedImageId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
-		return nil, err
-	}
-	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		GalleryName:    values["galleryName"],
+		ImageName:      values["imageName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SharedImageId{}
+var _ resourceid.SegmentedID = SharedImageId{}
 
 func TestSharedImageIDFormatter(t *testing.T) {
 	actual := NewSharedImageID("12345678-1234-9876-4563-123456789012", "resGroup1", "gallery1", "image1").ID()
@@ -18,6 +19,52 @@ func TestSharedImageIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSharedImageIDFromParent(t *testing.T) {
+	parent := NewSharedImageGalleryID("12345678-1234-9876-4563-123456789012", "resGroup1", "gallery1")
+	actual := NewSharedImageIDFromParent(parent, "image1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSharedImageIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/GALLERIES/gallery1/IMAGES/image1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSharedImageID("12345678-1234-9876-4563-123456789012", "resGroup1", "gallery1", "image1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSharedImageID(t *testing.T) {
 	testData := []struct {
 		Input    string
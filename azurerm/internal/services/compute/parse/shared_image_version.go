@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SharedImageVersionId struct {
@@ -27,6 +27,17 @@ func NewSharedImageVersionID(subscriptionId, resourceGroup, galleryName, imageNa
 	}
 }
 
+// NewSharedImageVersionIDFromParent returns a SharedImageVersionId for the SharedImageVersion within the specified SharedImage
+func NewSharedImageVersionIDFromParent(parent SharedImageId, versionName string) SharedImageVersionId {
+	return SharedImageVersionId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		GalleryName:    parent.GalleryName,
+		ImageName:      parent.ImageName,
+		VersionName:    versionName,
+	}
+}
+
 func (id SharedImageVersionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Version Name %q", id.VersionName),
@@ -43,38 +54,43 @@ func (id SharedImageVersionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.GalleryName, id.ImageName, id.VersionName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SharedImageVersion
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SharedImageVersionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SharedImageVersion ID
+func (id SharedImageVersionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute"),
+		resourceid.StaticSegment("staticGalleries", "galleries", false),
+		resourceid.UserSpecifiedSegment("galleryName"),
+		resourceid.StaticSegment("staticImages", "images", false),
+		resourceid.UserSpecifiedSegment("imageName"),
+		resourceid.StaticSegment("staticVersions", "versions", false),
+		resourceid.UserSpecifiedSegment("versionName"),
+	}
+}
+
 // SharedImageVersionID parses a SharedImageVersion ID into an SharedImageVersionId struct
 func SharedImageVersionID(input string) (*SharedImageVersionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SharedImageVersionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SharedImageVersionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
-		return nil, err
-	}
-	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
-		return nil, err
-	}
-	if resourceId.VersionName, err = id.PopSegment("versions"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		GalleryName:    values["galleryName"],
+		ImageName:      values["imageName"],
+		VersionName:    values["versionName"],
 	}
 
 	return &resourceId, nil
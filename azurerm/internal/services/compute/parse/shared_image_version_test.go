@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SharedImageVersionId{}
+var _ resourceid.SegmentedID = SharedImageVersionId{}
 
 func TestSharedImageVersionIDFormatter(t *testing.T) {
 	actual := NewSharedImageVersionID("12345678-1234-9876-4563-123456789012", "resGroup1", "gallery1", "image1", "version1").ID()
@@ -18,6 +19,52 @@ func TestSharedImageVersionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSharedImageVersionIDFromParent(t *testing.T) {
+	parent := NewSharedImageID("12345678-1234-9876-4563-123456789012", "resGroup1", "gallery1", "image1")
+	actual := NewSharedImageVersionIDFromParent(parent, "version1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/version1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSharedImageVersionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/version1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/GALLERIES/gallery1/IMAGES/image1/VERSIONS/version1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/version1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSharedImageVersionID("12345678-1234-9876-4563-123456789012", "resGroup1", "gallery1", "image1", "version1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSharedImageVersionID(t *testing.T) {
 	testData := []struct {
 		Input    string
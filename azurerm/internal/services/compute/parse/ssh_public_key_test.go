@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SSHPublicKeyId{}
+var _ resourceid.SegmentedID = SSHPublicKeyId{}
 
 func TestSSHPublicKeyIDFormatter(t *testing.T) {
 	actual := NewSSHPublicKeyID("12345678-1234-9876-4563-123456789012", "resGroup1", "sshpublickey1").ID()
@@ -18,6 +19,43 @@ func TestSSHPublicKeyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSSHPublicKeyIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/sshPublicKeys/sshpublickey1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/SSHPUBLICKEYS/sshpublickey1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/sshPublicKeys/sshpublickey1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSSHPublicKeyID("12345678-1234-9876-4563-123456789012", "resGroup1", "sshpublickey1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSSHPublicKeyID(t *testing.T) {
 	testData := []struct {
 		Input    string
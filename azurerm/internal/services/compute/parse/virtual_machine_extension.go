@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineExtensionId struct {
@@ -25,6 +25,16 @@ func NewVirtualMachineExtensionID(subscriptionId, resourceGroup, virtualMachineN
 	}
 }
 
+// NewVirtualMachineExtensionIDFromParent returns a VirtualMachineExtensionId for the VirtualMachineExtension within the specified VirtualMachine
+func NewVirtualMachineExtensionIDFromParent(parent VirtualMachineId, extensionName string) VirtualMachineExtensionId {
+	return VirtualMachineExtensionId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		VirtualMachineName: parent.Name,
+		ExtensionName:      extensionName,
+	}
+}
+
 func (id VirtualMachineExtensionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Extension Name %q", id.ExtensionName),
@@ -40,35 +50,40 @@ func (id VirtualMachineExtensionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineName, id.ExtensionName)
 }
 
+// Matches returns whether the specified Resource ID refers to this VirtualMachineExtension
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id VirtualMachineExtensionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this VirtualMachineExtension ID
+func (id VirtualMachineExtensionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute"),
+		resourceid.StaticSegment("staticVirtualMachines", "virtualMachines", false),
+		resourceid.UserSpecifiedSegment("virtualMachineName"),
+		resourceid.StaticSegment("staticExtensions", "extensions", false),
+		resourceid.UserSpecifiedSegment("extensionName"),
+	}
+}
+
 // VirtualMachineExtensionID parses a VirtualMachineExtension ID into an VirtualMachineExtensionId struct
 func VirtualMachineExtensionID(input string) (*VirtualMachineExtensionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, VirtualMachineExtensionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualMachineExtensionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
-		return nil, err
-	}
-	if resourceId.ExtensionName, err = id.PopSegment("extensions"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		VirtualMachineName: values["virtualMachineName"],
+		ExtensionName:      values["extensionName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VirtualMachineExtensionId{}
+var _ resourceid.SegmentedID = VirtualMachineExtensionId{}
 
 func TestVirtualMachineExtensionIDFormatter(t *testing.T) {
 	actual := NewVirtualMachineExtensionID("12345678-1234-9876-4563-123456789012", "resGroup1", "machine1", "extension1").ID()
@@ -18,6 +19,52 @@ func TestVirtualMachineExtensionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualMachineExtensionIDFromParent(t *testing.T) {
+	parent := NewVirtualMachineID("12345678-1234-9876-4563-123456789012", "resGroup1", "machine1")
+	actual := NewVirtualMachineExtensionIDFromParent(parent, "extension1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1/extensions/extension1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestVirtualMachineExtensionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1/extensions/extension1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/VIRTUALMACHINES/machine1/EXTENSIONS/extension1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/machine1/extensions/extension1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVirtualMachineExtensionID("12345678-1234-9876-4563-123456789012", "resGroup1", "machine1", "extension1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVirtualMachineExtensionID(t *testing.T) {
 	testData := []struct {
 		Input    string
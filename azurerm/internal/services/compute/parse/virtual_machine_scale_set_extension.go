@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualMachineScaleSetExtensionId struct {
@@ -25,6 +25,16 @@ func NewVirtualMachineScaleSetExtensionID(subscriptionId, resourceGroup, virtual
 	}
 }
 
+// NewVirtualMachineScaleSetExtensionIDFromParent returns a VirtualMachineScaleSetExtensionId for the VirtualMachineScaleSetExtension within the specified VirtualMachineScaleSet
+func NewVirtualMachineScaleSetExtensionIDFromParent(parent VirtualMachineScaleSetId, extensionName string) VirtualMachineScaleSetExtensionId {
+	return VirtualMachineScaleSetExtensionId{
+		SubscriptionId:             parent.SubscriptionId,
+		ResourceGroup:              parent.ResourceGroup,
+		VirtualMachineScaleSetName: parent.Name,
+		ExtensionName:              extensionName,
+	}
+}
+
 func (id VirtualMachineScaleSetExtensionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Extension Name %q", id.ExtensionName),
@@ -40,35 +50,40 @@ func (id VirtualMachineScaleSetExtensionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualMachineScaleSetName, id.ExtensionName)
 }
 
+// Matches returns whether the specified Resource ID refers to this VirtualMachineScaleSetExtension
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id VirtualMachineScaleSetExtensionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this VirtualMachineScaleSetExtension ID
+func (id VirtualMachineScaleSetExtensionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftCompute", "Microsoft.Compute"),
+		resourceid.StaticSegment("staticVirtualMachineScaleSets", "virtualMachineScaleSets", false),
+		resourceid.UserSpecifiedSegment("virtualMachineScaleSetName"),
+		resourceid.StaticSegment("staticExtensions", "extensions", false),
+		resourceid.UserSpecifiedSegment("extensionName"),
+	}
+}
+
 // VirtualMachineScaleSetExtensionID parses a VirtualMachineScaleSetExtension ID into an VirtualMachineScaleSetExtensionId struct
 func VirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExtensionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, VirtualMachineScaleSetExtensionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualMachineScaleSetExtensionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualMachineScaleSetName, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
-		return nil, err
-	}
-	if resourceId.ExtensionName, err = id.PopSegment("extensions"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:             values["subscriptionId"],
+		ResourceGroup:              values["resourceGroup"],
+		VirtualMachineScaleSetName: values["virtualMachineScaleSetName"],
+		ExtensionName:              values["extensionName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VirtualMachineScaleSetExtensionId{}
+var _ resourceid.SegmentedID = VirtualMachineScaleSetExtensionId{}
 
 func TestVirtualMachineScaleSetExtensionIDFormatter(t *testing.T) {
 	actual := NewVirtualMachineScaleSetExtensionID("12345678-1234-9876-4563-123456789012", "resGroup1", "scaleSet1", "extension1").ID()
@@ -18,6 +19,52 @@ func TestVirtualMachineScaleSetExtensionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualMachineScaleSetExtensionIDFromParent(t *testing.T) {
+	parent := NewVirtualMachineScaleSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "scaleSet1")
+	actual := NewVirtualMachineScaleSetExtensionIDFromParent(parent, "extension1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1/extensions/extension1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestVirtualMachineScaleSetExtensionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1/extensions/extension1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS/scaleSet1/EXTENSIONS/extension1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1/extensions/extension1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVirtualMachineScaleSetExtensionID("12345678-1234-9876-4563-123456789012", "resGroup1", "scaleSet1", "extension1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVirtualMachineScaleSetExtensionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -59,5 +59,5 @@ func dataSourceProximityPlacementGroupRead(d *schema.ResourceData, meta interfac
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
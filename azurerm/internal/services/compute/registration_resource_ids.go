@@ -0,0 +1,126 @@
+package compute
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Compute/availabilitySets",
+			TerraformResourceType: "azurerm_availability_set",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AvailabilitySetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/hostGroups/hosts",
+			TerraformResourceType: "azurerm_dedicated_host",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DedicatedHostID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/diskAccesses",
+			TerraformResourceType: "azurerm_disk_access",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DiskAccessID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/diskEncryptionSets",
+			TerraformResourceType: "azurerm_disk_encryption_set",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DiskEncryptionSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/virtualMachines",
+			TerraformResourceType: "azurerm_linux_virtual_machine",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualMachineID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/virtualMachineScaleSets",
+			TerraformResourceType: "azurerm_linux_virtual_machine_scale_set",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualMachineScaleSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/disks",
+			TerraformResourceType: "azurerm_managed_disk",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ManagedDiskID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/virtualMachineScaleSets",
+			TerraformResourceType: "azurerm_orchestrated_virtual_machine_scale_set",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualMachineScaleSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/galleries/images",
+			TerraformResourceType: "azurerm_shared_image",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SharedImageID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/galleries",
+			TerraformResourceType: "azurerm_shared_image_gallery",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SharedImageGalleryID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/galleries/images/versions",
+			TerraformResourceType: "azurerm_shared_image_version",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SharedImageVersionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/sshPublicKeys",
+			TerraformResourceType: "azurerm_ssh_public_key",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SSHPublicKeyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/virtualMachines/extensions",
+			TerraformResourceType: "azurerm_virtual_machine_extension",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualMachineExtensionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/virtualMachineScaleSets/extensions",
+			TerraformResourceType: "azurerm_virtual_machine_scale_set_extension",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualMachineScaleSetExtensionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/virtualMachines",
+			TerraformResourceType: "azurerm_windows_virtual_machine",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualMachineID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Compute/virtualMachineScaleSets",
+			TerraformResourceType: "azurerm_windows_virtual_machine_scale_set",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualMachineScaleSetID(input)
+			},
+		},
+	}
+}
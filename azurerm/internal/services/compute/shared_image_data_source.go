@@ -141,7 +141,7 @@ func dataSourceSharedImageRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenGalleryImageDataSourceIdentifier(input *compute.GalleryImageIdentifier) []interface{} {
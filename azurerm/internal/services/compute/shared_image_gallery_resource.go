@@ -95,7 +95,7 @@ func resourceSharedImageGalleryCreateUpdate(d *schema.ResourceData, meta interfa
 		GalleryProperties: &compute.GalleryProperties{
 			Description: utils.String(description),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, gallery)
@@ -155,7 +155,7 @@ func resourceSharedImageGalleryRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSharedImageGalleryDelete(d *schema.ResourceData, meta interface{}) error {
@@ -147,7 +147,7 @@ func dataSourceSharedImageVersionRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, image.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, image.Tags)
 }
 
 func obtainImage(client *compute.GalleryImageVersionsClient, ctx context.Context, resourceGroup string, galleryName string, galleryImageName string, galleryImageVersionName string) (*compute.GalleryImageVersion, error) {
@@ -119,7 +119,7 @@ func resourceSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 				CreateOption: compute.DiskCreateOption(createOption),
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("source_uri"); ok {
@@ -212,7 +212,7 @@ func resourceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
@@ -70,5 +70,5 @@ func dataSourceSshPublicKeyRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("public_key", props.PublicKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
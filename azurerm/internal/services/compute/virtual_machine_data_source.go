@@ -31,36 +31,7 @@ func dataSourceVirtualMachine() *schema.Resource {
 
 			"location": azure.SchemaLocationForDataSource(),
 
-			"identity": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"type": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-
-						"identity_ids": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-						},
-
-						"principal_id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-
-						"tenant_id": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
-			},
+			"identity": virtualMachineIdentity{}.SchemaDataSource(),
 		},
 	}
 }
@@ -84,11 +55,7 @@ func dataSourceVirtualMachineRead(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetId(*resp.ID)
 
-	identity, err := flattenVirtualMachineIdentity(resp.Identity)
-	if err != nil {
-		return err
-	}
-	if err := d.Set("identity", identity); err != nil {
+	if err := d.Set("identity", flattenVirtualMachineIdentity(resp.Identity)); err != nil {
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
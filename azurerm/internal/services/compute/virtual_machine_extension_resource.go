@@ -143,7 +143,7 @@ func resourceVirtualMachineExtensionsCreateUpdate(d *schema.ResourceData, meta i
 			TypeHandlerVersion:      &typeHandlerVersion,
 			AutoUpgradeMinorVersion: &autoUpgradeMinor,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if settingsString := d.Get("settings").(string); settingsString != "" {
@@ -234,7 +234,7 @@ func resourceVirtualMachineExtensionsRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualMachineExtensionsDelete(d *schema.ResourceData, meta interface{}) error {
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/structure"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/rickb777/date/period"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	azValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
@@ -1223,6 +1224,70 @@ func FlattenVirtualMachineScaleSetRollingUpgradePolicy(input *compute.RollingUpg
 	}
 }
 
+// VirtualMachineScaleSetInstanceRollPolicySchema is used to configure how the instances within the Scale Set are
+// rolled by the Provider when required (when `upgrade_mode` is `Manual`), since there's no API for this
+func VirtualMachineScaleSetInstanceRollPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_batch_instance_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      20,
+					ValidateFunc: validation.IntBetween(1, 100),
+				},
+
+				"max_unhealthy_instance_percent": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      20,
+					ValidateFunc: validation.IntBetween(0, 100),
+				},
+
+				"pause_time_between_batches": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "PT0S",
+					ValidateFunc: azValidate.ISO8601Duration,
+				},
+
+				"reimage_enabled": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  true,
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualMachineScaleSetInstanceRollPolicy(input []interface{}) (*virtualMachineScaleSetInstanceRollPolicy, error) {
+	if len(input) == 0 || input[0] == nil {
+		// when not configured the instances are rolled one at a time (and reimaged) without any health checks
+		return &virtualMachineScaleSetInstanceRollPolicy{
+			ReimageEnabled: true,
+		}, nil
+	}
+
+	raw := input[0].(map[string]interface{})
+
+	pauseTime, err := period.Parse(raw["pause_time_between_batches"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `pause_time_between_batches`: %+v", err)
+	}
+
+	return &virtualMachineScaleSetInstanceRollPolicy{
+		MaxBatchInstancePercent:     raw["max_batch_instance_percent"].(int),
+		MaxUnhealthyInstancePercent: raw["max_unhealthy_instance_percent"].(int),
+		PauseTimeBetweenBatches:     pauseTime.DurationApprox(),
+		ReimageEnabled:              raw["reimage_enabled"].(bool),
+		HealthChecksEnabled:         true,
+	}, nil
+}
+
 func VirtualMachineScaleSetTerminateNotificationSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -894,7 +894,7 @@ func resourceVirtualMachineScaleSetCreateUpdate(d *schema.ResourceData, meta int
 	properties := compute.VirtualMachineScaleSet{
 		Name:                             &name,
 		Location:                         &location,
-		Tags:                             tags.Expand(t),
+		Tags:                             tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		Sku:                              sku,
 		VirtualMachineScaleSetProperties: &scaleSetProps,
 		Zones:                            zones,
@@ -1093,7 +1093,7 @@ func resourceVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
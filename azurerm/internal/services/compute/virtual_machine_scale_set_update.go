@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/client"
@@ -18,6 +22,9 @@ type virtualMachineScaleSetUpdateMetaData struct {
 	// can we roll instances if we need too? this is a feature toggle
 	CanRollInstancesWhenRequired bool
 
+	// how should the instances be rolled (when `upgrade_mode` is `Manual`)?
+	InstanceRollPolicy virtualMachineScaleSetInstanceRollPolicy
+
 	// do we need to roll the instances in this scale set?
 	UpdateInstances bool
 
@@ -119,67 +126,230 @@ func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForAutomati
 }
 
 func (metadata virtualMachineScaleSetUpdateMetaData) upgradeInstancesForManualUpgradePolicy(ctx context.Context) error {
-	client := metadata.Client.VMScaleSetClient
 	id := metadata.ID
+	policy := metadata.InstanceRollPolicy
 
 	log.Printf("[DEBUG] Rolling the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q)..", metadata.OSType, id.Name, id.ResourceGroup)
 	instancesClient := metadata.Client.VMScaleSetVMsClient
-	instances, err := instancesClient.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", "")
+	iterator, err := instancesClient.ListComplete(ctx, id.ResourceGroup, id.Name, "", "", "")
 	if err != nil {
 		return fmt.Errorf("Error listing VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, err)
 	}
 
+	instances := make([]compute.VirtualMachineScaleSetVM, 0)
+	for iterator.NotDone() {
+		instances = append(instances, iterator.Value())
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return fmt.Errorf("Error enumerating instances: %s", err)
+		}
+	}
+
 	log.Printf("[DEBUG] Determining instances to roll..")
-	instanceIdsToRoll := make([]string, 0)
-	for instances.NotDone() {
-		instance := instances.Value()
-		props := instance.VirtualMachineScaleSetVMProperties
-		if props != nil && instance.InstanceID != nil {
-			latestModel := props.LatestModelApplied
-			if latestModel != nil || !*latestModel {
-				instanceIdsToRoll = append(instanceIdsToRoll, *instance.InstanceID)
+	instanceIdsToRoll := virtualMachineScaleSetInstanceIdsToRoll(instances)
+	batches := policy.batches(instanceIdsToRoll)
+	log.Printf("[DEBUG] Rolling %d instances in %d batches..", len(instanceIdsToRoll), len(batches))
+
+	rolledInstanceIds := make([]string, 0)
+	for i, batch := range batches {
+		if err := metadata.rollInstances(ctx, batch); err != nil {
+			return err
+		}
+		rolledInstanceIds = append(rolledInstanceIds, batch...)
+
+		if i < len(batches)-1 && policy.PauseTimeBetweenBatches > 0 {
+			log.Printf("[DEBUG] Pausing for %s before rolling the next batch of instances..", policy.PauseTimeBetweenBatches)
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("pausing between batches of instances for %s VM Scale Set %q (Resource Group %q): %+v", metadata.OSType, id.Name, id.ResourceGroup, ctx.Err())
+			case <-time.After(policy.PauseTimeBetweenBatches):
 			}
 		}
 
-		if err := instances.NextWithContext(ctx); err != nil {
-			return fmt.Errorf("Error enumerating instances: %s", err)
+		if policy.HealthChecksEnabled {
+			if err := metadata.checkInstanceHealth(ctx, rolledInstanceIds); err != nil {
+				return err
+			}
 		}
 	}
 
-	// TODO: there's a performance enhancement to do batches here, but this is fine for a first pass
-	for _, instanceId := range instanceIdsToRoll {
-		instanceIds := []string{instanceId}
+	log.Printf("[DEBUG] Rolled the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q).", metadata.OSType, id.Name, id.ResourceGroup)
+	return nil
+}
 
-		log.Printf("[DEBUG] Updating Instance %q to the Latest Configuration..", instanceId)
-		ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
-			InstanceIds: &instanceIds,
-		}
-		future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, ids)
+// rollInstances updates the specified instances to the latest model of the Scale Set, and then (optionally) reimages them
+func (metadata virtualMachineScaleSetUpdateMetaData) rollInstances(ctx context.Context, instanceIds []string) error {
+	client := metadata.Client.VMScaleSetClient
+	id := metadata.ID
+
+	log.Printf("[DEBUG] Updating Instances %q to the Latest Configuration..", strings.Join(instanceIds, ", "))
+	ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &instanceIds,
+	}
+	future, err := client.UpdateInstances(ctx, id.ResourceGroup, id.Name, ids)
+	if err != nil {
+		return fmt.Errorf("Error updating Instances %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", strings.Join(instanceIds, ", "), metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for update of Instances %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", strings.Join(instanceIds, ", "), metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Updated Instances %q to the Latest Configuration.", strings.Join(instanceIds, ", "))
+
+	if !metadata.InstanceRollPolicy.ReimageEnabled {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Reimaging Instances %q..", strings.Join(instanceIds, ", "))
+	reimageInput := &compute.VirtualMachineScaleSetReimageParameters{
+		InstanceIds: &instanceIds,
+	}
+	reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, reimageInput)
+	if err != nil {
+		return fmt.Errorf("Error reimaging Instances %q (%s VM Scale Set %q / Resource Group %q): %+v", strings.Join(instanceIds, ", "), metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+
+	if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for reimage of Instances %q (%s VM Scale Set %q / Resource Group %q): %+v", strings.Join(instanceIds, ", "), metadata.OSType, id.Name, id.ResourceGroup, err)
+	}
+	log.Printf("[DEBUG] Reimaged Instances %q.", strings.Join(instanceIds, ", "))
+
+	return nil
+}
+
+// checkInstanceHealth retrieves the Instance View for each of the instances which have been rolled, returning an
+// error if the percentage of these which are unhealthy exceeds `max_unhealthy_instance_percent`
+func (metadata virtualMachineScaleSetUpdateMetaData) checkInstanceHealth(ctx context.Context, instanceIds []string) error {
+	instancesClient := metadata.Client.VMScaleSetVMsClient
+	id := metadata.ID
+
+	unhealthyInstanceIds := make([]string, 0)
+	for _, instanceId := range instanceIds {
+		instanceView, err := instancesClient.GetInstanceView(ctx, id.ResourceGroup, id.Name, instanceId)
 		if err != nil {
-			return fmt.Errorf("Error updating Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+			return fmt.Errorf("retrieving Instance View for Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+		}
+
+		if !virtualMachineScaleSetInstanceIsHealthy(instanceView) {
+			unhealthyInstanceIds = append(unhealthyInstanceIds, instanceId)
 		}
+	}
+
+	log.Printf("[DEBUG] %d of the %d rolled instances are unhealthy", len(unhealthyInstanceIds), len(instanceIds))
+	if metadata.InstanceRollPolicy.tooManyUnhealthyInstances(len(instanceIds), len(unhealthyInstanceIds)) {
+		return fmt.Errorf("rolling the instances for %s VM Scale Set %q (Resource Group %q): %d of the %d rolled instances (%q) are unhealthy, which exceeds the `max_unhealthy_instance_percent` of %d%%", metadata.OSType, id.Name, id.ResourceGroup, len(unhealthyInstanceIds), len(instanceIds), strings.Join(unhealthyInstanceIds, ", "), metadata.InstanceRollPolicy.MaxUnhealthyInstancePercent)
+	}
+
+	return nil
+}
+
+// virtualMachineScaleSetInstanceRollPolicy defines how the instances within a Scale Set are rolled by the Provider
+type virtualMachineScaleSetInstanceRollPolicy struct {
+	// MaxBatchInstancePercent is the percentage of the instances which are rolled in each batch - when
+	// zero the instances are rolled one at a time
+	MaxBatchInstancePercent int
+
+	// MaxUnhealthyInstancePercent is the percentage of the rolled instances which can be unhealthy
+	MaxUnhealthyInstancePercent int
+
+	// PauseTimeBetweenBatches is how long to wait between rolling each batch of instances
+	PauseTimeBetweenBatches time.Duration
+
+	// ReimageEnabled specifies whether the instances should be reimaged once they've been updated
+	ReimageEnabled bool
+
+	// HealthChecksEnabled specifies whether the health of the rolled instances is checked between batches
+	HealthChecksEnabled bool
+}
 
-		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Error waiting for update of Instance %q (%s VM Scale Set %q / Resource Group %q) to the Latest Configuration: %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+// batches splits the specified instances into batches of (up to) `MaxBatchInstancePercent` of the instances
+func (p virtualMachineScaleSetInstanceRollPolicy) batches(instanceIds []string) [][]string {
+	batchSize := 1
+	if p.MaxBatchInstancePercent > 0 {
+		// round up, so that there's always at least one instance in each batch
+		batchSize = (len(instanceIds)*p.MaxBatchInstancePercent + 99) / 100
+		if batchSize < 1 {
+			batchSize = 1
 		}
-		log.Printf("[DEBUG] Updated Instance %q to the Latest Configuration.", instanceId)
+	}
 
-		// TODO: does this want to be a separate, user-configurable toggle?
-		log.Printf("[DEBUG] Reimaging Instance %q..", instanceId)
-		reimageInput := &compute.VirtualMachineScaleSetReimageParameters{
-			InstanceIds: &instanceIds,
+	batches := make([][]string, 0)
+	for start := 0; start < len(instanceIds); start += batchSize {
+		end := start + batchSize
+		if end > len(instanceIds) {
+			end = len(instanceIds)
 		}
-		reimageFuture, err := client.Reimage(ctx, id.ResourceGroup, id.Name, reimageInput)
-		if err != nil {
-			return fmt.Errorf("Error reimaging Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+
+		batches = append(batches, instanceIds[start:end])
+	}
+
+	return batches
+}
+
+func (p virtualMachineScaleSetInstanceRollPolicy) tooManyUnhealthyInstances(total, unhealthy int) bool {
+	if total == 0 {
+		return false
+	}
+
+	return unhealthy*100 > total*p.MaxUnhealthyInstancePercent
+}
+
+// virtualMachineScaleSetInstanceIdsToRoll returns the (sorted) ID's of the instances which aren't using the latest model
+func virtualMachineScaleSetInstanceIdsToRoll(instances []compute.VirtualMachineScaleSetVM) []string {
+	instanceIds := make([]string, 0)
+	for _, instance := range instances {
+		props := instance.VirtualMachineScaleSetVMProperties
+		if props == nil || instance.InstanceID == nil {
+			continue
 		}
 
-		if err = reimageFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
-			return fmt.Errorf("Error waiting for reimage of Instance %q (%s VM Scale Set %q / Resource Group %q): %+v", instanceId, metadata.OSType, id.Name, id.ResourceGroup, err)
+		if latestModel := props.LatestModelApplied; latestModel != nil && !*latestModel {
+			instanceIds = append(instanceIds, *instance.InstanceID)
 		}
-		log.Printf("[DEBUG] Reimaged Instance %q..", instanceId)
 	}
 
-	log.Printf("[DEBUG] Rolled the VM Instances for %s Virtual Machine Scale Set %q (Resource Group %q).", metadata.OSType, id.Name, id.ResourceGroup)
-	return nil
+	// the Instance ID's are numeric, so are sorted numerically where possible
+	sort.Slice(instanceIds, func(i, j int) bool {
+		first, firstErr := strconv.Atoi(instanceIds[i])
+		second, secondErr := strconv.Atoi(instanceIds[j])
+		if firstErr != nil || secondErr != nil {
+			return instanceIds[i] < instanceIds[j]
+		}
+
+		return first < second
+	})
+
+	return instanceIds
+}
+
+// virtualMachineScaleSetInstanceIsHealthy determines whether an instance is healthy from it's Instance View - that is
+// that it's provisioned and running, and (when the Application Health Extension is used) reporting as healthy
+func virtualMachineScaleSetInstanceIsHealthy(instanceView compute.VirtualMachineScaleSetVMInstanceView) bool {
+	if health := instanceView.VMHealth; health != nil && health.Status != nil && health.Status.Code != nil {
+		if strings.EqualFold(*health.Status.Code, "HealthState/unhealthy") {
+			return false
+		}
+	}
+
+	if instanceView.Statuses == nil {
+		return false
+	}
+
+	running := false
+	for _, status := range *instanceView.Statuses {
+		if status.Code == nil {
+			continue
+		}
+		code := strings.ToLower(*status.Code)
+
+		if strings.HasPrefix(code, "provisioningstate/") && code != "provisioningstate/succeeded" {
+			return false
+		}
+
+		if code == "powerstate/running" {
+			running = true
+		}
+	}
+
+	return running
 }
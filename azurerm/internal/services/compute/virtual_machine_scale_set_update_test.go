@@ -0,0 +1,277 @@
+package compute
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestVirtualMachineScaleSetInstanceIdsToRoll(t *testing.T) {
+	buildInstance := func(instanceId string, latestModelApplied *bool) compute.VirtualMachineScaleSetVM {
+		return compute.VirtualMachineScaleSetVM{
+			InstanceID: utils.String(instanceId),
+			VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+				LatestModelApplied: latestModelApplied,
+			},
+		}
+	}
+
+	testCases := []struct {
+		Name     string
+		Input    []compute.VirtualMachineScaleSetVM
+		Expected []string
+	}{
+		{
+			Name:     "None",
+			Input:    []compute.VirtualMachineScaleSetVM{},
+			Expected: []string{},
+		},
+		{
+			Name: "All Using The Latest Model",
+			Input: []compute.VirtualMachineScaleSetVM{
+				buildInstance("0", utils.Bool(true)),
+				buildInstance("1", utils.Bool(true)),
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "Some Using An Older Model",
+			Input: []compute.VirtualMachineScaleSetVM{
+				buildInstance("0", utils.Bool(false)),
+				buildInstance("1", utils.Bool(true)),
+				buildInstance("2", utils.Bool(false)),
+			},
+			Expected: []string{"0", "2"},
+		},
+		{
+			Name: "Unknown Model",
+			Input: []compute.VirtualMachineScaleSetVM{
+				buildInstance("0", nil),
+				{
+					InstanceID: utils.String("1"),
+				},
+				buildInstance("2", utils.Bool(false)),
+			},
+			Expected: []string{"2"},
+		},
+		{
+			Name: "Sorted Numerically",
+			Input: []compute.VirtualMachineScaleSetVM{
+				buildInstance("10", utils.Bool(false)),
+				buildInstance("2", utils.Bool(false)),
+				buildInstance("1", utils.Bool(false)),
+			},
+			Expected: []string{"1", "2", "10"},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := virtualMachineScaleSetInstanceIdsToRoll(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetInstanceRollPolicyBatches(t *testing.T) {
+	buildInstanceIds := func(count int) []string {
+		ids := make([]string, 0)
+		for i := 0; i < count; i++ {
+			ids = append(ids, string(rune('a'+i)))
+		}
+		return ids
+	}
+
+	testCases := []struct {
+		Name                    string
+		MaxBatchInstancePercent int
+		Instances               int
+		ExpectedBatchSizes      []int
+	}{
+		{
+			Name:               "No Instances",
+			Instances:          0,
+			ExpectedBatchSizes: []int{},
+		},
+		{
+			Name:               "One At A Time",
+			Instances:          3,
+			ExpectedBatchSizes: []int{1, 1, 1},
+		},
+		{
+			Name:                    "Twenty Percent",
+			MaxBatchInstancePercent: 20,
+			Instances:               10,
+			ExpectedBatchSizes:      []int{2, 2, 2, 2, 2},
+		},
+		{
+			Name:                    "Rounds Up",
+			MaxBatchInstancePercent: 20,
+			Instances:               11,
+			ExpectedBatchSizes:      []int{3, 3, 3, 2},
+		},
+		{
+			Name:                    "Small Percentage",
+			MaxBatchInstancePercent: 1,
+			Instances:               3,
+			ExpectedBatchSizes:      []int{1, 1, 1},
+		},
+		{
+			Name:                    "All At Once",
+			MaxBatchInstancePercent: 100,
+			Instances:               5,
+			ExpectedBatchSizes:      []int{5},
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		policy := virtualMachineScaleSetInstanceRollPolicy{
+			MaxBatchInstancePercent: v.MaxBatchInstancePercent,
+		}
+		input := buildInstanceIds(v.Instances)
+		batches := policy.batches(input)
+
+		actualSizes := make([]int, 0)
+		flattened := make([]string, 0)
+		for _, batch := range batches {
+			actualSizes = append(actualSizes, len(batch))
+			flattened = append(flattened, batch...)
+		}
+
+		if !reflect.DeepEqual(actualSizes, v.ExpectedBatchSizes) {
+			t.Fatalf("Expected batch sizes %+v but got %+v", v.ExpectedBatchSizes, actualSizes)
+		}
+		if !reflect.DeepEqual(flattened, input) {
+			t.Fatalf("Expected the batches to contain %+v in order but got %+v", input, flattened)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetInstanceRollPolicyTooManyUnhealthyInstances(t *testing.T) {
+	testCases := []struct {
+		MaxUnhealthyInstancePercent int
+		Total                       int
+		Unhealthy                   int
+		Expected                    bool
+	}{
+		{
+			MaxUnhealthyInstancePercent: 20,
+			Total:                       0,
+			Unhealthy:                   0,
+			Expected:                    false,
+		},
+		{
+			MaxUnhealthyInstancePercent: 20,
+			Total:                       10,
+			Unhealthy:                   2,
+			Expected:                    false,
+		},
+		{
+			MaxUnhealthyInstancePercent: 20,
+			Total:                       10,
+			Unhealthy:                   3,
+			Expected:                    true,
+		},
+		{
+			MaxUnhealthyInstancePercent: 0,
+			Total:                       10,
+			Unhealthy:                   1,
+			Expected:                    true,
+		},
+		{
+			MaxUnhealthyInstancePercent: 100,
+			Total:                       10,
+			Unhealthy:                   10,
+			Expected:                    false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %d of %d unhealthy with a max of %d%%..", v.Unhealthy, v.Total, v.MaxUnhealthyInstancePercent)
+
+		policy := virtualMachineScaleSetInstanceRollPolicy{
+			MaxUnhealthyInstancePercent: v.MaxUnhealthyInstancePercent,
+		}
+		if actual := policy.tooManyUnhealthyInstances(v.Total, v.Unhealthy); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestVirtualMachineScaleSetInstanceIsHealthy(t *testing.T) {
+	buildInstanceViewStatus := func(statuses ...string) *[]compute.InstanceViewStatus {
+		results := make([]compute.InstanceViewStatus, 0)
+
+		for _, v := range statuses {
+			results = append(results, compute.InstanceViewStatus{
+				Code: utils.String(v),
+			})
+		}
+
+		return &results
+	}
+
+	testCases := []struct {
+		Name     string
+		Statuses *[]compute.InstanceViewStatus
+		Health   string
+		Expected bool
+	}{
+		{
+			Name:     "No Statuses",
+			Expected: false,
+		},
+		{
+			Name:     "Provisioned and Running",
+			Statuses: buildInstanceViewStatus("ProvisioningState/succeeded", "PowerState/running"),
+			Expected: true,
+		},
+		{
+			Name:     "Provisioned and Stopped",
+			Statuses: buildInstanceViewStatus("ProvisioningState/succeeded", "PowerState/stopped"),
+			Expected: false,
+		},
+		{
+			Name:     "Failed Provisioning",
+			Statuses: buildInstanceViewStatus("ProvisioningState/failed/InternalOperationError", "PowerState/running"),
+			Expected: false,
+		},
+		{
+			Name:     "Running and Healthy",
+			Statuses: buildInstanceViewStatus("ProvisioningState/succeeded", "PowerState/running"),
+			Health:   "HealthState/healthy",
+			Expected: true,
+		},
+		{
+			Name:     "Running and Unhealthy",
+			Statuses: buildInstanceViewStatus("ProvisioningState/succeeded", "PowerState/running"),
+			Health:   "HealthState/unhealthy",
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		input := compute.VirtualMachineScaleSetVMInstanceView{
+			Statuses: v.Statuses,
+		}
+		if v.Health != "" {
+			input.VMHealth = &compute.VirtualMachineHealthStatus{
+				Status: &compute.InstanceViewStatus{
+					Code: utils.String(v.Health),
+				},
+			}
+		}
+
+		if actual := virtualMachineScaleSetInstanceIsHealthy(input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -116,5 +116,5 @@ func dataSourceContainerRegistryRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("admin_password", "")
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -303,7 +303,7 @@ func resourceContainerRegistryCreate(d *schema.ResourceData, meta interface{}) e
 			},
 		},
 
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("storage_account_id"); ok {
@@ -397,7 +397,7 @@ func resourceContainerRegistryUpdate(d *schema.ResourceData, meta interface{}) e
 				TrustPolicy:     trustPolicy,
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	// geo replication is only supported by Premium Sku
@@ -639,7 +639,7 @@ func resourceContainerRegistryRead(d *schema.ResourceData, meta interface{}) err
 		d.Set("georeplication_locations", georeplication_locations)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceContainerRegistryDelete(d *schema.ResourceData, meta interface{}) error {
@@ -135,7 +135,7 @@ func resourceContainerRegistryWebhookCreate(d *schema.ResourceData, meta interfa
 	webhook := containerregistry.WebhookCreateParameters{
 		Location:                          &location,
 		WebhookPropertiesCreateParameters: expandWebhookPropertiesCreateParameters(d),
-		Tags:                              tags.Expand(t),
+		Tags:                              tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, registryName, name, webhook)
@@ -181,7 +181,7 @@ func resourceContainerRegistryWebhookUpdate(d *schema.ResourceData, meta interfa
 
 	webhook := containerregistry.WebhookUpdateParameters{
 		WebhookPropertiesUpdateParameters: expandWebhookPropertiesUpdateParameters(d),
-		Tags:                              tags.Expand(t),
+		Tags:                              tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.Update(ctx, resourceGroup, registryName, name, webhook)
@@ -259,7 +259,7 @@ func resourceContainerRegistryWebhookRead(d *schema.ResourceData, meta interface
 		d.Set("actions", webhookActions)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceContainerRegistryWebhookDelete(d *schema.ResourceData, meta interface{}) error {
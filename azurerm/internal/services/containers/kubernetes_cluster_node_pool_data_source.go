@@ -286,5 +286,5 @@ func dataSourceKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interf
 		d.Set("vm_size", string(props.VMSize))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
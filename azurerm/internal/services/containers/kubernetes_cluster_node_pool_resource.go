@@ -300,7 +300,7 @@ func resourceKubernetesClusterNodePoolCreate(d *schema.ResourceData, meta interf
 		EnableNodePublicIP:     utils.Bool(d.Get("enable_node_public_ip").(bool)),
 		Mode:                   mode,
 		ScaleSetPriority:       containerservice.ScaleSetPriority(priority),
-		Tags:                   tags.Expand(t),
+		Tags:                   tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		Type:                   containerservice.VirtualMachineScaleSets,
 		VMSize:                 containerservice.VMSizeTypes(vmSize),
 		EnableEncryptionAtHost: utils.Bool(enableHostEncryption),
@@ -515,9 +515,9 @@ func resourceKubernetesClusterNodePoolUpdate(d *schema.ResourceData, meta interf
 		props.OrchestratorVersion = utils.String(orchestratorVersion)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
-		props.Tags = tags.Expand(t)
+		props.Tags = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 	}
 
 	if d.HasChange("upgrade_settings") {
@@ -695,7 +695,7 @@ func resourceKubernetesClusterNodePoolRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKubernetesClusterNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
@@ -820,7 +820,7 @@ func resourceKubernetesClusterCreate(d *schema.ResourceData, meta interface{}) e
 			NetworkProfile:         networkProfile,
 			NodeResourceGroup:      utils.String(nodeResourceGroup),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if v := d.Get("automatic_channel_upgrade").(string); v != "" {
@@ -1106,10 +1106,10 @@ func resourceKubernetesClusterUpdate(d *schema.ResourceData, meta interface{}) e
 		existing.ManagedClusterProperties.NetworkProfile.LoadBalancerProfile = &loadBalancerProfile
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		updateCluster = true
 		t := d.Get("tags").(map[string]interface{})
-		existing.Tags = tags.Expand(t)
+		existing.Tags = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 	}
 
 	if d.HasChange("windows_profile") {
@@ -1367,7 +1367,7 @@ func resourceKubernetesClusterRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("setting `kube_config`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKubernetesClusterDelete(d *schema.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ContainerGroupId{}
+var _ resourceid.SegmentedID = ContainerGroupId{}
 
 func TestContainerGroupIDFormatter(t *testing.T) {
 	actual := NewContainerGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "containerGroup1").ID()
@@ -18,6 +19,43 @@ func TestContainerGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestContainerGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerInstance/containerGroups/containerGroup1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.CONTAINERINSTANCE/CONTAINERGROUPS/containerGroup1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerInstance/containerGroups/containerGroup1other",
+			Expected: false,
+		},
+	}
+
+	id := NewContainerGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "containerGroup1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestContainerGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NodePoolId struct {
@@ -25,6 +25,16 @@ func NewNodePoolID(subscriptionId, resourceGroup, managedClusterName, agentPoolN
 	}
 }
 
+// NewNodePoolIDFromParent returns a NodePoolId for the NodePool within the specified Cluster
+func NewNodePoolIDFromParent(parent ClusterId, agentPoolName string) NodePoolId {
+	return NodePoolId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		ManagedClusterName: parent.ManagedClusterName,
+		AgentPoolName:      agentPoolName,
+	}
+}
+
 func (id NodePoolId) String() string {
 	segments := []string{
 		fmt.Sprintf("Agent Pool Name %q", id.AgentPoolName),
@@ -40,35 +50,40 @@ func (id NodePoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ManagedClusterName, id.AgentPoolName)
 }
 
+// Matches returns whether the specified Resource ID refers to this NodePool
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id NodePoolId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this NodePool ID
+func (id NodePoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftContainerService", "Microsoft.ContainerService"),
+		resourceid.StaticSegment("staticManagedClusters", "managedClusters", false),
+		resourceid.UserSpecifiedSegment("managedClusterName"),
+		resourceid.StaticSegment("staticAgentPools", "agentPools", false),
+		resourceid.UserSpecifiedSegment("agentPoolName"),
+	}
+}
+
 // NodePoolID parses a NodePool ID into an NodePoolId struct
 func NodePoolID(input string) (*NodePoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, NodePoolId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NodePoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ManagedClusterName, err = id.PopSegment("managedClusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.AgentPoolName, err = id.PopSegment("agentPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		ManagedClusterName: values["managedClusterName"],
+		AgentPoolName:      values["agentPoolName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = NodePoolId{}
+var _ resourceid.SegmentedID = NodePoolId{}
 
 func TestNodePoolIDFormatter(t *testing.T) {
 	actual := NewNodePoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1", "pool1").ID()
@@ -18,6 +19,52 @@ func TestNodePoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNodePoolIDFromParent(t *testing.T) {
+	parent := NewClusterID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1")
+	actual := NewNodePoolIDFromParent(parent, "pool1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1/agentPools/pool1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNodePoolIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1/agentPools/pool1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS/cluster1/AGENTPOOLS/pool1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ContainerService/managedClusters/cluster1/agentPools/pool1other",
+			Expected: false,
+		},
+	}
+
+	id := NewNodePoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1", "pool1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestNodePoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,28 @@
+package containers
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.ContainerService/managedClusters",
+			TerraformResourceType: "azurerm_kubernetes_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ContainerService/managedClusters/agentPools",
+			TerraformResourceType: "azurerm_kubernetes_cluster_node_pool",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NodePoolID(input)
+			},
+		},
+	}
+}
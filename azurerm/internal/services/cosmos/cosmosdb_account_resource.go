@@ -435,7 +435,7 @@ func resourceCosmosDbAccountCreate(d *schema.ResourceData, meta interface{}) err
 			PublicNetworkAccess:           publicNetworkAccess,
 			EnableAnalyticalStorage:       utils.Bool(enableAnalyticalStorage),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if keyVaultKeyIDRaw, ok := d.GetOk("key_vault_key_id"); ok {
@@ -543,7 +543,7 @@ func resourceCosmosDbAccountUpdate(d *schema.ResourceData, meta interface{}) err
 			PublicNetworkAccess:           publicNetworkAccess,
 			EnableAnalyticalStorage:       utils.Bool(enableAnalyticalStorage),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if keyVaultKeyIDRaw, ok := d.GetOk("key_vault_key_id"); ok {
@@ -755,7 +755,7 @@ func resourceCosmosDbAccountRead(d *schema.ResourceData, meta interface{}) error
 	}
 	d.Set("connection_strings", connStrings)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceCosmosDbAccountDelete(d *schema.ResourceData, meta interface{}) error {
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CassandraKeyspaceId struct {
@@ -25,6 +25,16 @@ func NewCassandraKeyspaceID(subscriptionId, resourceGroup, databaseAccountName,
 	}
 }
 
+// NewCassandraKeyspaceIDFromParent returns a CassandraKeyspaceId for the CassandraKeyspace within the specified DatabaseAccount
+func NewCassandraKeyspaceIDFromParent(parent DatabaseAccountId, name string) CassandraKeyspaceId {
+	return CassandraKeyspaceId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		DatabaseAccountName: parent.Name,
+		Name:                name,
+	}
+}
+
 func (id CassandraKeyspaceId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id CassandraKeyspaceId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this CassandraKeyspace
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id CassandraKeyspaceId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this CassandraKeyspace ID
+func (id CassandraKeyspaceId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB"),
+		resourceid.StaticSegment("staticDatabaseAccounts", "databaseAccounts", false),
+		resourceid.UserSpecifiedSegment("databaseAccountName"),
+		resourceid.StaticSegment("staticCassandraKeyspaces", "cassandraKeyspaces", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // CassandraKeyspaceID parses a CassandraKeyspace ID into an CassandraKeyspaceId struct
 func CassandraKeyspaceID(input string) (*CassandraKeyspaceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, CassandraKeyspaceId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CassandraKeyspaceId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("cassandraKeyspaces"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		DatabaseAccountName: values["databaseAccountName"],
+		Name:                values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = CassandraKeyspaceId{}
+var _ resourceid.SegmentedID = CassandraKeyspaceId{}
 
 func TestCassandraKeyspaceIDFormatter(t *testing.T) {
 	actual := NewCassandraKeyspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "keyspace1").ID()
@@ -18,6 +19,52 @@ func TestCassandraKeyspaceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCassandraKeyspaceIDFromParent(t *testing.T) {
+	parent := NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1")
+	actual := NewCassandraKeyspaceIDFromParent(parent, "keyspace1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/cassandraKeyspaces/keyspace1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestCassandraKeyspaceIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/cassandraKeyspaces/keyspace1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/acc1/CASSANDRAKEYSPACES/keyspace1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1/cassandraKeyspaces/keyspace1other",
+			Expected: false,
+		},
+	}
+
+	id := NewCassandraKeyspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1", "keyspace1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestCassandraKeyspaceID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CassandraTableId struct {
@@ -27,6 +27,17 @@ func NewCassandraTableID(subscriptionId, resourceGroup, databaseAccountName, cas
 	}
 }
 
+// NewCassandraTableIDFromParent returns a CassandraTableId for the CassandraTable within the specified CassandraKeyspace
+func NewCassandraTableIDFromParent(parent CassandraKeyspaceId, tableName string) CassandraTableId {
+	return CassandraTableId{
+		SubscriptionId:        parent.SubscriptionId,
+		ResourceGroup:         parent.ResourceGroup,
+		DatabaseAccountName:   parent.DatabaseAccountName,
+		CassandraKeyspaceName: parent.Name,
+		TableName:             tableName,
+	}
+}
+
 func (id CassandraTableId) String() string {
 	segments := []string{
 		fmt.Sprintf("Table Name %q", id.TableName),
@@ -43,38 +54,43 @@ func (id CassandraTableId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.CassandraKeyspaceName, id.TableName)
 }
 
+// Matches returns whether the specified Resource ID refers to this CassandraTable
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id CassandraTableId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this CassandraTable ID
+func (id CassandraTableId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB"),
+		resourceid.StaticSegment("staticDatabaseAccounts", "databaseAccounts", false),
+		resourceid.UserSpecifiedSegment("databaseAccountName"),
+		resourceid.StaticSegment("staticCassandraKeyspaces", "cassandraKeyspaces", false),
+		resourceid.UserSpecifiedSegment("cassandraKeyspaceName"),
+		resourceid.StaticSegment("staticTables", "tables", false),
+		resourceid.UserSpecifiedSegment("tableName"),
+	}
+}
+
 // CassandraTableID parses a CassandraTable ID into an CassandraTableId struct
 func CassandraTableID(input string) (*CassandraTableId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, CassandraTableId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CassandraTableId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.CassandraKeyspaceName, err = id.PopSegment("cassandraKeyspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.TableName, err = id.PopSegment("tables"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        values["subscriptionId"],
+		ResourceGroup:         values["resourceGroup"],
+		DatabaseAccountName:   values["databaseAccountName"],
+		CassandraKeyspaceName: values["cassandraKeyspaceName"],
+		TableName:             values["tableName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DatabaseAccountId{}
+var _ resourceid.SegmentedID = DatabaseAccountId{}
 
 func TestDatabaseAccountIDFormatter(t *testing.T) {
 	actual := NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1").ID()
@@ -18,6 +19,43 @@ func TestDatabaseAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDatabaseAccountIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/acc1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DocumentDB/databaseAccounts/acc1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDatabaseAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "acc1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDatabaseAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
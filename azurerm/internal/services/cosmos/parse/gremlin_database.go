@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GremlinDatabaseId struct {
@@ -25,6 +25,16 @@ func NewGremlinDatabaseID(subscriptionId, resourceGroup, databaseAccountName, na
 	}
 }
 
+// NewGremlinDatabaseIDFromParent returns a GremlinDatabaseId for the GremlinDatabase within the specified DatabaseAccount
+func NewGremlinDatabaseIDFromParent(parent DatabaseAccountId, name string) GremlinDatabaseId {
+	return GremlinDatabaseId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		DatabaseAccountName: parent.Name,
+		Name:                name,
+	}
+}
+
 func (id GremlinDatabaseId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id GremlinDatabaseId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this GremlinDatabase
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id GremlinDatabaseId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this GremlinDatabase ID
+func (id GremlinDatabaseId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB"),
+		resourceid.StaticSegment("staticDatabaseAccounts", "databaseAccounts", false),
+		resourceid.UserSpecifiedSegment("databaseAccountName"),
+		resourceid.StaticSegment("staticGremlinDatabases", "gremlinDatabases", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // GremlinDatabaseID parses a GremlinDatabase ID into an GremlinDatabaseId struct
 func GremlinDatabaseID(input string) (*GremlinDatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, GremlinDatabaseId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := GremlinDatabaseId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("gremlinDatabases"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		DatabaseAccountName: values["databaseAccountName"],
+		Name:                values["name"],
 	}
 
 	return &resourceId, nil
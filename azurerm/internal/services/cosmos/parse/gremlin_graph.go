@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type GremlinGraphId struct {
@@ -27,6 +27,17 @@ func NewGremlinGraphID(subscriptionId, resourceGroup, databaseAccountName, greml
 	}
 }
 
+// NewGremlinGraphIDFromParent returns a GremlinGraphId for the GremlinGraph within the specified GremlinDatabase
+func NewGremlinGraphIDFromParent(parent GremlinDatabaseId, graphName string) GremlinGraphId {
+	return GremlinGraphId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		DatabaseAccountName: parent.DatabaseAccountName,
+		GremlinDatabaseName: parent.Name,
+		GraphName:           graphName,
+	}
+}
+
 func (id GremlinGraphId) String() string {
 	segments := []string{
 		fmt.Sprintf("Graph Name %q", id.GraphName),
@@ -43,38 +54,43 @@ func (id GremlinGraphId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.GremlinDatabaseName, id.GraphName)
 }
 
+// Matches returns whether the specified Resource ID refers to this GremlinGraph
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id GremlinGraphId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this GremlinGraph ID
+func (id GremlinGraphId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB"),
+		resourceid.StaticSegment("staticDatabaseAccounts", "databaseAccounts", false),
+		resourceid.UserSpecifiedSegment("databaseAccountName"),
+		resourceid.StaticSegment("staticGremlinDatabases", "gremlinDatabases", false),
+		resourceid.UserSpecifiedSegment("gremlinDatabaseName"),
+		resourceid.StaticSegment("staticGraphs", "graphs", false),
+		resourceid.UserSpecifiedSegment("graphName"),
+	}
+}
+
 // GremlinGraphID parses a GremlinGraph ID into an GremlinGraphId struct
 func GremlinGraphID(input string) (*GremlinGraphId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, GremlinGraphId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := GremlinGraphId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.GremlinDatabaseName, err = id.PopSegment("gremlinDatabases"); err != nil {
-		return nil, err
-	}
-	if resourceId.GraphName, err = id.PopSegment("graphs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		DatabaseAccountName: values["databaseAccountName"],
+		GremlinDatabaseName: values["gremlinDatabaseName"],
+		GraphName:           values["graphName"],
 	}
 
 	return &resourceId, nil
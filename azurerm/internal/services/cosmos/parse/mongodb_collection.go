@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MongodbCollectionId struct {
@@ -27,6 +27,17 @@ func NewMongodbCollectionID(subscriptionId, resourceGroup, databaseAccountName,
 	}
 }
 
+// NewMongodbCollectionIDFromParent returns a MongodbCollectionId for the MongodbCollection within the specified MongodbDatabase
+func NewMongodbCollectionIDFromParent(parent MongodbDatabaseId, collectionName string) MongodbCollectionId {
+	return MongodbCollectionId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		DatabaseAccountName: parent.DatabaseAccountName,
+		MongodbDatabaseName: parent.Name,
+		CollectionName:      collectionName,
+	}
+}
+
 func (id MongodbCollectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Collection Name %q", id.CollectionName),
@@ -43,38 +54,43 @@ func (id MongodbCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.MongodbDatabaseName, id.CollectionName)
 }
 
+// Matches returns whether the specified Resource ID refers to this MongodbCollection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id MongodbCollectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this MongodbCollection ID
+func (id MongodbCollectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB"),
+		resourceid.StaticSegment("staticDatabaseAccounts", "databaseAccounts", false),
+		resourceid.UserSpecifiedSegment("databaseAccountName"),
+		resourceid.StaticSegment("staticMongodbDatabases", "mongodbDatabases", false),
+		resourceid.UserSpecifiedSegment("mongodbDatabaseName"),
+		resourceid.StaticSegment("staticCollections", "collections", false),
+		resourceid.UserSpecifiedSegment("collectionName"),
+	}
+}
+
 // MongodbCollectionID parses a MongodbCollection ID into an MongodbCollectionId struct
 func MongodbCollectionID(input string) (*MongodbCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, MongodbCollectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := MongodbCollectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.MongodbDatabaseName, err = id.PopSegment("mongodbDatabases"); err != nil {
-		return nil, err
-	}
-	if resourceId.CollectionName, err = id.PopSegment("collections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		DatabaseAccountName: values["databaseAccountName"],
+		MongodbDatabaseName: values["mongodbDatabaseName"],
+		CollectionName:      values["collectionName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MongodbDatabaseId struct {
@@ -25,6 +25,16 @@ func NewMongodbDatabaseID(subscriptionId, resourceGroup, databaseAccountName, na
 	}
 }
 
+// NewMongodbDatabaseIDFromParent returns a MongodbDatabaseId for the MongodbDatabase within the specified DatabaseAccount
+func NewMongodbDatabaseIDFromParent(parent DatabaseAccountId, name string) MongodbDatabaseId {
+	return MongodbDatabaseId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		DatabaseAccountName: parent.Name,
+		Name:                name,
+	}
+}
+
 func (id MongodbDatabaseId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id MongodbDatabaseId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this MongodbDatabase
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id MongodbDatabaseId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this MongodbDatabase ID
+func (id MongodbDatabaseId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB"),
+		resourceid.StaticSegment("staticDatabaseAccounts", "databaseAccounts", false),
+		resourceid.UserSpecifiedSegment("databaseAccountName"),
+		resourceid.StaticSegment("staticMongodbDatabases", "mongodbDatabases", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // MongodbDatabaseID parses a MongodbDatabase ID into an MongodbDatabaseId struct
 func MongodbDatabaseID(input string) (*MongodbDatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, MongodbDatabaseId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := MongodbDatabaseId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("mongodbDatabases"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		DatabaseAccountName: values["databaseAccountName"],
+		Name:                values["name"],
 	}
 
 	return &resourceId, nil
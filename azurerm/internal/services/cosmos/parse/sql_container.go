@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlContainerId struct {
@@ -27,6 +27,17 @@ func NewSqlContainerID(subscriptionId, resourceGroup, databaseAccountName, sqlDa
 	}
 }
 
+// NewSqlContainerIDFromParent returns a SqlContainerId for the SqlContainer within the specified SqlDatabase
+func NewSqlContainerIDFromParent(parent SqlDatabaseId, containerName string) SqlContainerId {
+	return SqlContainerId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		DatabaseAccountName: parent.DatabaseAccountName,
+		SqlDatabaseName:     parent.Name,
+		ContainerName:       containerName,
+	}
+}
+
 func (id SqlContainerId) String() string {
 	segments := []string{
 		fmt.Sprintf("Container Name %q", id.ContainerName),
@@ -43,38 +54,43 @@ func (id SqlContainerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SqlContainer
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SqlContainerId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SqlContainer ID
+func (id SqlContainerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB"),
+		resourceid.StaticSegment("staticDatabaseAccounts", "databaseAccounts", false),
+		resourceid.UserSpecifiedSegment("databaseAccountName"),
+		resourceid.StaticSegment("staticSqlDatabases", "sqlDatabases", false),
+		resourceid.UserSpecifiedSegment("sqlDatabaseName"),
+		resourceid.StaticSegment("staticContainers", "containers", false),
+		resourceid.UserSpecifiedSegment("containerName"),
+	}
+}
+
 // SqlContainerID parses a SqlContainer ID into an SqlContainerId struct
 func SqlContainerID(input string) (*SqlContainerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SqlContainerId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SqlContainerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.SqlDatabaseName, err = id.PopSegment("sqlDatabases"); err != nil {
-		return nil, err
-	}
-	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		DatabaseAccountName: values["databaseAccountName"],
+		SqlDatabaseName:     values["sqlDatabaseName"],
+		ContainerName:       values["containerName"],
 	}
 
 	return &resourceId, nil
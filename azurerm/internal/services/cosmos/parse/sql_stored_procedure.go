@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SqlStoredProcedureId struct {
@@ -29,6 +29,18 @@ func NewSqlStoredProcedureID(subscriptionId, resourceGroup, databaseAccountName,
 	}
 }
 
+// NewSqlStoredProcedureIDFromParent returns a SqlStoredProcedureId for the SqlStoredProcedure within the specified SqlContainer
+func NewSqlStoredProcedureIDFromParent(parent SqlContainerId, storedProcedureName string) SqlStoredProcedureId {
+	return SqlStoredProcedureId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		DatabaseAccountName: parent.DatabaseAccountName,
+		SqlDatabaseName:     parent.SqlDatabaseName,
+		ContainerName:       parent.ContainerName,
+		StoredProcedureName: storedProcedureName,
+	}
+}
+
 func (id SqlStoredProcedureId) String() string {
 	segments := []string{
 		fmt.Sprintf("Stored Procedure Name %q", id.StoredProcedureName),
@@ -46,41 +58,46 @@ func (id SqlStoredProcedureId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, id.StoredProcedureName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SqlStoredProcedure
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SqlStoredProcedureId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SqlStoredProcedure ID
+func (id SqlStoredProcedureId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDocumentDB", "Microsoft.DocumentDB"),
+		resourceid.StaticSegment("staticDatabaseAccounts", "databaseAccounts", false),
+		resourceid.UserSpecifiedSegment("databaseAccountName"),
+		resourceid.StaticSegment("staticSqlDatabases", "sqlDatabases", false),
+		resourceid.UserSpecifiedSegment("sqlDatabaseName"),
+		resourceid.StaticSegment("staticContainers", "containers", false),
+		resourceid.UserSpecifiedSegment("containerName"),
+		resourceid.StaticSegment("staticStoredProcedures", "storedProcedures", false),
+		resourceid.UserSpecifiedSegment("storedProcedureName"),
+	}
+}
+
 // SqlStoredProcedureID parses a SqlStoredProcedure ID into an SqlStoredProcedureId struct
 func SqlStoredProcedureID(input string) (*SqlStoredProcedureId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SqlStoredProcedureId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SqlStoredProcedureId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.SqlDatabaseName, err = id.PopSegment("sqlDatabases"); err != nil {
-		return nil, err
-	}
-	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
-		return nil, err
-	}
-	if resourceId.StoredProcedureName, err = id.PopSegment("storedProcedures"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		DatabaseAccountName: values["databaseAccountName"],
+		SqlDatabaseName:     values["sqlDatabaseName"],
+		ContainerName:       values["containerName"],
+		StoredProcedureName: values["storedProcedureName"],
 	}
 
 	return &resourceId, nil
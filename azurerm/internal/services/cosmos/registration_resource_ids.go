@@ -0,0 +1,91 @@
+package cosmos
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts",
+			TerraformResourceType: "azurerm_cosmosdb_account",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabaseAccountID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces",
+			TerraformResourceType: "azurerm_cosmosdb_cassandra_keyspace",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CassandraKeyspaceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/cassandraKeyspaces/tables",
+			TerraformResourceType: "azurerm_cosmosdb_cassandra_table",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CassandraTableID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/gremlinDatabases",
+			TerraformResourceType: "azurerm_cosmosdb_gremlin_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.GremlinDatabaseID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/gremlinDatabases/graphs",
+			TerraformResourceType: "azurerm_cosmosdb_gremlin_graph",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.GremlinGraphID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/mongodbDatabases/collections",
+			TerraformResourceType: "azurerm_cosmosdb_mongo_collection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.MongodbCollectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/mongodbDatabases",
+			TerraformResourceType: "azurerm_cosmosdb_mongo_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.MongodbDatabaseID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers",
+			TerraformResourceType: "azurerm_cosmosdb_sql_container",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SqlContainerID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/sqlDatabases",
+			TerraformResourceType: "azurerm_cosmosdb_sql_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SqlDatabaseID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers/storedProcedures",
+			TerraformResourceType: "azurerm_cosmosdb_sql_stored_procedure",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SqlStoredProcedureID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DocumentDB/databaseAccounts/tables",
+			TerraformResourceType: "azurerm_cosmosdb_table",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.TableID(input)
+			},
+		},
+	}
+}
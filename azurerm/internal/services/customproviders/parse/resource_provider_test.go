@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ResourceProviderId{}
+var _ resourceid.SegmentedID = ResourceProviderId{}
 
 func TestResourceProviderIDFormatter(t *testing.T) {
 	actual := NewResourceProviderID("12345678-1234-9876-4563-123456789012", "resGroup1", "provider1").ID()
@@ -18,6 +19,43 @@ func TestResourceProviderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceProviderIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CustomProviders/resourceproviders/provider1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.CUSTOMPROVIDERS/RESOURCEPROVIDERS/provider1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.CustomProviders/resourceproviders/provider1other",
+			Expected: false,
+		},
+	}
+
+	id := NewResourceProviderID("12345678-1234-9876-4563-123456789012", "resGroup1", "provider1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestResourceProviderID(t *testing.T) {
 	testData := []struct {
 		Input    string
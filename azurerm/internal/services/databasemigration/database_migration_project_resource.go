@@ -113,7 +113,7 @@ func resourceDatabaseMigrationProjectCreateUpdate(d *schema.ResourceData, meta i
 			SourcePlatform: datamigration.ProjectSourcePlatform(sourcePlatform),
 			TargetPlatform: datamigration.ProjectTargetPlatform(targetPlatform),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, parameters, resourceGroup, serviceName, name); err != nil {
@@ -167,7 +167,7 @@ func resourceDatabaseMigrationProjectRead(d *schema.ResourceData, meta interface
 		d.Set("target_platform", string(prop.TargetPlatform))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDatabaseMigrationProjectDelete(d *schema.ResourceData, meta interface{}) error {
@@ -80,5 +80,5 @@ func dataSourceDatabaseMigrationServiceRead(d *schema.ResourceData, meta interfa
 		d.Set("sku_name", resp.Sku.Name)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
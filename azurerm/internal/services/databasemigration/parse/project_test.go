@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ProjectId{}
+var _ resourceid.SegmentedID = ProjectId{}
 
 func TestProjectIDFormatter(t *testing.T) {
 	actual := NewProjectID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "project1").ID()
@@ -18,6 +19,52 @@ func TestProjectIDFormatter(t *testing.T) {
 	}
 }
 
+func TestProjectIDFromParent(t *testing.T) {
+	parent := NewServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1")
+	actual := NewProjectIDFromParent(parent, "project1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataMigration/services/service1/projects/project1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestProjectIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataMigration/services/service1/projects/project1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DATAMIGRATION/SERVICES/service1/PROJECTS/project1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataMigration/services/service1/projects/project1other",
+			Expected: false,
+		},
+	}
+
+	id := NewProjectID("12345678-1234-9876-4563-123456789012", "resGroup1", "service1", "project1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestProjectID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,28 @@
+package databasemigration
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/databasemigration/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.DataMigration/services/projects",
+			TerraformResourceType: "azurerm_database_migration_project",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ProjectID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataMigration/services",
+			TerraformResourceType: "azurerm_database_migration_service",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServiceID(input)
+			},
+		},
+	}
+}
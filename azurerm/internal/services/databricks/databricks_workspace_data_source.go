@@ -75,5 +75,5 @@ func dataSourceDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{})
 		d.Set("workspace_url", props.WorkspaceURL)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
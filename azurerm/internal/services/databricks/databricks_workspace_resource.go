@@ -170,7 +170,7 @@ func resourceDatabricksWorkspaceCreateUpdate(d *schema.ResourceData, meta interf
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 
 	if managedResourceGroupName == "" {
 		// no managed resource group name was provided, we use the default pattern
@@ -266,7 +266,7 @@ func resourceDatabricksWorkspaceRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("workspace_id", props.WorkspaceID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDatabricksWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
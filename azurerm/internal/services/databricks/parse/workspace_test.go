@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = WorkspaceId{}
+var _ resourceid.SegmentedID = WorkspaceId{}
 
 func TestWorkspaceIDFormatter(t *testing.T) {
 	actual := NewWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1").ID()
@@ -18,6 +19,43 @@ func TestWorkspaceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWorkspaceIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Databricks/workspaces/workspace1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DATABRICKS/WORKSPACES/workspace1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Databricks/workspaces/workspace1other",
+			Expected: false,
+		},
+	}
+
+	id := NewWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestWorkspaceID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -172,5 +172,5 @@ func dataSourceDataFactoryRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error flattening `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
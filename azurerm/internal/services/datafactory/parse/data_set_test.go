@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DataSetId{}
+var _ resourceid.SegmentedID = DataSetId{}
 
 func TestDataSetIDFormatter(t *testing.T) {
 	actual := NewDataSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "facName1", "dataSet1").ID()
@@ -18,6 +19,43 @@ func TestDataSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDataSetIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/facName1/datasets/dataSet1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DATAFACTORY/FACTORIES/facName1/DATASETS/dataSet1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/facName1/datasets/dataSet1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDataSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "facName1", "dataSet1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDataSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = IntegrationRuntimeId{}
+var _ resourceid.SegmentedID = IntegrationRuntimeId{}
 
 func TestIntegrationRuntimeIDFormatter(t *testing.T) {
 	actual := NewIntegrationRuntimeID("12345678-1234-9876-4563-123456789012", "resGroup1", "factory1", "runtime1").ID()
@@ -18,6 +19,43 @@ func TestIntegrationRuntimeIDFormatter(t *testing.T) {
 	}
 }
 
+func TestIntegrationRuntimeIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/integrationruntimes/runtime1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DATAFACTORY/FACTORIES/factory1/INTEGRATIONRUNTIMES/runtime1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataFactory/factories/factory1/integrationruntimes/runtime1other",
+			Expected: false,
+		},
+	}
+
+	id := NewIntegrationRuntimeID("12345678-1234-9876-4563-123456789012", "resGroup1", "factory1", "runtime1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestIntegrationRuntimeID(t *testing.T) {
 	testData := []struct {
 		Input    string
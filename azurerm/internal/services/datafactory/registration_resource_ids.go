@@ -0,0 +1,56 @@
+package datafactory
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datafactory/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.DataFactory/factories/datasets",
+			TerraformResourceType: "azurerm_data_factory_dataset_delimited_text",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataFactory/factories/integrationruntimes",
+			TerraformResourceType: "azurerm_data_factory_integration_runtime_self_hosted",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IntegrationRuntimeID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataFactory/factories/linkedservices",
+			TerraformResourceType: "azurerm_data_factory_linked_service_azure_sql_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LinkedServiceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataFactory/factories/linkedservices",
+			TerraformResourceType: "azurerm_data_factory_linked_service_snowflake",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LinkedServiceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataFactory/factories/linkedservices",
+			TerraformResourceType: "azurerm_data_factory_linked_service_sql_server",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LinkedServiceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataFactory/factories/linkedservices",
+			TerraformResourceType: "azurerm_data_factory_linked_service_synapse",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LinkedServiceID(input)
+			},
+		},
+	}
+}
@@ -96,5 +96,5 @@ func dataSourceArmDateLakeStoreAccountRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AccountId{}
+var _ resourceid.SegmentedID = AccountId{}
 
 func TestAccountIDFormatter(t *testing.T) {
 	actual := NewAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1").ID()
@@ -18,6 +19,43 @@ func TestAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAccountIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeStore/accounts/account1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DATALAKESTORE/ACCOUNTS/account1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataLakeStore/accounts/account1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -105,7 +105,7 @@ func resourceDataShareAccountCreate(d *schema.ResourceData, meta interface{}) er
 		Name:     utils.String(name),
 		Location: utils.String(location.Normalize(d.Get("location").(string))),
 		Identity: expandAzureRmDataShareAccountIdentity(d.Get("identity").([]interface{})),
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, account)
@@ -157,7 +157,7 @@ func resourceDataShareAccountRead(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDataShareAccountUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -172,8 +172,8 @@ func resourceDataShareAccountUpdate(d *schema.ResourceData, meta interface{}) er
 
 	props := datashare.AccountUpdateParameters{}
 
-	if d.HasChange("tags") {
-		props.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		props.Tags = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if _, err = client.Update(ctx, id.ResourceGroup, id.Name, props); err != nil {
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataSetId struct {
@@ -27,6 +27,17 @@ func NewDataSetID(subscriptionId, resourceGroup, accountName, shareName, name st
 	}
 }
 
+// NewDataSetIDFromParent returns a DataSetId for the DataSet within the specified Share
+func NewDataSetIDFromParent(parent ShareId, name string) DataSetId {
+	return DataSetId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		AccountName:    parent.AccountName,
+		ShareName:      parent.Name,
+		Name:           name,
+	}
+}
+
 func (id DataSetId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -43,38 +54,43 @@ func (id DataSetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AccountName, id.ShareName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this DataSet
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DataSetId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DataSet ID
+func (id DataSetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDataShare", "Microsoft.DataShare"),
+		resourceid.StaticSegment("staticAccounts", "accounts", false),
+		resourceid.UserSpecifiedSegment("accountName"),
+		resourceid.StaticSegment("staticShares", "shares", false),
+		resourceid.UserSpecifiedSegment("shareName"),
+		resourceid.StaticSegment("staticDataSets", "dataSets", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // DataSetID parses a DataSet ID into an DataSetId struct
 func DataSetID(input string) (*DataSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DataSetId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DataSetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.ShareName, err = id.PopSegment("shares"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("dataSets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		AccountName:    values["accountName"],
+		ShareName:      values["shareName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ShareId struct {
@@ -25,6 +25,16 @@ func NewShareID(subscriptionId, resourceGroup, accountName, name string) ShareId
 	}
 }
 
+// NewShareIDFromParent returns a ShareId for the Share within the specified Account
+func NewShareIDFromParent(parent AccountId, name string) ShareId {
+	return ShareId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		AccountName:    parent.Name,
+		Name:           name,
+	}
+}
+
 func (id ShareId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id ShareId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AccountName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Share
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ShareId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Share ID
+func (id ShareId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDataShare", "Microsoft.DataShare"),
+		resourceid.StaticSegment("staticAccounts", "accounts", false),
+		resourceid.UserSpecifiedSegment("accountName"),
+		resourceid.StaticSegment("staticShares", "shares", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // ShareID parses a Share ID into an ShareId struct
 func ShareID(input string) (*ShareId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ShareId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ShareId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("shares"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		AccountName:    values["accountName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ShareId{}
+var _ resourceid.SegmentedID = ShareId{}
 
 func TestShareIDFormatter(t *testing.T) {
 	actual := NewShareID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "share1").ID()
@@ -18,6 +19,52 @@ func TestShareIDFormatter(t *testing.T) {
 	}
 }
 
+func TestShareIDFromParent(t *testing.T) {
+	parent := NewAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1")
+	actual := NewShareIDFromParent(parent, "share1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataShare/accounts/account1/shares/share1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestShareIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataShare/accounts/account1/shares/share1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DATASHARE/ACCOUNTS/account1/SHARES/share1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DataShare/accounts/account1/shares/share1other",
+			Expected: false,
+		},
+	}
+
+	id := NewShareID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "share1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestShareID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,63 @@
+package datashare
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datashare/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.DataShare/accounts/shares",
+			TerraformResourceType: "azurerm_data_share",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ShareID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataShare/accounts",
+			TerraformResourceType: "azurerm_data_share_account",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AccountID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataShare/accounts/shares/dataSets",
+			TerraformResourceType: "azurerm_data_share_dataset_blob_storage",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataShare/accounts/shares/dataSets",
+			TerraformResourceType: "azurerm_data_share_dataset_data_lake_gen1",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataShare/accounts/shares/dataSets",
+			TerraformResourceType: "azurerm_data_share_dataset_data_lake_gen2",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataShare/accounts/shares/dataSets",
+			TerraformResourceType: "azurerm_data_share_dataset_kusto_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DataShare/accounts/shares/dataSets",
+			TerraformResourceType: "azurerm_data_share_dataset_kusto_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataSetID(input)
+			},
+		},
+	}
+}
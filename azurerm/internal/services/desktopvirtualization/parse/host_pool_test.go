@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = HostPoolId{}
+var _ resourceid.SegmentedID = HostPoolId{}
 
 func TestHostPoolIDFormatter(t *testing.T) {
 	actual := NewHostPoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "pool1").ID()
@@ -18,6 +19,43 @@ func TestHostPoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHostPoolIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DesktopVirtualization/hostPools/pool1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DESKTOPVIRTUALIZATION/HOSTPOOLS/pool1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DesktopVirtualization/hostPools/pool1other",
+			Expected: false,
+		},
+	}
+
+	id := NewHostPoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "pool1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestHostPoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
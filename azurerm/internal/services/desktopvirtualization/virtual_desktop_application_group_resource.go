@@ -137,7 +137,7 @@ func resourceVirtualDesktopApplicationGroupCreateUpdate(d *schema.ResourceData,
 
 	context := desktopvirtualization.ApplicationGroup{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		ApplicationGroupProperties: &desktopvirtualization.ApplicationGroupProperties{
 			ApplicationGroupType: desktopvirtualization.ApplicationGroupType(d.Get("type").(string)),
 			FriendlyName:         utils.String(d.Get("friendly_name").(string)),
@@ -200,7 +200,7 @@ func resourceVirtualDesktopApplicationGroupRead(d *schema.ResourceData, meta int
 		d.Set("host_pool_id", hostPoolIdStr)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualDesktopApplicationGroupDelete(d *schema.ResourceData, meta interface{}) error {
@@ -131,7 +131,7 @@ func resourceDevSpaceControllerCreate(d *schema.ResourceData, meta interface{})
 			TargetContainerHostResourceID:        utils.String(d.Get("target_container_host_resource_id").(string)),
 			TargetContainerHostCredentialsBase64: utils.String(d.Get("target_container_host_credentials_base64").(string)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, controller)
@@ -168,7 +168,7 @@ func resourceDevSpaceControllerUpdate(d *schema.ResourceData, meta interface{})
 		return err
 	}
 	params := devspaces.ControllerUpdateParameters{
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	result, err := client.Update(ctx, id.ResourceGroup, id.Name, params)
@@ -221,7 +221,7 @@ func resourceDevSpaceControllerRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("target_container_host_resource_id", props.TargetContainerHostResourceID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDevSpaceControllerDelete(d *schema.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ControllerId{}
+var _ resourceid.SegmentedID = ControllerId{}
 
 func TestControllerIDFormatter(t *testing.T) {
 	actual := NewControllerID("12345678-1234-9876-4563-123456789012", "group1", "controller1").ID()
@@ -18,6 +19,43 @@ func TestControllerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestControllerIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevSpaces/controllers/controller1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.DEVSPACES/CONTROLLERS/controller1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevSpaces/controllers/controller1other",
+			Expected: false,
+		},
+	}
+
+	id := NewControllerID("12345678-1234-9876-4563-123456789012", "group1", "controller1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestControllerID(t *testing.T) {
 	testData := []struct {
 		Input    string
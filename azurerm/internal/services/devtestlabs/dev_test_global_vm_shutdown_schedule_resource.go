@@ -135,7 +135,7 @@ func resourceDevTestGlobalVMShutdownScheduleCreateUpdate(d *schema.ResourceData,
 			TargetResourceID: &vmID,
 			TaskType:         &taskType,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if d.Get("enabled").(bool) {
@@ -213,7 +213,7 @@ func resourceDevTestGlobalVMShutdownScheduleRead(d *schema.ResourceData, meta in
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDevTestGlobalVMShutdownScheduleDelete(d *schema.ResourceData, meta interface{}) error {
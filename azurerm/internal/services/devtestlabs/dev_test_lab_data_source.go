@@ -109,5 +109,5 @@ func dataSourceDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("unique_identifier", props.UniqueIdentifier)
 	}
 
-	return tags.FlattenAndSet(d, read.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, read.Tags)
 }
@@ -122,7 +122,7 @@ func resourceDevTestLabCreateUpdate(d *schema.ResourceData, meta interface{}) er
 
 	parameters := dtl.Lab{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		LabProperties: &dtl.LabProperties{
 			LabStorageType: dtl.StorageType(storageType),
 		},
@@ -192,7 +192,7 @@ func resourceDevTestLabRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("unique_identifier", props.UniqueIdentifier)
 	}
 
-	return tags.FlattenAndSet(d, read.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, read.Tags)
 }
 
 func resourceDevTestLabDelete(d *schema.ResourceData, meta interface{}) error {
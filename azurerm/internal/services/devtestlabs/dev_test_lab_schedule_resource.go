@@ -206,7 +206,7 @@ func resourceDevTestLabSchedulesCreateUpdate(d *schema.ResourceData, meta interf
 	schedule := dtl.Schedule{
 		Location:           &location,
 		ScheduleProperties: &dtl.ScheduleProperties{},
-		Tags:               tags.Expand(t),
+		Tags:               tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	switch status := d.Get("status"); status {
@@ -317,7 +317,7 @@ func resourceDevTestLabSchedulesRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDevTestLabSchedulesDelete(d *schema.ResourceData, meta interface{}) error {
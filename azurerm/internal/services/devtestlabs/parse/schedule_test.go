@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ScheduleId{}
+var _ resourceid.SegmentedID = ScheduleId{}
 
 func TestScheduleIDFormatter(t *testing.T) {
 	actual := NewScheduleID("12345678-1234-9876-4563-123456789012", "group1", "schedule1").ID()
@@ -18,6 +19,43 @@ func TestScheduleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestScheduleIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevTestLab/schedules/schedule1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.DEVTESTLAB/SCHEDULES/schedule1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DevTestLab/schedules/schedule1other",
+			Expected: false,
+		},
+	}
+
+	id := NewScheduleID("12345678-1234-9876-4563-123456789012", "group1", "schedule1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestScheduleID(t *testing.T) {
 	testData := []struct {
 		Input    string
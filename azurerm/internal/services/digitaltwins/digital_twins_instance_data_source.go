@@ -72,5 +72,5 @@ func dataSourceDigitalTwinsInstanceRead(d *schema.ResourceData, meta interface{}
 
 	d.SetId(id)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DigitalTwinsEndpointId struct {
@@ -25,6 +25,16 @@ func NewDigitalTwinsEndpointID(subscriptionId, resourceGroup, digitalTwinsInstan
 	}
 }
 
+// NewDigitalTwinsEndpointIDFromParent returns a DigitalTwinsEndpointId for the DigitalTwinsEndpoint within the specified DigitalTwinsInstance
+func NewDigitalTwinsEndpointIDFromParent(parent DigitalTwinsInstanceId, endpointName string) DigitalTwinsEndpointId {
+	return DigitalTwinsEndpointId{
+		SubscriptionId:           parent.SubscriptionId,
+		ResourceGroup:            parent.ResourceGroup,
+		DigitalTwinsInstanceName: parent.Name,
+		EndpointName:             endpointName,
+	}
+}
+
 func (id DigitalTwinsEndpointId) String() string {
 	segments := []string{
 		fmt.Sprintf("Endpoint Name %q", id.EndpointName),
@@ -40,35 +50,40 @@ func (id DigitalTwinsEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DigitalTwinsInstanceName, id.EndpointName)
 }
 
+// Matches returns whether the specified Resource ID refers to this DigitalTwinsEndpoint
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DigitalTwinsEndpointId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DigitalTwinsEndpoint ID
+func (id DigitalTwinsEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDigitalTwins", "Microsoft.DigitalTwins"),
+		resourceid.StaticSegment("staticDigitalTwinsInstances", "digitalTwinsInstances", false),
+		resourceid.UserSpecifiedSegment("digitalTwinsInstanceName"),
+		resourceid.StaticSegment("staticEndpoints", "endpoints", false),
+		resourceid.UserSpecifiedSegment("endpointName"),
+	}
+}
+
 // DigitalTwinsEndpointID parses a DigitalTwinsEndpoint ID into an DigitalTwinsEndpointId struct
 func DigitalTwinsEndpointID(input string) (*DigitalTwinsEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DigitalTwinsEndpointId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DigitalTwinsEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DigitalTwinsInstanceName, err = id.PopSegment("digitalTwinsInstances"); err != nil {
-		return nil, err
-	}
-	if resourceId.EndpointName, err = id.PopSegment("endpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:           values["subscriptionId"],
+		ResourceGroup:            values["resourceGroup"],
+		DigitalTwinsInstanceName: values["digitalTwinsInstanceName"],
+		EndpointName:             values["endpointName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DigitalTwinsEndpointId{}
+var _ resourceid.SegmentedID = DigitalTwinsEndpointId{}
 
 func TestDigitalTwinsEndpointIDFormatter(t *testing.T) {
 	actual := NewDigitalTwinsEndpointID("12345678-1234-9876-4563-123456789012", "group1", "instance1", "endpoint1").ID()
@@ -18,6 +19,52 @@ func TestDigitalTwinsEndpointIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDigitalTwinsEndpointIDFromParent(t *testing.T) {
+	parent := NewDigitalTwinsInstanceID("12345678-1234-9876-4563-123456789012", "group1", "instance1")
+	actual := NewDigitalTwinsEndpointIDFromParent(parent, "endpoint1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1/endpoints/endpoint1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestDigitalTwinsEndpointIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1/endpoints/endpoint1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/instance1/ENDPOINTS/endpoint1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1/endpoints/endpoint1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDigitalTwinsEndpointID("12345678-1234-9876-4563-123456789012", "group1", "instance1", "endpoint1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDigitalTwinsEndpointID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DigitalTwinsInstanceId{}
+var _ resourceid.SegmentedID = DigitalTwinsInstanceId{}
 
 func TestDigitalTwinsInstanceIDFormatter(t *testing.T) {
 	actual := NewDigitalTwinsInstanceID("12345678-1234-9876-4563-123456789012", "group1", "instance1").ID()
@@ -18,6 +19,43 @@ func TestDigitalTwinsInstanceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDigitalTwinsInstanceIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/instance1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.DigitalTwins/digitalTwinsInstances/instance1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDigitalTwinsInstanceID("12345678-1234-9876-4563-123456789012", "group1", "instance1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDigitalTwinsInstanceID(t *testing.T) {
 	testData := []struct {
 		Input    string
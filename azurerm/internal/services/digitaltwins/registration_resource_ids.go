@@ -0,0 +1,42 @@
+package digitaltwins
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/digitaltwins/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.DigitalTwins/digitalTwinsInstances/endpoints",
+			TerraformResourceType: "azurerm_digital_twins_endpoint_eventgrid",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DigitalTwinsEndpointID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DigitalTwins/digitalTwinsInstances/endpoints",
+			TerraformResourceType: "azurerm_digital_twins_endpoint_eventhub",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DigitalTwinsEndpointID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DigitalTwins/digitalTwinsInstances/endpoints",
+			TerraformResourceType: "azurerm_digital_twins_endpoint_servicebus",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DigitalTwinsEndpointID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DigitalTwins/digitalTwinsInstances",
+			TerraformResourceType: "azurerm_digital_twins_instance",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DigitalTwinsInstanceID(input)
+			},
+		},
+	}
+}
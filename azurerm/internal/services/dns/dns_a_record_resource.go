@@ -114,7 +114,7 @@ func resourceDnsARecordCreateUpdate(d *schema.ResourceData, meta interface{}) er
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:            &ttl,
 			ARecords:       expandAzureRmDnsARecords(recordsRaw),
 			TargetResource: &dns.SubResource{},
@@ -177,7 +177,7 @@ func resourceDnsARecordRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("target_resource_id", targetResourceId)
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsARecordDelete(d *schema.ResourceData, meta interface{}) error {
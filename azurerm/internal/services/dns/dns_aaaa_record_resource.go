@@ -118,7 +118,7 @@ func resourceDnsAaaaRecordCreateUpdate(d *schema.ResourceData, meta interface{})
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:            &ttl,
 			AaaaRecords:    expandAzureRmDnsAaaaRecords(recordsRaw),
 			TargetResource: &dns.SubResource{},
@@ -181,7 +181,7 @@ func resourceDnsAaaaRecordRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("target_resource_id", targetResourceId)
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsAaaaRecordDelete(d *schema.ResourceData, meta interface{}) error {
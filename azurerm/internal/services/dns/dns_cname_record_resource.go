@@ -111,7 +111,7 @@ func resourceDnsCNameRecordCreateUpdate(d *schema.ResourceData, meta interface{}
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:       tags.Expand(t),
+			Metadata:       tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:            &ttl,
 			CnameRecord:    &dns.CnameRecord{},
 			TargetResource: &dns.SubResource{},
@@ -182,7 +182,7 @@ func resourceDnsCNameRecordRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("target_resource_id", targetResourceId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsCNameRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -119,7 +119,7 @@ func resourceDnsMxRecordCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:  tags.Expand(t),
+			Metadata:  tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:       &ttl,
 			MxRecords: expandAzureRmDnsMxRecords(d),
 		},
@@ -163,7 +163,7 @@ func resourceDnsMxRecordRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("record", flattenAzureRmDnsMxRecords(resp.MxRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsMxRecordDelete(d *schema.ResourceData, meta interface{}) error {
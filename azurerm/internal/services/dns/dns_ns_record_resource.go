@@ -105,7 +105,7 @@ func resourceDnsNsRecordCreate(d *schema.ResourceData, meta interface{}) error {
 	parameters := dns.RecordSet{
 		Name: &name,
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:  tags.Expand(t),
+			Metadata:  tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:       &ttl,
 			NsRecords: records,
 		},
@@ -147,9 +147,9 @@ func resourceDnsNsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
 		existing.RecordSetProperties.NsRecords = records
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
-		existing.RecordSetProperties.Metadata = tags.Expand(t)
+		existing.RecordSetProperties.Metadata = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 	}
 
 	if d.HasChange("ttl") {
@@ -197,7 +197,7 @@ func resourceDnsNsRecordRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsNsRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -101,7 +101,7 @@ func resourceDnsPtrRecordCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	parameters := dns.RecordSet{
 		RecordSetProperties: &dns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:        &ttl,
 			PtrRecords: expandAzureRmDnsPtrRecords(d),
 		},
@@ -148,7 +148,7 @@ func resourceDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("records", flattenAzureRmDnsPtrRecords(resp.PtrRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourceDnsPtrRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -116,7 +116,7 @@ func dataSourceDnsZoneRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func findZone(client *dns.ZonesClient, ctx context.Context, name string) (*dns.Zone, string, error) {
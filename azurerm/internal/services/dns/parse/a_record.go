@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ARecordId struct {
@@ -25,6 +25,16 @@ func NewARecordID(subscriptionId, resourceGroup, dnszoneName, aName string) ARec
 	}
 }
 
+// NewARecordIDFromParent returns a ARecordId for the ARecord within the specified DnsZone
+func NewARecordIDFromParent(parent DnsZoneId, aName string) ARecordId {
+	return ARecordId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		DnszoneName:    parent.Name,
+		AName:          aName,
+	}
+}
+
 func (id ARecordId) String() string {
 	segments := []string{
 		fmt.Sprintf("A Name %q", id.AName),
@@ -40,35 +50,40 @@ func (id ARecordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.AName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ARecord
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ARecordId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ARecord ID
+func (id ARecordId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticDnszones", "dnszones", false),
+		resourceid.UserSpecifiedSegment("dnszoneName"),
+		resourceid.StaticSegment("staticA", "A", false),
+		resourceid.UserSpecifiedSegment("aName"),
+	}
+}
+
 // ARecordID parses a ARecord ID into an ARecordId struct
 func ARecordID(input string) (*ARecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ARecordId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ARecordId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
-		return nil, err
-	}
-	if resourceId.AName, err = id.PopSegment("A"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		DnszoneName:    values["dnszoneName"],
+		AName:          values["aName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AaaaRecordId{}
+var _ resourceid.SegmentedID = AaaaRecordId{}
 
 func TestAaaaRecordIDFormatter(t *testing.T) {
 	actual := NewAaaaRecordID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1", "eheh1").ID()
@@ -18,6 +19,52 @@ func TestAaaaRecordIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAaaaRecordIDFromParent(t *testing.T) {
+	parent := NewDnsZoneID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1")
+	actual := NewAaaaRecordIDFromParent(parent, "eheh1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/AAAA/eheh1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestAaaaRecordIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/AAAA/eheh1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/DNSZONES/zone1/AAAA/eheh1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/AAAA/eheh1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAaaaRecordID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1", "eheh1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAaaaRecordID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CaaRecordId struct {
@@ -25,6 +25,16 @@ func NewCaaRecordID(subscriptionId, resourceGroup, dnszoneName, cAAName string)
 	}
 }
 
+// NewCaaRecordIDFromParent returns a CaaRecordId for the CaaRecord within the specified DnsZone
+func NewCaaRecordIDFromParent(parent DnsZoneId, cAAName string) CaaRecordId {
+	return CaaRecordId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		DnszoneName:    parent.Name,
+		CAAName:        cAAName,
+	}
+}
+
 func (id CaaRecordId) String() string {
 	segments := []string{
 		fmt.Sprintf("C A A Name %q", id.CAAName),
@@ -40,35 +50,40 @@ func (id CaaRecordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.CAAName)
 }
 
+// Matches returns whether the specified Resource ID refers to this CaaRecord
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id CaaRecordId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this CaaRecord ID
+func (id CaaRecordId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticDnszones", "dnszones", false),
+		resourceid.UserSpecifiedSegment("dnszoneName"),
+		resourceid.StaticSegment("staticCAA", "CAA", false),
+		resourceid.UserSpecifiedSegment("cAAName"),
+	}
+}
+
 // CaaRecordID parses a CaaRecord ID into an CaaRecordId struct
 func CaaRecordID(input string) (*CaaRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, CaaRecordId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CaaRecordId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
-		return nil, err
-	}
-	if resourceId.CAAName, err = id.PopSegment("CAA"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		DnszoneName:    values["dnszoneName"],
+		CAAName:        values["cAAName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = CaaRecordId{}
+var _ resourceid.SegmentedID = CaaRecordId{}
 
 func TestCaaRecordIDFormatter(t *testing.T) {
 	actual := NewCaaRecordID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1", "caa1").ID()
@@ -18,6 +19,52 @@ func TestCaaRecordIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCaaRecordIDFromParent(t *testing.T) {
+	parent := NewDnsZoneID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1")
+	actual := NewCaaRecordIDFromParent(parent, "caa1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/CAA/caa1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestCaaRecordIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/CAA/caa1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/DNSZONES/zone1/CAA/caa1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/CAA/caa1other",
+			Expected: false,
+		},
+	}
+
+	id := NewCaaRecordID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1", "caa1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestCaaRecordID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CnameRecordId struct {
@@ -25,6 +25,16 @@ func NewCnameRecordID(subscriptionId, resourceGroup, dnszoneName, cNAMEName stri
 	}
 }
 
+// NewCnameRecordIDFromParent returns a CnameRecordId for the CnameRecord within the specified DnsZone
+func NewCnameRecordIDFromParent(parent DnsZoneId, cNAMEName string) CnameRecordId {
+	return CnameRecordId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		DnszoneName:    parent.Name,
+		CNAMEName:      cNAMEName,
+	}
+}
+
 func (id CnameRecordId) String() string {
 	segments := []string{
 		fmt.Sprintf("C N A M E Name %q", id.CNAMEName),
@@ -40,35 +50,40 @@ func (id CnameRecordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.CNAMEName)
 }
 
+// Matches returns whether the specified Resource ID refers to this CnameRecord
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id CnameRecordId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this CnameRecord ID
+func (id CnameRecordId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticDnszones", "dnszones", false),
+		resourceid.UserSpecifiedSegment("dnszoneName"),
+		resourceid.StaticSegment("staticCNAME", "CNAME", false),
+		resourceid.UserSpecifiedSegment("cNAMEName"),
+	}
+}
+
 // CnameRecordID parses a CnameRecord ID into an CnameRecordId struct
 func CnameRecordID(input string) (*CnameRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, CnameRecordId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CnameRecordId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
-		return nil, err
-	}
-	if resourceId.CNAMEName, err = id.PopSegment("CNAME"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		DnszoneName:    values["dnszoneName"],
+		CNAMEName:      values["cNAMEName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DnsZoneId{}
+var _ resourceid.SegmentedID = DnsZoneId{}
 
 func TestDnsZoneIDFormatter(t *testing.T) {
 	actual := NewDnsZoneID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1").ID()
@@ -18,6 +19,43 @@ func TestDnsZoneIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDnsZoneIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/DNSZONES/zone1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDnsZoneID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDnsZoneID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type MxRecordId struct {
@@ -25,6 +25,16 @@ func NewMxRecordID(subscriptionId, resourceGroup, dnszoneName, mXName string) Mx
 	}
 }
 
+// NewMxRecordIDFromParent returns a MxRecordId for the MxRecord within the specified DnsZone
+func NewMxRecordIDFromParent(parent DnsZoneId, mXName string) MxRecordId {
+	return MxRecordId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		DnszoneName:    parent.Name,
+		MXName:         mXName,
+	}
+}
+
 func (id MxRecordId) String() string {
 	segments := []string{
 		fmt.Sprintf("M X Name %q", id.MXName),
@@ -40,35 +50,40 @@ func (id MxRecordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DnszoneName, id.MXName)
 }
 
+// Matches returns whether the specified Resource ID refers to this MxRecord
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id MxRecordId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this MxRecord ID
+func (id MxRecordId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticDnszones", "dnszones", false),
+		resourceid.UserSpecifiedSegment("dnszoneName"),
+		resourceid.StaticSegment("staticMX", "MX", false),
+		resourceid.UserSpecifiedSegment("mXName"),
+	}
+}
+
 // MxRecordID parses a MxRecord ID into an MxRecordId struct
 func MxRecordID(input string) (*MxRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, MxRecordId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := MxRecordId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
-		return nil, err
-	}
-	if resourceId.MXName, err = id.PopSegment("MX"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		DnszoneName:    values["dnszoneName"],
+		MXName:         values["mXName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = MxRecordId{}
+var _ resourceid.SegmentedID = MxRecordId{}
 
 func TestMxRecordIDFormatter(t *testing.T) {
 	actual := NewMxRecordID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1", "mx1").ID()
@@ -18,6 +19,52 @@ func TestMxRecordIDFormatter(t *testing.T) {
 	}
 }
 
+func TestMxRecordIDFromParent(t *testing.T) {
+	parent := NewDnsZoneID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1")
+	actual := NewMxRecordIDFromParent(parent, "mx1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/MX/mx1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestMxRecordIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/MX/mx1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/DNSZONES/zone1/MX/mx1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/dnszones/zone1/MX/mx1other",
+			Expected: false,
+		},
+	}
+
+	id := NewMxRecordID("12345678-1234-9876-4563-123456789012", "resGroup1", "zone1", "mx1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestMxRecordID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,84 @@
+package dns
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/dns/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Network/dnszones/A",
+			TerraformResourceType: "azurerm_dns_a_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ARecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/dnszones/AAAA",
+			TerraformResourceType: "azurerm_dns_aaaa_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AaaaRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/dnszones/CAA",
+			TerraformResourceType: "azurerm_dns_caa_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CaaRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/dnszones/CNAME",
+			TerraformResourceType: "azurerm_dns_cname_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CnameRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/dnszones/MX",
+			TerraformResourceType: "azurerm_dns_mx_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.MxRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/dnszones/NS",
+			TerraformResourceType: "azurerm_dns_ns_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NsRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/dnszones/PTR",
+			TerraformResourceType: "azurerm_dns_ptr_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PtrRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/dnszones/SRV",
+			TerraformResourceType: "azurerm_dns_srv_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SrvRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/dnszones/TXT",
+			TerraformResourceType: "azurerm_dns_txt_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.TxtRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/dnszones",
+			TerraformResourceType: "azurerm_dns_zone",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DnsZoneID(input)
+			},
+		},
+	}
+}
@@ -196,7 +196,7 @@ func resourceEventGridDomainCreateUpdate(d *schema.ResourceData, meta interface{
 	domain := eventgrid.Domain{
 		Location:         &location,
 		DomainProperties: domainProperties,
-		Tags:             tags.Expand(t),
+		Tags:             tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM EventGrid Domain creation with Properties: %+v", domain)
@@ -289,7 +289,7 @@ func resourceEventGridDomainRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceEventGridDomainDelete(d *schema.ResourceData, meta interface{}) error {
@@ -132,7 +132,7 @@ func resourceEventGridSystemTopicCreateUpdate(d *schema.ResourceData, meta inter
 			Source:    &source,
 			TopicType: &topicType,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM Event Grid System Topic creation with Properties: %+v.", systemTopic)
@@ -192,7 +192,7 @@ func resourceEventGridSystemTopicRead(d *schema.ResourceData, meta interface{})
 		d.Set("metric_arm_resource_id", props.MetricResourceID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceEventGridSystemTopicDelete(d *schema.ResourceData, meta interface{}) error {
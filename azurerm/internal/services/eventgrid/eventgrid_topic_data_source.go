@@ -91,5 +91,5 @@ func dataSourceEventGridTopicRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -196,7 +196,7 @@ func resourceEventGridTopicCreateUpdate(d *schema.ResourceData, meta interface{}
 	properties := eventgrid.Topic{
 		Location:        &location,
 		TopicProperties: topicProperties,
-		Tags:            tags.Expand(t),
+		Tags:            tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	log.Printf("[INFO] preparing arguments for AzureRM EventGrid Topic creation with Properties: %+v.", properties)
@@ -293,7 +293,7 @@ func resourceEventGridTopicRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("primary_access_key", keys.Key1)
 	d.Set("secondary_access_key", keys.Key2)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceEventGridTopicDelete(d *schema.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DomainId{}
+var _ resourceid.SegmentedID = DomainId{}
 
 func TestDomainIDFormatter(t *testing.T) {
 	actual := NewDomainID("12345678-1234-9876-4563-123456789012", "resGroup1", "domain1").ID()
@@ -18,6 +19,43 @@ func TestDomainIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDomainIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/domains/domain1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.EVENTGRID/DOMAINS/domain1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/domains/domain1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDomainID("12345678-1234-9876-4563-123456789012", "resGroup1", "domain1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDomainID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DomainTopicId struct {
@@ -25,6 +25,16 @@ func NewDomainTopicID(subscriptionId, resourceGroup, domainName, topicName strin
 	}
 }
 
+// NewDomainTopicIDFromParent returns a DomainTopicId for the DomainTopic within the specified Domain
+func NewDomainTopicIDFromParent(parent DomainId, topicName string) DomainTopicId {
+	return DomainTopicId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		DomainName:     parent.Name,
+		TopicName:      topicName,
+	}
+}
+
 func (id DomainTopicId) String() string {
 	segments := []string{
 		fmt.Sprintf("Topic Name %q", id.TopicName),
@@ -40,35 +50,40 @@ func (id DomainTopicId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DomainName, id.TopicName)
 }
 
+// Matches returns whether the specified Resource ID refers to this DomainTopic
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DomainTopicId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DomainTopic ID
+func (id DomainTopicId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftEventGrid", "Microsoft.EventGrid"),
+		resourceid.StaticSegment("staticDomains", "domains", false),
+		resourceid.UserSpecifiedSegment("domainName"),
+		resourceid.StaticSegment("staticTopics", "topics", false),
+		resourceid.UserSpecifiedSegment("topicName"),
+	}
+}
+
 // DomainTopicID parses a DomainTopic ID into an DomainTopicId struct
 func DomainTopicID(input string) (*DomainTopicId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DomainTopicId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DomainTopicId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DomainName, err = id.PopSegment("domains"); err != nil {
-		return nil, err
-	}
-	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		DomainName:     values["domainName"],
+		TopicName:      values["topicName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DomainTopicId{}
+var _ resourceid.SegmentedID = DomainTopicId{}
 
 func TestDomainTopicIDFormatter(t *testing.T) {
 	actual := NewDomainTopicID("12345678-1234-9876-4563-123456789012", "resGroup1", "domain1", "topic1").ID()
@@ -18,6 +19,52 @@ func TestDomainTopicIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDomainTopicIDFromParent(t *testing.T) {
+	parent := NewDomainID("12345678-1234-9876-4563-123456789012", "resGroup1", "domain1")
+	actual := NewDomainTopicIDFromParent(parent, "topic1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/domains/domain1/topics/topic1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestDomainTopicIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/domains/domain1/topics/topic1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.EVENTGRID/DOMAINS/domain1/TOPICS/topic1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/domains/domain1/topics/topic1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDomainTopicID("12345678-1234-9876-4563-123456789012", "resGroup1", "domain1", "topic1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDomainTopicID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SystemTopicId{}
+var _ resourceid.SegmentedID = SystemTopicId{}
 
 func TestSystemTopicIDFormatter(t *testing.T) {
 	actual := NewSystemTopicID("12345678-1234-9876-4563-123456789012", "resGroup1", "systemTopic1").ID()
@@ -18,6 +19,43 @@ func TestSystemTopicIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSystemTopicIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/systemTopics/systemTopic1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.EVENTGRID/SYSTEMTOPICS/systemTopic1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.EventGrid/systemTopics/systemTopic1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSystemTopicID("12345678-1234-9876-4563-123456789012", "resGroup1", "systemTopic1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSystemTopicID(t *testing.T) {
 	testData := []struct {
 		Input    string
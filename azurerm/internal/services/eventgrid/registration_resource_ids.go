@@ -0,0 +1,42 @@
+package eventgrid
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventgrid/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.EventGrid/domains",
+			TerraformResourceType: "azurerm_eventgrid_domain",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DomainID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.EventGrid/domains/topics",
+			TerraformResourceType: "azurerm_eventgrid_domain_topic",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DomainTopicID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.EventGrid/systemTopics",
+			TerraformResourceType: "azurerm_eventgrid_system_topic",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SystemTopicID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.EventGrid/topics",
+			TerraformResourceType: "azurerm_eventgrid_topic",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.TopicID(input)
+			},
+		},
+	}
+}
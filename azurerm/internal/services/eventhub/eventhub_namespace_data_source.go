@@ -155,5 +155,5 @@ func EventHubNamespaceDataSourceRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("dedicated_cluster_id", props.ClusterArmID)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
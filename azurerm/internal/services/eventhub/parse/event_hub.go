@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EventHubId struct {
@@ -25,6 +25,16 @@ func NewEventHubID(subscriptionId, resourceGroup, namespaceName, name string) Ev
 	}
 }
 
+// NewEventHubIDFromParent returns a EventHubId for the EventHub within the specified Namespace
+func NewEventHubIDFromParent(parent NamespaceId, name string) EventHubId {
+	return EventHubId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		NamespaceName:  parent.Name,
+		Name:           name,
+	}
+}
+
 func (id EventHubId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id EventHubId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this EventHub
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id EventHubId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this EventHub ID
+func (id EventHubId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftEventHub", "Microsoft.EventHub"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticEventhubs", "eventhubs", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // EventHubID parses a EventHub ID into an EventHubId struct
 func EventHubID(input string) (*EventHubId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, EventHubId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EventHubId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("eventhubs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		NamespaceName:  values["namespaceName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
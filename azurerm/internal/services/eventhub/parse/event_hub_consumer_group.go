@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EventHubConsumerGroupId struct {
@@ -27,6 +27,17 @@ func NewEventHubConsumerGroupID(subscriptionId, resourceGroup, namespaceName, ev
 	}
 }
 
+// NewEventHubConsumerGroupIDFromParent returns a EventHubConsumerGroupId for the EventHubConsumerGroup within the specified EventHub
+func NewEventHubConsumerGroupIDFromParent(parent EventHubId, consumergroupName string) EventHubConsumerGroupId {
+	return EventHubConsumerGroupId{
+		SubscriptionId:    parent.SubscriptionId,
+		ResourceGroup:     parent.ResourceGroup,
+		NamespaceName:     parent.NamespaceName,
+		EventhubName:      parent.Name,
+		ConsumergroupName: consumergroupName,
+	}
+}
+
 func (id EventHubConsumerGroupId) String() string {
 	segments := []string{
 		fmt.Sprintf("Consumergroup Name %q", id.ConsumergroupName),
@@ -43,38 +54,43 @@ func (id EventHubConsumerGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.EventhubName, id.ConsumergroupName)
 }
 
+// Matches returns whether the specified Resource ID refers to this EventHubConsumerGroup
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id EventHubConsumerGroupId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this EventHubConsumerGroup ID
+func (id EventHubConsumerGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftEventHub", "Microsoft.EventHub"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticEventhubs", "eventhubs", false),
+		resourceid.UserSpecifiedSegment("eventhubName"),
+		resourceid.StaticSegment("staticConsumergroups", "consumergroups", false),
+		resourceid.UserSpecifiedSegment("consumergroupName"),
+	}
+}
+
 // EventHubConsumerGroupID parses a EventHubConsumerGroup ID into an EventHubConsumerGroupId struct
 func EventHubConsumerGroupID(input string) (*EventHubConsumerGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, EventHubConsumerGroupId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EventHubConsumerGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.EventhubName, err = id.PopSegment("eventhubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.ConsumergroupName, err = id.PopSegment("consumergroups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    values["subscriptionId"],
+		ResourceGroup:     values["resourceGroup"],
+		NamespaceName:     values["namespaceName"],
+		EventhubName:      values["eventhubName"],
+		ConsumergroupName: values["consumergroupName"],
 	}
 
 	return &resourceId, nil
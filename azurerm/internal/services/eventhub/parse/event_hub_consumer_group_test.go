@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = EventHubConsumerGroupId{}
+var _ resourceid.SegmentedID = EventHubConsumerGroupId{}
 
 func TestEventHubConsumerGroupIDFormatter(t *testing.T) {
 	actual := NewEventHubConsumerGroupID("12345678-1234-9876-4563-123456789012", "group1", "namespace1", "eventhub1", "consumergroup1").ID()
@@ -18,6 +19,52 @@ func TestEventHubConsumerGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEventHubConsumerGroupIDFromParent(t *testing.T) {
+	parent := NewEventHubID("12345678-1234-9876-4563-123456789012", "group1", "namespace1", "eventhub1")
+	actual := NewEventHubConsumerGroupIDFromParent(parent, "consumergroup1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestEventHubConsumerGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.EVENTHUB/NAMESPACES/namespace1/EVENTHUBS/eventhub1/CONSUMERGROUPS/consumergroup1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.EventHub/namespaces/namespace1/eventhubs/eventhub1/consumergroups/consumergroup1other",
+			Expected: false,
+		},
+	}
+
+	id := NewEventHubConsumerGroupID("12345678-1234-9876-4563-123456789012", "group1", "namespace1", "eventhub1", "consumergroup1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestEventHubConsumerGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
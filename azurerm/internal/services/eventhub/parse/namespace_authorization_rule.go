@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamespaceAuthorizationRuleId struct {
@@ -25,6 +25,16 @@ func NewNamespaceAuthorizationRuleID(subscriptionId, resourceGroup, namespaceNam
 	}
 }
 
+// NewNamespaceAuthorizationRuleIDFromParent returns a NamespaceAuthorizationRuleId for the NamespaceAuthorizationRule within the specified Namespace
+func NewNamespaceAuthorizationRuleIDFromParent(parent NamespaceId, authorizationRuleName string) NamespaceAuthorizationRuleId {
+	return NamespaceAuthorizationRuleId{
+		SubscriptionId:        parent.SubscriptionId,
+		ResourceGroup:         parent.ResourceGroup,
+		NamespaceName:         parent.Name,
+		AuthorizationRuleName: authorizationRuleName,
+	}
+}
+
 func (id NamespaceAuthorizationRuleId) String() string {
 	segments := []string{
 		fmt.Sprintf("Authorization Rule Name %q", id.AuthorizationRuleName),
@@ -40,35 +50,40 @@ func (id NamespaceAuthorizationRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.AuthorizationRuleName)
 }
 
+// Matches returns whether the specified Resource ID refers to this NamespaceAuthorizationRule
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id NamespaceAuthorizationRuleId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this NamespaceAuthorizationRule ID
+func (id NamespaceAuthorizationRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftEventHub", "Microsoft.EventHub"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticAuthorizationRules", "authorizationRules", false),
+		resourceid.UserSpecifiedSegment("authorizationRuleName"),
+	}
+}
+
 // NamespaceAuthorizationRuleID parses a NamespaceAuthorizationRule ID into an NamespaceAuthorizationRuleId struct
 func NamespaceAuthorizationRuleID(input string) (*NamespaceAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, NamespaceAuthorizationRuleId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NamespaceAuthorizationRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment("authorizationRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        values["subscriptionId"],
+		ResourceGroup:         values["resourceGroup"],
+		NamespaceName:         values["namespaceName"],
+		AuthorizationRuleName: values["authorizationRuleName"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +96,16 @@ func NamespaceAuthorizationRuleID(input string) (*NamespaceAuthorizationRuleId,
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func NamespaceAuthorizationRuleIDInsensitively(input string) (*NamespaceAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, NamespaceAuthorizationRuleId{}.Segments(), true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NamespaceAuthorizationRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'namespaces' segment
-	namespacesKey := "namespaces"
-	for key := range id.Path {
-		if strings.EqualFold(key, namespacesKey) {
-			namespacesKey = key
-			break
-		}
-	}
-	if resourceId.NamespaceName, err = id.PopSegment(namespacesKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'authorizationRules' segment
-	authorizationRulesKey := "authorizationRules"
-	for key := range id.Path {
-		if strings.EqualFold(key, authorizationRulesKey) {
-			authorizationRulesKey = key
-			break
-		}
-	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment(authorizationRulesKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        values["subscriptionId"],
+		ResourceGroup:         values["resourceGroup"],
+		NamespaceName:         values["namespaceName"],
+		AuthorizationRuleName: values["authorizationRuleName"],
 	}
 
 	return &resourceId, nil
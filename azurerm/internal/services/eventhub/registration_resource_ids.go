@@ -0,0 +1,49 @@
+package eventhub
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/eventhub/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.EventHub/namespaces/eventhubs",
+			TerraformResourceType: "azurerm_eventhub",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.EventHubID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.EventHub/clusters",
+			TerraformResourceType: "azurerm_eventhub_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.EventHub/namespaces/eventhubs/consumergroups",
+			TerraformResourceType: "azurerm_eventhub_consumer_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.EventHubConsumerGroupID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.EventHub/namespaces",
+			TerraformResourceType: "azurerm_eventhub_namespace",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NamespaceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.EventHub/namespaces/authorizationRules",
+			TerraformResourceType: "azurerm_eventhub_namespace_authorization_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NamespaceAuthorizationRuleID(input)
+			},
+		},
+	}
+}
@@ -205,5 +205,5 @@ func FirewallDataSourceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, read.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, read.Tags)
 }
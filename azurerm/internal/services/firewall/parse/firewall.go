@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallId struct {
@@ -37,32 +37,37 @@ func (id FirewallId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName)
 }
 
+// Matches returns whether the specified Resource ID refers to this Firewall
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id FirewallId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Firewall ID
+func (id FirewallId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticAzureFirewalls", "azureFirewalls", false),
+		resourceid.UserSpecifiedSegment("azureFirewallName"),
+	}
+}
+
 // FirewallID parses a Firewall ID into an FirewallId struct
 func FirewallID(input string) (*FirewallId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, FirewallId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    values["subscriptionId"],
+		ResourceGroup:     values["resourceGroup"],
+		AzureFirewallName: values["azureFirewallName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallApplicationRuleCollectionId struct {
@@ -25,6 +25,16 @@ func NewFirewallApplicationRuleCollectionID(subscriptionId, resourceGroup, azure
 	}
 }
 
+// NewFirewallApplicationRuleCollectionIDFromParent returns a FirewallApplicationRuleCollectionId for the FirewallApplicationRuleCollection within the specified Firewall
+func NewFirewallApplicationRuleCollectionIDFromParent(parent FirewallId, applicationRuleCollectionName string) FirewallApplicationRuleCollectionId {
+	return FirewallApplicationRuleCollectionId{
+		SubscriptionId:                parent.SubscriptionId,
+		ResourceGroup:                 parent.ResourceGroup,
+		AzureFirewallName:             parent.AzureFirewallName,
+		ApplicationRuleCollectionName: applicationRuleCollectionName,
+	}
+}
+
 func (id FirewallApplicationRuleCollectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Application Rule Collection Name %q", id.ApplicationRuleCollectionName),
@@ -40,35 +50,40 @@ func (id FirewallApplicationRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.ApplicationRuleCollectionName)
 }
 
+// Matches returns whether the specified Resource ID refers to this FirewallApplicationRuleCollection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id FirewallApplicationRuleCollectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this FirewallApplicationRuleCollection ID
+func (id FirewallApplicationRuleCollectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticAzureFirewalls", "azureFirewalls", false),
+		resourceid.UserSpecifiedSegment("azureFirewallName"),
+		resourceid.StaticSegment("staticApplicationRuleCollections", "applicationRuleCollections", false),
+		resourceid.UserSpecifiedSegment("applicationRuleCollectionName"),
+	}
+}
+
 // FirewallApplicationRuleCollectionID parses a FirewallApplicationRuleCollection ID into an FirewallApplicationRuleCollectionId struct
 func FirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRuleCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, FirewallApplicationRuleCollectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallApplicationRuleCollectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
-		return nil, err
-	}
-	if resourceId.ApplicationRuleCollectionName, err = id.PopSegment("applicationRuleCollections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:                values["subscriptionId"],
+		ResourceGroup:                 values["resourceGroup"],
+		AzureFirewallName:             values["azureFirewallName"],
+		ApplicationRuleCollectionName: values["applicationRuleCollectionName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = FirewallApplicationRuleCollectionId{}
+var _ resourceid.SegmentedID = FirewallApplicationRuleCollectionId{}
 
 func TestFirewallApplicationRuleCollectionIDFormatter(t *testing.T) {
 	actual := NewFirewallApplicationRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "applicationRuleCollection1").ID()
@@ -18,6 +19,52 @@ func TestFirewallApplicationRuleCollectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallApplicationRuleCollectionIDFromParent(t *testing.T) {
+	parent := NewFirewallID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall")
+	actual := NewFirewallApplicationRuleCollectionIDFromParent(parent, "applicationRuleCollection1").ID()
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/applicationRuleCollections/applicationRuleCollection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestFirewallApplicationRuleCollectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/applicationRuleCollections/applicationRuleCollection1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/mygroup1/PROVIDERS/MICROSOFT.NETWORK/AZUREFIREWALLS/myfirewall/APPLICATIONRULECOLLECTIONS/applicationRuleCollection1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/applicationRuleCollections/applicationRuleCollection1other",
+			Expected: false,
+		},
+	}
+
+	id := NewFirewallApplicationRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "applicationRuleCollection1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestFirewallApplicationRuleCollectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
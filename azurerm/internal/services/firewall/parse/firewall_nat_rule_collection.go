@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallNatRuleCollectionId struct {
@@ -25,6 +25,16 @@ func NewFirewallNatRuleCollectionID(subscriptionId, resourceGroup, azureFirewall
 	}
 }
 
+// NewFirewallNatRuleCollectionIDFromParent returns a FirewallNatRuleCollectionId for the FirewallNatRuleCollection within the specified Firewall
+func NewFirewallNatRuleCollectionIDFromParent(parent FirewallId, natRuleCollectionName string) FirewallNatRuleCollectionId {
+	return FirewallNatRuleCollectionId{
+		SubscriptionId:        parent.SubscriptionId,
+		ResourceGroup:         parent.ResourceGroup,
+		AzureFirewallName:     parent.AzureFirewallName,
+		NatRuleCollectionName: natRuleCollectionName,
+	}
+}
+
 func (id FirewallNatRuleCollectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Nat Rule Collection Name %q", id.NatRuleCollectionName),
@@ -40,35 +50,40 @@ func (id FirewallNatRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.NatRuleCollectionName)
 }
 
+// Matches returns whether the specified Resource ID refers to this FirewallNatRuleCollection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id FirewallNatRuleCollectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this FirewallNatRuleCollection ID
+func (id FirewallNatRuleCollectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticAzureFirewalls", "azureFirewalls", false),
+		resourceid.UserSpecifiedSegment("azureFirewallName"),
+		resourceid.StaticSegment("staticNatRuleCollections", "natRuleCollections", false),
+		resourceid.UserSpecifiedSegment("natRuleCollectionName"),
+	}
+}
+
 // FirewallNatRuleCollectionID parses a FirewallNatRuleCollection ID into an FirewallNatRuleCollectionId struct
 func FirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, FirewallNatRuleCollectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallNatRuleCollectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
-		return nil, err
-	}
-	if resourceId.NatRuleCollectionName, err = id.PopSegment("natRuleCollections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        values["subscriptionId"],
+		ResourceGroup:         values["resourceGroup"],
+		AzureFirewallName:     values["azureFirewallName"],
+		NatRuleCollectionName: values["natRuleCollectionName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = FirewallNatRuleCollectionId{}
+var _ resourceid.SegmentedID = FirewallNatRuleCollectionId{}
 
 func TestFirewallNatRuleCollectionIDFormatter(t *testing.T) {
 	actual := NewFirewallNatRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "natRuleCollection1").ID()
@@ -18,6 +19,52 @@ func TestFirewallNatRuleCollectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallNatRuleCollectionIDFromParent(t *testing.T) {
+	parent := NewFirewallID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall")
+	actual := NewFirewallNatRuleCollectionIDFromParent(parent, "natRuleCollection1").ID()
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestFirewallNatRuleCollectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/mygroup1/PROVIDERS/MICROSOFT.NETWORK/AZUREFIREWALLS/myfirewall/NATRULECOLLECTIONS/natRuleCollection1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/natRuleCollections/natRuleCollection1other",
+			Expected: false,
+		},
+	}
+
+	id := NewFirewallNatRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "natRuleCollection1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestFirewallNatRuleCollectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
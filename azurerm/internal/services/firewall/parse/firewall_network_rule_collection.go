@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallNetworkRuleCollectionId struct {
@@ -25,6 +25,16 @@ func NewFirewallNetworkRuleCollectionID(subscriptionId, resourceGroup, azureFire
 	}
 }
 
+// NewFirewallNetworkRuleCollectionIDFromParent returns a FirewallNetworkRuleCollectionId for the FirewallNetworkRuleCollection within the specified Firewall
+func NewFirewallNetworkRuleCollectionIDFromParent(parent FirewallId, networkRuleCollectionName string) FirewallNetworkRuleCollectionId {
+	return FirewallNetworkRuleCollectionId{
+		SubscriptionId:            parent.SubscriptionId,
+		ResourceGroup:             parent.ResourceGroup,
+		AzureFirewallName:         parent.AzureFirewallName,
+		NetworkRuleCollectionName: networkRuleCollectionName,
+	}
+}
+
 func (id FirewallNetworkRuleCollectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Network Rule Collection Name %q", id.NetworkRuleCollectionName),
@@ -40,35 +50,40 @@ func (id FirewallNetworkRuleCollectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.NetworkRuleCollectionName)
 }
 
+// Matches returns whether the specified Resource ID refers to this FirewallNetworkRuleCollection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id FirewallNetworkRuleCollectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this FirewallNetworkRuleCollection ID
+func (id FirewallNetworkRuleCollectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticAzureFirewalls", "azureFirewalls", false),
+		resourceid.UserSpecifiedSegment("azureFirewallName"),
+		resourceid.StaticSegment("staticNetworkRuleCollections", "networkRuleCollections", false),
+		resourceid.UserSpecifiedSegment("networkRuleCollectionName"),
+	}
+}
+
 // FirewallNetworkRuleCollectionID parses a FirewallNetworkRuleCollection ID into an FirewallNetworkRuleCollectionId struct
 func FirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, FirewallNetworkRuleCollectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallNetworkRuleCollectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
-		return nil, err
-	}
-	if resourceId.NetworkRuleCollectionName, err = id.PopSegment("networkRuleCollections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:            values["subscriptionId"],
+		ResourceGroup:             values["resourceGroup"],
+		AzureFirewallName:         values["azureFirewallName"],
+		NetworkRuleCollectionName: values["networkRuleCollectionName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = FirewallNetworkRuleCollectionId{}
+var _ resourceid.SegmentedID = FirewallNetworkRuleCollectionId{}
 
 func TestFirewallNetworkRuleCollectionIDFormatter(t *testing.T) {
 	actual := NewFirewallNetworkRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "networkRuleCollection1").ID()
@@ -18,6 +19,52 @@ func TestFirewallNetworkRuleCollectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallNetworkRuleCollectionIDFromParent(t *testing.T) {
+	parent := NewFirewallID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall")
+	actual := NewFirewallNetworkRuleCollectionIDFromParent(parent, "networkRuleCollection1").ID()
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/networkRuleCollections/networkRuleCollection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestFirewallNetworkRuleCollectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/networkRuleCollections/networkRuleCollection1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/mygroup1/PROVIDERS/MICROSOFT.NETWORK/AZUREFIREWALLS/myfirewall/NETWORKRULECOLLECTIONS/networkRuleCollection1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/mygroup1/providers/Microsoft.Network/azureFirewalls/myfirewall/networkRuleCollections/networkRuleCollection1other",
+			Expected: false,
+		},
+	}
+
+	id := NewFirewallNetworkRuleCollectionID("00000000-0000-0000-0000-000000000000", "mygroup1", "myfirewall", "networkRuleCollection1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestFirewallNetworkRuleCollectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
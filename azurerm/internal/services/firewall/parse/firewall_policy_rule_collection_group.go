@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FirewallPolicyRuleCollectionGroupId struct {
@@ -25,6 +25,16 @@ func NewFirewallPolicyRuleCollectionGroupID(subscriptionId, resourceGroup, firew
 	}
 }
 
+// NewFirewallPolicyRuleCollectionGroupIDFromParent returns a FirewallPolicyRuleCollectionGroupId for the FirewallPolicyRuleCollectionGroup within the specified FirewallPolicy
+func NewFirewallPolicyRuleCollectionGroupIDFromParent(parent FirewallPolicyId, ruleCollectionGroupName string) FirewallPolicyRuleCollectionGroupId {
+	return FirewallPolicyRuleCollectionGroupId{
+		SubscriptionId:          parent.SubscriptionId,
+		ResourceGroup:           parent.ResourceGroup,
+		FirewallPolicyName:      parent.Name,
+		RuleCollectionGroupName: ruleCollectionGroupName,
+	}
+}
+
 func (id FirewallPolicyRuleCollectionGroupId) String() string {
 	segments := []string{
 		fmt.Sprintf("Rule Collection Group Name %q", id.RuleCollectionGroupName),
@@ -40,35 +50,40 @@ func (id FirewallPolicyRuleCollectionGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FirewallPolicyName, id.RuleCollectionGroupName)
 }
 
+// Matches returns whether the specified Resource ID refers to this FirewallPolicyRuleCollectionGroup
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id FirewallPolicyRuleCollectionGroupId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this FirewallPolicyRuleCollectionGroup ID
+func (id FirewallPolicyRuleCollectionGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticFirewallPolicies", "firewallPolicies", false),
+		resourceid.UserSpecifiedSegment("firewallPolicyName"),
+		resourceid.StaticSegment("staticRuleCollectionGroups", "ruleCollectionGroups", false),
+		resourceid.UserSpecifiedSegment("ruleCollectionGroupName"),
+	}
+}
+
 // FirewallPolicyRuleCollectionGroupID parses a FirewallPolicyRuleCollectionGroup ID into an FirewallPolicyRuleCollectionGroupId struct
 func FirewallPolicyRuleCollectionGroupID(input string) (*FirewallPolicyRuleCollectionGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, FirewallPolicyRuleCollectionGroupId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FirewallPolicyRuleCollectionGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FirewallPolicyName, err = id.PopSegment("firewallPolicies"); err != nil {
-		return nil, err
-	}
-	if resourceId.RuleCollectionGroupName, err = id.PopSegment("ruleCollectionGroups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          values["subscriptionId"],
+		ResourceGroup:           values["resourceGroup"],
+		FirewallPolicyName:      values["firewallPolicyName"],
+		RuleCollectionGroupName: values["ruleCollectionGroupName"],
 	}
 
 	return &resourceId, nil
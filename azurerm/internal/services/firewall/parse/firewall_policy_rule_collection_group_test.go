@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = FirewallPolicyRuleCollectionGroupId{}
+var _ resourceid.SegmentedID = FirewallPolicyRuleCollectionGroupId{}
 
 func TestFirewallPolicyRuleCollectionGroupIDFormatter(t *testing.T) {
 	actual := NewFirewallPolicyRuleCollectionGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "policy1", "ruleCollectionGroup1").ID()
@@ -18,6 +19,52 @@ func TestFirewallPolicyRuleCollectionGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallPolicyRuleCollectionGroupIDFromParent(t *testing.T) {
+	parent := NewFirewallPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "policy1")
+	actual := NewFirewallPolicyRuleCollectionGroupIDFromParent(parent, "ruleCollectionGroup1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/firewallPolicies/policy1/ruleCollectionGroups/ruleCollectionGroup1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestFirewallPolicyRuleCollectionGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/firewallPolicies/policy1/ruleCollectionGroups/ruleCollectionGroup1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/FIREWALLPOLICIES/policy1/RULECOLLECTIONGROUPS/ruleCollectionGroup1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/firewallPolicies/policy1/ruleCollectionGroups/ruleCollectionGroup1other",
+			Expected: false,
+		},
+	}
+
+	id := NewFirewallPolicyRuleCollectionGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "policy1", "ruleCollectionGroup1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestFirewallPolicyRuleCollectionGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = FirewallId{}
+var _ resourceid.SegmentedID = FirewallId{}
 
 func TestFirewallIDFormatter(t *testing.T) {
 	actual := NewFirewallID("12345678-1234-9876-4563-123456789012", "group1", "firewall1").ID()
@@ -18,6 +19,43 @@ func TestFirewallIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/firewall1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.NETWORK/AZUREFIREWALLS/firewall1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/azureFirewalls/firewall1other",
+			Expected: false,
+		},
+	}
+
+	id := NewFirewallID("12345678-1234-9876-4563-123456789012", "group1", "firewall1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestFirewallID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,56 @@
+package firewall
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/firewall/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Network/azureFirewalls",
+			TerraformResourceType: "azurerm_firewall",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/azureFirewalls/applicationRuleCollections",
+			TerraformResourceType: "azurerm_firewall_application_rule_collection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallApplicationRuleCollectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/azureFirewalls/natRuleCollections",
+			TerraformResourceType: "azurerm_firewall_nat_rule_collection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallNatRuleCollectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/azureFirewalls/networkRuleCollections",
+			TerraformResourceType: "azurerm_firewall_network_rule_collection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallNetworkRuleCollectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/firewallPolicies",
+			TerraformResourceType: "azurerm_firewall_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/firewallPolicies/ruleCollectionGroups",
+			TerraformResourceType: "azurerm_firewall_policy_rule_collection_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallPolicyRuleCollectionGroupID(input)
+			},
+		},
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackendPoolId struct {
@@ -25,6 +25,16 @@ func NewBackendPoolID(subscriptionId, resourceGroup, frontDoorName, name string)
 	}
 }
 
+// NewBackendPoolIDFromParent returns a BackendPoolId for the BackendPool within the specified FrontDoor
+func NewBackendPoolIDFromParent(parent FrontDoorId, name string) BackendPoolId {
+	return BackendPoolId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		FrontDoorName:  parent.Name,
+		Name:           name,
+	}
+}
+
 func (id BackendPoolId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id BackendPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this BackendPool
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id BackendPoolId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this BackendPool ID
+func (id BackendPoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticFrontDoors", "frontDoors", false),
+		resourceid.UserSpecifiedSegment("frontDoorName"),
+		resourceid.StaticSegment("staticBackendPools", "backendPools", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // BackendPoolID parses a BackendPool ID into an BackendPoolId struct
 func BackendPoolID(input string) (*BackendPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, BackendPoolId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BackendPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("backendPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		FrontDoorName:  values["frontDoorName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +96,16 @@ func BackendPoolID(input string) (*BackendPoolId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func BackendPoolIDInsensitively(input string) (*BackendPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, BackendPoolId{}.Segments(), true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BackendPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'backendPools' segment
-	backendPoolsKey := "backendPools"
-	for key := range id.Path {
-		if strings.EqualFold(key, backendPoolsKey) {
-			backendPoolsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(backendPoolsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		FrontDoorName:  values["frontDoorName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
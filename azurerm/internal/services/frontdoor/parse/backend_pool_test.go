@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = BackendPoolId{}
+var _ resourceid.SegmentedID = BackendPoolId{}
 
 func TestBackendPoolIDFormatter(t *testing.T) {
 	actual := NewBackendPoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1", "pool1").ID()
@@ -18,6 +19,52 @@ func TestBackendPoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBackendPoolIDFromParent(t *testing.T) {
+	parent := NewFrontDoorID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1")
+	actual := NewBackendPoolIDFromParent(parent, "pool1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/backendPools/pool1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestBackendPoolIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/backendPools/pool1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/FRONTDOORS/frontdoor1/BACKENDPOOLS/pool1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1/backendPools/pool1other",
+			Expected: false,
+		},
+	}
+
+	id := NewBackendPoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1", "pool1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestBackendPoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = FrontDoorId{}
+var _ resourceid.SegmentedID = FrontDoorId{}
 
 func TestFrontDoorIDFormatter(t *testing.T) {
 	actual := NewFrontDoorID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1").ID()
@@ -18,6 +19,43 @@ func TestFrontDoorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFrontDoorIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/FRONTDOORS/frontdoor1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoors/frontdoor1other",
+			Expected: false,
+		},
+	}
+
+	id := NewFrontDoorID("12345678-1234-9876-4563-123456789012", "resGroup1", "frontdoor1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestFrontDoorID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FrontendEndpointId struct {
@@ -25,6 +25,16 @@ func NewFrontendEndpointID(subscriptionId, resourceGroup, frontDoorName, name st
 	}
 }
 
+// NewFrontendEndpointIDFromParent returns a FrontendEndpointId for the FrontendEndpoint within the specified FrontDoor
+func NewFrontendEndpointIDFromParent(parent FrontDoorId, name string) FrontendEndpointId {
+	return FrontendEndpointId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		FrontDoorName:  parent.Name,
+		Name:           name,
+	}
+}
+
 func (id FrontendEndpointId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id FrontendEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this FrontendEndpoint
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id FrontendEndpointId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this FrontendEndpoint ID
+func (id FrontendEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticFrontDoors", "frontDoors", false),
+		resourceid.UserSpecifiedSegment("frontDoorName"),
+		resourceid.StaticSegment("staticFrontendEndpoints", "frontendEndpoints", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // FrontendEndpointID parses a FrontendEndpoint ID into an FrontendEndpointId struct
 func FrontendEndpointID(input string) (*FrontendEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, FrontendEndpointId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FrontendEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("frontendEndpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		FrontDoorName:  values["frontDoorName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +96,16 @@ func FrontendEndpointID(input string) (*FrontendEndpointId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func FrontendEndpointIDInsensitively(input string) (*FrontendEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, FrontendEndpointId{}.Segments(), true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FrontendEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'frontendEndpoints' segment
-	frontendEndpointsKey := "frontendEndpoints"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontendEndpointsKey) {
-			frontendEndpointsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(frontendEndpointsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		FrontDoorName:  values["frontDoorName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HealthProbeId struct {
@@ -25,6 +25,16 @@ func NewHealthProbeID(subscriptionId, resourceGroup, frontDoorName, healthProbeS
 	}
 }
 
+// NewHealthProbeIDFromParent returns a HealthProbeId for the HealthProbe within the specified FrontDoor
+func NewHealthProbeIDFromParent(parent FrontDoorId, healthProbeSettingName string) HealthProbeId {
+	return HealthProbeId{
+		SubscriptionId:         parent.SubscriptionId,
+		ResourceGroup:          parent.ResourceGroup,
+		FrontDoorName:          parent.Name,
+		HealthProbeSettingName: healthProbeSettingName,
+	}
+}
+
 func (id HealthProbeId) String() string {
 	segments := []string{
 		fmt.Sprintf("Health Probe Setting Name %q", id.HealthProbeSettingName),
@@ -40,35 +50,40 @@ func (id HealthProbeId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.HealthProbeSettingName)
 }
 
+// Matches returns whether the specified Resource ID refers to this HealthProbe
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id HealthProbeId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this HealthProbe ID
+func (id HealthProbeId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticFrontDoors", "frontDoors", false),
+		resourceid.UserSpecifiedSegment("frontDoorName"),
+		resourceid.StaticSegment("staticHealthProbeSettings", "healthProbeSettings", false),
+		resourceid.UserSpecifiedSegment("healthProbeSettingName"),
+	}
+}
+
 // HealthProbeID parses a HealthProbe ID into an HealthProbeId struct
 func HealthProbeID(input string) (*HealthProbeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, HealthProbeId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := HealthProbeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
-		return nil, err
-	}
-	if resourceId.HealthProbeSettingName, err = id.PopSegment("healthProbeSettings"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         values["subscriptionId"],
+		ResourceGroup:          values["resourceGroup"],
+		FrontDoorName:          values["frontDoorName"],
+		HealthProbeSettingName: values["healthProbeSettingName"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +96,16 @@ func HealthProbeID(input string) (*HealthProbeId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func HealthProbeIDInsensitively(input string) (*HealthProbeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, HealthProbeId{}.Segments(), true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := HealthProbeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'healthProbeSettings' segment
-	healthProbeSettingsKey := "healthProbeSettings"
-	for key := range id.Path {
-		if strings.EqualFold(key, healthProbeSettingsKey) {
-			healthProbeSettingsKey = key
-			break
-		}
-	}
-	if resourceId.HealthProbeSettingName, err = id.PopSegment(healthProbeSettingsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         values["subscriptionId"],
+		ResourceGroup:          values["resourceGroup"],
+		FrontDoorName:          values["frontDoorName"],
+		HealthProbeSettingName: values["healthProbeSettingName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancingId struct {
@@ -25,6 +25,16 @@ func NewLoadBalancingID(subscriptionId, resourceGroup, frontDoorName, loadBalanc
 	}
 }
 
+// NewLoadBalancingIDFromParent returns a LoadBalancingId for the LoadBalancing within the specified FrontDoor
+func NewLoadBalancingIDFromParent(parent FrontDoorId, loadBalancingSettingName string) LoadBalancingId {
+	return LoadBalancingId{
+		SubscriptionId:           parent.SubscriptionId,
+		ResourceGroup:            parent.ResourceGroup,
+		FrontDoorName:            parent.Name,
+		LoadBalancingSettingName: loadBalancingSettingName,
+	}
+}
+
 func (id LoadBalancingId) String() string {
 	segments := []string{
 		fmt.Sprintf("Load Balancing Setting Name %q", id.LoadBalancingSettingName),
@@ -40,35 +50,40 @@ func (id LoadBalancingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.FrontDoorName, id.LoadBalancingSettingName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LoadBalancing
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LoadBalancingId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LoadBalancing ID
+func (id LoadBalancingId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticFrontDoors", "frontDoors", false),
+		resourceid.UserSpecifiedSegment("frontDoorName"),
+		resourceid.StaticSegment("staticLoadBalancingSettings", "loadBalancingSettings", false),
+		resourceid.UserSpecifiedSegment("loadBalancingSettingName"),
+	}
+}
+
 // LoadBalancingID parses a LoadBalancing ID into an LoadBalancingId struct
 func LoadBalancingID(input string) (*LoadBalancingId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LoadBalancingId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancingId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
-		return nil, err
-	}
-	if resourceId.LoadBalancingSettingName, err = id.PopSegment("loadBalancingSettings"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:           values["subscriptionId"],
+		ResourceGroup:            values["resourceGroup"],
+		FrontDoorName:            values["frontDoorName"],
+		LoadBalancingSettingName: values["loadBalancingSettingName"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +96,16 @@ func LoadBalancingID(input string) (*LoadBalancingId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func LoadBalancingIDInsensitively(input string) (*LoadBalancingId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LoadBalancingId{}.Segments(), true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancingId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'frontDoors' segment
-	frontDoorsKey := "frontDoors"
-	for key := range id.Path {
-		if strings.EqualFold(key, frontDoorsKey) {
-			frontDoorsKey = key
-			break
-		}
-	}
-	if resourceId.FrontDoorName, err = id.PopSegment(frontDoorsKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'loadBalancingSettings' segment
-	loadBalancingSettingsKey := "loadBalancingSettings"
-	for key := range id.Path {
-		if strings.EqualFold(key, loadBalancingSettingsKey) {
-			loadBalancingSettingsKey = key
-			break
-		}
-	}
-	if resourceId.LoadBalancingSettingName, err = id.PopSegment(loadBalancingSettingsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:           values["subscriptionId"],
+		ResourceGroup:            values["resourceGroup"],
+		FrontDoorName:            values["frontDoorName"],
+		LoadBalancingSettingName: values["loadBalancingSettingName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = WebApplicationFirewallPolicyId{}
+var _ resourceid.SegmentedID = WebApplicationFirewallPolicyId{}
 
 func TestWebApplicationFirewallPolicyIDFormatter(t *testing.T) {
 	actual := NewWebApplicationFirewallPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "policy1").ID()
@@ -18,6 +19,43 @@ func TestWebApplicationFirewallPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestWebApplicationFirewallPolicyIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoorWebApplicationFirewallPolicies/policy1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/FRONTDOORWEBAPPLICATIONFIREWALLPOLICIES/policy1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/frontDoorWebApplicationFirewallPolicies/policy1other",
+			Expected: false,
+		},
+	}
+
+	id := NewWebApplicationFirewallPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "policy1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestWebApplicationFirewallPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
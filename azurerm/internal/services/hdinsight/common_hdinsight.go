@@ -32,10 +32,10 @@ func hdinsightClusterUpdate(clusterKind string, readFunc schema.ReadFunc) schema
 		resourceGroup := id.ResourceGroup
 		name := id.Name
 
-		if d.HasChange("tags") {
+		if d.HasChanges("tags", "tags_all") {
 			t := d.Get("tags").(map[string]interface{})
 			params := hdinsight.ClusterPatchParameters{
-				Tags: tags.Expand(t),
+				Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			}
 			if _, err := client.Update(ctx, resourceGroup, name, params); err != nil {
 				return fmt.Errorf("Error updating Tags for HDInsight %q Cluster %q (Resource Group %q): %+v", clusterKind, name, resourceGroup, err)
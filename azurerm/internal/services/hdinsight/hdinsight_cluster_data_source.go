@@ -159,7 +159,7 @@ func dataSourceHDInsightClusterRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("kafka_rest_proxy_endpoint", kafkaRestProxyEndpoint)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenHDInsightsDataSourceComponentVersions(input map[string]*string) map[string]string {
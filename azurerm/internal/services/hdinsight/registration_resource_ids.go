@@ -0,0 +1,70 @@
+package hdinsight
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hdinsight/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.HDInsight/clusters",
+			TerraformResourceType: "azurerm_hdinsight_hadoop_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.HDInsight/clusters",
+			TerraformResourceType: "azurerm_hdinsight_hbase_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.HDInsight/clusters",
+			TerraformResourceType: "azurerm_hdinsight_interactive_query_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.HDInsight/clusters",
+			TerraformResourceType: "azurerm_hdinsight_kafka_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.HDInsight/clusters",
+			TerraformResourceType: "azurerm_hdinsight_ml_services_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.HDInsight/clusters",
+			TerraformResourceType: "azurerm_hdinsight_rserver_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.HDInsight/clusters",
+			TerraformResourceType: "azurerm_hdinsight_spark_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.HDInsight/clusters",
+			TerraformResourceType: "azurerm_hdinsight_storm_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ServiceId{}
+var _ resourceid.SegmentedID = ServiceId{}
 
 func TestServiceIDFormatter(t *testing.T) {
 	actual := NewServiceID("12345678-1234-9876-4563-123456789012", "group1", "service1").ID()
@@ -18,6 +19,43 @@ func TestServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServiceIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.HealthcareApis/services/service1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.HEALTHCAREAPIS/SERVICES/service1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.HealthcareApis/services/service1other",
+			Expected: false,
+		},
+	}
+
+	id := NewServiceID("12345678-1234-9876-4563-123456789012", "group1", "service1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
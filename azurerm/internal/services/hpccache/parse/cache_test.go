@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = CacheId{}
+var _ resourceid.SegmentedID = CacheId{}
 
 func TestCacheIDFormatter(t *testing.T) {
 	actual := NewCacheID("12345678-1234-9876-4563-123456789012", "group1", "cache1").ID()
@@ -18,6 +19,43 @@ func TestCacheIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCacheIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.STORAGECACHE/CACHES/cache1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1other",
+			Expected: false,
+		},
+	}
+
+	id := NewCacheID("12345678-1234-9876-4563-123456789012", "group1", "cache1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestCacheID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageTargetId struct {
@@ -25,6 +25,16 @@ func NewStorageTargetID(subscriptionId, resourceGroup, cacheName, name string) S
 	}
 }
 
+// NewStorageTargetIDFromParent returns a StorageTargetId for the StorageTarget within the specified Cache
+func NewStorageTargetIDFromParent(parent CacheId, name string) StorageTargetId {
+	return StorageTargetId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		CacheName:      parent.Name,
+		Name:           name,
+	}
+}
+
 func (id StorageTargetId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id StorageTargetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.CacheName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this StorageTarget
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id StorageTargetId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this StorageTarget ID
+func (id StorageTargetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftStorageCache", "Microsoft.StorageCache"),
+		resourceid.StaticSegment("staticCaches", "caches", false),
+		resourceid.UserSpecifiedSegment("cacheName"),
+		resourceid.StaticSegment("staticStorageTargets", "storageTargets", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // StorageTargetID parses a StorageTarget ID into an StorageTargetId struct
 func StorageTargetID(input string) (*StorageTargetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, StorageTargetId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageTargetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.CacheName, err = id.PopSegment("caches"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("storageTargets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		CacheName:      values["cacheName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = StorageTargetId{}
+var _ resourceid.SegmentedID = StorageTargetId{}
 
 func TestStorageTargetIDFormatter(t *testing.T) {
 	actual := NewStorageTargetID("12345678-1234-9876-4563-123456789012", "group1", "cache1", "target1").ID()
@@ -18,6 +19,52 @@ func TestStorageTargetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageTargetIDFromParent(t *testing.T) {
+	parent := NewCacheID("12345678-1234-9876-4563-123456789012", "group1", "cache1")
+	actual := NewStorageTargetIDFromParent(parent, "target1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1/storageTargets/target1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageTargetIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1/storageTargets/target1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.STORAGECACHE/CACHES/cache1/STORAGETARGETS/target1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.StorageCache/caches/cache1/storageTargets/target1other",
+			Expected: false,
+		},
+	}
+
+	id := NewStorageTargetID("12345678-1234-9876-4563-123456789012", "group1", "cache1", "target1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestStorageTargetID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,35 @@
+package hpccache
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/hpccache/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.StorageCache/caches",
+			TerraformResourceType: "azurerm_hpc_cache",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CacheID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StorageCache/caches/storageTargets",
+			TerraformResourceType: "azurerm_hpc_cache_blob_target",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StorageTargetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StorageCache/caches/storageTargets",
+			TerraformResourceType: "azurerm_hpc_cache_nfs_target",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StorageTargetID(input)
+			},
+		},
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DedicatedHardwareSecurityModuleId struct {
@@ -37,32 +37,37 @@ func (id DedicatedHardwareSecurityModuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DedicatedHSMName)
 }
 
+// Matches returns whether the specified Resource ID refers to this DedicatedHardwareSecurityModule
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DedicatedHardwareSecurityModuleId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DedicatedHardwareSecurityModule ID
+func (id DedicatedHardwareSecurityModuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftHardwareSecurityModules", "Microsoft.HardwareSecurityModules"),
+		resourceid.StaticSegment("staticDedicatedHSMs", "dedicatedHSMs", false),
+		resourceid.UserSpecifiedSegment("dedicatedHSMName"),
+	}
+}
+
 // DedicatedHardwareSecurityModuleID parses a DedicatedHardwareSecurityModule ID into an DedicatedHardwareSecurityModuleId struct
 func DedicatedHardwareSecurityModuleID(input string) (*DedicatedHardwareSecurityModuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DedicatedHardwareSecurityModuleId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DedicatedHardwareSecurityModuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.DedicatedHSMName, err = id.PopSegment("dedicatedHSMs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		DedicatedHSMName: values["dedicatedHSMName"],
 	}
 
 	return &resourceId, nil
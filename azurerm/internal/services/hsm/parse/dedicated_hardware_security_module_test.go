@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DedicatedHardwareSecurityModuleId{}
+var _ resourceid.SegmentedID = DedicatedHardwareSecurityModuleId{}
 
 func TestDedicatedHardwareSecurityModuleIDFormatter(t *testing.T) {
 	actual := NewDedicatedHardwareSecurityModuleID("12345678-1234-9876-4563-123456789012", "group1", "hsm1").ID()
@@ -18,6 +19,43 @@ func TestDedicatedHardwareSecurityModuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDedicatedHardwareSecurityModuleIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.HARDWARESECURITYMODULES/DEDICATEDHSMS/hsm1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.HardwareSecurityModules/dedicatedHSMs/hsm1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDedicatedHardwareSecurityModuleID("12345678-1234-9876-4563-123456789012", "group1", "hsm1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDedicatedHardwareSecurityModuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
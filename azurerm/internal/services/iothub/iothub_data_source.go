@@ -54,5 +54,5 @@ func dataSourceIotHubRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("resource_group_name", resourceGroup)
 	d.SetId(*resp.ID)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -89,5 +89,5 @@ func dataSourceIotHubDPSRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("allocation_policy", props.AllocationPolicy)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -569,7 +569,7 @@ func resourceIotHubCreateUpdate(d *schema.ResourceData, meta interface{}) error
 			MessagingEndpoints:            messagingEndpoints,
 			EnableFileUploadNotifications: &enableFileUploadNotifications,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	// nolint staticcheck
@@ -726,7 +726,7 @@ func resourceIotHubRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
 	d.Set("type", hub.Type)
-	return tags.FlattenAndSet(d, hub.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, hub.Tags)
 }
 
 func resourceIotHubDelete(d *schema.ResourceData, meta interface{}) error {
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EnrichmentId struct {
@@ -25,6 +25,16 @@ func NewEnrichmentID(subscriptionId, resourceGroup, iotHubName, name string) Enr
 	}
 }
 
+// NewEnrichmentIDFromParent returns a EnrichmentId for the Enrichment within the specified IotHub
+func NewEnrichmentIDFromParent(parent IotHubId, name string) EnrichmentId {
+	return EnrichmentId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		IotHubName:     parent.Name,
+		Name:           name,
+	}
+}
+
 func (id EnrichmentId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id EnrichmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.IotHubName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Enrichment
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id EnrichmentId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Enrichment ID
+func (id EnrichmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDevices", "Microsoft.Devices"),
+		resourceid.StaticSegment("staticIotHubs", "IotHubs", false),
+		resourceid.UserSpecifiedSegment("iotHubName"),
+		resourceid.StaticSegment("staticEnrichments", "Enrichments", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // EnrichmentID parses a Enrichment ID into an EnrichmentId struct
 func EnrichmentID(input string) (*EnrichmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, EnrichmentId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EnrichmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.IotHubName, err = id.PopSegment("IotHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("Enrichments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		IotHubName:     values["iotHubName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = EnrichmentId{}
+var _ resourceid.SegmentedID = EnrichmentId{}
 
 func TestEnrichmentIDFormatter(t *testing.T) {
 	actual := NewEnrichmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "enrichment1").ID()
@@ -18,6 +19,52 @@ func TestEnrichmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEnrichmentIDFromParent(t *testing.T) {
+	parent := NewIotHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1")
+	actual := NewEnrichmentIDFromParent(parent, "enrichment1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1/Enrichments/enrichment1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestEnrichmentIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1/Enrichments/enrichment1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DEVICES/IOTHUBS/hub1/ENRICHMENTS/enrichment1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1/Enrichments/enrichment1other",
+			Expected: false,
+		},
+	}
+
+	id := NewEnrichmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1", "enrichment1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestEnrichmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = IotHubId{}
+var _ resourceid.SegmentedID = IotHubId{}
 
 func TestIotHubIDFormatter(t *testing.T) {
 	actual := NewIotHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1").ID()
@@ -18,6 +19,43 @@ func TestIotHubIDFormatter(t *testing.T) {
 	}
 }
 
+func TestIotHubIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DEVICES/IOTHUBS/hub1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Devices/IotHubs/hub1other",
+			Expected: false,
+		},
+	}
+
+	id := NewIotHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "hub1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestIotHubID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,28 @@
+package iothub
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iothub/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Devices/IotHubs",
+			TerraformResourceType: "azurerm_iothub",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IotHubID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Devices/IotHubs/Enrichments",
+			TerraformResourceType: "azurerm_iothub_enrichment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.EnrichmentID(input)
+			},
+		},
+	}
+}
@@ -143,7 +143,7 @@ func resourceIoTTimeSeriesInsightsGen2EnvironmentCreateUpdate(d *schema.Resource
 
 	environment := timeseriesinsights.Gen2EnvironmentCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		Sku:      sku,
 		Gen2EnvironmentCreationProperties: &timeseriesinsights.Gen2EnvironmentCreationProperties{
 			TimeSeriesIDProperties: expandIdProperties(d.Get("id_properties").(*schema.Set).List()),
@@ -225,7 +225,7 @@ func resourceIoTTimeSeriesInsightsGen2EnvironmentRead(d *schema.ResourceData, me
 		return fmt.Errorf("setting `storage`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, environment.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, environment.Tags)
 }
 
 func resourceIoTTimeSeriesInsightsGen2EnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
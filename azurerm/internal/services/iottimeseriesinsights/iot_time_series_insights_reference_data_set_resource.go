@@ -130,7 +130,7 @@ func resourceIoTTimeSeriesInsightsReferenceDataSetCreateUpdate(d *schema.Resourc
 
 	dataset := timeseriesinsights.ReferenceDataSetCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		ReferenceDataSetCreationProperties: &timeseriesinsights.ReferenceDataSetCreationProperties{
 			DataStringComparisonBehavior: timeseriesinsights.DataStringComparisonBehavior(d.Get("data_string_comparison_behavior").(string)),
 			KeyProperties:                expandIoTTimeSeriesInsightsReferenceDataSetKeyProperties(d.Get("key_property").(*schema.Set).List()),
@@ -188,7 +188,7 @@ func resourceIoTTimeSeriesInsightsReferenceDataSetRead(d *schema.ResourceData, m
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceIoTTimeSeriesInsightsReferenceDataSetDelete(d *schema.ResourceData, meta interface{}) error {
@@ -147,7 +147,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironmentCreateUpdate(d *schema.Reso
 
 	environment := timeseriesinsights.Gen1EnvironmentCreateOrUpdateParameters{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		Sku:      sku,
 		Gen1EnvironmentCreationProperties: &timeseriesinsights.Gen1EnvironmentCreationProperties{
 			StorageLimitExceededBehavior: timeseriesinsights.StorageLimitExceededBehavior(d.Get("storage_limit_exceeded_behavior").(string)),
@@ -235,7 +235,7 @@ func resourceIoTTimeSeriesInsightsStandardEnvironmentRead(d *schema.ResourceData
 		}
 	}
 
-	return tags.FlattenAndSet(d, environment.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, environment.Tags)
 }
 
 func resourceIoTTimeSeriesInsightsStandardEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AccessPolicyId struct {
@@ -25,6 +25,16 @@ func NewAccessPolicyID(subscriptionId, resourceGroup, environmentName, name stri
 	}
 }
 
+// NewAccessPolicyIDFromParent returns a AccessPolicyId for the AccessPolicy within the specified Environment
+func NewAccessPolicyIDFromParent(parent EnvironmentId, name string) AccessPolicyId {
+	return AccessPolicyId{
+		SubscriptionId:  parent.SubscriptionId,
+		ResourceGroup:   parent.ResourceGroup,
+		EnvironmentName: parent.Name,
+		Name:            name,
+	}
+}
+
 func (id AccessPolicyId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id AccessPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.EnvironmentName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this AccessPolicy
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id AccessPolicyId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this AccessPolicy ID
+func (id AccessPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftTimeSeriesInsights", "Microsoft.TimeSeriesInsights"),
+		resourceid.StaticSegment("staticEnvironments", "environments", false),
+		resourceid.UserSpecifiedSegment("environmentName"),
+		resourceid.StaticSegment("staticAccessPolicies", "accessPolicies", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // AccessPolicyID parses a AccessPolicy ID into an AccessPolicyId struct
 func AccessPolicyID(input string) (*AccessPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, AccessPolicyId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AccessPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.EnvironmentName, err = id.PopSegment("environments"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("accessPolicies"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:  values["subscriptionId"],
+		ResourceGroup:   values["resourceGroup"],
+		EnvironmentName: values["environmentName"],
+		Name:            values["name"],
 	}
 
 	return &resourceId, nil
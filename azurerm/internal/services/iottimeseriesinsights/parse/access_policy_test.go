@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AccessPolicyId{}
+var _ resourceid.SegmentedID = AccessPolicyId{}
 
 func TestAccessPolicyIDFormatter(t *testing.T) {
 	actual := NewAccessPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "environment1", "policy1").ID()
@@ -18,6 +19,52 @@ func TestAccessPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAccessPolicyIDFromParent(t *testing.T) {
+	parent := NewEnvironmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "environment1")
+	actual := NewAccessPolicyIDFromParent(parent, "policy1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/accessPolicies/policy1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestAccessPolicyIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/accessPolicies/policy1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.TIMESERIESINSIGHTS/ENVIRONMENTS/environment1/ACCESSPOLICIES/policy1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/accessPolicies/policy1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAccessPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "environment1", "policy1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAccessPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = EnvironmentId{}
+var _ resourceid.SegmentedID = EnvironmentId{}
 
 func TestEnvironmentIDFormatter(t *testing.T) {
 	actual := NewEnvironmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "environment1").ID()
@@ -18,6 +19,43 @@ func TestEnvironmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEnvironmentIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.TIMESERIESINSIGHTS/ENVIRONMENTS/environment1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1other",
+			Expected: false,
+		},
+	}
+
+	id := NewEnvironmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "environment1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestEnvironmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ReferenceDataSetId struct {
@@ -25,6 +25,16 @@ func NewReferenceDataSetID(subscriptionId, resourceGroup, environmentName, name
 	}
 }
 
+// NewReferenceDataSetIDFromParent returns a ReferenceDataSetId for the ReferenceDataSet within the specified Environment
+func NewReferenceDataSetIDFromParent(parent EnvironmentId, name string) ReferenceDataSetId {
+	return ReferenceDataSetId{
+		SubscriptionId:  parent.SubscriptionId,
+		ResourceGroup:   parent.ResourceGroup,
+		EnvironmentName: parent.Name,
+		Name:            name,
+	}
+}
+
 func (id ReferenceDataSetId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id ReferenceDataSetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.EnvironmentName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this ReferenceDataSet
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ReferenceDataSetId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ReferenceDataSet ID
+func (id ReferenceDataSetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftTimeSeriesInsights", "Microsoft.TimeSeriesInsights"),
+		resourceid.StaticSegment("staticEnvironments", "environments", false),
+		resourceid.UserSpecifiedSegment("environmentName"),
+		resourceid.StaticSegment("staticReferenceDataSets", "referenceDataSets", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // ReferenceDataSetID parses a ReferenceDataSet ID into an ReferenceDataSetId struct
 func ReferenceDataSetID(input string) (*ReferenceDataSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ReferenceDataSetId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ReferenceDataSetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.EnvironmentName, err = id.PopSegment("environments"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("referenceDataSets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:  values["subscriptionId"],
+		ResourceGroup:   values["resourceGroup"],
+		EnvironmentName: values["environmentName"],
+		Name:            values["name"],
 	}
 
 	return &resourceId, nil
@@ -0,0 +1,42 @@
+package iottimeseriesinsights
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/iottimeseriesinsights/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.TimeSeriesInsights/environments/accessPolicies",
+			TerraformResourceType: "azurerm_iot_time_series_insights_access_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AccessPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.TimeSeriesInsights/environments",
+			TerraformResourceType: "azurerm_iot_time_series_insights_gen2_environment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.EnvironmentID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.TimeSeriesInsights/environments/referenceDataSets",
+			TerraformResourceType: "azurerm_iot_time_series_insights_reference_data_set",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ReferenceDataSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.TimeSeriesInsights/environments",
+			TerraformResourceType: "azurerm_iot_time_series_insights_standard_environment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.EnvironmentID(input)
+			},
+		},
+	}
+}
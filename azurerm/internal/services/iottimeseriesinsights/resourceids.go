@@ -3,3 +3,6 @@ package iottimeseriesinsights
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=AccessPolicy -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/accessPolicies/policy1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Environment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ReferenceDataSet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.TimeSeriesInsights/environments/environment1/referenceDataSets/dataSet1
+
+// Resource ID Registrations, mapping each Resource ID to the Resource which manages it
+//go:generate go run ../../tools/generator-resource-id-registrations/main.go -path=./
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"strings"
+
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2019-09-01/keyvault"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
@@ -9,6 +11,8 @@ import (
 type Client struct {
 	ManagementClient *keyvaultmgmt.BaseClient
 	VaultsClient     *keyvault.VaultsClient
+
+	managedHSMDNSSuffix string
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -21,5 +25,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		ManagementClient: &managementClient,
 		VaultsClient:     &vaultsClient,
+
+		// the Managed HSM DNS Suffix isn't defined in the Environment, but is the equivalent of the Key Vault
+		// DNS Suffix (e.g. `managedhsm.azure.net` rather than `vault.azure.net`)
+		managedHSMDNSSuffix: strings.Replace(o.Environment.KeyVaultDNSSuffix, "vault.", "managedhsm.", 1),
 	}
 }
@@ -58,6 +58,12 @@ func (c *Client) BaseUriForKeyVault(ctx context.Context, keyVaultId parse.VaultI
 	return resp.Properties.VaultURI, nil
 }
 
+// BaseUriForManagedHSM returns the Data Plane URI for the specified Managed HSM, which (unlike a Key Vault)
+// is determined by the name of the Managed HSM
+func (c *Client) BaseUriForManagedHSM(managedHSMId parse.ManagedHSMId) string {
+	return fmt.Sprintf("https://%s.%s/", managedHSMId.Name, c.managedHSMDNSSuffix)
+}
+
 func (c *Client) Exists(ctx context.Context, keyVaultId parse.VaultId) (bool, error) {
 	cacheKey := c.cacheKeyForKeyVault(keyVaultId.Name)
 	keysmith.Lock()
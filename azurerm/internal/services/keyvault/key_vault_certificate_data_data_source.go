@@ -187,5 +187,5 @@ func dataSourceArmKeyVaultCertificateDataRead(d *schema.ResourceData, meta inter
 	d.Set("pem", certPEM.String())
 	d.Set("key", keyPEM.String())
 
-	return tags.FlattenAndSet(d, cert.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, cert.Tags)
 }
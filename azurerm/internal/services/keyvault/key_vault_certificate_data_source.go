@@ -299,7 +299,7 @@ func dataSourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("thumbprint", thumbprint)
 
-	return tags.FlattenAndSet(d, cert.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, cert.Tags)
 }
 
 func flattenKeyVaultCertificatePolicyForDataSource(input *keyvault.CertificatePolicy) []interface{} {
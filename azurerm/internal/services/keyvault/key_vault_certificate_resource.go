@@ -28,9 +28,9 @@ import (
 
 func resourceKeyVaultCertificate() *schema.Resource {
 	return &schema.Resource{
-		// TODO: support Updating once we have more information about what can be updated
 		Create: resourceKeyVaultCertificateCreate,
 		Read:   resourceKeyVaultCertificateRead,
+		Update: resourceKeyVaultCertificateUpdate,
 		Delete: resourceKeyVaultCertificateDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -40,6 +40,7 @@ func resourceKeyVaultCertificate() *schema.Resource {
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -61,20 +62,17 @@ func resourceKeyVaultCertificate() *schema.Resource {
 			"certificate": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"contents": {
 							Type:      schema.TypeString,
 							Required:  true,
-							ForceNew:  true,
 							Sensitive: true,
 						},
 						"password": {
 							Type:      schema.TypeString,
 							Optional:  true,
-							ForceNew:  true,
 							Sensitive: true,
 						},
 					},
@@ -84,7 +82,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 			"certificate_policy": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -97,7 +94,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 									"name": {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
 									},
 								},
 							},
@@ -111,12 +107,10 @@ func resourceKeyVaultCertificate() *schema.Resource {
 									"exportable": {
 										Type:     schema.TypeBool,
 										Required: true,
-										ForceNew: true,
 									},
 									"key_size": {
 										Type:     schema.TypeInt,
 										Required: true,
-										ForceNew: true,
 										ValidateFunc: validation.IntInSlice([]int{
 											2048,
 											3072,
@@ -126,12 +120,10 @@ func resourceKeyVaultCertificate() *schema.Resource {
 									"key_type": {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
 									},
 									"reuse_key": {
 										Type:     schema.TypeBool,
 										Required: true,
-										ForceNew: true,
 									},
 								},
 							},
@@ -150,7 +142,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 												"action_type": {
 													Type:     schema.TypeString,
 													Required: true,
-													ForceNew: true,
 													ValidateFunc: validation.StringInSlice([]string{
 														string(keyvault.AutoRenew),
 														string(keyvault.EmailContacts),
@@ -168,12 +159,10 @@ func resourceKeyVaultCertificate() *schema.Resource {
 												"days_before_expiry": {
 													Type:     schema.TypeInt,
 													Optional: true,
-													ForceNew: true,
 												},
 												"lifetime_percentage": {
 													Type:     schema.TypeInt,
 													Optional: true,
-													ForceNew: true,
 												},
 											},
 										},
@@ -190,7 +179,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 									"content_type": {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
 									},
 								},
 							},
@@ -207,7 +195,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 										Type:     schema.TypeList,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 										Elem: &schema.Schema{
 											Type:         schema.TypeString,
 											ValidateFunc: validation.StringIsNotEmpty,
@@ -216,7 +203,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 									"key_usage": {
 										Type:     schema.TypeList,
 										Required: true,
-										ForceNew: true,
 										Elem: &schema.Schema{
 											Type: schema.TypeString,
 											ValidateFunc: validation.StringInSlice([]string{
@@ -235,12 +221,10 @@ func resourceKeyVaultCertificate() *schema.Resource {
 									"subject": {
 										Type:     schema.TypeString,
 										Required: true,
-										ForceNew: true,
 									},
 									"subject_alternative_names": {
 										Type:     schema.TypeList,
 										Optional: true,
-										ForceNew: true,
 										Computed: true,
 										MaxItems: 1,
 										Elem: &schema.Resource{
@@ -248,7 +232,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 												"emails": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type: schema.TypeString,
 													},
@@ -257,7 +240,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 												"dns_names": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type: schema.TypeString,
 													},
@@ -266,7 +248,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 												"upns": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type: schema.TypeString,
 													},
@@ -278,7 +259,6 @@ func resourceKeyVaultCertificate() *schema.Resource {
 									"validity_in_months": {
 										Type:     schema.TypeInt,
 										Required: true,
-										ForceNew: true,
 									},
 								},
 							},
@@ -331,6 +311,11 @@ func resourceKeyVaultCertificate() *schema.Resource {
 				Computed: true,
 			},
 
+			"versionless_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"secret_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -351,9 +336,50 @@ func resourceKeyVaultCertificate() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tags.ForceNewSchema(),
+			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: keyVaultCertificateCustomizeDiff,
+	}
+}
+
+// keyVaultCertificateCustomizeDiff marks the fields which change when a new version of the Certificate is
+// imported or issued (see the Update function) as Computed, and recreates an imported Certificate when the
+// `certificate` block is removed, since Key Vault can't issue a new version of a Certificate which it didn't issue.
+//
+// NOTE: the `id` (which contains the version) can't be marked as Computed here, however since this uses the
+// legacy SDK Terraform allows this to change during the apply.
+func keyVaultCertificateCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldCertificate, newCertificate := d.GetChange("certificate")
+	wasImported := len(oldCertificate.([]interface{})) > 0
+	importing := len(newCertificate.([]interface{})) > 0
+	if wasImported && !importing {
+		return d.ForceNew("certificate")
 	}
+
+	newVersion := importing && d.HasChange("certificate")
+	if !importing {
+		for _, key := range []string{"certificate_policy.0.issuer_parameters", "certificate_policy.0.key_properties", "certificate_policy.0.x509_certificate_properties"} {
+			if d.HasChange(key) {
+				newVersion = true
+			}
+		}
+	}
+	if !newVersion {
+		return nil
+	}
+
+	for _, key := range []string{"version", "secret_id", "thumbprint", "certificate_data", "certificate_data_base64", "certificate_attribute"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return fmt.Errorf("setting %q to be computed: %+v", key, err)
+		}
+	}
+
+	return nil
 }
 
 func resourceKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{}) error {
@@ -394,7 +420,7 @@ func resourceKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{})
 			Base64EncodedCertificate: utils.String(certificate.CertificateData),
 			Password:                 utils.String(certificate.CertificatePassword),
 			CertificatePolicy:        &policy,
-			Tags:                     tags.Expand(t),
+			Tags:                     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		}
 		if _, err := client.ImportCertificate(ctx, *keyVaultBaseUrl, name, importParameters); err != nil {
 			return err
@@ -403,7 +429,7 @@ func resourceKeyVaultCertificateCreate(d *schema.ResourceData, meta interface{})
 		// Generate new
 		parameters := keyvault.CertificateCreateParameters{
 			CertificatePolicy: &policy,
-			Tags:              tags.Expand(t),
+			Tags:              tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		}
 		if resp, err := client.CreateCertificate(ctx, *keyVaultBaseUrl, name, parameters); err != nil {
 			if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults && utils.ResponseWasConflict(resp.Response) {
@@ -486,6 +512,122 @@ func keyVaultCertificateCreationRefreshFunc(ctx context.Context, client *keyvaul
 	}
 }
 
+func resourceKeyVaultCertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagementClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ParseNestedItemID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	t := d.Get("tags").(map[string]interface{})
+	policy := expandKeyVaultCertificatePolicy(d)
+
+	_, importing := d.GetOk("certificate")
+	switch {
+	case importing && d.HasChange("certificate"):
+		// importing a certificate creates a new version of this certificate
+		log.Printf("[DEBUG] Importing a new version of Certificate %q in Vault %q", id.Name, id.KeyVaultBaseUrl)
+		certificate := expandKeyVaultCertificate(d.Get("certificate"))
+		importParameters := keyvault.CertificateImportParameters{
+			Base64EncodedCertificate: utils.String(certificate.CertificateData),
+			Password:                 utils.String(certificate.CertificatePassword),
+			CertificatePolicy:        &policy,
+			Tags:                     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
+		}
+		if _, err := client.ImportCertificate(ctx, id.KeyVaultBaseUrl, id.Name, importParameters); err != nil {
+			return fmt.Errorf("importing a new version of Certificate %q in Vault %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+
+	case !importing && d.HasChanges("certificate_policy.0.issuer_parameters", "certificate_policy.0.key_properties", "certificate_policy.0.x509_certificate_properties"):
+		// changes to the key, issuer or subject only apply to newly issued certificates - so we issue a new version
+		log.Printf("[DEBUG] Issuing a new version of Certificate %q in Vault %q", id.Name, id.KeyVaultBaseUrl)
+		parameters := keyvault.CertificateCreateParameters{
+			CertificatePolicy: &policy,
+			Tags:              tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
+		}
+		if _, err := client.CreateCertificate(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+			return fmt.Errorf("issuing a new version of Certificate %q in Vault %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+
+		log.Printf("[DEBUG] Waiting for the new version of Certificate %q in Vault %q to be issued", id.Name, id.KeyVaultBaseUrl)
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"Provisioning"},
+			Target:     []string{"Ready"},
+			Refresh:    keyVaultCertificateOperationRefreshFunc(ctx, client, id.KeyVaultBaseUrl, id.Name),
+			MinTimeout: 15 * time.Second,
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+		}
+		if policy.IssuerParameters != nil && policy.IssuerParameters.Name != nil && *policy.IssuerParameters.Name != "Self" {
+			stateConf.PollInterval = 30 * time.Second
+		}
+
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("waiting for the new version of Certificate %q in Vault %q to be issued: %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+
+	case d.HasChange("certificate_policy"):
+		// the remaining fields (e.g. the lifetime actions) can be updated in-place
+		log.Printf("[DEBUG] Updating the Policy for Certificate %q in Vault %q", id.Name, id.KeyVaultBaseUrl)
+		if _, err := client.UpdateCertificatePolicy(ctx, id.KeyVaultBaseUrl, id.Name, policy); err != nil {
+			return fmt.Errorf("updating the Policy for Certificate %q in Vault %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	if d.HasChanges("tags", "tags_all") {
+		// an empty version updates the latest version of the certificate
+		parameters := keyvault.CertificateUpdateParameters{
+			Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
+		}
+		if _, err := client.UpdateCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+			return fmt.Errorf("updating the Tags for Certificate %q in Vault %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	// the ID contains the version, which changes when a new version is imported or issued
+	resp, err := client.GetCertificate(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Certificate %q in Vault %q: %+v", id.Name, id.KeyVaultBaseUrl, err)
+	}
+	if resp.ID == nil {
+		return fmt.Errorf("retrieving Certificate %q in Vault %q: `id` was nil", id.Name, id.KeyVaultBaseUrl)
+	}
+	d.SetId(*resp.ID)
+
+	return resourceKeyVaultCertificateRead(d, meta)
+}
+
+func keyVaultCertificateOperationRefreshFunc(ctx context.Context, client *keyvault.BaseClient, keyVaultBaseUrl string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.GetCertificateOperation(ctx, keyVaultBaseUrl, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving the pending operation for Certificate %q in Vault %q: %+v", name, keyVaultBaseUrl, err)
+		}
+
+		status := ""
+		if res.Status != nil {
+			status = *res.Status
+		}
+
+		switch strings.ToLower(status) {
+		case "inprogress":
+			return res, "Provisioning", nil
+		case "completed":
+			return res, "Ready", nil
+		}
+
+		message := ""
+		if res.Error != nil && res.Error.Message != nil {
+			message = *res.Error.Message
+		} else if res.StatusDetails != nil {
+			message = *res.StatusDetails
+		}
+		return nil, "", fmt.Errorf("the pending operation for Certificate %q in Vault %q has the status %q: %s", name, keyVaultBaseUrl, status, message)
+	}
+}
+
 func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) error {
 	keyVaultsClient := meta.(*clients.Client).KeyVault
 	client := meta.(*clients.Client).KeyVault.ManagementClient
@@ -547,6 +689,7 @@ func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) e
 
 	// Computed
 	d.Set("version", id.Version)
+	d.Set("versionless_id", fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.NestedItemType, id.Name))
 	d.Set("secret_id", cert.Sid)
 
 	certificateData := ""
@@ -572,7 +715,7 @@ func resourceKeyVaultCertificateRead(d *schema.ResourceData, meta interface{}) e
 	}
 	d.Set("thumbprint", thumbprint)
 
-	return tags.FlattenAndSet(d, cert.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, cert.Tags)
 }
 
 func resourceKeyVaultCertificateDelete(d *schema.ResourceData, meta interface{}) error {
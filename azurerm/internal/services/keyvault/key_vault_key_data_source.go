@@ -121,7 +121,7 @@ func dataSourceKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("version", parsedId.Version)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenKeyVaultKeyDataSourceOptions(input *[]string) []interface{} {
@@ -0,0 +1,318 @@
+package keyvault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// octHSM is a symmetric key stored in a Hardware Security Module, which isn't defined in the SDK
+const octHSM keyvault.JSONWebKeyType = "oct-HSM"
+
+// keyVaultKeyImportSource is the key material which should be imported into the Key Vault, exactly one of
+// which should be specified
+type keyVaultKeyImportSource struct {
+	// PEM is a PEM encoded (unencrypted) RSA or EC private key, in either PKCS#1, PKCS#8 or SEC 1 format
+	PEM string
+
+	// JWK is a JSON Web Key containing the private key (or the symmetric key for an `oct` key)
+	JWK string
+
+	// BYOKTransferBlob is the base64 encoded key transfer blob generated by the HSM vendor's BYOK tooling
+	BYOKTransferBlob string
+}
+
+func expandKeyVaultKeyImportSource(input []interface{}) *keyVaultKeyImportSource {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	raw := input[0].(map[string]interface{})
+	return &keyVaultKeyImportSource{
+		PEM:              raw["pem"].(string),
+		JWK:              raw["jwk"].(string),
+		BYOKTransferBlob: raw["byok_transfer_blob"].(string),
+	}
+}
+
+// jsonWebKey parses the key material into a JSON Web Key of the specified Key Type, which can be imported
+func (s keyVaultKeyImportSource) jsonWebKey(keyType keyvault.JSONWebKeyType) (*keyvault.JSONWebKey, error) {
+	var key *keyvault.JSONWebKey
+	var err error
+
+	switch {
+	case s.PEM != "":
+		key, err = parseKeyVaultKeyFromPEM(s.PEM)
+	case s.JWK != "":
+		key, err = parseKeyVaultKeyFromJWK(s.JWK)
+	case s.BYOKTransferBlob != "":
+		key, err = parseKeyVaultKeyFromBYOKTransferBlob(s.BYOKTransferBlob, keyType)
+	default:
+		return nil, fmt.Errorf("one of `pem`, `jwk` or `byok_transfer_blob` must be specified")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !keyVaultKeyTypesMatch(key.Kty, keyType) {
+		return nil, fmt.Errorf("the key material is a %q key but the `key_type` is %q", string(key.Kty), string(keyType))
+	}
+	key.Kty = keyType
+
+	return key, nil
+}
+
+// keyVaultKeyTypesMatch returns whether the imported key (e.g. `RSA`) can be imported as the specified Key Type (e.g. `RSA-HSM`)
+func keyVaultKeyTypesMatch(imported, keyType keyvault.JSONWebKeyType) bool {
+	family := func(input keyvault.JSONWebKeyType) string {
+		return strings.TrimSuffix(strings.ToLower(string(input)), "-hsm")
+	}
+
+	return family(imported) == family(keyType)
+}
+
+func keyVaultKeyTypeIsHSM(keyType keyvault.JSONWebKeyType) bool {
+	return strings.HasSuffix(strings.ToLower(string(keyType)), "-hsm")
+}
+
+func keyVaultKeyTypeIsSymmetric(keyType keyvault.JSONWebKeyType) bool {
+	return strings.EqualFold(string(keyType), string(keyvault.Oct)) || strings.EqualFold(string(keyType), string(octHSM))
+}
+
+func parseKeyVaultKeyFromPEM(input string) (*keyvault.JSONWebKey, error) {
+	rest := []byte(input)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, fmt.Errorf("no private key was found in the PEM")
+		}
+
+		//nolint:staticcheck
+		if x509.IsEncryptedPEMBlock(block) || block.Type == "ENCRYPTED PRIVATE KEY" {
+			return nil, fmt.Errorf("encrypted private keys aren't supported, the private key must be decrypted before importing")
+		}
+
+		switch block.Type {
+		case "RSA PRIVATE KEY":
+			key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the PKCS#1 RSA private key: %+v", err)
+			}
+			return jsonWebKeyFromRSA(key), nil
+
+		case "EC PRIVATE KEY":
+			key, err := x509.ParseECPrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the SEC 1 EC private key: %+v", err)
+			}
+			return jsonWebKeyFromECDSA(key)
+
+		case "PRIVATE KEY":
+			key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the PKCS#8 private key: %+v", err)
+			}
+
+			switch v := key.(type) {
+			case *rsa.PrivateKey:
+				return jsonWebKeyFromRSA(v), nil
+			case *ecdsa.PrivateKey:
+				return jsonWebKeyFromECDSA(v)
+			default:
+				return nil, fmt.Errorf("unsupported PKCS#8 private key type %T, only RSA and EC keys can be imported", key)
+			}
+		}
+
+		// other blocks (e.g. `EC PARAMETERS` or a `CERTIFICATE`) are skipped
+	}
+}
+
+func jsonWebKeyFromRSA(key *rsa.PrivateKey) *keyvault.JSONWebKey {
+	key.Precompute()
+
+	return &keyvault.JSONWebKey{
+		Kty: keyvault.RSA,
+		N:   base64URL(key.N.Bytes()),
+		E:   base64URL(big.NewInt(int64(key.E)).Bytes()),
+		D:   base64URL(key.D.Bytes()),
+		P:   base64URL(key.Primes[0].Bytes()),
+		Q:   base64URL(key.Primes[1].Bytes()),
+		DP:  base64URL(key.Precomputed.Dp.Bytes()),
+		DQ:  base64URL(key.Precomputed.Dq.Bytes()),
+		QI:  base64URL(key.Precomputed.Qinv.Bytes()),
+	}
+}
+
+func jsonWebKeyFromECDSA(key *ecdsa.PrivateKey) (*keyvault.JSONWebKey, error) {
+	var curve keyvault.JSONWebKeyCurveName
+	switch key.Curve {
+	case elliptic.P256():
+		curve = keyvault.P256
+	case elliptic.P384():
+		curve = keyvault.P384
+	case elliptic.P521():
+		curve = keyvault.P521
+	default:
+		return nil, fmt.Errorf("unsupported curve %q, only P-256, P-384 and P-521 keys can be imported from a PEM", key.Curve.Params().Name)
+	}
+
+	// the coordinates and private key are padded to the size of the curve
+	size := (key.Curve.Params().BitSize + 7) / 8
+	return &keyvault.JSONWebKey{
+		Kty: keyvault.EC,
+		Crv: curve,
+		X:   base64URL(padBytes(key.X.Bytes(), size)),
+		Y:   base64URL(padBytes(key.Y.Bytes(), size)),
+		D:   base64URL(padBytes(key.D.Bytes(), size)),
+	}, nil
+}
+
+func parseKeyVaultKeyFromJWK(input string) (*keyvault.JSONWebKey, error) {
+	var key keyvault.JSONWebKey
+	if err := json.Unmarshal([]byte(input), &key); err != nil {
+		return nil, fmt.Errorf("parsing the JSON Web Key: %+v", err)
+	}
+
+	// the key ID and operations are determined by the Key Vault and `key_opts` respectively
+	key.Kid = nil
+	key.KeyOps = nil
+	key.T = nil
+
+	type component struct {
+		name  string
+		value *string
+	}
+	required := make([]component, 0)
+	switch strings.TrimSuffix(strings.ToLower(string(key.Kty)), "-hsm") {
+	case "rsa":
+		key.Kty = keyvault.RSA
+		required = []component{{"n", key.N}, {"e", key.E}, {"d", key.D}, {"p", key.P}, {"q", key.Q}}
+
+		// the CRT parameters are optional, but must be valid when specified
+		for _, v := range []component{{"dp", key.DP}, {"dq", key.DQ}, {"qi", key.QI}} {
+			if v.value != nil {
+				required = append(required, v)
+			}
+		}
+
+	case "ec":
+		key.Kty = keyvault.EC
+		switch key.Crv {
+		case keyvault.P256, keyvault.P384, keyvault.P521, keyvault.SECP256K1:
+		default:
+			return nil, fmt.Errorf("unsupported curve %q in the JSON Web Key", string(key.Crv))
+		}
+		required = []component{{"x", key.X}, {"y", key.Y}, {"d", key.D}}
+
+	case "oct":
+		key.Kty = keyvault.Oct
+		required = []component{{"k", key.K}}
+
+		if key.K != nil {
+			k, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*key.K, "="))
+			if err != nil {
+				return nil, fmt.Errorf("`k` in the JSON Web Key isn't URL-encoded base64: %+v", err)
+			}
+			if size := len(k) * 8; size != 128 && size != 192 && size != 256 {
+				return nil, fmt.Errorf("symmetric keys must be 128, 192 or 256 bits but got %d bits", size)
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported key type %q in the JSON Web Key, only `RSA`, `EC` and `oct` keys can be imported", string(key.Kty))
+	}
+
+	for _, v := range required {
+		if v.value == nil || *v.value == "" {
+			return nil, fmt.Errorf("`%s` must be specified in the JSON Web Key for a %q key", v.name, string(key.Kty))
+		}
+		if _, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*v.value, "=")); err != nil {
+			return nil, fmt.Errorf("`%s` in the JSON Web Key isn't URL-encoded base64: %+v", v.name, err)
+		}
+	}
+
+	return &key, nil
+}
+
+func parseKeyVaultKeyFromBYOKTransferBlob(input string, keyType keyvault.JSONWebKeyType) (*keyvault.JSONWebKey, error) {
+	if !keyVaultKeyTypeIsHSM(keyType) {
+		return nil, fmt.Errorf("a BYOK transfer blob can only be imported as a `RSA-HSM`, `EC-HSM` or `oct-HSM` key but got %q", string(keyType))
+	}
+
+	blob, err := base64.StdEncoding.DecodeString(strings.TrimSpace(input))
+	if err != nil {
+		return nil, fmt.Errorf("the BYOK transfer blob must be base64 encoded (e.g. using `filebase64`): %+v", err)
+	}
+	if len(blob) == 0 {
+		return nil, fmt.Errorf("the BYOK transfer blob was empty")
+	}
+
+	return &keyvault.JSONWebKey{
+		Kty: keyType,
+		T:   base64URL(blob),
+	}, nil
+}
+
+func base64URL(input []byte) *string {
+	return utils.String(base64.RawURLEncoding.EncodeToString(input))
+}
+
+func padBytes(input []byte, size int) []byte {
+	if len(input) >= size {
+		return input
+	}
+
+	output := make([]byte, size)
+	copy(output[size-len(input):], input)
+	return output
+}
+
+func validateKeyVaultKeyImportPEM(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, err := parseKeyVaultKeyFromPEM(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid private key: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
+func validateKeyVaultKeyImportJWK(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if _, err := parseKeyVaultKeyFromJWK(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid JSON Web Key: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
+func validateKeyVaultKeyImportBYOKTransferBlob(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if blob, err := base64.StdEncoding.DecodeString(strings.TrimSpace(v)); err != nil || len(blob) == 0 {
+		errors = append(errors, fmt.Errorf("%q must be a base64 encoded BYOK transfer blob (e.g. using `filebase64`)", k))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,316 @@
+package keyvault
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/2016-10-01/keyvault"
+)
+
+func TestParseKeyVaultKeyFromPEM(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generating RSA key: %+v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating EC key: %+v", err)
+	}
+
+	ecBytes, err := x509.MarshalECPrivateKey(ecKey)
+	if err != nil {
+		t.Fatalf("marshalling EC key: %+v", err)
+	}
+	pkcs8Bytes, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatalf("marshalling PKCS#8 key: %+v", err)
+	}
+
+	encode := func(blockType string, bytes []byte) string {
+		return string(pem.EncodeToMemory(&pem.Block{
+			Type:  blockType,
+			Bytes: bytes,
+		}))
+	}
+
+	testData := []struct {
+		Name          string
+		Input         string
+		ExpectedType  keyvault.JSONWebKeyType
+		ExpectedCurve keyvault.JSONWebKeyCurveName
+		ExpectError   bool
+	}{
+		{
+			Name:        "Empty",
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Name:        "Not a PEM",
+			Input:       "hello world",
+			ExpectError: true,
+		},
+		{
+			Name:         "PKCS#1 RSA",
+			Input:        encode("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)),
+			ExpectedType: keyvault.RSA,
+		},
+		{
+			Name:          "SEC 1 EC with Parameters",
+			Input:         encode("EC PARAMETERS", []byte{0x06, 0x05, 0x2b, 0x81, 0x04, 0x00, 0x22}) + encode("EC PRIVATE KEY", ecBytes),
+			ExpectedType:  keyvault.EC,
+			ExpectedCurve: keyvault.P384,
+		},
+		{
+			Name:          "PKCS#8 EC",
+			Input:         encode("PRIVATE KEY", pkcs8Bytes),
+			ExpectedType:  keyvault.EC,
+			ExpectedCurve: keyvault.P384,
+		},
+		{
+			Name:        "Public Key Only",
+			Input:       encode("PUBLIC KEY", []byte("not-a-private-key")),
+			ExpectError: true,
+		},
+		{
+			Name:        "Encrypted",
+			Input:       encode("ENCRYPTED PRIVATE KEY", []byte("encrypted")),
+			ExpectError: true,
+		},
+		{
+			Name:        "Invalid Key",
+			Input:       encode("RSA PRIVATE KEY", []byte("invalid")),
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := parseKeyVaultKeyFromPEM(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual.Kty != v.ExpectedType {
+			t.Fatalf("Expected the key type %q but got %q", v.ExpectedType, actual.Kty)
+		}
+		if actual.Crv != v.ExpectedCurve {
+			t.Fatalf("Expected the curve %q but got %q", v.ExpectedCurve, actual.Crv)
+		}
+		if actual.D == nil {
+			t.Fatalf("Expected the private key to be populated")
+		}
+	}
+
+	// the RSA components should round-trip
+	key, err := parseKeyVaultKeyFromPEM(encode("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)))
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+	n, err := base64.RawURLEncoding.DecodeString(*key.N)
+	if err != nil {
+		t.Fatalf("decoding N: %+v", err)
+	}
+	if len(n)*8 != 2048 {
+		t.Fatalf("Expected a 2048 bit modulus but got %d bits", len(n)*8)
+	}
+	if *key.E != "AQAB" {
+		t.Fatalf("Expected the exponent to be %q but got %q", "AQAB", *key.E)
+	}
+}
+
+func TestParseKeyVaultKeyFromJWK(t *testing.T) {
+	component := base64.RawURLEncoding.EncodeToString([]byte("component"))
+	symmetric128 := base64.RawURLEncoding.EncodeToString(make([]byte, 16))
+	symmetric100 := base64.RawURLEncoding.EncodeToString(make([]byte, 12))
+
+	testData := []struct {
+		Name         string
+		Input        string
+		ExpectedType keyvault.JSONWebKeyType
+		ExpectError  bool
+	}{
+		{
+			Name:        "Not JSON",
+			Input:       "hello",
+			ExpectError: true,
+		},
+		{
+			Name:         "RSA",
+			Input:        fmt.Sprintf(`{"kty": "RSA", "n": %q, "e": "AQAB", "d": %q, "p": %q, "q": %q}`, component, component, component, component),
+			ExpectedType: keyvault.RSA,
+		},
+		{
+			Name:        "RSA Public Key",
+			Input:       fmt.Sprintf(`{"kty": "RSA", "n": %q, "e": "AQAB"}`, component),
+			ExpectError: true,
+		},
+		{
+			Name:        "RSA Invalid Component",
+			Input:       fmt.Sprintf(`{"kty": "RSA", "n": %q, "e": "AQAB", "d": "!!!", "p": %q, "q": %q}`, component, component, component),
+			ExpectError: true,
+		},
+		{
+			Name:         "EC",
+			Input:        fmt.Sprintf(`{"kty": "EC", "crv": "P-256", "x": %q, "y": %q, "d": %q}`, component, component, component),
+			ExpectedType: keyvault.EC,
+		},
+		{
+			Name:         "EC-HSM",
+			Input:        fmt.Sprintf(`{"kty": "EC-HSM", "crv": "P-256", "x": %q, "y": %q, "d": %q}`, component, component, component),
+			ExpectedType: keyvault.EC,
+		},
+		{
+			Name:        "EC Unsupported Curve",
+			Input:       fmt.Sprintf(`{"kty": "EC", "crv": "P-192", "x": %q, "y": %q, "d": %q}`, component, component, component),
+			ExpectError: true,
+		},
+		{
+			Name:         "Symmetric",
+			Input:        fmt.Sprintf(`{"kty": "oct", "k": %q}`, symmetric128),
+			ExpectedType: keyvault.Oct,
+		},
+		{
+			Name:        "Symmetric Invalid Size",
+			Input:       fmt.Sprintf(`{"kty": "oct", "k": %q}`, symmetric100),
+			ExpectError: true,
+		},
+		{
+			Name:        "Unsupported Type",
+			Input:       `{"kty": "OKP"}`,
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := parseKeyVaultKeyFromJWK(v.Input)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual.Kty != v.ExpectedType {
+			t.Fatalf("Expected the key type %q but got %q", v.ExpectedType, actual.Kty)
+		}
+	}
+}
+
+func TestKeyVaultKeyImportSourceJSONWebKey(t *testing.T) {
+	symmetric := base64.RawURLEncoding.EncodeToString(make([]byte, 32))
+	blob := base64.StdEncoding.EncodeToString([]byte(`{"schema_version": "1.0.0"}`))
+
+	testData := []struct {
+		Name        string
+		Source      keyVaultKeyImportSource
+		KeyType     keyvault.JSONWebKeyType
+		ExpectError bool
+	}{
+		{
+			Name:        "Nothing Specified",
+			Source:      keyVaultKeyImportSource{},
+			KeyType:     keyvault.RSA,
+			ExpectError: true,
+		},
+		{
+			Name: "Symmetric as oct-HSM",
+			Source: keyVaultKeyImportSource{
+				JWK: fmt.Sprintf(`{"kty": "oct", "k": %q}`, symmetric),
+			},
+			KeyType: octHSM,
+		},
+		{
+			Name: "Symmetric as RSA",
+			Source: keyVaultKeyImportSource{
+				JWK: fmt.Sprintf(`{"kty": "oct", "k": %q}`, symmetric),
+			},
+			KeyType:     keyvault.RSA,
+			ExpectError: true,
+		},
+		{
+			Name: "BYOK as RSA-HSM",
+			Source: keyVaultKeyImportSource{
+				BYOKTransferBlob: blob,
+			},
+			KeyType: keyvault.RSAHSM,
+		},
+		{
+			Name: "BYOK as a Software Key",
+			Source: keyVaultKeyImportSource{
+				BYOKTransferBlob: blob,
+			},
+			KeyType:     keyvault.RSA,
+			ExpectError: true,
+		},
+		{
+			Name: "BYOK not Base64",
+			Source: keyVaultKeyImportSource{
+				BYOKTransferBlob: "not base64!",
+			},
+			KeyType:     keyvault.ECHSM,
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual, err := v.Source.jsonWebKey(v.KeyType)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if actual.Kty != v.KeyType {
+			t.Fatalf("Expected the key type %q but got %q", v.KeyType, actual.Kty)
+		}
+	}
+}
+
+func TestKeyVaultKeyTypeIsSymmetric(t *testing.T) {
+	testData := map[keyvault.JSONWebKeyType]bool{
+		keyvault.EC:     false,
+		keyvault.ECHSM:  false,
+		keyvault.Oct:    true,
+		octHSM:          true,
+		"OCT-hsm":       true,
+		keyvault.RSA:    false,
+		keyvault.RSAHSM: false,
+	}
+
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Testing %q..", string(input))
+
+		if actual := keyVaultKeyTypeIsSymmetric(input); actual != expected {
+			t.Fatalf("expected %t but got %t", expected, actual)
+		}
+	}
+}
@@ -16,8 +16,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/cmk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/client"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
 	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	resourcesClient "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/client"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
@@ -48,11 +51,12 @@ func resourceKeyVaultKey() *schema.Resource {
 				ValidateFunc: keyVaultValidate.NestedItemName,
 			},
 
+			// this can also be the ID of a Managed HSM, which is required for symmetric keys
 			"key_vault_id": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: keyVaultValidate.VaultID,
+				ValidateFunc: validation.Any(keyVaultValidate.VaultID, keyVaultValidate.ManagedHSMID),
 			},
 
 			"key_type": {
@@ -62,20 +66,22 @@ func resourceKeyVaultKey() *schema.Resource {
 				// turns out Azure's *really* sensitive about the casing of these
 				// issue: https://github.com/Azure/azure-rest-api-specs/issues/1739
 				ValidateFunc: validation.StringInSlice([]string{
-					// TODO: add `oct` back in once this is fixed
-					// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
+					// NOTE: symmetric keys (`oct` and `oct-HSM`) can only be created within a Managed HSM
 					string(keyvault.EC),
 					string(keyvault.ECHSM),
+					string(keyvault.Oct),
+					string(octHSM),
 					string(keyvault.RSA),
 					string(keyvault.RSAHSM),
 				}, false),
 			},
 
 			"key_size": {
+				// changing this creates a new version of the Key
 				Type:          schema.TypeInt,
 				Optional:      true,
-				ForceNew:      true,
-				ConflictsWith: []string{"curve"},
+				Computed:      true,
+				ConflictsWith: []string{"curve", "import_source"},
 			},
 
 			"key_opts": {
@@ -100,7 +106,7 @@ func resourceKeyVaultKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+				// changing this creates a new version of the Key
 				ValidateFunc: validation.StringInSlice([]string{
 					string(keyvault.P256),
 					string(keyvault.P384),
@@ -110,7 +116,41 @@ func resourceKeyVaultKey() *schema.Resource {
 				// TODO: the curve name should probably be mandatory for EC in the future,
 				// but handle the diff so that we don't break existing configurations and
 				// imported EC keys
-				ConflictsWith: []string{"key_size"},
+				ConflictsWith: []string{"key_size", "import_source"},
+			},
+
+			"import_source": {
+				// changing this imports a new version of the Key
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pem": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validateKeyVaultKeyImportPEM,
+							ExactlyOneOf: []string{"import_source.0.pem", "import_source.0.jwk", "import_source.0.byok_transfer_blob"},
+						},
+
+						"jwk": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validateKeyVaultKeyImportJWK,
+							ExactlyOneOf: []string{"import_source.0.pem", "import_source.0.jwk", "import_source.0.byok_transfer_blob"},
+						},
+
+						"byok_transfer_blob": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validateKeyVaultKeyImportBYOKTransferBlob,
+							ExactlyOneOf: []string{"import_source.0.pem", "import_source.0.jwk", "import_source.0.byok_transfer_blob"},
+						},
+					},
+				},
 			},
 
 			"not_before_date": {
@@ -158,7 +198,39 @@ func resourceKeyVaultKey() *schema.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: keyVaultKeyCustomizeDiff,
+	}
+}
+
+// keyVaultKeyCustomizeDiff marks the fields which change when the Key is rotated (see the Update function) as
+// Computed, since rotating the Key creates a new version with new key material.
+//
+// NOTE: the `id` (which contains the version) can't be marked as Computed here, however since this uses the
+// legacy SDK Terraform allows this to change during the apply.
+func keyVaultKeyCustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	importing := d.HasChange("import_source") && len(d.Get("import_source").([]interface{})) > 0
+	if !importing && !d.HasChange("key_size") && !d.HasChange("curve") {
+		return nil
+	}
+
+	computed := []string{"version", "n", "e", "x", "y"}
+	if importing {
+		// these are determined by the key material which is imported
+		computed = append(computed, "key_size", "curve")
 	}
+
+	for _, key := range computed {
+		if err := d.SetNewComputed(key); err != nil {
+			return fmt.Errorf("setting %q to be computed: %+v", key, err)
+		}
+	}
+
+	return nil
 }
 
 func resourceKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
@@ -169,14 +241,9 @@ func resourceKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Print("[INFO] preparing arguments for AzureRM KeyVault Key creation.")
 	name := d.Get("name").(string)
-	keyVaultId, err := parse.VaultID(d.Get("key_vault_id").(string))
-	if err != nil {
-		return err
-	}
-
-	keyVaultBaseUri, err := keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+	keyVaultBaseUri, err := keyVaultKeyBaseUri(ctx, keyVaultsClient, d.Get("key_vault_id").(string))
 	if err != nil {
-		return fmt.Errorf("Error looking up Key %q vault url from id %q: %+v", name, *keyVaultId, err)
+		return fmt.Errorf("Error looking up Key %q vault url: %+v", name, err)
 	}
 
 	existing, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
@@ -190,49 +257,8 @@ func resourceKeyVaultKeyCreate(d *schema.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurerm_key_vault_key", *existing.Key.Kid)
 	}
 
-	keyType := d.Get("key_type").(string)
-	keyOptions := expandKeyVaultKeyOptions(d)
-	t := d.Get("tags").(map[string]interface{})
-
-	// TODO: support Importing Keys once this is fixed:
-	// https://github.com/Azure/azure-rest-api-specs/issues/1747
-	parameters := keyvault.KeyCreateParameters{
-		Kty:    keyvault.JSONWebKeyType(keyType),
-		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
-		},
-
-		Tags: tags.Expand(t),
-	}
-
-	if parameters.Kty == keyvault.EC || parameters.Kty == keyvault.ECHSM {
-		curveName := d.Get("curve").(string)
-		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
-	} else if parameters.Kty == keyvault.RSA || parameters.Kty == keyvault.RSAHSM {
-		keySize, ok := d.GetOk("key_size")
-		if !ok {
-			return fmt.Errorf("Key size is required when creating an RSA key")
-		}
-		parameters.KeySize = utils.Int32(int32(keySize.(int)))
-	}
-	// TODO: support `oct` once this is fixed
-	// https://github.com/Azure/azure-rest-api-specs/issues/1739#issuecomment-332236257
-
-	if v, ok := d.GetOk("not_before_date"); ok {
-		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		notBeforeUnixTime := date.UnixTime(notBeforeDate)
-		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
-	}
-
-	if v, ok := d.GetOk("expiration_date"); ok {
-		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		expirationUnixTime := date.UnixTime(expirationDate)
-		parameters.KeyAttributes.Expires = &expirationUnixTime
-	}
-
-	if resp, err := client.CreateKey(ctx, *keyVaultBaseUri, name, parameters); err != nil {
-		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults && utils.ResponseWasConflict(resp.Response) {
+	if resp, err := createKeyVaultKeyVersion(ctx, d, client, meta.(*clients.Client).Tags, *keyVaultBaseUri, name); err != nil {
+		if meta.(*clients.Client).Features.KeyVault.RecoverSoftDeletedKeyVaults && utils.ResponseWasConflict(resp) {
 			recoveredKey, err := client.RecoverDeletedKey(ctx, *keyVaultBaseUri, name)
 			if err != nil {
 				return err
@@ -282,54 +308,41 @@ func resourceKeyVaultKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
-	if err != nil {
-		return fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
-	}
-	if keyVaultIdRaw == nil {
-		return fmt.Errorf("Unable to determine the Resource ID for the Key Vault at URL %q", id.KeyVaultBaseUrl)
-	}
-
-	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
-	if err != nil {
-		return err
-	}
-
-	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	ok, err := keyVaultKeyParentExists(ctx, keyVaultsClient, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
-		return fmt.Errorf("Error checking if key vault %q for Key %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+		return fmt.Errorf("Error checking if the Key Vault for Key %q in Vault at url %q exists: %v", id.Name, id.KeyVaultBaseUrl, err)
 	}
 	if !ok {
-		log.Printf("[DEBUG] Key %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		log.Printf("[DEBUG] Key %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
 		d.SetId("")
 		return nil
 	}
 
-	keyOptions := expandKeyVaultKeyOptions(d)
-	t := d.Get("tags").(map[string]interface{})
-
-	parameters := keyvault.KeyUpdateParameters{
-		KeyOps: keyOptions,
-		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
-		},
-		Tags: tags.Expand(t),
+	// rather than replacing the Key, rotating it (either by importing new key material, or changing the size/curve) creates a new version
+	importSource := expandKeyVaultKeyImportSource(d.Get("import_source").([]interface{}))
+	if (d.HasChange("import_source") && importSource != nil) || d.HasChanges("key_size", "curve") {
+		log.Printf("[DEBUG] Creating a new version of Key %q (Key Vault %q)..", id.Name, id.KeyVaultBaseUrl)
+		if _, err := createKeyVaultKeyVersion(ctx, d, client, meta.(*clients.Client).Tags, id.KeyVaultBaseUrl, id.Name); err != nil {
+			return fmt.Errorf("creating a new version of Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		log.Printf("[DEBUG] Created a new version of Key %q (Key Vault %q).", id.Name, id.KeyVaultBaseUrl)
 	}
 
-	if v, ok := d.GetOk("not_before_date"); ok {
-		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		notBeforeUnixTime := date.UnixTime(notBeforeDate)
-		parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+	parameters := keyvault.KeyUpdateParameters{
+		KeyOps:        expandKeyVaultKeyOptions(d),
+		KeyAttributes: expandKeyVaultKeyAttributes(d),
+		Tags:          tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
-	if v, ok := d.GetOk("expiration_date"); ok {
-		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
-		expirationUnixTime := date.UnixTime(expirationDate)
-		parameters.KeyAttributes.Expires = &expirationUnixTime
+	// "" indicates the latest version
+	updated, err := client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters)
+	if err != nil {
+		return err
 	}
 
-	if _, err = client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
-		return err
+	// the ID contains the version, which changes when the Key's rotated
+	if updated.Key != nil && updated.Key.Kid != nil {
+		d.SetId(*updated.Key.Kid)
 	}
 
 	return resourceKeyVaultKeyRead(d, meta)
@@ -347,26 +360,12 @@ func resourceKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
-	if err != nil {
-		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
-	}
-	if keyVaultIdRaw == nil {
-		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q - removing from state!", id.KeyVaultBaseUrl)
-		d.SetId("")
-		return nil
-	}
-	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
-	if err != nil {
-		return err
-	}
-
-	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	ok, err := keyVaultKeyParentExists(ctx, keyVaultsClient, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
-		return fmt.Errorf("Error checking if key vault %q for Key %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+		return fmt.Errorf("Error checking if the Key Vault for Key %q in Vault at url %q exists: %v", id.Name, id.KeyVaultBaseUrl, err)
 	}
 	if !ok {
-		log.Printf("[DEBUG] Key %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		log.Printf("[DEBUG] Key %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
 		d.SetId("")
 		return nil
 	}
@@ -421,7 +420,7 @@ func resourceKeyVaultKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("version", id.Version)
 	d.Set("versionless_id", fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(id.KeyVaultBaseUrl, "/"), id.NestedItemType, id.Name))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -436,24 +435,12 @@ func resourceKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, id.KeyVaultBaseUrl)
-	if err != nil {
-		return fmt.Errorf("Error retrieving the Resource ID the Key Vault at URL %q: %s", id.KeyVaultBaseUrl, err)
-	}
-	if keyVaultIdRaw == nil {
-		return fmt.Errorf("Unable to determine the Resource ID for the Key Vault at URL %q", id.KeyVaultBaseUrl)
-	}
-	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
-	if err != nil {
-		return err
-	}
-
-	ok, err := keyVaultsClient.Exists(ctx, *keyVaultId)
+	ok, err := keyVaultKeyParentExists(ctx, keyVaultsClient, resourcesClient, id.KeyVaultBaseUrl)
 	if err != nil {
-		return fmt.Errorf("Error checking if key vault %q for Key %q in Vault at url %q exists: %v", *keyVaultId, id.Name, id.KeyVaultBaseUrl, err)
+		return fmt.Errorf("Error checking if the Key Vault for Key %q in Vault at url %q exists: %v", id.Name, id.KeyVaultBaseUrl, err)
 	}
 	if !ok {
-		log.Printf("[DEBUG] Key %q Key Vault %q was not found in Key Vault at URI %q - removing from state", id.Name, *keyVaultId, id.KeyVaultBaseUrl)
+		log.Printf("[DEBUG] Key %q was not found in Key Vault at URI %q - removing from state", id.Name, id.KeyVaultBaseUrl)
 		d.SetId("")
 		return nil
 	}
@@ -472,6 +459,45 @@ func resourceKeyVaultKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// keyVaultKeyBaseUri returns the Data Plane URI for the specified Key Vault or Managed HSM
+func keyVaultKeyBaseUri(ctx context.Context, keyVaultsClient *client.Client, input string) (*string, error) {
+	if managedHSMId, err := parse.ManagedHSMID(input); err == nil {
+		return utils.String(keyVaultsClient.BaseUriForManagedHSM(*managedHSMId)), nil
+	}
+
+	keyVaultId, err := parse.VaultID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return keyVaultsClient.BaseUriForKeyVault(ctx, *keyVaultId)
+}
+
+// keyVaultKeyParentExists returns whether the Key Vault containing the Key at the specified URI exists
+//
+// Managed HSM's can't be looked up as a Key Vault, instead a Key is removed from the state when it can't be found
+func keyVaultKeyParentExists(ctx context.Context, keyVaultsClient *client.Client, resourcesClient *resourcesClient.Client, keyVaultBaseUrl string) (bool, error) {
+	if cmk.IsManagedHSMBaseUrl(keyVaultBaseUrl) {
+		return true, nil
+	}
+
+	keyVaultIdRaw, err := keyVaultsClient.KeyVaultIDFromBaseUrl(ctx, resourcesClient, keyVaultBaseUrl)
+	if err != nil {
+		return false, fmt.Errorf("retrieving the Resource ID the Key Vault at URL %q: %s", keyVaultBaseUrl, err)
+	}
+	if keyVaultIdRaw == nil {
+		log.Printf("[DEBUG] Unable to determine the Resource ID for the Key Vault at URL %q", keyVaultBaseUrl)
+		return false, nil
+	}
+
+	keyVaultId, err := parse.VaultID(*keyVaultIdRaw)
+	if err != nil {
+		return false, err
+	}
+
+	return keyVaultsClient.Exists(ctx, *keyVaultId)
+}
+
 var _ deleteAndPurgeNestedItem = deleteAndPurgeKey{}
 
 type deleteAndPurgeKey struct {
@@ -499,6 +525,92 @@ func (d deleteAndPurgeKey) NestedItemHasBeenPurged(ctx context.Context) (autores
 	return resp.Response, err
 }
 
+// createKeyVaultKeyVersion creates a new version of the Key, either by importing the key material from the
+// `import_source` or by generating a new Key - returning the response so that conflicts can be detected
+func createKeyVaultKeyVersion(ctx context.Context, d *schema.ResourceData, client *keyvault.BaseClient, tagsConfig tags.ProviderConfiguration, keyVaultBaseUri, name string) (autorest.Response, error) {
+	keyType := keyvault.JSONWebKeyType(d.Get("key_type").(string))
+
+	if keyVaultKeyTypeIsSymmetric(keyType) && !cmk.IsManagedHSMBaseUrl(keyVaultBaseUri) {
+		return autorest.Response{}, fmt.Errorf("symmetric (`oct` and `oct-HSM`) keys can only be created within a Managed HSM but %q is a Key Vault", keyVaultBaseUri)
+	}
+
+	if importSource := expandKeyVaultKeyImportSource(d.Get("import_source").([]interface{})); importSource != nil {
+		key, err := importSource.jsonWebKey(keyType)
+		if err != nil {
+			return autorest.Response{}, fmt.Errorf("parsing `import_source`: %+v", err)
+		}
+
+		keyOps := make([]string, 0)
+		for _, v := range *expandKeyVaultKeyOptions(d) {
+			keyOps = append(keyOps, string(v))
+		}
+		key.KeyOps = &keyOps
+
+		parameters := keyvault.KeyImportParameters{
+			Hsm:           utils.Bool(keyVaultKeyTypeIsHSM(keyType)),
+			Key:           key,
+			KeyAttributes: expandKeyVaultKeyAttributes(d),
+			Tags:          tags.ExpandWithDefaults(tagsConfig, d.Get("tags").(map[string]interface{})),
+		}
+
+		resp, err := client.ImportKey(ctx, keyVaultBaseUri, name, parameters)
+		return resp.Response, err
+	}
+
+	parameters := keyvault.KeyCreateParameters{
+		Kty:           keyType,
+		KeyOps:        expandKeyVaultKeyOptions(d),
+		KeyAttributes: expandKeyVaultKeyAttributes(d),
+		Tags:          tags.ExpandWithDefaults(tagsConfig, d.Get("tags").(map[string]interface{})),
+	}
+
+	switch keyType {
+	case keyvault.EC, keyvault.ECHSM:
+		curveName := d.Get("curve").(string)
+		parameters.Curve = keyvault.JSONWebKeyCurveName(curveName)
+
+	case keyvault.RSA, keyvault.RSAHSM:
+		keySize, ok := d.GetOk("key_size")
+		if !ok {
+			return autorest.Response{}, fmt.Errorf("Key size is required when creating an RSA key")
+		}
+		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+
+	case keyvault.Oct, octHSM:
+		keySize, ok := d.GetOk("key_size")
+		if !ok {
+			return autorest.Response{}, fmt.Errorf("Key size is required when creating a symmetric key")
+		}
+		if v := keySize.(int); v != 128 && v != 192 && v != 256 {
+			return autorest.Response{}, fmt.Errorf("Key size must be 128, 192 or 256 when creating a symmetric key but got %d", v)
+		}
+		parameters.KeySize = utils.Int32(int32(keySize.(int)))
+	}
+
+	resp, err := client.CreateKey(ctx, keyVaultBaseUri, name, parameters)
+	return resp.Response, err
+}
+
+func expandKeyVaultKeyAttributes(d *schema.ResourceData) *keyvault.KeyAttributes {
+	attributes := &keyvault.KeyAttributes{
+		Enabled: utils.Bool(true),
+	}
+
+	if v, ok := d.GetOk("not_before_date"); ok {
+		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		notBeforeUnixTime := date.UnixTime(notBeforeDate)
+		attributes.NotBefore = &notBeforeUnixTime
+	}
+
+	if v, ok := d.GetOk("expiration_date"); ok {
+		expirationDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
+		expirationUnixTime := date.UnixTime(expirationDate)
+		attributes.Expires = &expirationUnixTime
+	}
+
+	return attributes
+}
+
 func expandKeyVaultKeyOptions(d *schema.ResourceData) *[]keyvault.JSONWebKeyOperation {
 	options := d.Get("key_opts").([]interface{})
 	results := make([]keyvault.JSONWebKeyOperation, 0, len(options))
@@ -3,6 +3,7 @@ package keyvault_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
@@ -112,6 +113,18 @@ func TestAccKeyVaultKey_basicRSAHSM(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_symmetricRequiresManagedHSM(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config:      r.symmetricHSM(data),
+			ExpectError: regexp.MustCompile("can only be created within a Managed HSM"),
+		},
+	})
+}
+
 func TestAccKeyVaultKey_complete(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -189,6 +202,31 @@ func TestAccKeyVaultKey_update(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_rotate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+	var version string
+
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basicRSA(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_size").HasValue("2048"),
+				check.That(data.ResourceName).Key("version").StoresValueIn(&version),
+			),
+		},
+		{
+			Config: r.basicRSARotated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("key_size").HasValue("4096"),
+				check.That(data.ResourceName).Key("version").DiffersFromValueIn(&version),
+			),
+		},
+	})
+}
+
 func TestAccKeyVaultKey_updatedExternally(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -470,6 +508,32 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templateStandard(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) basicRSARotated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 4096
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+`, r.templateStandard(data), data.RandomString)
+}
+
 func (r KeyVaultKeyResource) basicRSAHSM(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -496,6 +560,30 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templatePremium(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) symmetricHSM(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "oct-HSM"
+  key_size     = 256
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "unwrapKey",
+    "wrapKey",
+  ]
+}
+`, r.templatePremium(data), data.RandomString)
+}
+
 func (r KeyVaultKeyResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
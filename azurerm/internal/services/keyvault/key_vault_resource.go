@@ -327,7 +327,7 @@ func resourceKeyVaultCreate(d *schema.ResourceData, meta interface{}) error {
 			// documentation with further details
 			EnableSoftDelete: utils.Bool(true),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if purgeProtectionEnabled := d.Get("purge_protection_enabled").(bool); purgeProtectionEnabled {
@@ -566,9 +566,9 @@ func resourceKeyVaultUpdate(d *schema.ResourceData, meta interface{}) error {
 		update.Properties.TenantID = &tenantUUID
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(t)
+		update.Tags = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 	}
 
 	if _, err := client.Update(ctx, id.ResourceGroup, id.Name, update); err != nil {
@@ -687,7 +687,7 @@ func resourceKeyVaultRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `contact` for KeyVault: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKeyVaultDelete(d *schema.ResourceData, meta interface{}) error {
@@ -95,5 +95,5 @@ func dataSourceKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("version", respID.Version)
 	d.Set("content_type", resp.ContentType)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
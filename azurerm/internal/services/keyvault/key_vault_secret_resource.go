@@ -129,7 +129,7 @@ func resourceKeyVaultSecretCreate(d *schema.ResourceData, meta interface{}) erro
 	parameters := keyvault.SecretSetParameters{
 		Value:            utils.String(value),
 		ContentType:      utils.String(contentType),
-		Tags:             tags.Expand(t),
+		Tags:             tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		SecretAttributes: &keyvault.SecretAttributes{},
 	}
 
@@ -250,7 +250,7 @@ func resourceKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) erro
 		parameters := keyvault.SecretSetParameters{
 			Value:            utils.String(value),
 			ContentType:      utils.String(contentType),
-			Tags:             tags.Expand(t),
+			Tags:             tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			SecretAttributes: secretAttributes,
 		}
 
@@ -260,7 +260,7 @@ func resourceKeyVaultSecretUpdate(d *schema.ResourceData, meta interface{}) erro
 	} else {
 		parameters := keyvault.SecretUpdateParameters{
 			ContentType:      utils.String(contentType),
-			Tags:             tags.Expand(t),
+			Tags:             tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			SecretAttributes: secretAttributes,
 		}
 
@@ -354,7 +354,7 @@ func resourceKeyVaultSecretRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceKeyVaultSecretDelete(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,74 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type ManagedHSMId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewManagedHSMID(subscriptionId, resourceGroup, name string) ManagedHSMId {
+	return ManagedHSMId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ManagedHSMId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Managed H S M", segmentsStr)
+}
+
+func (id ManagedHSMId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.KeyVault/managedHSMs/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// Matches returns whether the specified Resource ID refers to this ManagedHSM
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ManagedHSMId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ManagedHSM ID
+func (id ManagedHSMId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftKeyVault", "Microsoft.KeyVault"),
+		resourceid.StaticSegment("staticManagedHSMs", "managedHSMs", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
+// ManagedHSMID parses a ManagedHSM ID into an ManagedHSMId struct
+func ManagedHSMID(input string) (*ManagedHSMId, error) {
+	values, err := resourceid.ParseSegments(input, ManagedHSMId{}.Segments(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagedHSMId{
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		Name:           values["name"],
+	}
+
+	return &resourceId, nil
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VaultId{}
+var _ resourceid.SegmentedID = VaultId{}
 
 func TestVaultIDFormatter(t *testing.T) {
 	actual := NewVaultID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1").ID()
@@ -18,6 +19,43 @@ func TestVaultIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVaultIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.KEYVAULT/VAULTS/vault1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.KeyVault/vaults/vault1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVaultID("12345678-1234-9876-4563-123456789012", "resGroup1", "vault1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVaultID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -77,5 +77,5 @@ func dataSourceKustoClusterRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("data_ingestion_uri", clusterProperties.DataIngestionURI)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -298,7 +298,7 @@ func resourceKustoClusterCreateUpdate(d *schema.ResourceData, meta interface{})
 		Sku:               sku,
 		Zones:             zones,
 		ClusterProperties: &clusterProperties,
-		Tags:              tags.Expand(t),
+		Tags:              tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, ok := d.GetOk("identity"); ok {
@@ -431,7 +431,7 @@ func resourceKustoClusterRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("engine", clusterProperties.EngineType)
 	}
 
-	return tags.FlattenAndSet(d, clusterResponse.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, clusterResponse.Tags)
 }
 
 func resourceKustoClusterDelete(d *schema.ResourceData, meta interface{}) error {
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AttachedDatabaseConfigurationId struct {
@@ -25,6 +25,16 @@ func NewAttachedDatabaseConfigurationID(subscriptionId, resourceGroup, clusterNa
 	}
 }
 
+// NewAttachedDatabaseConfigurationIDFromParent returns a AttachedDatabaseConfigurationId for the AttachedDatabaseConfiguration within the specified Cluster
+func NewAttachedDatabaseConfigurationIDFromParent(parent ClusterId, name string) AttachedDatabaseConfigurationId {
+	return AttachedDatabaseConfigurationId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ClusterName:    parent.Name,
+		Name:           name,
+	}
+}
+
 func (id AttachedDatabaseConfigurationId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id AttachedDatabaseConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this AttachedDatabaseConfiguration
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id AttachedDatabaseConfigurationId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this AttachedDatabaseConfiguration ID
+func (id AttachedDatabaseConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto"),
+		resourceid.StaticSegment("staticClusters", "Clusters", false),
+		resourceid.UserSpecifiedSegment("clusterName"),
+		resourceid.StaticSegment("staticAttachedDatabaseConfigurations", "AttachedDatabaseConfigurations", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // AttachedDatabaseConfigurationID parses a AttachedDatabaseConfiguration ID into an AttachedDatabaseConfigurationId struct
 func AttachedDatabaseConfigurationID(input string) (*AttachedDatabaseConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, AttachedDatabaseConfigurationId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AttachedDatabaseConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("AttachedDatabaseConfigurations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ClusterName:    values["clusterName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AttachedDatabaseConfigurationId{}
+var _ resourceid.SegmentedID = AttachedDatabaseConfigurationId{}
 
 func TestAttachedDatabaseConfigurationIDFormatter(t *testing.T) {
 	actual := NewAttachedDatabaseConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1", "config1").ID()
@@ -18,6 +19,52 @@ func TestAttachedDatabaseConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAttachedDatabaseConfigurationIDFromParent(t *testing.T) {
+	parent := NewClusterID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1")
+	actual := NewAttachedDatabaseConfigurationIDFromParent(parent, "config1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/AttachedDatabaseConfigurations/config1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestAttachedDatabaseConfigurationIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/AttachedDatabaseConfigurations/config1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.KUSTO/CLUSTERS/cluster1/ATTACHEDDATABASECONFIGURATIONS/config1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/AttachedDatabaseConfigurations/config1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAttachedDatabaseConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1", "config1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAttachedDatabaseConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ClusterPrincipalAssignmentId struct {
@@ -25,6 +25,16 @@ func NewClusterPrincipalAssignmentID(subscriptionId, resourceGroup, clusterName,
 	}
 }
 
+// NewClusterPrincipalAssignmentIDFromParent returns a ClusterPrincipalAssignmentId for the ClusterPrincipalAssignment within the specified Cluster
+func NewClusterPrincipalAssignmentIDFromParent(parent ClusterId, principalAssignmentName string) ClusterPrincipalAssignmentId {
+	return ClusterPrincipalAssignmentId{
+		SubscriptionId:          parent.SubscriptionId,
+		ResourceGroup:           parent.ResourceGroup,
+		ClusterName:             parent.Name,
+		PrincipalAssignmentName: principalAssignmentName,
+	}
+}
+
 func (id ClusterPrincipalAssignmentId) String() string {
 	segments := []string{
 		fmt.Sprintf("Principal Assignment Name %q", id.PrincipalAssignmentName),
@@ -40,35 +50,40 @@ func (id ClusterPrincipalAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.PrincipalAssignmentName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ClusterPrincipalAssignment
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ClusterPrincipalAssignmentId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ClusterPrincipalAssignment ID
+func (id ClusterPrincipalAssignmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto"),
+		resourceid.StaticSegment("staticClusters", "Clusters", false),
+		resourceid.UserSpecifiedSegment("clusterName"),
+		resourceid.StaticSegment("staticPrincipalAssignments", "PrincipalAssignments", false),
+		resourceid.UserSpecifiedSegment("principalAssignmentName"),
+	}
+}
+
 // ClusterPrincipalAssignmentID parses a ClusterPrincipalAssignment ID into an ClusterPrincipalAssignmentId struct
 func ClusterPrincipalAssignmentID(input string) (*ClusterPrincipalAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ClusterPrincipalAssignmentId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ClusterPrincipalAssignmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.PrincipalAssignmentName, err = id.PopSegment("PrincipalAssignments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          values["subscriptionId"],
+		ResourceGroup:           values["resourceGroup"],
+		ClusterName:             values["clusterName"],
+		PrincipalAssignmentName: values["principalAssignmentName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DataConnectionId struct {
@@ -27,6 +27,17 @@ func NewDataConnectionID(subscriptionId, resourceGroup, clusterName, databaseNam
 	}
 }
 
+// NewDataConnectionIDFromParent returns a DataConnectionId for the DataConnection within the specified Database
+func NewDataConnectionIDFromParent(parent DatabaseId, name string) DataConnectionId {
+	return DataConnectionId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ClusterName:    parent.ClusterName,
+		DatabaseName:   parent.Name,
+		Name:           name,
+	}
+}
+
 func (id DataConnectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -43,38 +54,43 @@ func (id DataConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this DataConnection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DataConnectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DataConnection ID
+func (id DataConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto"),
+		resourceid.StaticSegment("staticClusters", "Clusters", false),
+		resourceid.UserSpecifiedSegment("clusterName"),
+		resourceid.StaticSegment("staticDatabases", "Databases", false),
+		resourceid.UserSpecifiedSegment("databaseName"),
+		resourceid.StaticSegment("staticDataConnections", "DataConnections", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // DataConnectionID parses a DataConnection ID into an DataConnectionId struct
 func DataConnectionID(input string) (*DataConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DataConnectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DataConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("DataConnections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ClusterName:    values["clusterName"],
+		DatabaseName:   values["databaseName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DataConnectionId{}
+var _ resourceid.SegmentedID = DataConnectionId{}
 
 func TestDataConnectionIDFormatter(t *testing.T) {
 	actual := NewDataConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1", "database1", "connection1").ID()
@@ -18,6 +19,52 @@ func TestDataConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDataConnectionIDFromParent(t *testing.T) {
+	parent := NewDatabaseID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1", "database1")
+	actual := NewDataConnectionIDFromParent(parent, "connection1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/Databases/database1/DataConnections/connection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestDataConnectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/Databases/database1/DataConnections/connection1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.KUSTO/CLUSTERS/cluster1/DATABASES/database1/DATACONNECTIONS/connection1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Kusto/Clusters/cluster1/Databases/database1/DataConnections/connection1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDataConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1", "database1", "connection1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDataConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseId struct {
@@ -25,6 +25,16 @@ func NewDatabaseID(subscriptionId, resourceGroup, clusterName, name string) Data
 	}
 }
 
+// NewDatabaseIDFromParent returns a DatabaseId for the Database within the specified Cluster
+func NewDatabaseIDFromParent(parent ClusterId, name string) DatabaseId {
+	return DatabaseId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ClusterName:    parent.Name,
+		Name:           name,
+	}
+}
+
 func (id DatabaseId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id DatabaseId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Database
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DatabaseId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Database ID
+func (id DatabaseId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto"),
+		resourceid.StaticSegment("staticClusters", "Clusters", false),
+		resourceid.UserSpecifiedSegment("clusterName"),
+		resourceid.StaticSegment("staticDatabases", "Databases", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // DatabaseID parses a Database ID into an DatabaseId struct
 func DatabaseID(input string) (*DatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DatabaseId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DatabaseId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("Databases"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ClusterName:    values["clusterName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
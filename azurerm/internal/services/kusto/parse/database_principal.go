@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabasePrincipalId struct {
@@ -46,41 +46,46 @@ func (id DatabasePrincipalId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.RoleName, id.FQNName)
 }
 
+// Matches returns whether the specified Resource ID refers to this DatabasePrincipal
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DatabasePrincipalId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DatabasePrincipal ID
+func (id DatabasePrincipalId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto"),
+		resourceid.StaticSegment("staticClusters", "Clusters", false),
+		resourceid.UserSpecifiedSegment("clusterName"),
+		resourceid.StaticSegment("staticDatabases", "Databases", false),
+		resourceid.UserSpecifiedSegment("databaseName"),
+		resourceid.StaticSegment("staticRole", "Role", false),
+		resourceid.UserSpecifiedSegment("roleName"),
+		resourceid.StaticSegment("staticFQN", "FQN", false),
+		resourceid.UserSpecifiedSegment("fQNName"),
+	}
+}
+
 // DatabasePrincipalID parses a DatabasePrincipal ID into an DatabasePrincipalId struct
 func DatabasePrincipalID(input string) (*DatabasePrincipalId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DatabasePrincipalId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DatabasePrincipalId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.RoleName, err = id.PopSegment("Role"); err != nil {
-		return nil, err
-	}
-	if resourceId.FQNName, err = id.PopSegment("FQN"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ClusterName:    values["clusterName"],
+		DatabaseName:   values["databaseName"],
+		RoleName:       values["roleName"],
+		FQNName:        values["fQNName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabasePrincipalAssignmentId struct {
@@ -27,6 +27,17 @@ func NewDatabasePrincipalAssignmentID(subscriptionId, resourceGroup, clusterName
 	}
 }
 
+// NewDatabasePrincipalAssignmentIDFromParent returns a DatabasePrincipalAssignmentId for the DatabasePrincipalAssignment within the specified Database
+func NewDatabasePrincipalAssignmentIDFromParent(parent DatabaseId, principalAssignmentName string) DatabasePrincipalAssignmentId {
+	return DatabasePrincipalAssignmentId{
+		SubscriptionId:          parent.SubscriptionId,
+		ResourceGroup:           parent.ResourceGroup,
+		ClusterName:             parent.ClusterName,
+		DatabaseName:            parent.Name,
+		PrincipalAssignmentName: principalAssignmentName,
+	}
+}
+
 func (id DatabasePrincipalAssignmentId) String() string {
 	segments := []string{
 		fmt.Sprintf("Principal Assignment Name %q", id.PrincipalAssignmentName),
@@ -43,38 +54,43 @@ func (id DatabasePrincipalAssignmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ClusterName, id.DatabaseName, id.PrincipalAssignmentName)
 }
 
+// Matches returns whether the specified Resource ID refers to this DatabasePrincipalAssignment
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DatabasePrincipalAssignmentId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DatabasePrincipalAssignment ID
+func (id DatabasePrincipalAssignmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftKusto", "Microsoft.Kusto"),
+		resourceid.StaticSegment("staticClusters", "Clusters", false),
+		resourceid.UserSpecifiedSegment("clusterName"),
+		resourceid.StaticSegment("staticDatabases", "Databases", false),
+		resourceid.UserSpecifiedSegment("databaseName"),
+		resourceid.StaticSegment("staticPrincipalAssignments", "PrincipalAssignments", false),
+		resourceid.UserSpecifiedSegment("principalAssignmentName"),
+	}
+}
+
 // DatabasePrincipalAssignmentID parses a DatabasePrincipalAssignment ID into an DatabasePrincipalAssignmentId struct
 func DatabasePrincipalAssignmentID(input string) (*DatabasePrincipalAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DatabasePrincipalAssignmentId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DatabasePrincipalAssignmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.PrincipalAssignmentName, err = id.PopSegment("PrincipalAssignments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          values["subscriptionId"],
+		ResourceGroup:           values["resourceGroup"],
+		ClusterName:             values["clusterName"],
+		DatabaseName:            values["databaseName"],
+		PrincipalAssignmentName: values["principalAssignmentName"],
 	}
 
 	return &resourceId, nil
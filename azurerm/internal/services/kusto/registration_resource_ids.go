@@ -0,0 +1,77 @@
+package kusto
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/kusto/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Kusto/Clusters/AttachedDatabaseConfigurations",
+			TerraformResourceType: "azurerm_kusto_attached_database_configuration",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AttachedDatabaseConfigurationID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Kusto/Clusters",
+			TerraformResourceType: "azurerm_kusto_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Kusto/Clusters/PrincipalAssignments",
+			TerraformResourceType: "azurerm_kusto_cluster_principal_assignment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ClusterPrincipalAssignmentID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Kusto/Clusters/Databases",
+			TerraformResourceType: "azurerm_kusto_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabaseID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Kusto/Clusters/Databases/Role/FQN",
+			TerraformResourceType: "azurerm_kusto_database_principal",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabasePrincipalID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Kusto/Clusters/Databases/PrincipalAssignments",
+			TerraformResourceType: "azurerm_kusto_database_principal_assignment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabasePrincipalAssignmentID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Kusto/Clusters/Databases/DataConnections",
+			TerraformResourceType: "azurerm_kusto_eventgrid_data_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Kusto/Clusters/Databases/DataConnections",
+			TerraformResourceType: "azurerm_kusto_eventhub_data_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Kusto/Clusters/Databases/DataConnections",
+			TerraformResourceType: "azurerm_kusto_iothub_data_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataConnectionID(input)
+			},
+		},
+	}
+}
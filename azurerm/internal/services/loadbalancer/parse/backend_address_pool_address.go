@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BackendAddressPoolAddressId struct {
@@ -27,6 +27,17 @@ func NewBackendAddressPoolAddressID(subscriptionId, resourceGroup, loadBalancerN
 	}
 }
 
+// NewBackendAddressPoolAddressIDFromParent returns a BackendAddressPoolAddressId for the BackendAddressPoolAddress within the specified LoadBalancerBackendAddressPool
+func NewBackendAddressPoolAddressIDFromParent(parent LoadBalancerBackendAddressPoolId, addressName string) BackendAddressPoolAddressId {
+	return BackendAddressPoolAddressId{
+		SubscriptionId:         parent.SubscriptionId,
+		ResourceGroup:          parent.ResourceGroup,
+		LoadBalancerName:       parent.LoadBalancerName,
+		BackendAddressPoolName: parent.BackendAddressPoolName,
+		AddressName:            addressName,
+	}
+}
+
 func (id BackendAddressPoolAddressId) String() string {
 	segments := []string{
 		fmt.Sprintf("Address Name %q", id.AddressName),
@@ -43,38 +54,43 @@ func (id BackendAddressPoolAddressId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName, id.AddressName)
 }
 
+// Matches returns whether the specified Resource ID refers to this BackendAddressPoolAddress
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id BackendAddressPoolAddressId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this BackendAddressPoolAddress ID
+func (id BackendAddressPoolAddressId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticLoadBalancers", "loadBalancers", false),
+		resourceid.UserSpecifiedSegment("loadBalancerName"),
+		resourceid.StaticSegment("staticBackendAddressPools", "backendAddressPools", false),
+		resourceid.UserSpecifiedSegment("backendAddressPoolName"),
+		resourceid.StaticSegment("staticAddresses", "addresses", false),
+		resourceid.UserSpecifiedSegment("addressName"),
+	}
+}
+
 // BackendAddressPoolAddressID parses a BackendAddressPoolAddress ID into an BackendAddressPoolAddressId struct
 func BackendAddressPoolAddressID(input string) (*BackendAddressPoolAddressId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, BackendAddressPoolAddressId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BackendAddressPoolAddressId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.BackendAddressPoolName, err = id.PopSegment("backendAddressPools"); err != nil {
-		return nil, err
-	}
-	if resourceId.AddressName, err = id.PopSegment("addresses"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         values["subscriptionId"],
+		ResourceGroup:          values["resourceGroup"],
+		LoadBalancerName:       values["loadBalancerName"],
+		BackendAddressPoolName: values["backendAddressPoolName"],
+		AddressName:            values["addressName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerBackendAddressPoolId struct {
@@ -25,6 +25,16 @@ func NewLoadBalancerBackendAddressPoolID(subscriptionId, resourceGroup, loadBala
 	}
 }
 
+// NewLoadBalancerBackendAddressPoolIDFromParent returns a LoadBalancerBackendAddressPoolId for the LoadBalancerBackendAddressPool within the specified LoadBalancer
+func NewLoadBalancerBackendAddressPoolIDFromParent(parent LoadBalancerId, backendAddressPoolName string) LoadBalancerBackendAddressPoolId {
+	return LoadBalancerBackendAddressPoolId{
+		SubscriptionId:         parent.SubscriptionId,
+		ResourceGroup:          parent.ResourceGroup,
+		LoadBalancerName:       parent.Name,
+		BackendAddressPoolName: backendAddressPoolName,
+	}
+}
+
 func (id LoadBalancerBackendAddressPoolId) String() string {
 	segments := []string{
 		fmt.Sprintf("Backend Address Pool Name %q", id.BackendAddressPoolName),
@@ -40,35 +50,40 @@ func (id LoadBalancerBackendAddressPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.BackendAddressPoolName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LoadBalancerBackendAddressPool
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LoadBalancerBackendAddressPoolId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LoadBalancerBackendAddressPool ID
+func (id LoadBalancerBackendAddressPoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticLoadBalancers", "loadBalancers", false),
+		resourceid.UserSpecifiedSegment("loadBalancerName"),
+		resourceid.StaticSegment("staticBackendAddressPools", "backendAddressPools", false),
+		resourceid.UserSpecifiedSegment("backendAddressPoolName"),
+	}
+}
+
 // LoadBalancerBackendAddressPoolID parses a LoadBalancerBackendAddressPool ID into an LoadBalancerBackendAddressPoolId struct
 func LoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LoadBalancerBackendAddressPoolId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancerBackendAddressPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.BackendAddressPoolName, err = id.PopSegment("backendAddressPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         values["subscriptionId"],
+		ResourceGroup:          values["resourceGroup"],
+		LoadBalancerName:       values["loadBalancerName"],
+		BackendAddressPoolName: values["backendAddressPoolName"],
 	}
 
 	return &resourceId, nil
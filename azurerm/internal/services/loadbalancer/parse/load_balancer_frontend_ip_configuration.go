@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerFrontendIpConfigurationId struct {
@@ -25,6 +25,16 @@ func NewLoadBalancerFrontendIpConfigurationID(subscriptionId, resourceGroup, loa
 	}
 }
 
+// NewLoadBalancerFrontendIpConfigurationIDFromParent returns a LoadBalancerFrontendIpConfigurationId for the LoadBalancerFrontendIpConfiguration within the specified LoadBalancer
+func NewLoadBalancerFrontendIpConfigurationIDFromParent(parent LoadBalancerId, frontendIPConfigurationName string) LoadBalancerFrontendIpConfigurationId {
+	return LoadBalancerFrontendIpConfigurationId{
+		SubscriptionId:              parent.SubscriptionId,
+		ResourceGroup:               parent.ResourceGroup,
+		LoadBalancerName:            parent.Name,
+		FrontendIPConfigurationName: frontendIPConfigurationName,
+	}
+}
+
 func (id LoadBalancerFrontendIpConfigurationId) String() string {
 	segments := []string{
 		fmt.Sprintf("Frontend I P Configuration Name %q", id.FrontendIPConfigurationName),
@@ -40,35 +50,40 @@ func (id LoadBalancerFrontendIpConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.FrontendIPConfigurationName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LoadBalancerFrontendIpConfiguration
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LoadBalancerFrontendIpConfigurationId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LoadBalancerFrontendIpConfiguration ID
+func (id LoadBalancerFrontendIpConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticLoadBalancers", "loadBalancers", false),
+		resourceid.UserSpecifiedSegment("loadBalancerName"),
+		resourceid.StaticSegment("staticFrontendIPConfigurations", "frontendIPConfigurations", false),
+		resourceid.UserSpecifiedSegment("frontendIPConfigurationName"),
+	}
+}
+
 // LoadBalancerFrontendIpConfigurationID parses a LoadBalancerFrontendIpConfiguration ID into an LoadBalancerFrontendIpConfigurationId struct
 func LoadBalancerFrontendIpConfigurationID(input string) (*LoadBalancerFrontendIpConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LoadBalancerFrontendIpConfigurationId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancerFrontendIpConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.FrontendIPConfigurationName, err = id.PopSegment("frontendIPConfigurations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:              values["subscriptionId"],
+		ResourceGroup:               values["resourceGroup"],
+		LoadBalancerName:            values["loadBalancerName"],
+		FrontendIPConfigurationName: values["frontendIPConfigurationName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = LoadBalancerFrontendIpConfigurationId{}
+var _ resourceid.SegmentedID = LoadBalancerFrontendIpConfigurationId{}
 
 func TestLoadBalancerFrontendIpConfigurationIDFormatter(t *testing.T) {
 	actual := NewLoadBalancerFrontendIpConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "loadBalancer1", "frontendIPConfig1").ID()
@@ -18,6 +19,52 @@ func TestLoadBalancerFrontendIpConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLoadBalancerFrontendIpConfigurationIDFromParent(t *testing.T) {
+	parent := NewLoadBalancerID("12345678-1234-9876-4563-123456789012", "resGroup1", "loadBalancer1")
+	actual := NewLoadBalancerFrontendIpConfigurationIDFromParent(parent, "frontendIPConfig1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/frontendIPConfigurations/frontendIPConfig1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestLoadBalancerFrontendIpConfigurationIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/frontendIPConfigurations/frontendIPConfig1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/LOADBALANCERS/loadBalancer1/FRONTENDIPCONFIGURATIONS/frontendIPConfig1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/loadBalancers/loadBalancer1/frontendIPConfigurations/frontendIPConfig1other",
+			Expected: false,
+		},
+	}
+
+	id := NewLoadBalancerFrontendIpConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "loadBalancer1", "frontendIPConfig1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestLoadBalancerFrontendIpConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerInboundNatPoolId struct {
@@ -25,6 +25,16 @@ func NewLoadBalancerInboundNatPoolID(subscriptionId, resourceGroup, loadBalancer
 	}
 }
 
+// NewLoadBalancerInboundNatPoolIDFromParent returns a LoadBalancerInboundNatPoolId for the LoadBalancerInboundNatPool within the specified LoadBalancer
+func NewLoadBalancerInboundNatPoolIDFromParent(parent LoadBalancerId, inboundNatPoolName string) LoadBalancerInboundNatPoolId {
+	return LoadBalancerInboundNatPoolId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		LoadBalancerName:   parent.Name,
+		InboundNatPoolName: inboundNatPoolName,
+	}
+}
+
 func (id LoadBalancerInboundNatPoolId) String() string {
 	segments := []string{
 		fmt.Sprintf("Inbound Nat Pool Name %q", id.InboundNatPoolName),
@@ -40,35 +50,40 @@ func (id LoadBalancerInboundNatPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.InboundNatPoolName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LoadBalancerInboundNatPool
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LoadBalancerInboundNatPoolId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LoadBalancerInboundNatPool ID
+func (id LoadBalancerInboundNatPoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticLoadBalancers", "loadBalancers", false),
+		resourceid.UserSpecifiedSegment("loadBalancerName"),
+		resourceid.StaticSegment("staticInboundNatPools", "inboundNatPools", false),
+		resourceid.UserSpecifiedSegment("inboundNatPoolName"),
+	}
+}
+
 // LoadBalancerInboundNatPoolID parses a LoadBalancerInboundNatPool ID into an LoadBalancerInboundNatPoolId struct
 func LoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LoadBalancerInboundNatPoolId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancerInboundNatPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.InboundNatPoolName, err = id.PopSegment("inboundNatPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		LoadBalancerName:   values["loadBalancerName"],
+		InboundNatPoolName: values["inboundNatPoolName"],
 	}
 
 	return &resourceId, nil
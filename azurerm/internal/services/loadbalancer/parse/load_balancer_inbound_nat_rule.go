@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerInboundNatRuleId struct {
@@ -25,6 +25,16 @@ func NewLoadBalancerInboundNatRuleID(subscriptionId, resourceGroup, loadBalancer
 	}
 }
 
+// NewLoadBalancerInboundNatRuleIDFromParent returns a LoadBalancerInboundNatRuleId for the LoadBalancerInboundNatRule within the specified LoadBalancer
+func NewLoadBalancerInboundNatRuleIDFromParent(parent LoadBalancerId, inboundNatRuleName string) LoadBalancerInboundNatRuleId {
+	return LoadBalancerInboundNatRuleId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		LoadBalancerName:   parent.Name,
+		InboundNatRuleName: inboundNatRuleName,
+	}
+}
+
 func (id LoadBalancerInboundNatRuleId) String() string {
 	segments := []string{
 		fmt.Sprintf("Inbound Nat Rule Name %q", id.InboundNatRuleName),
@@ -40,35 +50,40 @@ func (id LoadBalancerInboundNatRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.InboundNatRuleName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LoadBalancerInboundNatRule
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LoadBalancerInboundNatRuleId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LoadBalancerInboundNatRule ID
+func (id LoadBalancerInboundNatRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticLoadBalancers", "loadBalancers", false),
+		resourceid.UserSpecifiedSegment("loadBalancerName"),
+		resourceid.StaticSegment("staticInboundNatRules", "inboundNatRules", false),
+		resourceid.UserSpecifiedSegment("inboundNatRuleName"),
+	}
+}
+
 // LoadBalancerInboundNatRuleID parses a LoadBalancerInboundNatRule ID into an LoadBalancerInboundNatRuleId struct
 func LoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LoadBalancerInboundNatRuleId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancerInboundNatRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.InboundNatRuleName, err = id.PopSegment("inboundNatRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		LoadBalancerName:   values["loadBalancerName"],
+		InboundNatRuleName: values["inboundNatRuleName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerOutboundRuleId struct {
@@ -25,6 +25,16 @@ func NewLoadBalancerOutboundRuleID(subscriptionId, resourceGroup, loadBalancerNa
 	}
 }
 
+// NewLoadBalancerOutboundRuleIDFromParent returns a LoadBalancerOutboundRuleId for the LoadBalancerOutboundRule within the specified LoadBalancer
+func NewLoadBalancerOutboundRuleIDFromParent(parent LoadBalancerId, outboundRuleName string) LoadBalancerOutboundRuleId {
+	return LoadBalancerOutboundRuleId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		LoadBalancerName: parent.Name,
+		OutboundRuleName: outboundRuleName,
+	}
+}
+
 func (id LoadBalancerOutboundRuleId) String() string {
 	segments := []string{
 		fmt.Sprintf("Outbound Rule Name %q", id.OutboundRuleName),
@@ -40,35 +50,40 @@ func (id LoadBalancerOutboundRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.OutboundRuleName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LoadBalancerOutboundRule
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LoadBalancerOutboundRuleId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LoadBalancerOutboundRule ID
+func (id LoadBalancerOutboundRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticLoadBalancers", "loadBalancers", false),
+		resourceid.UserSpecifiedSegment("loadBalancerName"),
+		resourceid.StaticSegment("staticOutboundRules", "outboundRules", false),
+		resourceid.UserSpecifiedSegment("outboundRuleName"),
+	}
+}
+
 // LoadBalancerOutboundRuleID parses a LoadBalancerOutboundRule ID into an LoadBalancerOutboundRuleId struct
 func LoadBalancerOutboundRuleID(input string) (*LoadBalancerOutboundRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LoadBalancerOutboundRuleId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancerOutboundRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.OutboundRuleName, err = id.PopSegment("outboundRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		LoadBalancerName: values["loadBalancerName"],
+		OutboundRuleName: values["outboundRuleName"],
 	}
 
 	return &resourceId, nil
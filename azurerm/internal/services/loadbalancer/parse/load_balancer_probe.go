@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LoadBalancerProbeId struct {
@@ -25,6 +25,16 @@ func NewLoadBalancerProbeID(subscriptionId, resourceGroup, loadBalancerName, pro
 	}
 }
 
+// NewLoadBalancerProbeIDFromParent returns a LoadBalancerProbeId for the LoadBalancerProbe within the specified LoadBalancer
+func NewLoadBalancerProbeIDFromParent(parent LoadBalancerId, probeName string) LoadBalancerProbeId {
+	return LoadBalancerProbeId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		LoadBalancerName: parent.Name,
+		ProbeName:        probeName,
+	}
+}
+
 func (id LoadBalancerProbeId) String() string {
 	segments := []string{
 		fmt.Sprintf("Probe Name %q", id.ProbeName),
@@ -40,35 +50,40 @@ func (id LoadBalancerProbeId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.LoadBalancerName, id.ProbeName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LoadBalancerProbe
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LoadBalancerProbeId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LoadBalancerProbe ID
+func (id LoadBalancerProbeId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticLoadBalancers", "loadBalancers", false),
+		resourceid.UserSpecifiedSegment("loadBalancerName"),
+		resourceid.StaticSegment("staticProbes", "probes", false),
+		resourceid.UserSpecifiedSegment("probeName"),
+	}
+}
+
 // LoadBalancerProbeID parses a LoadBalancerProbe ID into an LoadBalancerProbeId struct
 func LoadBalancerProbeID(input string) (*LoadBalancerProbeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LoadBalancerProbeId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LoadBalancerProbeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
-		return nil, err
-	}
-	if resourceId.ProbeName, err = id.PopSegment("probes"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		LoadBalancerName: values["loadBalancerName"],
+		ProbeName:        values["probeName"],
 	}
 
 	return &resourceId, nil
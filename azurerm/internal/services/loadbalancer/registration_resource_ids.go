@@ -0,0 +1,70 @@
+package loadbalancer
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loadbalancer/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Network/loadBalancers",
+			TerraformResourceType: "azurerm_lb",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LoadBalancerID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/loadBalancers/backendAddressPools",
+			TerraformResourceType: "azurerm_lb_backend_address_pool",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LoadBalancerBackendAddressPoolID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/loadBalancers/backendAddressPools/addresses",
+			TerraformResourceType: "azurerm_lb_backend_address_pool_address",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BackendAddressPoolAddressID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/loadBalancers/inboundNatPools",
+			TerraformResourceType: "azurerm_lb_nat_pool",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LoadBalancerInboundNatPoolID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/loadBalancers/inboundNatRules",
+			TerraformResourceType: "azurerm_lb_nat_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LoadBalancerInboundNatRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/loadBalancers/outboundRules",
+			TerraformResourceType: "azurerm_lb_outbound_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LoadBalancerOutboundRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/loadBalancers/probes",
+			TerraformResourceType: "azurerm_lb_probe",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LoadBalancerProbeID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/loadBalancers/loadBalancingRules",
+			TerraformResourceType: "azurerm_lb_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LoadBalancingRuleID(input)
+			},
+		},
+	}
+}
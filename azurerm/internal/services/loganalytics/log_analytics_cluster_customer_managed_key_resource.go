@@ -10,8 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
-	keyVaultParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
-	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/cmk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
@@ -47,7 +46,7 @@ func resourceLogAnalyticsClusterCustomerManagedKey() *schema.Resource {
 			"key_vault_key_id": {
 				Type:         schema.TypeString,
 				Required:     true,
-				ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+				ValidateFunc: cmk.OptionallyVersionedKeyVaultKeyID,
 			},
 		},
 	}
@@ -87,7 +86,7 @@ func resourceLogAnalyticsClusterCustomerManagedKeyUpdate(d *schema.ResourceData,
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	keyId, err := keyVaultParse.ParseOptionallyVersionedNestedItemID(d.Get("key_vault_key_id").(string))
+	keyId, err := cmk.ParseOptionallyVersionedKeyVaultKeyID(d.Get("key_vault_key_id").(string))
 	if err != nil {
 		return fmt.Errorf("could not parse Key Vault Key ID: %+v", err)
 	}
@@ -160,7 +159,7 @@ func resourceLogAnalyticsClusterCustomerManagedKeyRead(d *schema.ResourceData, m
 			if kvProps.KeyVersion != nil {
 				keyVersion = *kvProps.KeyVersion
 			}
-			keyVaultKeyId, err := keyVaultParse.NewNestedItemID(keyVaultUri, "keys", keyName, keyVersion)
+			keyVaultKeyId, err := cmk.NewKeyVaultKeyID(keyVaultUri, keyName, keyVersion)
 			if err != nil {
 				return err
 			}
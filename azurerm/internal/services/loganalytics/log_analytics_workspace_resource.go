@@ -199,7 +199,7 @@ func resourceLogAnalyticsWorkspaceCreateUpdate(d *schema.ResourceData, meta inte
 	parameters := operationalinsights.Workspace{
 		Name:     &name,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		WorkspaceProperties: &operationalinsights.WorkspaceProperties{
 			Sku:                             sku,
 			PublicNetworkAccessForIngestion: internetIngestionEnabled,
@@ -299,7 +299,7 @@ func resourceLogAnalyticsWorkspaceRead(d *schema.ResourceData, meta interface{})
 		d.Set("secondary_shared_key", sharedKeys.SecondarySharedKey)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceLogAnalyticsWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
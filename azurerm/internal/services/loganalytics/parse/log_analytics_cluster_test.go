@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = LogAnalyticsClusterId{}
+var _ resourceid.SegmentedID = LogAnalyticsClusterId{}
 
 func TestLogAnalyticsClusterIDFormatter(t *testing.T) {
 	actual := NewLogAnalyticsClusterID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1").ID()
@@ -18,6 +19,43 @@ func TestLogAnalyticsClusterIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLogAnalyticsClusterIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/clusters/cluster1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.OPERATIONALINSIGHTS/CLUSTERS/cluster1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/clusters/cluster1other",
+			Expected: false,
+		},
+	}
+
+	id := NewLogAnalyticsClusterID("12345678-1234-9876-4563-123456789012", "resGroup1", "cluster1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestLogAnalyticsClusterID(t *testing.T) {
 	testData := []struct {
 		Input    string
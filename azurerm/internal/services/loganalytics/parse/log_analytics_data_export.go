@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsDataExportId struct {
@@ -25,6 +25,16 @@ func NewLogAnalyticsDataExportID(subscriptionId, resourceGroup, workspaceName, d
 	}
 }
 
+// NewLogAnalyticsDataExportIDFromParent returns a LogAnalyticsDataExportId for the LogAnalyticsDataExport within the specified LogAnalyticsWorkspace
+func NewLogAnalyticsDataExportIDFromParent(parent LogAnalyticsWorkspaceId, dataexportName string) LogAnalyticsDataExportId {
+	return LogAnalyticsDataExportId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		WorkspaceName:  parent.WorkspaceName,
+		DataexportName: dataexportName,
+	}
+}
+
 func (id LogAnalyticsDataExportId) String() string {
 	segments := []string{
 		fmt.Sprintf("Dataexport Name %q", id.DataexportName),
@@ -40,35 +50,40 @@ func (id LogAnalyticsDataExportId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.DataexportName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LogAnalyticsDataExport
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LogAnalyticsDataExportId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LogAnalyticsDataExport ID
+func (id LogAnalyticsDataExportId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights"),
+		resourceid.StaticSegment("staticWorkspaces", "workspaces", false),
+		resourceid.UserSpecifiedSegment("workspaceName"),
+		resourceid.StaticSegment("staticDataexports", "dataexports", false),
+		resourceid.UserSpecifiedSegment("dataexportName"),
+	}
+}
+
 // LogAnalyticsDataExportID parses a LogAnalyticsDataExport ID into an LogAnalyticsDataExportId struct
 func LogAnalyticsDataExportID(input string) (*LogAnalyticsDataExportId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LogAnalyticsDataExportId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LogAnalyticsDataExportId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.DataexportName, err = id.PopSegment("dataexports"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		WorkspaceName:  values["workspaceName"],
+		DataexportName: values["dataexportName"],
 	}
 
 	return &resourceId, nil
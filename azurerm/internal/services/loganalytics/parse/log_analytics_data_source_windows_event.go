@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsDataSourceWindowsEventId struct {
@@ -25,6 +25,16 @@ func NewLogAnalyticsDataSourceWindowsEventID(subscriptionId, resourceGroup, work
 	}
 }
 
+// NewLogAnalyticsDataSourceWindowsEventIDFromParent returns a LogAnalyticsDataSourceWindowsEventId for the LogAnalyticsDataSourceWindowsEvent within the specified LogAnalyticsWorkspace
+func NewLogAnalyticsDataSourceWindowsEventIDFromParent(parent LogAnalyticsWorkspaceId, dataSourceName string) LogAnalyticsDataSourceWindowsEventId {
+	return LogAnalyticsDataSourceWindowsEventId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		WorkspaceName:  parent.WorkspaceName,
+		DataSourceName: dataSourceName,
+	}
+}
+
 func (id LogAnalyticsDataSourceWindowsEventId) String() string {
 	segments := []string{
 		fmt.Sprintf("Data Source Name %q", id.DataSourceName),
@@ -40,35 +50,40 @@ func (id LogAnalyticsDataSourceWindowsEventId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.DataSourceName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LogAnalyticsDataSourceWindowsEvent
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LogAnalyticsDataSourceWindowsEventId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LogAnalyticsDataSourceWindowsEvent ID
+func (id LogAnalyticsDataSourceWindowsEventId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights"),
+		resourceid.StaticSegment("staticWorkspaces", "workspaces", false),
+		resourceid.UserSpecifiedSegment("workspaceName"),
+		resourceid.StaticSegment("staticDataSources", "dataSources", false),
+		resourceid.UserSpecifiedSegment("dataSourceName"),
+	}
+}
+
 // LogAnalyticsDataSourceWindowsEventID parses a LogAnalyticsDataSourceWindowsEvent ID into an LogAnalyticsDataSourceWindowsEventId struct
 func LogAnalyticsDataSourceWindowsEventID(input string) (*LogAnalyticsDataSourceWindowsEventId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LogAnalyticsDataSourceWindowsEventId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LogAnalyticsDataSourceWindowsEventId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.DataSourceName, err = id.PopSegment("dataSources"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		WorkspaceName:  values["workspaceName"],
+		DataSourceName: values["dataSourceName"],
 	}
 
 	return &resourceId, nil
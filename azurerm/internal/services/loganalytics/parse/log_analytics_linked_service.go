@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsLinkedServiceId struct {
@@ -25,6 +25,16 @@ func NewLogAnalyticsLinkedServiceID(subscriptionId, resourceGroup, workspaceName
 	}
 }
 
+// NewLogAnalyticsLinkedServiceIDFromParent returns a LogAnalyticsLinkedServiceId for the LogAnalyticsLinkedService within the specified LogAnalyticsWorkspace
+func NewLogAnalyticsLinkedServiceIDFromParent(parent LogAnalyticsWorkspaceId, linkedServiceName string) LogAnalyticsLinkedServiceId {
+	return LogAnalyticsLinkedServiceId{
+		SubscriptionId:    parent.SubscriptionId,
+		ResourceGroup:     parent.ResourceGroup,
+		WorkspaceName:     parent.WorkspaceName,
+		LinkedServiceName: linkedServiceName,
+	}
+}
+
 func (id LogAnalyticsLinkedServiceId) String() string {
 	segments := []string{
 		fmt.Sprintf("Linked Service Name %q", id.LinkedServiceName),
@@ -40,35 +50,40 @@ func (id LogAnalyticsLinkedServiceId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.LinkedServiceName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LogAnalyticsLinkedService
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LogAnalyticsLinkedServiceId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LogAnalyticsLinkedService ID
+func (id LogAnalyticsLinkedServiceId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights"),
+		resourceid.StaticSegment("staticWorkspaces", "workspaces", false),
+		resourceid.UserSpecifiedSegment("workspaceName"),
+		resourceid.StaticSegment("staticLinkedServices", "linkedServices", false),
+		resourceid.UserSpecifiedSegment("linkedServiceName"),
+	}
+}
+
 // LogAnalyticsLinkedServiceID parses a LogAnalyticsLinkedService ID into an LogAnalyticsLinkedServiceId struct
 func LogAnalyticsLinkedServiceID(input string) (*LogAnalyticsLinkedServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LogAnalyticsLinkedServiceId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LogAnalyticsLinkedServiceId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.LinkedServiceName, err = id.PopSegment("linkedServices"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    values["subscriptionId"],
+		ResourceGroup:     values["resourceGroup"],
+		WorkspaceName:     values["workspaceName"],
+		LinkedServiceName: values["linkedServiceName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsLinkedStorageAccountId struct {
@@ -25,6 +25,16 @@ func NewLogAnalyticsLinkedStorageAccountID(subscriptionId, resourceGroup, worksp
 	}
 }
 
+// NewLogAnalyticsLinkedStorageAccountIDFromParent returns a LogAnalyticsLinkedStorageAccountId for the LogAnalyticsLinkedStorageAccount within the specified LogAnalyticsWorkspace
+func NewLogAnalyticsLinkedStorageAccountIDFromParent(parent LogAnalyticsWorkspaceId, linkedStorageAccountName string) LogAnalyticsLinkedStorageAccountId {
+	return LogAnalyticsLinkedStorageAccountId{
+		SubscriptionId:           parent.SubscriptionId,
+		ResourceGroup:            parent.ResourceGroup,
+		WorkspaceName:            parent.WorkspaceName,
+		LinkedStorageAccountName: linkedStorageAccountName,
+	}
+}
+
 func (id LogAnalyticsLinkedStorageAccountId) String() string {
 	segments := []string{
 		fmt.Sprintf("Linked Storage Account Name %q", id.LinkedStorageAccountName),
@@ -40,35 +50,40 @@ func (id LogAnalyticsLinkedStorageAccountId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.LinkedStorageAccountName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LogAnalyticsLinkedStorageAccount
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LogAnalyticsLinkedStorageAccountId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LogAnalyticsLinkedStorageAccount ID
+func (id LogAnalyticsLinkedStorageAccountId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights"),
+		resourceid.StaticSegment("staticWorkspaces", "workspaces", false),
+		resourceid.UserSpecifiedSegment("workspaceName"),
+		resourceid.StaticSegment("staticLinkedStorageAccounts", "linkedStorageAccounts", false),
+		resourceid.UserSpecifiedSegment("linkedStorageAccountName"),
+	}
+}
+
 // LogAnalyticsLinkedStorageAccountID parses a LogAnalyticsLinkedStorageAccount ID into an LogAnalyticsLinkedStorageAccountId struct
 func LogAnalyticsLinkedStorageAccountID(input string) (*LogAnalyticsLinkedStorageAccountId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LogAnalyticsLinkedStorageAccountId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LogAnalyticsLinkedStorageAccountId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.LinkedStorageAccountName, err = id.PopSegment("linkedStorageAccounts"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:           values["subscriptionId"],
+		ResourceGroup:            values["resourceGroup"],
+		WorkspaceName:            values["workspaceName"],
+		LinkedStorageAccountName: values["linkedStorageAccountName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = LogAnalyticsLinkedStorageAccountId{}
+var _ resourceid.SegmentedID = LogAnalyticsLinkedStorageAccountId{}
 
 func TestLogAnalyticsLinkedStorageAccountIDFormatter(t *testing.T) {
 	actual := NewLogAnalyticsLinkedStorageAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "query").ID()
@@ -18,6 +19,52 @@ func TestLogAnalyticsLinkedStorageAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLogAnalyticsLinkedStorageAccountIDFromParent(t *testing.T) {
+	parent := NewLogAnalyticsWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1")
+	actual := NewLogAnalyticsLinkedStorageAccountIDFromParent(parent, "query").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedStorageAccounts/query"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestLogAnalyticsLinkedStorageAccountIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedStorageAccounts/query",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/workspace1/LINKEDSTORAGEACCOUNTS/query",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/linkedStorageAccounts/queryother",
+			Expected: false,
+		},
+	}
+
+	id := NewLogAnalyticsLinkedStorageAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "query")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestLogAnalyticsLinkedStorageAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
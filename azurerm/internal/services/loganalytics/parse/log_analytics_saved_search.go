@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsSavedSearchId struct {
@@ -25,6 +25,16 @@ func NewLogAnalyticsSavedSearchID(subscriptionId, resourceGroup, workspaceName,
 	}
 }
 
+// NewLogAnalyticsSavedSearchIDFromParent returns a LogAnalyticsSavedSearchId for the LogAnalyticsSavedSearch within the specified LogAnalyticsWorkspace
+func NewLogAnalyticsSavedSearchIDFromParent(parent LogAnalyticsWorkspaceId, savedSearcheName string) LogAnalyticsSavedSearchId {
+	return LogAnalyticsSavedSearchId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		WorkspaceName:    parent.WorkspaceName,
+		SavedSearcheName: savedSearcheName,
+	}
+}
+
 func (id LogAnalyticsSavedSearchId) String() string {
 	segments := []string{
 		fmt.Sprintf("Saved Searche Name %q", id.SavedSearcheName),
@@ -40,35 +50,40 @@ func (id LogAnalyticsSavedSearchId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.SavedSearcheName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LogAnalyticsSavedSearch
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LogAnalyticsSavedSearchId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LogAnalyticsSavedSearch ID
+func (id LogAnalyticsSavedSearchId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights"),
+		resourceid.StaticSegment("staticWorkspaces", "workspaces", false),
+		resourceid.UserSpecifiedSegment("workspaceName"),
+		resourceid.StaticSegment("staticSavedSearches", "savedSearches", false),
+		resourceid.UserSpecifiedSegment("savedSearcheName"),
+	}
+}
+
 // LogAnalyticsSavedSearchID parses a LogAnalyticsSavedSearch ID into an LogAnalyticsSavedSearchId struct
 func LogAnalyticsSavedSearchID(input string) (*LogAnalyticsSavedSearchId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LogAnalyticsSavedSearchId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LogAnalyticsSavedSearchId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.SavedSearcheName, err = id.PopSegment("savedSearches"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		WorkspaceName:    values["workspaceName"],
+		SavedSearcheName: values["savedSearcheName"],
 	}
 
 	return &resourceId, nil
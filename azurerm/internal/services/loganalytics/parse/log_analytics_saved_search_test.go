@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = LogAnalyticsSavedSearchId{}
+var _ resourceid.SegmentedID = LogAnalyticsSavedSearchId{}
 
 func TestLogAnalyticsSavedSearchIDFormatter(t *testing.T) {
 	actual := NewLogAnalyticsSavedSearchID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "search1").ID()
@@ -18,6 +19,52 @@ func TestLogAnalyticsSavedSearchIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLogAnalyticsSavedSearchIDFromParent(t *testing.T) {
+	parent := NewLogAnalyticsWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1")
+	actual := NewLogAnalyticsSavedSearchIDFromParent(parent, "search1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/savedSearches/search1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestLogAnalyticsSavedSearchIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/savedSearches/search1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/workspace1/SAVEDSEARCHES/search1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/savedSearches/search1other",
+			Expected: false,
+		},
+	}
+
+	id := NewLogAnalyticsSavedSearchID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "search1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestLogAnalyticsSavedSearchID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LogAnalyticsStorageInsightsId struct {
@@ -25,6 +25,16 @@ func NewLogAnalyticsStorageInsightsID(subscriptionId, resourceGroup, workspaceNa
 	}
 }
 
+// NewLogAnalyticsStorageInsightsIDFromParent returns a LogAnalyticsStorageInsightsId for the LogAnalyticsStorageInsights within the specified LogAnalyticsWorkspace
+func NewLogAnalyticsStorageInsightsIDFromParent(parent LogAnalyticsWorkspaceId, storageInsightConfigName string) LogAnalyticsStorageInsightsId {
+	return LogAnalyticsStorageInsightsId{
+		SubscriptionId:           parent.SubscriptionId,
+		ResourceGroup:            parent.ResourceGroup,
+		WorkspaceName:            parent.WorkspaceName,
+		StorageInsightConfigName: storageInsightConfigName,
+	}
+}
+
 func (id LogAnalyticsStorageInsightsId) String() string {
 	segments := []string{
 		fmt.Sprintf("Storage Insight Config Name %q", id.StorageInsightConfigName),
@@ -40,35 +50,40 @@ func (id LogAnalyticsStorageInsightsId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.StorageInsightConfigName)
 }
 
+// Matches returns whether the specified Resource ID refers to this LogAnalyticsStorageInsights
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LogAnalyticsStorageInsightsId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LogAnalyticsStorageInsights ID
+func (id LogAnalyticsStorageInsightsId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftOperationalInsights", "Microsoft.OperationalInsights"),
+		resourceid.StaticSegment("staticWorkspaces", "workspaces", false),
+		resourceid.UserSpecifiedSegment("workspaceName"),
+		resourceid.StaticSegment("staticStorageInsightConfigs", "storageInsightConfigs", false),
+		resourceid.UserSpecifiedSegment("storageInsightConfigName"),
+	}
+}
+
 // LogAnalyticsStorageInsightsID parses a LogAnalyticsStorageInsights ID into an LogAnalyticsStorageInsightsId struct
 func LogAnalyticsStorageInsightsID(input string) (*LogAnalyticsStorageInsightsId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LogAnalyticsStorageInsightsId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LogAnalyticsStorageInsightsId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.StorageInsightConfigName, err = id.PopSegment("storageInsightConfigs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:           values["subscriptionId"],
+		ResourceGroup:            values["resourceGroup"],
+		WorkspaceName:            values["workspaceName"],
+		StorageInsightConfigName: values["storageInsightConfigName"],
 	}
 
 	return &resourceId, nil
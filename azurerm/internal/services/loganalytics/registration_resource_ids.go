@@ -0,0 +1,49 @@
+package loganalytics
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/loganalytics/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.OperationalInsights/clusters",
+			TerraformResourceType: "azurerm_log_analytics_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LogAnalyticsClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.OperationalInsights/workspaces/dataexports",
+			TerraformResourceType: "azurerm_log_analytics_data_export_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LogAnalyticsDataExportID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.OperationalInsights/workspaces/linkedStorageAccounts",
+			TerraformResourceType: "azurerm_log_analytics_linked_storage_account",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LogAnalyticsLinkedStorageAccountID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.OperationalInsights/workspaces/storageInsightConfigs",
+			TerraformResourceType: "azurerm_log_analytics_storage_insights",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LogAnalyticsStorageInsightsID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.OperationalInsights/workspaces",
+			TerraformResourceType: "azurerm_log_analytics_workspace",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LogAnalyticsWorkspaceID(input)
+			},
+		},
+	}
+}
@@ -164,7 +164,7 @@ func resourceLogicAppWorkflowCreate(d *schema.ResourceData, meta interface{}) er
 			},
 			Parameters: parameters,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if iseID, ok := d.GetOk("integration_service_environment_id"); ok {
@@ -237,7 +237,7 @@ func resourceLogicAppWorkflowUpdate(d *schema.ResourceData, meta interface{}) er
 			Definition: read.WorkflowProperties.Definition,
 			Parameters: parameters,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("logic_app_integration_account_id"); ok {
@@ -329,7 +329,7 @@ func resourceLogicAppWorkflowRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("logic_app_integration_account_id", integrationAccountId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceLogicAppWorkflowDelete(d *schema.ResourceData, meta interface{}) error {
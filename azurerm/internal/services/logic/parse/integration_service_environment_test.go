@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = IntegrationServiceEnvironmentId{}
+var _ resourceid.SegmentedID = IntegrationServiceEnvironmentId{}
 
 func TestIntegrationServiceEnvironmentIDFormatter(t *testing.T) {
 	actual := NewIntegrationServiceEnvironmentID("12345678-1234-9876-4563-123456789012", "group1", "ise1").ID()
@@ -18,6 +19,43 @@ func TestIntegrationServiceEnvironmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestIntegrationServiceEnvironmentIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Logic/integrationServiceEnvironments/ise1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.LOGIC/INTEGRATIONSERVICEENVIRONMENTS/ise1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Logic/integrationServiceEnvironments/ise1other",
+			Expected: false,
+		},
+	}
+
+	id := NewIntegrationServiceEnvironmentID("12345678-1234-9876-4563-123456789012", "group1", "ise1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestIntegrationServiceEnvironmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
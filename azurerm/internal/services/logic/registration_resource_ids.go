@@ -0,0 +1,28 @@
+package logic
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/logic/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Logic/integrationServiceEnvironments",
+			TerraformResourceType: "azurerm_integration_service_environment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IntegrationServiceEnvironmentID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Logic/integrationAccounts",
+			TerraformResourceType: "azurerm_logic_app_integration_account",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IntegrationAccountID(input)
+			},
+		},
+	}
+}
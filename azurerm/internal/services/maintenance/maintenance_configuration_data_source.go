@@ -70,5 +70,5 @@ func dataSourceArmMaintenanceConfigurationRead(d *schema.ResourceData, meta inte
 		d.Set("scope", props.MaintenanceScope)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
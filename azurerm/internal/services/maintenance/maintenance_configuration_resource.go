@@ -110,7 +110,7 @@ func resourceArmMaintenanceConfigurationCreateUpdate(d *schema.ResourceData, met
 			MaintenanceScope: maintenance.Scope(scope),
 			Namespace:        utils.String("Microsoft.Maintenance"),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, name, configuration); err != nil {
@@ -156,7 +156,7 @@ func resourceArmMaintenanceConfigurationRead(d *schema.ResourceData, meta interf
 	if props := resp.ConfigurationProperties; props != nil {
 		d.Set("scope", props.MaintenanceScope)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmMaintenanceConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ApplicationDefinitionId{}
+var _ resourceid.SegmentedID = ApplicationDefinitionId{}
 
 func TestApplicationDefinitionIDFormatter(t *testing.T) {
 	actual := NewApplicationDefinitionID("12345678-1234-9876-4563-123456789012", "resGroup1", "definition1").ID()
@@ -18,6 +19,43 @@ func TestApplicationDefinitionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApplicationDefinitionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applicationDefinitions/definition1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SOLUTIONS/APPLICATIONDEFINITIONS/definition1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applicationDefinitions/definition1other",
+			Expected: false,
+		},
+	}
+
+	id := NewApplicationDefinitionID("12345678-1234-9876-4563-123456789012", "resGroup1", "definition1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestApplicationDefinitionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ApplicationId{}
+var _ resourceid.SegmentedID = ApplicationId{}
 
 func TestApplicationIDFormatter(t *testing.T) {
 	actual := NewApplicationID("12345678-1234-9876-4563-123456789012", "resGroup1", "app1").ID()
@@ -18,6 +19,43 @@ func TestApplicationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestApplicationIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applications/app1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SOLUTIONS/APPLICATIONS/app1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Solutions/applications/app1other",
+			Expected: false,
+		},
+	}
+
+	id := NewApplicationID("12345678-1234-9876-4563-123456789012", "resGroup1", "app1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestApplicationID(t *testing.T) {
 	testData := []struct {
 		Input    string
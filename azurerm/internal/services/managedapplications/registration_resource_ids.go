@@ -0,0 +1,28 @@
+package managedapplications
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managedapplications/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Solutions/applications",
+			TerraformResourceType: "azurerm_managed_application",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApplicationID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Solutions/applicationDefinitions",
+			TerraformResourceType: "azurerm_managed_application_definition",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApplicationDefinitionID(input)
+			},
+		},
+	}
+}
@@ -110,7 +110,7 @@ func resourceMapsAccountCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		Sku: &maps.Sku{
 			Name: &sku,
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resGroup, name, parameters); err != nil {
@@ -167,7 +167,7 @@ func resourceMapsAccountRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("primary_access_key", keysResp.PrimaryKey)
 	d.Set("secondary_access_key", keysResp.SecondaryKey)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMapsAccountDelete(d *schema.ResourceData, meta interface{}) error {
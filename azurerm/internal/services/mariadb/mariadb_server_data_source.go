@@ -138,5 +138,5 @@ func dataSourceMariaDbServerRead(d *schema.ResourceData, meta interface{}) error
 			return fmt.Errorf("Error setting `storage_profile`: %+v", err)
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -367,7 +367,7 @@ func resourceMariaDbServerCreate(d *schema.ResourceData, meta interface{}) error
 		Location:   &location,
 		Properties: props,
 		Sku:        sku,
-		Tags:       tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:       tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, resourceGroup, name, server)
@@ -431,7 +431,7 @@ func resourceMariaDbServerUpdate(d *schema.ResourceData, meta interface{}) error
 			Version:                    mariadb.ServerVersion(d.Get("version").(string)),
 		},
 		Sku:  sku,
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.Name, properties)
@@ -511,7 +511,7 @@ func resourceMariaDbServerRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("fqdn", props.FullyQualifiedDomainName)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMariaDbServerDelete(d *schema.ResourceData, meta interface{}) error {
@@ -152,7 +152,7 @@ func resourceMediaServicesAccountCreateUpdate(d *schema.ResourceData, meta inter
 			StorageAccounts: storageAccounts,
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, ok := d.GetOk("identity"); ok {
@@ -211,7 +211,7 @@ func resourceMediaServicesAccountRead(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("flattening `identity`: %s", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMediaServicesAccountDelete(d *schema.ResourceData, meta interface{}) error {
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AssetId struct {
@@ -25,6 +25,16 @@ func NewAssetID(subscriptionId, resourceGroup, mediaserviceName, name string) As
 	}
 }
 
+// NewAssetIDFromParent returns a AssetId for the Asset within the specified MediaService
+func NewAssetIDFromParent(parent MediaServiceId, name string) AssetId {
+	return AssetId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		MediaserviceName: parent.Name,
+		Name:             name,
+	}
+}
+
 func (id AssetId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id AssetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MediaserviceName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Asset
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id AssetId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Asset ID
+func (id AssetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftMedia", "Microsoft.Media"),
+		resourceid.StaticSegment("staticMediaservices", "mediaservices", false),
+		resourceid.UserSpecifiedSegment("mediaserviceName"),
+		resourceid.StaticSegment("staticAssets", "assets", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // AssetID parses a Asset ID into an AssetId struct
 func AssetID(input string) (*AssetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, AssetId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AssetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("assets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		MediaserviceName: values["mediaserviceName"],
+		Name:             values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ContentKeyPolicyId{}
+var _ resourceid.SegmentedID = ContentKeyPolicyId{}
 
 func TestContentKeyPolicyIDFormatter(t *testing.T) {
 	actual := NewContentKeyPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "policy1").ID()
@@ -18,6 +19,52 @@ func TestContentKeyPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestContentKeyPolicyIDFromParent(t *testing.T) {
+	parent := NewMediaServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1")
+	actual := NewContentKeyPolicyIDFromParent(parent, "policy1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/contentkeypolicies/policy1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestContentKeyPolicyIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/contentkeypolicies/policy1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/account1/CONTENTKEYPOLICIES/policy1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/contentkeypolicies/policy1other",
+			Expected: false,
+		},
+	}
+
+	id := NewContentKeyPolicyID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "policy1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestContentKeyPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
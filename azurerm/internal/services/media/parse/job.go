@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type JobId struct {
@@ -27,6 +27,17 @@ func NewJobID(subscriptionId, resourceGroup, mediaserviceName, transformName, na
 	}
 }
 
+// NewJobIDFromParent returns a JobId for the Job within the specified Transform
+func NewJobIDFromParent(parent TransformId, name string) JobId {
+	return JobId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		MediaserviceName: parent.MediaserviceName,
+		TransformName:    parent.Name,
+		Name:             name,
+	}
+}
+
 func (id JobId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -43,38 +54,43 @@ func (id JobId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MediaserviceName, id.TransformName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Job
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id JobId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Job ID
+func (id JobId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftMedia", "Microsoft.Media"),
+		resourceid.StaticSegment("staticMediaservices", "mediaservices", false),
+		resourceid.UserSpecifiedSegment("mediaserviceName"),
+		resourceid.StaticSegment("staticTransforms", "transforms", false),
+		resourceid.UserSpecifiedSegment("transformName"),
+		resourceid.StaticSegment("staticJobs", "jobs", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // JobID parses a Job ID into an JobId struct
 func JobID(input string) (*JobId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, JobId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := JobId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
-		return nil, err
-	}
-	if resourceId.TransformName, err = id.PopSegment("transforms"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("jobs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		MediaserviceName: values["mediaserviceName"],
+		TransformName:    values["transformName"],
+		Name:             values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = JobId{}
+var _ resourceid.SegmentedID = JobId{}
 
 func TestJobIDFormatter(t *testing.T) {
 	actual := NewJobID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "transform1", "job1").ID()
@@ -18,6 +19,52 @@ func TestJobIDFormatter(t *testing.T) {
 	}
 }
 
+func TestJobIDFromParent(t *testing.T) {
+	parent := NewTransformID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "transform1")
+	actual := NewJobIDFromParent(parent, "job1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/transforms/transform1/jobs/job1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestJobIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/transforms/transform1/jobs/job1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/account1/TRANSFORMS/transform1/JOBS/job1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/transforms/transform1/jobs/job1other",
+			Expected: false,
+		},
+	}
+
+	id := NewJobID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "transform1", "job1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestJobID(t *testing.T) {
 	testData := []struct {
 		Input    string
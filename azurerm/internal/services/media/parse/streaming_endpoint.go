@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamingEndpointId struct {
@@ -25,6 +25,16 @@ func NewStreamingEndpointID(subscriptionId, resourceGroup, mediaserviceName, nam
 	}
 }
 
+// NewStreamingEndpointIDFromParent returns a StreamingEndpointId for the StreamingEndpoint within the specified MediaService
+func NewStreamingEndpointIDFromParent(parent MediaServiceId, name string) StreamingEndpointId {
+	return StreamingEndpointId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		MediaserviceName: parent.Name,
+		Name:             name,
+	}
+}
+
 func (id StreamingEndpointId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id StreamingEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MediaserviceName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this StreamingEndpoint
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id StreamingEndpointId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this StreamingEndpoint ID
+func (id StreamingEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftMedia", "Microsoft.Media"),
+		resourceid.StaticSegment("staticMediaservices", "mediaservices", false),
+		resourceid.UserSpecifiedSegment("mediaserviceName"),
+		resourceid.StaticSegment("staticStreamingendpoints", "streamingendpoints", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // StreamingEndpointID parses a StreamingEndpoint ID into an StreamingEndpointId struct
 func StreamingEndpointID(input string) (*StreamingEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, StreamingEndpointId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StreamingEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("streamingendpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		MediaserviceName: values["mediaserviceName"],
+		Name:             values["name"],
 	}
 
 	return &resourceId, nil
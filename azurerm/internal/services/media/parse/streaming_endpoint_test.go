@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = StreamingEndpointId{}
+var _ resourceid.SegmentedID = StreamingEndpointId{}
 
 func TestStreamingEndpointIDFormatter(t *testing.T) {
 	actual := NewStreamingEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "endpoint1").ID()
@@ -18,6 +19,52 @@ func TestStreamingEndpointIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStreamingEndpointIDFromParent(t *testing.T) {
+	parent := NewMediaServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1")
+	actual := NewStreamingEndpointIDFromParent(parent, "endpoint1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/streamingendpoints/endpoint1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStreamingEndpointIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/streamingendpoints/endpoint1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/account1/STREAMINGENDPOINTS/endpoint1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/streamingendpoints/endpoint1other",
+			Expected: false,
+		},
+	}
+
+	id := NewStreamingEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "endpoint1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestStreamingEndpointID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = StreamingLocatorId{}
+var _ resourceid.SegmentedID = StreamingLocatorId{}
 
 func TestStreamingLocatorIDFormatter(t *testing.T) {
 	actual := NewStreamingLocatorID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "locator1").ID()
@@ -18,6 +19,52 @@ func TestStreamingLocatorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStreamingLocatorIDFromParent(t *testing.T) {
+	parent := NewMediaServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1")
+	actual := NewStreamingLocatorIDFromParent(parent, "locator1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/streaminglocators/locator1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStreamingLocatorIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/streaminglocators/locator1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/account1/STREAMINGLOCATORS/locator1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/streaminglocators/locator1other",
+			Expected: false,
+		},
+	}
+
+	id := NewStreamingLocatorID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "locator1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestStreamingLocatorID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = TransformId{}
+var _ resourceid.SegmentedID = TransformId{}
 
 func TestTransformIDFormatter(t *testing.T) {
 	actual := NewTransformID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "transform1").ID()
@@ -18,6 +19,52 @@ func TestTransformIDFormatter(t *testing.T) {
 	}
 }
 
+func TestTransformIDFromParent(t *testing.T) {
+	parent := NewMediaServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1")
+	actual := NewTransformIDFromParent(parent, "transform1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/transforms/transform1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestTransformIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/transforms/transform1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/account1/TRANSFORMS/transform1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Media/mediaservices/account1/transforms/transform1other",
+			Expected: false,
+		},
+	}
+
+	id := NewTransformID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "transform1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestTransformID(t *testing.T) {
 	testData := []struct {
 		Input    string
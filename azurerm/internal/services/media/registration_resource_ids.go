@@ -0,0 +1,70 @@
+package media
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/media/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Media/mediaservices/assets",
+			TerraformResourceType: "azurerm_media_asset",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AssetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Media/mediaservices/contentkeypolicies",
+			TerraformResourceType: "azurerm_media_content_key_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ContentKeyPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Media/mediaservices/transforms/jobs",
+			TerraformResourceType: "azurerm_media_job",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.JobID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Media/mediaservices",
+			TerraformResourceType: "azurerm_media_services_account",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.MediaServiceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Media/mediaservices/streamingendpoints",
+			TerraformResourceType: "azurerm_media_streaming_endpoint",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StreamingEndpointID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Media/mediaservices/streaminglocators",
+			TerraformResourceType: "azurerm_media_streaming_locator",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StreamingLocatorID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Media/mediaservices/streamingpolicies",
+			TerraformResourceType: "azurerm_media_streaming_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StreamingPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Media/mediaservices/transforms",
+			TerraformResourceType: "azurerm_media_transform",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.TransformID(input)
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SpatialAnchorsAccountId{}
+var _ resourceid.SegmentedID = SpatialAnchorsAccountId{}
 
 func TestSpatialAnchorsAccountIDFormatter(t *testing.T) {
 	actual := NewSpatialAnchorsAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "Account1").ID()
@@ -18,6 +19,43 @@ func TestSpatialAnchorsAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpatialAnchorsAccountIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.MixedReality/spatialAnchorsAccounts/Account1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.MIXEDREALITY/SPATIALANCHORSACCOUNTS/Account1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.MixedReality/spatialAnchorsAccounts/Account1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSpatialAnchorsAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "Account1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSpatialAnchorsAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
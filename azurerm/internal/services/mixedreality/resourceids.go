@@ -1,3 +1,6 @@
 package mixedreality
 
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SpatialAnchorsAccount -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.MixedReality/spatialAnchorsAccounts/Account1
+
+// Resource ID Registrations, mapping each Resource ID to the Resource which manages it
+//go:generate go run ../../tools/generator-resource-id-registrations/main.go -path=./
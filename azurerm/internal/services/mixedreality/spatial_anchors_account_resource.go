@@ -80,7 +80,7 @@ func resourceSpatialAnchorsAccountCreate(d *schema.ResourceData, meta interface{
 
 	account := mixedreality.SpatialAnchorsAccount{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, account); err != nil {
@@ -127,7 +127,7 @@ func resourceSpatialAnchorsAccountRead(d *schema.ResourceData, meta interface{})
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSpatialAnchorsAccountDelete(d *schema.ResourceData, meta interface{}) error {
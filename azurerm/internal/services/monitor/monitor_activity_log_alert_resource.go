@@ -221,7 +221,7 @@ func resourceMonitorActivityLogAlertCreateUpdate(d *schema.ResourceData, meta in
 	actionRaw := d.Get("action").(*schema.Set).List()
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 
 	parameters := insights.ActivityLogAlertResource{
 		Location: utils.String(azure.NormalizeLocation("Global")),
@@ -288,7 +288,7 @@ func resourceMonitorActivityLogAlertRead(d *schema.ResourceData, meta interface{
 			return fmt.Errorf("Error setting `action`: %+v", err)
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMonitorActivityLogAlertDelete(d *schema.ResourceData, meta interface{}) error {
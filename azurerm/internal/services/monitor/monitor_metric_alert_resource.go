@@ -408,7 +408,7 @@ func resourceMonitorMetricAlertCreateUpdate(d *schema.ResourceData, meta interfa
 	targetResourceLocation := d.Get("target_resource_location").(string)
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 
 	// The criteria type of "old" resource is `MetricAlertSingleResourceMultipleMetricCriteria` (rather than `MetricAlertMultipleResourceMultipleMetricCriteria`).
 	// We need to keep using that type in order to keep backward compatibility. Otherwise, changing the criteria type will cause error as reported in issue:
@@ -554,7 +554,7 @@ func resourceMonitorMetricAlertRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("target_resource_type", alert.TargetResourceType)
 		d.Set("target_resource_location", alert.TargetResourceRegion)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMonitorMetricAlertDelete(d *schema.ResourceData, meta interface{}) error {
@@ -214,5 +214,5 @@ func dataSourceMonitorScheduledQueryRulesAlertRead(d *schema.ResourceData, meta
 		d.Set("query_type", string(source.QueryType))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
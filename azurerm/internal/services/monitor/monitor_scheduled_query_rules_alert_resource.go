@@ -252,7 +252,7 @@ func resourceMonitorScheduledQueryRulesAlertCreateUpdate(d *schema.ResourceData,
 	source := expandMonitorScheduledQueryRulesCommonSource(d)
 
 	t := d.Get("tags").(map[string]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 
 	parameters := insights.LogSearchRuleResource{
 		Location: utils.String(location),
@@ -356,7 +356,7 @@ func resourceMonitorScheduledQueryRulesAlertRead(d *schema.ResourceData, meta in
 		d.Set("query_type", string(source.QueryType))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMonitorScheduledQueryRulesAlertDelete(d *schema.ResourceData, meta interface{}) error {
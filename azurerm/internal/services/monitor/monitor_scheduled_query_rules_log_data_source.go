@@ -148,5 +148,5 @@ func dataSourceMonitorScheduledQueryRulesLogRead(d *schema.ResourceData, meta in
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ActionGroupId{}
+var _ resourceid.SegmentedID = ActionGroupId{}
 
 func TestActionGroupIDFormatter(t *testing.T) {
 	actual := NewActionGroupID("12345678-1234-9876-4563-123456789012", "group1", "actionGroup1").ID()
@@ -18,6 +19,43 @@ func TestActionGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestActionGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/actionGroups/actionGroup1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.INSIGHTS/ACTIONGROUPS/actionGroup1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/microsoft.insights/actionGroups/actionGroup1other",
+			Expected: false,
+		},
+	}
+
+	id := NewActionGroupID("12345678-1234-9876-4563-123456789012", "group1", "actionGroup1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestActionGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
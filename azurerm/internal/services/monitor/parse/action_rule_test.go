@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ActionRuleId{}
+var _ resourceid.SegmentedID = ActionRuleId{}
 
 func TestActionRuleIDFormatter(t *testing.T) {
 	actual := NewActionRuleID("12345678-1234-9876-4563-123456789012", "group1", "actionRule1").ID()
@@ -18,6 +19,43 @@ func TestActionRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestActionRuleIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/actionRules/actionRule1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.ALERTSMANAGEMENT/ACTIONRULES/actionRule1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/actionRules/actionRule1other",
+			Expected: false,
+		},
+	}
+
+	id := NewActionRuleID("12345678-1234-9876-4563-123456789012", "group1", "actionRule1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestActionRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
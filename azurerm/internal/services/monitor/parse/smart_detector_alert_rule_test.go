@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SmartDetectorAlertRuleId{}
+var _ resourceid.SegmentedID = SmartDetectorAlertRuleId{}
 
 func TestSmartDetectorAlertRuleIDFormatter(t *testing.T) {
 	actual := NewSmartDetectorAlertRuleID("12345678-1234-9876-4563-123456789012", "group1", "rule1").ID()
@@ -18,6 +19,43 @@ func TestSmartDetectorAlertRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSmartDetectorAlertRuleIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/smartdetectoralertrules/rule1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.ALERTSMANAGEMENT/SMARTDETECTORALERTRULES/rule1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AlertsManagement/smartdetectoralertrules/rule1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSmartDetectorAlertRuleID("12345678-1234-9876-4563-123456789012", "group1", "rule1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSmartDetectorAlertRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,35 @@
+package monitor
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/monitor/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.AlertsManagement/actionRules",
+			TerraformResourceType: "azurerm_monitor_action_rule_action_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ActionRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.AlertsManagement/actionRules",
+			TerraformResourceType: "azurerm_monitor_action_rule_suppression",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ActionRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.AlertsManagement/smartdetectoralertrules",
+			TerraformResourceType: "azurerm_monitor_smart_detector_alert_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SmartDetectorAlertRuleID(input)
+			},
+		},
+	}
+}
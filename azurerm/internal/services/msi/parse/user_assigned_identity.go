@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type UserAssignedIdentityId struct {
@@ -37,32 +37,37 @@ func (id UserAssignedIdentityId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this UserAssignedIdentity
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id UserAssignedIdentityId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this UserAssignedIdentity ID
+func (id UserAssignedIdentityId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftManagedIdentity", "Microsoft.ManagedIdentity"),
+		resourceid.StaticSegment("staticUserAssignedIdentities", "userAssignedIdentities", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // UserAssignedIdentityID parses a UserAssignedIdentity ID into an UserAssignedIdentityId struct
 func UserAssignedIdentityID(input string) (*UserAssignedIdentityId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, UserAssignedIdentityId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := UserAssignedIdentityId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.Name, err = id.PopSegment("userAssignedIdentities"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -75,38 +80,15 @@ func UserAssignedIdentityID(input string) (*UserAssignedIdentityId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func UserAssignedIdentityIDInsensitively(input string) (*UserAssignedIdentityId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, UserAssignedIdentityId{}.Segments(), true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := UserAssignedIdentityId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'userAssignedIdentities' segment
-	userAssignedIdentitiesKey := "userAssignedIdentities"
-	for key := range id.Path {
-		if strings.EqualFold(key, userAssignedIdentitiesKey) {
-			userAssignedIdentitiesKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(userAssignedIdentitiesKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
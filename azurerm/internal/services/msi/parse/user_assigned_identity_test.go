@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = UserAssignedIdentityId{}
+var _ resourceid.SegmentedID = UserAssignedIdentityId{}
 
 func TestUserAssignedIdentityIDFormatter(t *testing.T) {
 	actual := NewUserAssignedIdentityID("12345678-1234-9876-4563-123456789012", "group1", "identity1").ID()
@@ -18,6 +19,43 @@ func TestUserAssignedIdentityIDFormatter(t *testing.T) {
 	}
 }
 
+func TestUserAssignedIdentityIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.MANAGEDIDENTITY/USERASSIGNEDIDENTITIES/identity1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.ManagedIdentity/userAssignedIdentities/identity1other",
+			Expected: false,
+		},
+	}
+
+	id := NewUserAssignedIdentityID("12345678-1234-9876-4563-123456789012", "group1", "identity1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestUserAssignedIdentityID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -80,5 +80,5 @@ func dataSourceArmUserAssignedIdentityRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -130,5 +130,5 @@ func dataSourceMsSqlDatabaseRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("zone_redundant", props.ZoneRedundant)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
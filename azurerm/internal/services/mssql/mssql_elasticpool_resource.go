@@ -211,7 +211,7 @@ func resourceMsSqlElasticPoolCreateUpdate(d *schema.ResourceData, meta interface
 		Name:     &elasticPoolName,
 		Location: &location,
 		Sku:      sku,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		ElasticPoolProperties: &sql.ElasticPoolProperties{
 			LicenseType:         sql.ElasticPoolLicenseType(d.Get("license_type").(string)),
 			PerDatabaseSettings: expandMsSqlElasticPoolPerDatabaseSettings(d),
@@ -299,7 +299,7 @@ func resourceMsSqlElasticPoolRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMsSqlElasticPoolDelete(d *schema.ResourceData, meta interface{}) error {
@@ -123,5 +123,5 @@ func dataSourceMsSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `restorable_dropped_database_ids`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
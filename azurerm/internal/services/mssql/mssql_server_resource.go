@@ -193,7 +193,7 @@ func resourceMsSqlServerCreateUpdate(d *schema.ResourceData, meta interface{}) e
 	version := d.Get("version").(string)
 
 	t := d.Get("tags").(map[string]interface{})
-	metadata := tags.Expand(t)
+	metadata := tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
@@ -386,7 +386,7 @@ func resourceMsSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `restorable_dropped_database_ids`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceMsSqlServerDelete(d *schema.ResourceData, meta interface{}) error {
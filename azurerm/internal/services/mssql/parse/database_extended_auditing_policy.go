@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseExtendedAuditingPolicyId struct {
@@ -27,6 +27,17 @@ func NewDatabaseExtendedAuditingPolicyID(subscriptionId, resourceGroup, serverNa
 	}
 }
 
+// NewDatabaseExtendedAuditingPolicyIDFromParent returns a DatabaseExtendedAuditingPolicyId for the DatabaseExtendedAuditingPolicy within the specified Database
+func NewDatabaseExtendedAuditingPolicyIDFromParent(parent DatabaseId, extendedAuditingSettingName string) DatabaseExtendedAuditingPolicyId {
+	return DatabaseExtendedAuditingPolicyId{
+		SubscriptionId:              parent.SubscriptionId,
+		ResourceGroup:               parent.ResourceGroup,
+		ServerName:                  parent.ServerName,
+		DatabaseName:                parent.Name,
+		ExtendedAuditingSettingName: extendedAuditingSettingName,
+	}
+}
+
 func (id DatabaseExtendedAuditingPolicyId) String() string {
 	segments := []string{
 		fmt.Sprintf("Extended Auditing Setting Name %q", id.ExtendedAuditingSettingName),
@@ -43,38 +54,43 @@ func (id DatabaseExtendedAuditingPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName, id.ExtendedAuditingSettingName)
 }
 
+// Matches returns whether the specified Resource ID refers to this DatabaseExtendedAuditingPolicy
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DatabaseExtendedAuditingPolicyId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DatabaseExtendedAuditingPolicy ID
+func (id DatabaseExtendedAuditingPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql"),
+		resourceid.StaticSegment("staticServers", "servers", false),
+		resourceid.UserSpecifiedSegment("serverName"),
+		resourceid.StaticSegment("staticDatabases", "databases", false),
+		resourceid.UserSpecifiedSegment("databaseName"),
+		resourceid.StaticSegment("staticExtendedAuditingSettings", "extendedAuditingSettings", false),
+		resourceid.UserSpecifiedSegment("extendedAuditingSettingName"),
+	}
+}
+
 // DatabaseExtendedAuditingPolicyID parses a DatabaseExtendedAuditingPolicy ID into an DatabaseExtendedAuditingPolicyId struct
 func DatabaseExtendedAuditingPolicyID(input string) (*DatabaseExtendedAuditingPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DatabaseExtendedAuditingPolicyId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DatabaseExtendedAuditingPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.ExtendedAuditingSettingName, err = id.PopSegment("extendedAuditingSettings"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:              values["subscriptionId"],
+		ResourceGroup:               values["resourceGroup"],
+		ServerName:                  values["serverName"],
+		DatabaseName:                values["databaseName"],
+		ExtendedAuditingSettingName: values["extendedAuditingSettingName"],
 	}
 
 	return &resourceId, nil
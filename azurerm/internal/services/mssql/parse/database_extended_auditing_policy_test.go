@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DatabaseExtendedAuditingPolicyId{}
+var _ resourceid.SegmentedID = DatabaseExtendedAuditingPolicyId{}
 
 func TestDatabaseExtendedAuditingPolicyIDFormatter(t *testing.T) {
 	actual := NewDatabaseExtendedAuditingPolicyID("12345678-1234-9876-4563-123456789012", "group1", "server1", "database1", "default").ID()
@@ -18,6 +19,52 @@ func TestDatabaseExtendedAuditingPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDatabaseExtendedAuditingPolicyIDFromParent(t *testing.T) {
+	parent := NewDatabaseID("12345678-1234-9876-4563-123456789012", "group1", "server1", "database1")
+	actual := NewDatabaseExtendedAuditingPolicyIDFromParent(parent, "default").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1/extendedAuditingSettings/default"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestDatabaseExtendedAuditingPolicyIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1/extendedAuditingSettings/default",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.SQL/SERVERS/server1/DATABASES/database1/EXTENDEDAUDITINGSETTINGS/default",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1/extendedAuditingSettings/defaultother",
+			Expected: false,
+		},
+	}
+
+	id := NewDatabaseExtendedAuditingPolicyID("12345678-1234-9876-4563-123456789012", "group1", "server1", "database1", "default")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDatabaseExtendedAuditingPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type DatabaseVulnerabilityAssessmentRuleBaselineId struct {
@@ -49,44 +49,49 @@ func (id DatabaseVulnerabilityAssessmentRuleBaselineId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName, id.VulnerabilityAssessmentName, id.RuleName, id.BaselineName)
 }
 
+// Matches returns whether the specified Resource ID refers to this DatabaseVulnerabilityAssessmentRuleBaseline
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id DatabaseVulnerabilityAssessmentRuleBaselineId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this DatabaseVulnerabilityAssessmentRuleBaseline ID
+func (id DatabaseVulnerabilityAssessmentRuleBaselineId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql"),
+		resourceid.StaticSegment("staticServers", "servers", false),
+		resourceid.UserSpecifiedSegment("serverName"),
+		resourceid.StaticSegment("staticDatabases", "databases", false),
+		resourceid.UserSpecifiedSegment("databaseName"),
+		resourceid.StaticSegment("staticVulnerabilityAssessments", "vulnerabilityAssessments", false),
+		resourceid.UserSpecifiedSegment("vulnerabilityAssessmentName"),
+		resourceid.StaticSegment("staticRules", "rules", false),
+		resourceid.UserSpecifiedSegment("ruleName"),
+		resourceid.StaticSegment("staticBaselines", "baselines", false),
+		resourceid.UserSpecifiedSegment("baselineName"),
+	}
+}
+
 // DatabaseVulnerabilityAssessmentRuleBaselineID parses a DatabaseVulnerabilityAssessmentRuleBaseline ID into an DatabaseVulnerabilityAssessmentRuleBaselineId struct
 func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulnerabilityAssessmentRuleBaselineId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, DatabaseVulnerabilityAssessmentRuleBaselineId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := DatabaseVulnerabilityAssessmentRuleBaselineId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
-		return nil, err
-	}
-	if resourceId.VulnerabilityAssessmentName, err = id.PopSegment("vulnerabilityAssessments"); err != nil {
-		return nil, err
-	}
-	if resourceId.RuleName, err = id.PopSegment("rules"); err != nil {
-		return nil, err
-	}
-	if resourceId.BaselineName, err = id.PopSegment("baselines"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:              values["subscriptionId"],
+		ResourceGroup:               values["resourceGroup"],
+		ServerName:                  values["serverName"],
+		DatabaseName:                values["databaseName"],
+		VulnerabilityAssessmentName: values["vulnerabilityAssessmentName"],
+		RuleName:                    values["ruleName"],
+		BaselineName:                values["baselineName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DatabaseVulnerabilityAssessmentRuleBaselineId{}
+var _ resourceid.SegmentedID = DatabaseVulnerabilityAssessmentRuleBaselineId{}
 
 func TestDatabaseVulnerabilityAssessmentRuleBaselineIDFormatter(t *testing.T) {
 	actual := NewDatabaseVulnerabilityAssessmentRuleBaselineID("12345678-1234-9876-4563-123456789012", "group1", "server1", "database1", "default", "rule1", "baseline1").ID()
@@ -18,6 +19,43 @@ func TestDatabaseVulnerabilityAssessmentRuleBaselineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDatabaseVulnerabilityAssessmentRuleBaselineIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1/vulnerabilityAssessments/default/rules/rule1/baselines/baseline1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.SQL/SERVERS/server1/DATABASES/database1/VULNERABILITYASSESSMENTS/default/RULES/rule1/BASELINES/baseline1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/databases/database1/vulnerabilityAssessments/default/rules/rule1/baselines/baseline1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDatabaseVulnerabilityAssessmentRuleBaselineID("12345678-1234-9876-4563-123456789012", "group1", "server1", "database1", "default", "rule1", "baseline1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDatabaseVulnerabilityAssessmentRuleBaselineID(t *testing.T) {
 	testData := []struct {
 		Input    string
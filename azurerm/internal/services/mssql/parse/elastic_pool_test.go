@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ElasticPoolId{}
+var _ resourceid.SegmentedID = ElasticPoolId{}
 
 func TestElasticPoolIDFormatter(t *testing.T) {
 	actual := NewElasticPoolID("12345678-1234-9876-4563-123456789012", "group1", "server1", "pool1").ID()
@@ -18,6 +19,52 @@ func TestElasticPoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestElasticPoolIDFromParent(t *testing.T) {
+	parent := NewServerID("12345678-1234-9876-4563-123456789012", "group1", "server1")
+	actual := NewElasticPoolIDFromParent(parent, "pool1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/elasticPools/pool1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestElasticPoolIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/elasticPools/pool1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.SQL/SERVERS/server1/ELASTICPOOLS/pool1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/elasticPools/pool1other",
+			Expected: false,
+		},
+	}
+
+	id := NewElasticPoolID("12345678-1234-9876-4563-123456789012", "group1", "server1", "pool1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestElasticPoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RecoverableDatabaseId struct {
@@ -25,6 +25,16 @@ func NewRecoverableDatabaseID(subscriptionId, resourceGroup, serverName, name st
 	}
 }
 
+// NewRecoverableDatabaseIDFromParent returns a RecoverableDatabaseId for the RecoverableDatabase within the specified Server
+func NewRecoverableDatabaseIDFromParent(parent ServerId, name string) RecoverableDatabaseId {
+	return RecoverableDatabaseId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServerName:     parent.Name,
+		Name:           name,
+	}
+}
+
 func (id RecoverableDatabaseId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id RecoverableDatabaseId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this RecoverableDatabase
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id RecoverableDatabaseId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this RecoverableDatabase ID
+func (id RecoverableDatabaseId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql"),
+		resourceid.StaticSegment("staticServers", "servers", false),
+		resourceid.UserSpecifiedSegment("serverName"),
+		resourceid.StaticSegment("staticRecoverabledatabases", "recoverabledatabases", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // RecoverableDatabaseID parses a RecoverableDatabase ID into an RecoverableDatabaseId struct
 func RecoverableDatabaseID(input string) (*RecoverableDatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, RecoverableDatabaseId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := RecoverableDatabaseId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("recoverabledatabases"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServerName:     values["serverName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
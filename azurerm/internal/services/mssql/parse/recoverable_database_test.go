@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = RecoverableDatabaseId{}
+var _ resourceid.SegmentedID = RecoverableDatabaseId{}
 
 func TestRecoverableDatabaseIDFormatter(t *testing.T) {
 	actual := NewRecoverableDatabaseID("12345678-1234-9876-4563-123456789012", "group1", "server1", "database1").ID()
@@ -18,6 +19,52 @@ func TestRecoverableDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRecoverableDatabaseIDFromParent(t *testing.T) {
+	parent := NewServerID("12345678-1234-9876-4563-123456789012", "group1", "server1")
+	actual := NewRecoverableDatabaseIDFromParent(parent, "database1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/recoverabledatabases/database1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRecoverableDatabaseIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/recoverabledatabases/database1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.SQL/SERVERS/server1/RECOVERABLEDATABASES/database1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/recoverabledatabases/database1other",
+			Expected: false,
+		},
+	}
+
+	id := NewRecoverableDatabaseID("12345678-1234-9876-4563-123456789012", "group1", "server1", "database1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestRecoverableDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerExtendedAuditingPolicyId struct {
@@ -25,6 +25,16 @@ func NewServerExtendedAuditingPolicyID(subscriptionId, resourceGroup, serverName
 	}
 }
 
+// NewServerExtendedAuditingPolicyIDFromParent returns a ServerExtendedAuditingPolicyId for the ServerExtendedAuditingPolicy within the specified Server
+func NewServerExtendedAuditingPolicyIDFromParent(parent ServerId, extendedAuditingSettingName string) ServerExtendedAuditingPolicyId {
+	return ServerExtendedAuditingPolicyId{
+		SubscriptionId:              parent.SubscriptionId,
+		ResourceGroup:               parent.ResourceGroup,
+		ServerName:                  parent.Name,
+		ExtendedAuditingSettingName: extendedAuditingSettingName,
+	}
+}
+
 func (id ServerExtendedAuditingPolicyId) String() string {
 	segments := []string{
 		fmt.Sprintf("Extended Auditing Setting Name %q", id.ExtendedAuditingSettingName),
@@ -40,35 +50,40 @@ func (id ServerExtendedAuditingPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.ExtendedAuditingSettingName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ServerExtendedAuditingPolicy
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ServerExtendedAuditingPolicyId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ServerExtendedAuditingPolicy ID
+func (id ServerExtendedAuditingPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql"),
+		resourceid.StaticSegment("staticServers", "servers", false),
+		resourceid.UserSpecifiedSegment("serverName"),
+		resourceid.StaticSegment("staticExtendedAuditingSettings", "extendedAuditingSettings", false),
+		resourceid.UserSpecifiedSegment("extendedAuditingSettingName"),
+	}
+}
+
 // ServerExtendedAuditingPolicyID parses a ServerExtendedAuditingPolicy ID into an ServerExtendedAuditingPolicyId struct
 func ServerExtendedAuditingPolicyID(input string) (*ServerExtendedAuditingPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ServerExtendedAuditingPolicyId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ServerExtendedAuditingPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.ExtendedAuditingSettingName, err = id.PopSegment("extendedAuditingSettings"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:              values["subscriptionId"],
+		ResourceGroup:               values["resourceGroup"],
+		ServerName:                  values["serverName"],
+		ExtendedAuditingSettingName: values["extendedAuditingSettingName"],
 	}
 
 	return &resourceId, nil
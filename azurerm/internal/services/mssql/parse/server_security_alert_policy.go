@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerSecurityAlertPolicyId struct {
@@ -25,6 +25,16 @@ func NewServerSecurityAlertPolicyID(subscriptionId, resourceGroup, serverName, s
 	}
 }
 
+// NewServerSecurityAlertPolicyIDFromParent returns a ServerSecurityAlertPolicyId for the ServerSecurityAlertPolicy within the specified Server
+func NewServerSecurityAlertPolicyIDFromParent(parent ServerId, securityAlertPolicyName string) ServerSecurityAlertPolicyId {
+	return ServerSecurityAlertPolicyId{
+		SubscriptionId:          parent.SubscriptionId,
+		ResourceGroup:           parent.ResourceGroup,
+		ServerName:              parent.Name,
+		SecurityAlertPolicyName: securityAlertPolicyName,
+	}
+}
+
 func (id ServerSecurityAlertPolicyId) String() string {
 	segments := []string{
 		fmt.Sprintf("Security Alert Policy Name %q", id.SecurityAlertPolicyName),
@@ -40,35 +50,40 @@ func (id ServerSecurityAlertPolicyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.SecurityAlertPolicyName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ServerSecurityAlertPolicy
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ServerSecurityAlertPolicyId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ServerSecurityAlertPolicy ID
+func (id ServerSecurityAlertPolicyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql"),
+		resourceid.StaticSegment("staticServers", "servers", false),
+		resourceid.UserSpecifiedSegment("serverName"),
+		resourceid.StaticSegment("staticSecurityAlertPolicies", "securityAlertPolicies", false),
+		resourceid.UserSpecifiedSegment("securityAlertPolicyName"),
+	}
+}
+
 // ServerSecurityAlertPolicyID parses a ServerSecurityAlertPolicy ID into an ServerSecurityAlertPolicyId struct
 func ServerSecurityAlertPolicyID(input string) (*ServerSecurityAlertPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ServerSecurityAlertPolicyId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ServerSecurityAlertPolicyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.SecurityAlertPolicyName, err = id.PopSegment("securityAlertPolicies"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          values["subscriptionId"],
+		ResourceGroup:           values["resourceGroup"],
+		ServerName:              values["serverName"],
+		SecurityAlertPolicyName: values["securityAlertPolicyName"],
 	}
 
 	return &resourceId, nil
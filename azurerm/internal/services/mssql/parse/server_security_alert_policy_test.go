@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ServerSecurityAlertPolicyId{}
+var _ resourceid.SegmentedID = ServerSecurityAlertPolicyId{}
 
 func TestServerSecurityAlertPolicyIDFormatter(t *testing.T) {
 	actual := NewServerSecurityAlertPolicyID("12345678-1234-9876-4563-123456789012", "group1", "server1", "Default").ID()
@@ -18,6 +19,52 @@ func TestServerSecurityAlertPolicyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServerSecurityAlertPolicyIDFromParent(t *testing.T) {
+	parent := NewServerID("12345678-1234-9876-4563-123456789012", "group1", "server1")
+	actual := NewServerSecurityAlertPolicyIDFromParent(parent, "Default").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/securityAlertPolicies/Default"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestServerSecurityAlertPolicyIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/securityAlertPolicies/Default",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.SQL/SERVERS/server1/SECURITYALERTPOLICIES/Default",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/securityAlertPolicies/Defaultother",
+			Expected: false,
+		},
+	}
+
+	id := NewServerSecurityAlertPolicyID("12345678-1234-9876-4563-123456789012", "group1", "server1", "Default")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestServerSecurityAlertPolicyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerVulnerabilityAssessmentId struct {
@@ -25,6 +25,16 @@ func NewServerVulnerabilityAssessmentID(subscriptionId, resourceGroup, serverNam
 	}
 }
 
+// NewServerVulnerabilityAssessmentIDFromParent returns a ServerVulnerabilityAssessmentId for the ServerVulnerabilityAssessment within the specified Server
+func NewServerVulnerabilityAssessmentIDFromParent(parent ServerId, vulnerabilityAssessmentName string) ServerVulnerabilityAssessmentId {
+	return ServerVulnerabilityAssessmentId{
+		SubscriptionId:              parent.SubscriptionId,
+		ResourceGroup:               parent.ResourceGroup,
+		ServerName:                  parent.Name,
+		VulnerabilityAssessmentName: vulnerabilityAssessmentName,
+	}
+}
+
 func (id ServerVulnerabilityAssessmentId) String() string {
 	segments := []string{
 		fmt.Sprintf("Vulnerability Assessment Name %q", id.VulnerabilityAssessmentName),
@@ -40,35 +50,40 @@ func (id ServerVulnerabilityAssessmentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.VulnerabilityAssessmentName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ServerVulnerabilityAssessment
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ServerVulnerabilityAssessmentId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ServerVulnerabilityAssessment ID
+func (id ServerVulnerabilityAssessmentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftSql", "Microsoft.Sql"),
+		resourceid.StaticSegment("staticServers", "servers", false),
+		resourceid.UserSpecifiedSegment("serverName"),
+		resourceid.StaticSegment("staticVulnerabilityAssessments", "vulnerabilityAssessments", false),
+		resourceid.UserSpecifiedSegment("vulnerabilityAssessmentName"),
+	}
+}
+
 // ServerVulnerabilityAssessmentID parses a ServerVulnerabilityAssessment ID into an ServerVulnerabilityAssessmentId struct
 func ServerVulnerabilityAssessmentID(input string) (*ServerVulnerabilityAssessmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ServerVulnerabilityAssessmentId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ServerVulnerabilityAssessmentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.VulnerabilityAssessmentName, err = id.PopSegment("vulnerabilityAssessments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:              values["subscriptionId"],
+		ResourceGroup:               values["resourceGroup"],
+		ServerName:                  values["serverName"],
+		VulnerabilityAssessmentName: values["vulnerabilityAssessmentName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ServerVulnerabilityAssessmentId{}
+var _ resourceid.SegmentedID = ServerVulnerabilityAssessmentId{}
 
 func TestServerVulnerabilityAssessmentIDFormatter(t *testing.T) {
 	actual := NewServerVulnerabilityAssessmentID("12345678-1234-9876-4563-123456789012", "group1", "server1", "default").ID()
@@ -18,6 +19,52 @@ func TestServerVulnerabilityAssessmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestServerVulnerabilityAssessmentIDFromParent(t *testing.T) {
+	parent := NewServerID("12345678-1234-9876-4563-123456789012", "group1", "server1")
+	actual := NewServerVulnerabilityAssessmentIDFromParent(parent, "default").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/vulnerabilityAssessments/default"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestServerVulnerabilityAssessmentIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/vulnerabilityAssessments/default",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.SQL/SERVERS/server1/VULNERABILITYASSESSMENTS/default",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Sql/servers/server1/vulnerabilityAssessments/defaultother",
+			Expected: false,
+		},
+	}
+
+	id := NewServerVulnerabilityAssessmentID("12345678-1234-9876-4563-123456789012", "group1", "server1", "default")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestServerVulnerabilityAssessmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
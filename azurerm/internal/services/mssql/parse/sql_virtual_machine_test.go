@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SqlVirtualMachineId{}
+var _ resourceid.SegmentedID = SqlVirtualMachineId{}
 
 func TestSqlVirtualMachineIDFormatter(t *testing.T) {
 	actual := NewSqlVirtualMachineID("12345678-1234-9876-4563-123456789012", "group1", "virtualMachine1").ID()
@@ -18,6 +19,43 @@ func TestSqlVirtualMachineIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlVirtualMachineIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/virtualMachine1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.SQLVIRTUALMACHINE/SQLVIRTUALMACHINES/virtualMachine1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.SqlVirtualMachine/sqlVirtualMachines/virtualMachine1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSqlVirtualMachineID("12345678-1234-9876-4563-123456789012", "group1", "virtualMachine1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSqlVirtualMachineID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,77 @@
+package mssql
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Sql/servers/databases",
+			TerraformResourceType: "azurerm_mssql_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabaseID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/databases/extendedAuditingSettings",
+			TerraformResourceType: "azurerm_mssql_database_extended_auditing_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabaseExtendedAuditingPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/databases/vulnerabilityAssessments/rules/baselines",
+			TerraformResourceType: "azurerm_mssql_database_vulnerability_assessment_rule_baseline",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabaseVulnerabilityAssessmentRuleBaselineID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/elasticPools",
+			TerraformResourceType: "azurerm_mssql_elasticpool",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ElasticPoolID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers",
+			TerraformResourceType: "azurerm_mssql_server",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServerID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/extendedAuditingSettings",
+			TerraformResourceType: "azurerm_mssql_server_extended_auditing_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServerExtendedAuditingPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/securityAlertPolicies",
+			TerraformResourceType: "azurerm_mssql_server_security_alert_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServerSecurityAlertPolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/vulnerabilityAssessments",
+			TerraformResourceType: "azurerm_mssql_server_vulnerability_assessment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServerVulnerabilityAssessmentID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.SqlVirtualMachine/sqlVirtualMachines",
+			TerraformResourceType: "azurerm_mssql_virtual_machine",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SqlVirtualMachineID(input)
+			},
+		},
+	}
+}
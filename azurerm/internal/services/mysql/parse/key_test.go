@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = KeyId{}
+var _ resourceid.SegmentedID = KeyId{}
 
 func TestKeyIDFormatter(t *testing.T) {
 	actual := NewKeyID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1", "key1").ID()
@@ -18,6 +19,52 @@ func TestKeyIDFormatter(t *testing.T) {
 	}
 }
 
+func TestKeyIDFromParent(t *testing.T) {
+	parent := NewServerID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1")
+	actual := NewKeyIDFromParent(parent, "key1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/keys/key1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestKeyIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/keys/key1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DBFORMYSQL/SERVERS/server1/KEYS/key1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforMySQL/servers/server1/keys/key1other",
+			Expected: false,
+		},
+	}
+
+	id := NewKeyID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1", "key1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestKeyID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,42 @@
+package mysql
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mysql/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.DBforMySQL/servers/configurations",
+			TerraformResourceType: "azurerm_mysql_configuration",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ConfigurationID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DBforMySQL/servers",
+			TerraformResourceType: "azurerm_mysql_server",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServerID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DBforMySQL/servers/keys",
+			TerraformResourceType: "azurerm_mysql_server_key",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.KeyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DBforMySQL/servers/virtualNetworkRules",
+			TerraformResourceType: "azurerm_mysql_virtual_network_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualNetworkRuleID(input)
+			},
+		},
+	}
+}
@@ -110,7 +110,7 @@ func resourceNetAppSnapshotCreate(d *schema.ResourceData, meta interface{}) erro
 
 	location := azure.NormalizeLocation(d.Get("location").(string))
 
-	if tags.Expand(d.Get("tags").(map[string]interface{})) != nil {
+	if tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})) != nil {
 		log.Printf("[WARN] Tags are not supported on snaphots anymore, ignoring values.")
 	}
 
@@ -173,7 +173,7 @@ func resourceNetAppSnapshotRead(d *schema.ResourceData, meta interface{}) error
 func resourceNetAppSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
 	// Snapshot resource in Azure changed its type to proxied resource, therefore
 	// tags are not supported anymore, ignoring any tags.
-	if tags.Expand(d.Get("tags").(map[string]interface{})) == nil {
+	if tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})) == nil {
 		log.Printf("[WARN] Tags are not supported on snaphots anymore, no update will happen in a snapshot at this time.")
 	}
 
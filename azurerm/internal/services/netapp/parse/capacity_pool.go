@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type CapacityPoolId struct {
@@ -25,6 +25,16 @@ func NewCapacityPoolID(subscriptionId, resourceGroup, netAppAccountName, name st
 	}
 }
 
+// NewCapacityPoolIDFromParent returns a CapacityPoolId for the CapacityPool within the specified Account
+func NewCapacityPoolIDFromParent(parent AccountId, name string) CapacityPoolId {
+	return CapacityPoolId{
+		SubscriptionId:    parent.SubscriptionId,
+		ResourceGroup:     parent.ResourceGroup,
+		NetAppAccountName: parent.NetAppAccountName,
+		Name:              name,
+	}
+}
+
 func (id CapacityPoolId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id CapacityPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetAppAccountName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this CapacityPool
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id CapacityPoolId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this CapacityPool ID
+func (id CapacityPoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetApp", "Microsoft.NetApp"),
+		resourceid.StaticSegment("staticNetAppAccounts", "netAppAccounts", false),
+		resourceid.UserSpecifiedSegment("netAppAccountName"),
+		resourceid.StaticSegment("staticCapacityPools", "capacityPools", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // CapacityPoolID parses a CapacityPool ID into an CapacityPoolId struct
 func CapacityPoolID(input string) (*CapacityPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, CapacityPoolId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := CapacityPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NetAppAccountName, err = id.PopSegment("netAppAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("capacityPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    values["subscriptionId"],
+		ResourceGroup:     values["resourceGroup"],
+		NetAppAccountName: values["netAppAccountName"],
+		Name:              values["name"],
 	}
 
 	return &resourceId, nil
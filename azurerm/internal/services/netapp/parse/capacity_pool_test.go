@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = CapacityPoolId{}
+var _ resourceid.SegmentedID = CapacityPoolId{}
 
 func TestCapacityPoolIDFormatter(t *testing.T) {
 	actual := NewCapacityPoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "pool1").ID()
@@ -18,6 +19,52 @@ func TestCapacityPoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCapacityPoolIDFromParent(t *testing.T) {
+	parent := NewAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1")
+	actual := NewCapacityPoolIDFromParent(parent, "pool1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NetApp/netAppAccounts/account1/capacityPools/pool1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestCapacityPoolIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NetApp/netAppAccounts/account1/capacityPools/pool1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETAPP/NETAPPACCOUNTS/account1/CAPACITYPOOLS/pool1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NetApp/netAppAccounts/account1/capacityPools/pool1other",
+			Expected: false,
+		},
+	}
+
+	id := NewCapacityPoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "pool1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestCapacityPoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SnapshotId struct {
@@ -29,6 +29,18 @@ func NewSnapshotID(subscriptionId, resourceGroup, netAppAccountName, capacityPoo
 	}
 }
 
+// NewSnapshotIDFromParent returns a SnapshotId for the Snapshot within the specified Volume
+func NewSnapshotIDFromParent(parent VolumeId, name string) SnapshotId {
+	return SnapshotId{
+		SubscriptionId:    parent.SubscriptionId,
+		ResourceGroup:     parent.ResourceGroup,
+		NetAppAccountName: parent.NetAppAccountName,
+		CapacityPoolName:  parent.CapacityPoolName,
+		VolumeName:        parent.Name,
+		Name:              name,
+	}
+}
+
 func (id SnapshotId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -46,41 +58,46 @@ func (id SnapshotId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.VolumeName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Snapshot
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SnapshotId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Snapshot ID
+func (id SnapshotId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetApp", "Microsoft.NetApp"),
+		resourceid.StaticSegment("staticNetAppAccounts", "netAppAccounts", false),
+		resourceid.UserSpecifiedSegment("netAppAccountName"),
+		resourceid.StaticSegment("staticCapacityPools", "capacityPools", false),
+		resourceid.UserSpecifiedSegment("capacityPoolName"),
+		resourceid.StaticSegment("staticVolumes", "volumes", false),
+		resourceid.UserSpecifiedSegment("volumeName"),
+		resourceid.StaticSegment("staticSnapshots", "snapshots", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // SnapshotID parses a Snapshot ID into an SnapshotId struct
 func SnapshotID(input string) (*SnapshotId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SnapshotId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SnapshotId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NetAppAccountName, err = id.PopSegment("netAppAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.CapacityPoolName, err = id.PopSegment("capacityPools"); err != nil {
-		return nil, err
-	}
-	if resourceId.VolumeName, err = id.PopSegment("volumes"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("snapshots"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    values["subscriptionId"],
+		ResourceGroup:     values["resourceGroup"],
+		NetAppAccountName: values["netAppAccountName"],
+		CapacityPoolName:  values["capacityPoolName"],
+		VolumeName:        values["volumeName"],
+		Name:              values["name"],
 	}
 
 	return &resourceId, nil
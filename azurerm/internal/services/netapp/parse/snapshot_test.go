@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SnapshotId{}
+var _ resourceid.SegmentedID = SnapshotId{}
 
 func TestSnapshotIDFormatter(t *testing.T) {
 	actual := NewSnapshotID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "pool1", "volume1", "snapshot1").ID()
@@ -18,6 +19,52 @@ func TestSnapshotIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSnapshotIDFromParent(t *testing.T) {
+	parent := NewVolumeID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "pool1", "volume1")
+	actual := NewSnapshotIDFromParent(parent, "snapshot1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NetApp/netAppAccounts/account1/capacityPools/pool1/volumes/volume1/snapshots/snapshot1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSnapshotIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NetApp/netAppAccounts/account1/capacityPools/pool1/volumes/volume1/snapshots/snapshot1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETAPP/NETAPPACCOUNTS/account1/CAPACITYPOOLS/pool1/VOLUMES/volume1/SNAPSHOTS/snapshot1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NetApp/netAppAccounts/account1/capacityPools/pool1/volumes/volume1/snapshots/snapshot1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSnapshotID("12345678-1234-9876-4563-123456789012", "resGroup1", "account1", "pool1", "volume1", "snapshot1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSnapshotID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VolumeId struct {
@@ -27,6 +27,17 @@ func NewVolumeID(subscriptionId, resourceGroup, netAppAccountName, capacityPoolN
 	}
 }
 
+// NewVolumeIDFromParent returns a VolumeId for the Volume within the specified CapacityPool
+func NewVolumeIDFromParent(parent CapacityPoolId, name string) VolumeId {
+	return VolumeId{
+		SubscriptionId:    parent.SubscriptionId,
+		ResourceGroup:     parent.ResourceGroup,
+		NetAppAccountName: parent.NetAppAccountName,
+		CapacityPoolName:  parent.Name,
+		Name:              name,
+	}
+}
+
 func (id VolumeId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -43,38 +54,43 @@ func (id VolumeId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetAppAccountName, id.CapacityPoolName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Volume
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id VolumeId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Volume ID
+func (id VolumeId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetApp", "Microsoft.NetApp"),
+		resourceid.StaticSegment("staticNetAppAccounts", "netAppAccounts", false),
+		resourceid.UserSpecifiedSegment("netAppAccountName"),
+		resourceid.StaticSegment("staticCapacityPools", "capacityPools", false),
+		resourceid.UserSpecifiedSegment("capacityPoolName"),
+		resourceid.StaticSegment("staticVolumes", "volumes", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // VolumeID parses a Volume ID into an VolumeId struct
 func VolumeID(input string) (*VolumeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, VolumeId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VolumeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NetAppAccountName, err = id.PopSegment("netAppAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.CapacityPoolName, err = id.PopSegment("capacityPools"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("volumes"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    values["subscriptionId"],
+		ResourceGroup:     values["resourceGroup"],
+		NetAppAccountName: values["netAppAccountName"],
+		CapacityPoolName:  values["capacityPoolName"],
+		Name:              values["name"],
 	}
 
 	return &resourceId, nil
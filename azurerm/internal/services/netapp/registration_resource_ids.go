@@ -0,0 +1,42 @@
+package netapp
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/netapp/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.NetApp/netAppAccounts",
+			TerraformResourceType: "azurerm_netapp_account",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AccountID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.NetApp/netAppAccounts/capacityPools",
+			TerraformResourceType: "azurerm_netapp_pool",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CapacityPoolID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.NetApp/netAppAccounts/capacityPools/volumes/snapshots",
+			TerraformResourceType: "azurerm_netapp_snapshot",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SnapshotID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.NetApp/netAppAccounts/capacityPools/volumes",
+			TerraformResourceType: "azurerm_netapp_volume",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VolumeID(input)
+			},
+		},
+	}
+}
@@ -69,16 +69,22 @@ func dataSourceApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenApplicationGatewayDataSourceIdentity(input *network.ManagedServiceIdentity) *identity.ExpandedConfig {
 	var config *identity.ExpandedConfig
 	if input != nil {
+		identityIds := make([]string, 0)
+		for key := range input.UserAssignedIdentities {
+			identityIds = append(identityIds, key)
+		}
+
 		config = &identity.ExpandedConfig{
-			Type:        string(input.Type),
-			PrincipalId: input.PrincipalID,
-			TenantId:    input.TenantID,
+			Type:                    string(input.Type),
+			PrincipalId:             input.PrincipalID,
+			TenantId:                input.TenantID,
+			UserAssignedIdentityIds: &identityIds,
 		}
 	}
 	return config
@@ -60,5 +60,5 @@ func dataSourceApplicationSecurityGroupRead(d *schema.ResourceData, meta interfa
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -103,7 +103,7 @@ func resourceExpressRouteGatewayCreateUpdate(d *schema.ResourceData, meta interf
 				ID: &virtualHubId,
 			},
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
@@ -168,7 +168,7 @@ func resourceExpressRouteGatewayRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("scale_units", scaleUnits)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceExpressRouteGatewayDelete(d *schema.ResourceData, meta interface{}) error {
@@ -124,7 +124,7 @@ func resourceLocalNetworkGatewayCreateUpdate(d *schema.ResourceData, meta interf
 			LocalNetworkAddressSpace: &network.AddressSpace{},
 			BgpSettings:              expandLocalNetworkGatewayBGPSettings(d),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	ipAddress := d.Get("gateway_address").(string)
@@ -210,7 +210,7 @@ func resourceLocalNetworkGatewayRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceLocalNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
@@ -123,5 +123,5 @@ func dataSourceNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error setting `zones`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
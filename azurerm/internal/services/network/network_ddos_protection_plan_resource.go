@@ -99,7 +99,7 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *schema.ResourceData, meta
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
@@ -161,7 +161,7 @@ func resourceNetworkDDoSProtectionPlanRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	return tags.FlattenAndSet(d, plan.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, plan.Tags)
 }
 
 func resourceNetworkDDoSProtectionPlanDelete(d *schema.ResourceData, meta interface{}) error {
@@ -253,5 +253,5 @@ func dataSourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("enable_accelerated_networking", props.EnableAcceleratedNetworking)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
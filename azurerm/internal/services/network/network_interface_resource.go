@@ -245,7 +245,9 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx); err != nil {
+		return fmt.Errorf("locking the Subnets and Virtual Networks used by %s: %+v", id, err)
+	}
 	defer lockingDetails.unlock()
 
 	if len(*ipConfigs) > 0 {
@@ -256,7 +258,7 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 		Name:                      utils.String(id.Name),
 		Location:                  utils.String(location),
 		InterfacePropertiesFormat: &properties,
-		Tags:                      tags.Expand(t),
+		Tags:                      tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, iface)
@@ -340,7 +342,9 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 			return fmt.Errorf("Error determining locking details: %+v", err)
 		}
 
-		lockingDetails.lock()
+		if err := lockingDetails.lock(ctx); err != nil {
+			return fmt.Errorf("locking the Subnets and Virtual Networks used by %s: %+v", *id, err)
+		}
 		defer lockingDetails.unlock()
 
 		// then map the fields managed in other resources back
@@ -351,9 +355,9 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 		update.InterfacePropertiesFormat.IPConfigurations = existing.InterfacePropertiesFormat.IPConfigurations
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		update.Tags = tags.Expand(tagsRaw)
+		update.Tags = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, tagsRaw)
 	} else {
 		update.Tags = existing.Tags
 	}
@@ -464,7 +468,7 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -501,7 +505,9 @@ func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
+	if err := lockingDetails.lock(ctx); err != nil {
+		return fmt.Errorf("locking the Subnets and Virtual Networks used by %s: %+v", *id, err)
+	}
 	defer lockingDetails.unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
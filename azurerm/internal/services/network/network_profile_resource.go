@@ -128,15 +128,12 @@ func resourceNetworkProfileCreateUpdate(d *schema.ResourceData, meta interface{}
 	locks.ByName(name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	lockVirtualNetworksAndSubnets(vnetsToLock, subnetsToLock)
+	defer unlockVirtualNetworksAndSubnets(vnetsToLock, subnetsToLock)
 
 	parameters := network.Profile{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		ProfilePropertiesFormat: &network.ProfilePropertiesFormat{
 			ContainerNetworkInterfaceConfigurations: expandNetworkProfileContainerNetworkInterface(d),
 		},
@@ -201,7 +198,7 @@ func resourceNetworkProfileRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	return tags.FlattenAndSet(d, profile.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, profile.Tags)
 }
 
 func resourceNetworkProfileDelete(d *schema.ResourceData, meta interface{}) error {
@@ -235,11 +232,8 @@ func resourceNetworkProfileDelete(d *schema.ResourceData, meta interface{}) erro
 	locks.ByName(name, azureNetworkProfileResourceName)
 	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
 
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	lockVirtualNetworksAndSubnets(vnetsToLock, subnetsToLock)
+	defer unlockVirtualNetworksAndSubnets(vnetsToLock, subnetsToLock)
 
 	if _, err = client.Delete(ctx, resourceGroup, name); err != nil {
 		return fmt.Errorf("Error deleting Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -76,7 +76,7 @@ func resourceNetworkWatcherCreateUpdate(d *schema.ResourceData, meta interface{}
 
 	watcher := network.Watcher{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, resourceGroup, name, watcher); err != nil {
@@ -121,7 +121,7 @@ func resourceNetworkWatcherRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceNetworkWatcherDelete(d *schema.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = BastionHostId{}
+var _ resourceid.SegmentedID = BastionHostId{}
 
 func TestBastionHostIDFormatter(t *testing.T) {
 	actual := NewBastionHostID("12345678-1234-9876-4563-123456789012", "resGroup1", "bastionHost1").ID()
@@ -18,6 +19,43 @@ func TestBastionHostIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBastionHostIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/bastionHosts/bastionHost1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/BASTIONHOSTS/bastionHost1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/bastionHosts/bastionHost1other",
+			Expected: false,
+		},
+	}
+
+	id := NewBastionHostID("12345678-1234-9876-4563-123456789012", "resGroup1", "bastionHost1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestBastionHostID(t *testing.T) {
 	testData := []struct {
 		Input    string
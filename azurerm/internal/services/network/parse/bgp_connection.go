@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type BgpConnectionId struct {
@@ -25,6 +25,16 @@ func NewBgpConnectionID(subscriptionId, resourceGroup, virtualHubName, name stri
 	}
 }
 
+// NewBgpConnectionIDFromParent returns a BgpConnectionId for the BgpConnection within the specified VirtualHub
+func NewBgpConnectionIDFromParent(parent VirtualHubId, name string) BgpConnectionId {
+	return BgpConnectionId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		VirtualHubName: parent.Name,
+		Name:           name,
+	}
+}
+
 func (id BgpConnectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id BgpConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this BgpConnection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id BgpConnectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this BgpConnection ID
+func (id BgpConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticVirtualHubs", "virtualHubs", false),
+		resourceid.UserSpecifiedSegment("virtualHubName"),
+		resourceid.StaticSegment("staticBgpConnections", "bgpConnections", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // BgpConnectionID parses a BgpConnection ID into an BgpConnectionId struct
 func BgpConnectionID(input string) (*BgpConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, BgpConnectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := BgpConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("bgpConnections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		VirtualHubName: values["virtualHubName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
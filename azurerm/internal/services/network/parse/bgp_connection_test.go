@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = BgpConnectionId{}
+var _ resourceid.SegmentedID = BgpConnectionId{}
 
 func TestBgpConnectionIDFormatter(t *testing.T) {
 	actual := NewBgpConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1", "connection1").ID()
@@ -18,6 +19,52 @@ func TestBgpConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestBgpConnectionIDFromParent(t *testing.T) {
+	parent := NewVirtualHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1")
+	actual := NewBgpConnectionIDFromParent(parent, "connection1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/bgpConnections/connection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestBgpConnectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/bgpConnections/connection1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALHUBS/virtualHub1/BGPCONNECTIONS/connection1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/bgpConnections/connection1other",
+			Expected: false,
+		},
+	}
+
+	id := NewBgpConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1", "connection1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestBgpConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ConnectionMonitorId struct {
@@ -25,6 +25,16 @@ func NewConnectionMonitorID(subscriptionId, resourceGroup, networkWatcherName, n
 	}
 }
 
+// NewConnectionMonitorIDFromParent returns a ConnectionMonitorId for the ConnectionMonitor within the specified NetworkWatcher
+func NewConnectionMonitorIDFromParent(parent NetworkWatcherId, name string) ConnectionMonitorId {
+	return ConnectionMonitorId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		NetworkWatcherName: parent.Name,
+		Name:               name,
+	}
+}
+
 func (id ConnectionMonitorId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id ConnectionMonitorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this ConnectionMonitor
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ConnectionMonitorId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ConnectionMonitor ID
+func (id ConnectionMonitorId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticNetworkWatchers", "networkWatchers", false),
+		resourceid.UserSpecifiedSegment("networkWatcherName"),
+		resourceid.StaticSegment("staticConnectionMonitors", "connectionMonitors", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // ConnectionMonitorID parses a ConnectionMonitor ID into an ConnectionMonitorId struct
 func ConnectionMonitorID(input string) (*ConnectionMonitorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ConnectionMonitorId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ConnectionMonitorId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NetworkWatcherName, err = id.PopSegment("networkWatchers"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("connectionMonitors"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		NetworkWatcherName: values["networkWatcherName"],
+		Name:               values["name"],
 	}
 
 	return &resourceId, nil
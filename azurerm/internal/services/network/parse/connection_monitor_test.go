@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ConnectionMonitorId{}
+var _ resourceid.SegmentedID = ConnectionMonitorId{}
 
 func TestConnectionMonitorIDFormatter(t *testing.T) {
 	actual := NewConnectionMonitorID("12345678-1234-9876-4563-123456789012", "resGroup1", "watcher1", "connectionMonitor1").ID()
@@ -18,6 +19,52 @@ func TestConnectionMonitorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestConnectionMonitorIDFromParent(t *testing.T) {
+	parent := NewNetworkWatcherID("12345678-1234-9876-4563-123456789012", "resGroup1", "watcher1")
+	actual := NewConnectionMonitorIDFromParent(parent, "connectionMonitor1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/connectionMonitors/connectionMonitor1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestConnectionMonitorIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/connectionMonitors/connectionMonitor1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/NETWORKWATCHERS/watcher1/CONNECTIONMONITORS/connectionMonitor1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/connectionMonitors/connectionMonitor1other",
+			Expected: false,
+		},
+	}
+
+	id := NewConnectionMonitorID("12345678-1234-9876-4563-123456789012", "resGroup1", "watcher1", "connectionMonitor1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestConnectionMonitorID(t *testing.T) {
 	testData := []struct {
 		Input    string
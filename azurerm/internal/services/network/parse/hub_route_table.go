@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HubRouteTableId struct {
@@ -25,6 +25,16 @@ func NewHubRouteTableID(subscriptionId, resourceGroup, virtualHubName, name stri
 	}
 }
 
+// NewHubRouteTableIDFromParent returns a HubRouteTableId for the HubRouteTable within the specified VirtualHub
+func NewHubRouteTableIDFromParent(parent VirtualHubId, name string) HubRouteTableId {
+	return HubRouteTableId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		VirtualHubName: parent.Name,
+		Name:           name,
+	}
+}
+
 func (id HubRouteTableId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id HubRouteTableId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this HubRouteTable
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id HubRouteTableId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this HubRouteTable ID
+func (id HubRouteTableId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticVirtualHubs", "virtualHubs", false),
+		resourceid.UserSpecifiedSegment("virtualHubName"),
+		resourceid.StaticSegment("staticHubRouteTables", "hubRouteTables", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // HubRouteTableID parses a HubRouteTable ID into an HubRouteTableId struct
 func HubRouteTableID(input string) (*HubRouteTableId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, HubRouteTableId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := HubRouteTableId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("hubRouteTables"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		VirtualHubName: values["virtualHubName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = HubRouteTableId{}
+var _ resourceid.SegmentedID = HubRouteTableId{}
 
 func TestHubRouteTableIDFormatter(t *testing.T) {
 	actual := NewHubRouteTableID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1", "routeTable1").ID()
@@ -18,6 +19,52 @@ func TestHubRouteTableIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHubRouteTableIDFromParent(t *testing.T) {
+	parent := NewVirtualHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1")
+	actual := NewHubRouteTableIDFromParent(parent, "routeTable1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/hubRouteTables/routeTable1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestHubRouteTableIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/hubRouteTables/routeTable1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALHUBS/virtualHub1/HUBROUTETABLES/routeTable1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/hubRouteTables/routeTable1other",
+			Expected: false,
+		},
+	}
+
+	id := NewHubRouteTableID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1", "routeTable1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestHubRouteTableID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HubVirtualNetworkConnectionId struct {
@@ -25,6 +25,16 @@ func NewHubVirtualNetworkConnectionID(subscriptionId, resourceGroup, virtualHubN
 	}
 }
 
+// NewHubVirtualNetworkConnectionIDFromParent returns a HubVirtualNetworkConnectionId for the HubVirtualNetworkConnection within the specified VirtualHub
+func NewHubVirtualNetworkConnectionIDFromParent(parent VirtualHubId, name string) HubVirtualNetworkConnectionId {
+	return HubVirtualNetworkConnectionId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		VirtualHubName: parent.Name,
+		Name:           name,
+	}
+}
+
 func (id HubVirtualNetworkConnectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id HubVirtualNetworkConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this HubVirtualNetworkConnection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id HubVirtualNetworkConnectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this HubVirtualNetworkConnection ID
+func (id HubVirtualNetworkConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticVirtualHubs", "virtualHubs", false),
+		resourceid.UserSpecifiedSegment("virtualHubName"),
+		resourceid.StaticSegment("staticHubVirtualNetworkConnections", "hubVirtualNetworkConnections", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // HubVirtualNetworkConnectionID parses a HubVirtualNetworkConnection ID into an HubVirtualNetworkConnectionId struct
 func HubVirtualNetworkConnectionID(input string) (*HubVirtualNetworkConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, HubVirtualNetworkConnectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := HubVirtualNetworkConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("hubVirtualNetworkConnections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		VirtualHubName: values["virtualHubName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = HubVirtualNetworkConnectionId{}
+var _ resourceid.SegmentedID = HubVirtualNetworkConnectionId{}
 
 func TestHubVirtualNetworkConnectionIDFormatter(t *testing.T) {
 	actual := NewHubVirtualNetworkConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1", "hubConnection1").ID()
@@ -18,6 +19,52 @@ func TestHubVirtualNetworkConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHubVirtualNetworkConnectionIDFromParent(t *testing.T) {
+	parent := NewVirtualHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1")
+	actual := NewHubVirtualNetworkConnectionIDFromParent(parent, "hubConnection1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/hubVirtualNetworkConnections/hubConnection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestHubVirtualNetworkConnectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/hubVirtualNetworkConnections/hubConnection1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALHUBS/virtualHub1/HUBVIRTUALNETWORKCONNECTIONS/hubConnection1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/hubVirtualNetworkConnections/hubConnection1other",
+			Expected: false,
+		},
+	}
+
+	id := NewHubVirtualNetworkConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1", "hubConnection1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestHubVirtualNetworkConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = NetworkSecurityGroupId{}
+var _ resourceid.SegmentedID = NetworkSecurityGroupId{}
 
 func TestNetworkSecurityGroupIDFormatter(t *testing.T) {
 	actual := NewNetworkSecurityGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "securityGroup1").ID()
@@ -18,6 +19,43 @@ func TestNetworkSecurityGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNetworkSecurityGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkSecurityGroups/securityGroup1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/NETWORKSECURITYGROUPS/securityGroup1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkSecurityGroups/securityGroup1other",
+			Expected: false,
+		},
+	}
+
+	id := NewNetworkSecurityGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "securityGroup1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestNetworkSecurityGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PacketCaptureId struct {
@@ -25,6 +25,16 @@ func NewPacketCaptureID(subscriptionId, resourceGroup, networkWatcherName, name
 	}
 }
 
+// NewPacketCaptureIDFromParent returns a PacketCaptureId for the PacketCapture within the specified NetworkWatcher
+func NewPacketCaptureIDFromParent(parent NetworkWatcherId, name string) PacketCaptureId {
+	return PacketCaptureId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		NetworkWatcherName: parent.Name,
+		Name:               name,
+	}
+}
+
 func (id PacketCaptureId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id PacketCaptureId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NetworkWatcherName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this PacketCapture
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id PacketCaptureId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this PacketCapture ID
+func (id PacketCaptureId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticNetworkWatchers", "networkWatchers", false),
+		resourceid.UserSpecifiedSegment("networkWatcherName"),
+		resourceid.StaticSegment("staticPacketCaptures", "packetCaptures", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // PacketCaptureID parses a PacketCapture ID into an PacketCaptureId struct
 func PacketCaptureID(input string) (*PacketCaptureId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, PacketCaptureId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PacketCaptureId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NetworkWatcherName, err = id.PopSegment("networkWatchers"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("packetCaptures"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		NetworkWatcherName: values["networkWatcherName"],
+		Name:               values["name"],
 	}
 
 	return &resourceId, nil
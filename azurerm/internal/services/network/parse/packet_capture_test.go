@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = PacketCaptureId{}
+var _ resourceid.SegmentedID = PacketCaptureId{}
 
 func TestPacketCaptureIDFormatter(t *testing.T) {
 	actual := NewPacketCaptureID("12345678-1234-9876-4563-123456789012", "resGroup1", "watcher1", "capture1").ID()
@@ -18,6 +19,52 @@ func TestPacketCaptureIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPacketCaptureIDFromParent(t *testing.T) {
+	parent := NewNetworkWatcherID("12345678-1234-9876-4563-123456789012", "resGroup1", "watcher1")
+	actual := NewPacketCaptureIDFromParent(parent, "capture1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/packetCaptures/capture1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestPacketCaptureIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/packetCaptures/capture1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/NETWORKWATCHERS/watcher1/PACKETCAPTURES/capture1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/networkWatchers/watcher1/packetCaptures/capture1other",
+			Expected: false,
+		},
+	}
+
+	id := NewPacketCaptureID("12345678-1234-9876-4563-123456789012", "resGroup1", "watcher1", "capture1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestPacketCaptureID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PointToSiteVpnGatewayId struct {
@@ -37,32 +37,37 @@ func (id PointToSiteVpnGatewayId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.P2sVpnGatewayName)
 }
 
+// Matches returns whether the specified Resource ID refers to this PointToSiteVpnGateway
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id PointToSiteVpnGatewayId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this PointToSiteVpnGateway ID
+func (id PointToSiteVpnGatewayId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticP2sVpnGateways", "p2sVpnGateways", false),
+		resourceid.UserSpecifiedSegment("p2sVpnGatewayName"),
+	}
+}
+
 // PointToSiteVpnGatewayID parses a PointToSiteVpnGateway ID into an PointToSiteVpnGatewayId struct
 func PointToSiteVpnGatewayID(input string) (*PointToSiteVpnGatewayId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, PointToSiteVpnGatewayId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PointToSiteVpnGatewayId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.P2sVpnGatewayName, err = id.PopSegment("p2sVpnGateways"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    values["subscriptionId"],
+		ResourceGroup:     values["resourceGroup"],
+		P2sVpnGatewayName: values["p2sVpnGatewayName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = PointToSiteVpnGatewayId{}
+var _ resourceid.SegmentedID = PointToSiteVpnGatewayId{}
 
 func TestPointToSiteVpnGatewayIDFormatter(t *testing.T) {
 	actual := NewPointToSiteVpnGatewayID("12345678-1234-9876-4563-123456789012", "resGroup1", "pointToSite1").ID()
@@ -18,6 +19,43 @@ func TestPointToSiteVpnGatewayIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPointToSiteVpnGatewayIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/p2sVpnGateways/pointToSite1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/P2SVPNGATEWAYS/pointToSite1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/p2sVpnGateways/pointToSite1other",
+			Expected: false,
+		},
+	}
+
+	id := NewPointToSiteVpnGatewayID("12345678-1234-9876-4563-123456789012", "resGroup1", "pointToSite1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestPointToSiteVpnGatewayID(t *testing.T) {
 	testData := []struct {
 		Input    string
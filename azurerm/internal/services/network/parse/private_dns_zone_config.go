@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateDnsZoneConfigId struct {
@@ -27,6 +27,17 @@ func NewPrivateDnsZoneConfigID(subscriptionId, resourceGroup, privateEndpointNam
 	}
 }
 
+// NewPrivateDnsZoneConfigIDFromParent returns a PrivateDnsZoneConfigId for the PrivateDnsZoneConfig within the specified PrivateDnsZoneGroup
+func NewPrivateDnsZoneConfigIDFromParent(parent PrivateDnsZoneGroupId, name string) PrivateDnsZoneConfigId {
+	return PrivateDnsZoneConfigId{
+		SubscriptionId:          parent.SubscriptionId,
+		ResourceGroup:           parent.ResourceGroup,
+		PrivateEndpointName:     parent.PrivateEndpointName,
+		PrivateDnsZoneGroupName: parent.Name,
+		Name:                    name,
+	}
+}
+
 func (id PrivateDnsZoneConfigId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -43,38 +54,43 @@ func (id PrivateDnsZoneConfigId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateEndpointName, id.PrivateDnsZoneGroupName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this PrivateDnsZoneConfig
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id PrivateDnsZoneConfigId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this PrivateDnsZoneConfig ID
+func (id PrivateDnsZoneConfigId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticPrivateEndpoints", "privateEndpoints", false),
+		resourceid.UserSpecifiedSegment("privateEndpointName"),
+		resourceid.StaticSegment("staticPrivateDnsZoneGroups", "privateDnsZoneGroups", false),
+		resourceid.UserSpecifiedSegment("privateDnsZoneGroupName"),
+		resourceid.StaticSegment("staticPrivateDnsZoneConfigs", "privateDnsZoneConfigs", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // PrivateDnsZoneConfigID parses a PrivateDnsZoneConfig ID into an PrivateDnsZoneConfigId struct
 func PrivateDnsZoneConfigID(input string) (*PrivateDnsZoneConfigId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, PrivateDnsZoneConfigId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PrivateDnsZoneConfigId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.PrivateEndpointName, err = id.PopSegment("privateEndpoints"); err != nil {
-		return nil, err
-	}
-	if resourceId.PrivateDnsZoneGroupName, err = id.PopSegment("privateDnsZoneGroups"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("privateDnsZoneConfigs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:          values["subscriptionId"],
+		ResourceGroup:           values["resourceGroup"],
+		PrivateEndpointName:     values["privateEndpointName"],
+		PrivateDnsZoneGroupName: values["privateDnsZoneGroupName"],
+		Name:                    values["name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PrivateDnsZoneGroupId struct {
@@ -25,6 +25,16 @@ func NewPrivateDnsZoneGroupID(subscriptionId, resourceGroup, privateEndpointName
 	}
 }
 
+// NewPrivateDnsZoneGroupIDFromParent returns a PrivateDnsZoneGroupId for the PrivateDnsZoneGroup within the specified PrivateEndpoint
+func NewPrivateDnsZoneGroupIDFromParent(parent PrivateEndpointId, name string) PrivateDnsZoneGroupId {
+	return PrivateDnsZoneGroupId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		PrivateEndpointName: parent.Name,
+		Name:                name,
+	}
+}
+
 func (id PrivateDnsZoneGroupId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id PrivateDnsZoneGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateEndpointName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this PrivateDnsZoneGroup
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id PrivateDnsZoneGroupId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this PrivateDnsZoneGroup ID
+func (id PrivateDnsZoneGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticPrivateEndpoints", "privateEndpoints", false),
+		resourceid.UserSpecifiedSegment("privateEndpointName"),
+		resourceid.StaticSegment("staticPrivateDnsZoneGroups", "privateDnsZoneGroups", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // PrivateDnsZoneGroupID parses a PrivateDnsZoneGroup ID into an PrivateDnsZoneGroupId struct
 func PrivateDnsZoneGroupID(input string) (*PrivateDnsZoneGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, PrivateDnsZoneGroupId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PrivateDnsZoneGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.PrivateEndpointName, err = id.PopSegment("privateEndpoints"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("privateDnsZoneGroups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		PrivateEndpointName: values["privateEndpointName"],
+		Name:                values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = PrivateDnsZoneGroupId{}
+var _ resourceid.SegmentedID = PrivateDnsZoneGroupId{}
 
 func TestPrivateDnsZoneGroupIDFormatter(t *testing.T) {
 	actual := NewPrivateDnsZoneGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "endpoint1", "privateDnsZoneGroup1").ID()
@@ -18,6 +19,52 @@ func TestPrivateDnsZoneGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPrivateDnsZoneGroupIDFromParent(t *testing.T) {
+	parent := NewPrivateEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "endpoint1")
+	actual := NewPrivateDnsZoneGroupIDFromParent(parent, "privateDnsZoneGroup1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1/privateDnsZoneGroups/privateDnsZoneGroup1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestPrivateDnsZoneGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1/privateDnsZoneGroups/privateDnsZoneGroup1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/PRIVATEENDPOINTS/endpoint1/PRIVATEDNSZONEGROUPS/privateDnsZoneGroup1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1/privateDnsZoneGroups/privateDnsZoneGroup1other",
+			Expected: false,
+		},
+	}
+
+	id := NewPrivateDnsZoneGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "endpoint1", "privateDnsZoneGroup1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestPrivateDnsZoneGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
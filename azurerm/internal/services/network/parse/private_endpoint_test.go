@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = PrivateEndpointId{}
+var _ resourceid.SegmentedID = PrivateEndpointId{}
 
 func TestPrivateEndpointIDFormatter(t *testing.T) {
 	actual := NewPrivateEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "endpoint1").ID()
@@ -18,6 +19,43 @@ func TestPrivateEndpointIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPrivateEndpointIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/PRIVATEENDPOINTS/endpoint1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/privateEndpoints/endpoint1other",
+			Expected: false,
+		},
+	}
+
+	id := NewPrivateEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "endpoint1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestPrivateEndpointID(t *testing.T) {
 	testData := []struct {
 		Input    string
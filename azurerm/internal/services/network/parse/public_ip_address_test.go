@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = PublicIpAddressId{}
+var _ resourceid.SegmentedID = PublicIpAddressId{}
 
 func TestPublicIpAddressIDFormatter(t *testing.T) {
 	actual := NewPublicIpAddressID("12345678-1234-9876-4563-123456789012", "resGroup1", "publicIpAddress1").ID()
@@ -18,6 +19,43 @@ func TestPublicIpAddressIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPublicIpAddressIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPAddresses/publicIpAddress1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/PUBLICIPADDRESSES/publicIpAddress1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/publicIPAddresses/publicIpAddress1other",
+			Expected: false,
+		},
+	}
+
+	id := NewPublicIpAddressID("12345678-1234-9876-4563-123456789012", "resGroup1", "publicIpAddress1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestPublicIpAddressID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RouteId struct {
@@ -25,6 +25,16 @@ func NewRouteID(subscriptionId, resourceGroup, routeTableName, name string) Rout
 	}
 }
 
+// NewRouteIDFromParent returns a RouteId for the Route within the specified RouteTable
+func NewRouteIDFromParent(parent RouteTableId, name string) RouteId {
+	return RouteId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		RouteTableName: parent.Name,
+		Name:           name,
+	}
+}
+
 func (id RouteId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id RouteId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RouteTableName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Route
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id RouteId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Route ID
+func (id RouteId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticRouteTables", "routeTables", false),
+		resourceid.UserSpecifiedSegment("routeTableName"),
+		resourceid.StaticSegment("staticRoutes", "routes", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // RouteID parses a Route ID into an RouteId struct
 func RouteID(input string) (*RouteId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, RouteId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := RouteId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.RouteTableName, err = id.PopSegment("routeTables"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("routes"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		RouteTableName: values["routeTableName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
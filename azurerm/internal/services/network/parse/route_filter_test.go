@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = RouteFilterId{}
+var _ resourceid.SegmentedID = RouteFilterId{}
 
 func TestRouteFilterIDFormatter(t *testing.T) {
 	actual := NewRouteFilterID("12345678-1234-9876-4563-123456789012", "resGroup1", "filter1").ID()
@@ -18,6 +19,43 @@ func TestRouteFilterIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRouteFilterIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeFilters/filter1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/ROUTEFILTERS/filter1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeFilters/filter1other",
+			Expected: false,
+		},
+	}
+
+	id := NewRouteFilterID("12345678-1234-9876-4563-123456789012", "resGroup1", "filter1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestRouteFilterID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = RouteTableId{}
+var _ resourceid.SegmentedID = RouteTableId{}
 
 func TestRouteTableIDFormatter(t *testing.T) {
 	actual := NewRouteTableID("12345678-1234-9876-4563-123456789012", "resGroup1", "routeTable1").ID()
@@ -18,6 +19,43 @@ func TestRouteTableIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRouteTableIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/routeTable1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/ROUTETABLES/routeTable1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/routeTable1other",
+			Expected: false,
+		},
+	}
+
+	id := NewRouteTableID("12345678-1234-9876-4563-123456789012", "resGroup1", "routeTable1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestRouteTableID(t *testing.T) {
 	testData := []struct {
 		Input    string
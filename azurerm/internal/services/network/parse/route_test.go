@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = RouteId{}
+var _ resourceid.SegmentedID = RouteId{}
 
 func TestRouteIDFormatter(t *testing.T) {
 	actual := NewRouteID("12345678-1234-9876-4563-123456789012", "resGroup1", "routeTable1", "route1").ID()
@@ -18,6 +19,52 @@ func TestRouteIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRouteIDFromParent(t *testing.T) {
+	parent := NewRouteTableID("12345678-1234-9876-4563-123456789012", "resGroup1", "routeTable1")
+	actual := NewRouteIDFromParent(parent, "route1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/routeTable1/routes/route1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRouteIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/routeTable1/routes/route1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/ROUTETABLES/routeTable1/ROUTES/route1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/routeTables/routeTable1/routes/route1other",
+			Expected: false,
+		},
+	}
+
+	id := NewRouteID("12345678-1234-9876-4563-123456789012", "resGroup1", "routeTable1", "route1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestRouteID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SecurityPartnerProviderId{}
+var _ resourceid.SegmentedID = SecurityPartnerProviderId{}
 
 func TestSecurityPartnerProviderIDFormatter(t *testing.T) {
 	actual := NewSecurityPartnerProviderID("12345678-1234-9876-4563-123456789012", "resGroup1", "partnerProvider1").ID()
@@ -18,6 +19,43 @@ func TestSecurityPartnerProviderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSecurityPartnerProviderIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/securityPartnerProviders/partnerProvider1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/SECURITYPARTNERPROVIDERS/partnerProvider1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/securityPartnerProviders/partnerProvider1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSecurityPartnerProviderID("12345678-1234-9876-4563-123456789012", "resGroup1", "partnerProvider1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSecurityPartnerProviderID(t *testing.T) {
 	testData := []struct {
 		Input    string
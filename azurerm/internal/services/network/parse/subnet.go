@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubnetId struct {
@@ -25,6 +25,16 @@ func NewSubnetID(subscriptionId, resourceGroup, virtualNetworkName, name string)
 	}
 }
 
+// NewSubnetIDFromParent returns a SubnetId for the Subnet within the specified VirtualNetwork
+func NewSubnetIDFromParent(parent VirtualNetworkId, name string) SubnetId {
+	return SubnetId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		VirtualNetworkName: parent.Name,
+		Name:               name,
+	}
+}
+
 func (id SubnetId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id SubnetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Subnet
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SubnetId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Subnet ID
+func (id SubnetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticVirtualNetworks", "virtualNetworks", false),
+		resourceid.UserSpecifiedSegment("virtualNetworkName"),
+		resourceid.StaticSegment("staticSubnets", "subnets", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // SubnetID parses a Subnet ID into an SubnetId struct
 func SubnetID(input string) (*SubnetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SubnetId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubnetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualNetworkName, err = id.PopSegment("virtualNetworks"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("subnets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		VirtualNetworkName: values["virtualNetworkName"],
+		Name:               values["name"],
 	}
 
 	return &resourceId, nil
@@ -81,50 +96,16 @@ func SubnetID(input string) (*SubnetId, error) {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func SubnetIDInsensitively(input string) (*SubnetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SubnetId{}.Segments(), true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubnetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	// find the correct casing for the 'virtualNetworks' segment
-	virtualNetworksKey := "virtualNetworks"
-	for key := range id.Path {
-		if strings.EqualFold(key, virtualNetworksKey) {
-			virtualNetworksKey = key
-			break
-		}
-	}
-	if resourceId.VirtualNetworkName, err = id.PopSegment(virtualNetworksKey); err != nil {
-		return nil, err
-	}
-
-	// find the correct casing for the 'subnets' segment
-	subnetsKey := "subnets"
-	for key := range id.Path {
-		if strings.EqualFold(key, subnetsKey) {
-			subnetsKey = key
-			break
-		}
-	}
-	if resourceId.Name, err = id.PopSegment(subnetsKey); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		VirtualNetworkName: values["virtualNetworkName"],
+		Name:               values["name"],
 	}
 
 	return &resourceId, nil
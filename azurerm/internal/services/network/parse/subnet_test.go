@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SubnetId{}
+var _ resourceid.SegmentedID = SubnetId{}
 
 func TestSubnetIDFormatter(t *testing.T) {
 	actual := NewSubnetID("12345678-1234-9876-4563-123456789012", "resGroup1", "network1", "subnet1").ID()
@@ -18,6 +19,52 @@ func TestSubnetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubnetIDFromParent(t *testing.T) {
+	parent := NewVirtualNetworkID("12345678-1234-9876-4563-123456789012", "resGroup1", "network1")
+	actual := NewSubnetIDFromParent(parent, "subnet1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSubnetIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALNETWORKS/network1/SUBNETS/subnet1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSubnetID("12345678-1234-9876-4563-123456789012", "resGroup1", "network1", "subnet1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSubnetID(t *testing.T) {
 	testData := []struct {
 		Input    string
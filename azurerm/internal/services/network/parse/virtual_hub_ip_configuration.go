@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualHubIpConfigurationId struct {
@@ -25,6 +25,16 @@ func NewVirtualHubIpConfigurationID(subscriptionId, resourceGroup, virtualHubNam
 	}
 }
 
+// NewVirtualHubIpConfigurationIDFromParent returns a VirtualHubIpConfigurationId for the VirtualHubIpConfiguration within the specified VirtualHub
+func NewVirtualHubIpConfigurationIDFromParent(parent VirtualHubId, ipConfigurationName string) VirtualHubIpConfigurationId {
+	return VirtualHubIpConfigurationId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		VirtualHubName:      parent.Name,
+		IpConfigurationName: ipConfigurationName,
+	}
+}
+
 func (id VirtualHubIpConfigurationId) String() string {
 	segments := []string{
 		fmt.Sprintf("Ip Configuration Name %q", id.IpConfigurationName),
@@ -40,35 +50,40 @@ func (id VirtualHubIpConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualHubName, id.IpConfigurationName)
 }
 
+// Matches returns whether the specified Resource ID refers to this VirtualHubIpConfiguration
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id VirtualHubIpConfigurationId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this VirtualHubIpConfiguration ID
+func (id VirtualHubIpConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticVirtualHubs", "virtualHubs", false),
+		resourceid.UserSpecifiedSegment("virtualHubName"),
+		resourceid.StaticSegment("staticIpConfigurations", "ipConfigurations", false),
+		resourceid.UserSpecifiedSegment("ipConfigurationName"),
+	}
+}
+
 // VirtualHubIpConfigurationID parses a VirtualHubIpConfiguration ID into an VirtualHubIpConfigurationId struct
 func VirtualHubIpConfigurationID(input string) (*VirtualHubIpConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, VirtualHubIpConfigurationId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualHubIpConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.IpConfigurationName, err = id.PopSegment("ipConfigurations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		VirtualHubName:      values["virtualHubName"],
+		IpConfigurationName: values["ipConfigurationName"],
 	}
 
 	return &resourceId, nil
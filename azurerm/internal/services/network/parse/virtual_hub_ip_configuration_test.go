@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VirtualHubIpConfigurationId{}
+var _ resourceid.SegmentedID = VirtualHubIpConfigurationId{}
 
 func TestVirtualHubIpConfigurationIDFormatter(t *testing.T) {
 	actual := NewVirtualHubIpConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1", "ipConfiguration1").ID()
@@ -18,6 +19,52 @@ func TestVirtualHubIpConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualHubIpConfigurationIDFromParent(t *testing.T) {
+	parent := NewVirtualHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1")
+	actual := NewVirtualHubIpConfigurationIDFromParent(parent, "ipConfiguration1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/ipConfigurations/ipConfiguration1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestVirtualHubIpConfigurationIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/ipConfigurations/ipConfiguration1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALHUBS/virtualHub1/IPCONFIGURATIONS/ipConfiguration1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1/ipConfigurations/ipConfiguration1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVirtualHubIpConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1", "ipConfiguration1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVirtualHubIpConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VirtualHubId{}
+var _ resourceid.SegmentedID = VirtualHubId{}
 
 func TestVirtualHubIDFormatter(t *testing.T) {
 	actual := NewVirtualHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1").ID()
@@ -18,6 +19,43 @@ func TestVirtualHubIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualHubIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALHUBS/virtualHub1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualHubs/virtualHub1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVirtualHubID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualHub1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVirtualHubID(t *testing.T) {
 	testData := []struct {
 		Input    string
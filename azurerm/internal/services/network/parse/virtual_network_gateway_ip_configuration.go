@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkGatewayIpConfigurationId struct {
@@ -25,6 +25,16 @@ func NewVirtualNetworkGatewayIpConfigurationID(subscriptionId, resourceGroup, vi
 	}
 }
 
+// NewVirtualNetworkGatewayIpConfigurationIDFromParent returns a VirtualNetworkGatewayIpConfigurationId for the VirtualNetworkGatewayIpConfiguration within the specified VirtualNetworkGateway
+func NewVirtualNetworkGatewayIpConfigurationIDFromParent(parent VirtualNetworkGatewayId, ipConfigurationName string) VirtualNetworkGatewayIpConfigurationId {
+	return VirtualNetworkGatewayIpConfigurationId{
+		SubscriptionId:            parent.SubscriptionId,
+		ResourceGroup:             parent.ResourceGroup,
+		VirtualNetworkGatewayName: parent.Name,
+		IpConfigurationName:       ipConfigurationName,
+	}
+}
+
 func (id VirtualNetworkGatewayIpConfigurationId) String() string {
 	segments := []string{
 		fmt.Sprintf("Ip Configuration Name %q", id.IpConfigurationName),
@@ -40,35 +50,40 @@ func (id VirtualNetworkGatewayIpConfigurationId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VirtualNetworkGatewayName, id.IpConfigurationName)
 }
 
+// Matches returns whether the specified Resource ID refers to this VirtualNetworkGatewayIpConfiguration
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id VirtualNetworkGatewayIpConfigurationId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this VirtualNetworkGatewayIpConfiguration ID
+func (id VirtualNetworkGatewayIpConfigurationId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticVirtualNetworkGateways", "virtualNetworkGateways", false),
+		resourceid.UserSpecifiedSegment("virtualNetworkGatewayName"),
+		resourceid.StaticSegment("staticIpConfigurations", "ipConfigurations", false),
+		resourceid.UserSpecifiedSegment("ipConfigurationName"),
+	}
+}
+
 // VirtualNetworkGatewayIpConfigurationID parses a VirtualNetworkGatewayIpConfiguration ID into an VirtualNetworkGatewayIpConfigurationId struct
 func VirtualNetworkGatewayIpConfigurationID(input string) (*VirtualNetworkGatewayIpConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, VirtualNetworkGatewayIpConfigurationId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualNetworkGatewayIpConfigurationId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VirtualNetworkGatewayName, err = id.PopSegment("virtualNetworkGateways"); err != nil {
-		return nil, err
-	}
-	if resourceId.IpConfigurationName, err = id.PopSegment("ipConfigurations"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:            values["subscriptionId"],
+		ResourceGroup:             values["resourceGroup"],
+		VirtualNetworkGatewayName: values["virtualNetworkGatewayName"],
+		IpConfigurationName:       values["ipConfigurationName"],
 	}
 
 	return &resourceId, nil
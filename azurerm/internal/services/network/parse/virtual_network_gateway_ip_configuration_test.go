@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VirtualNetworkGatewayIpConfigurationId{}
+var _ resourceid.SegmentedID = VirtualNetworkGatewayIpConfigurationId{}
 
 func TestVirtualNetworkGatewayIpConfigurationIDFormatter(t *testing.T) {
 	actual := NewVirtualNetworkGatewayIpConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "gw1", "cfg1").ID()
@@ -18,6 +19,52 @@ func TestVirtualNetworkGatewayIpConfigurationIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkGatewayIpConfigurationIDFromParent(t *testing.T) {
+	parent := NewVirtualNetworkGatewayID("12345678-1234-9876-4563-123456789012", "resGroup1", "gw1")
+	actual := NewVirtualNetworkGatewayIpConfigurationIDFromParent(parent, "cfg1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/ipConfigurations/cfg1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestVirtualNetworkGatewayIpConfigurationIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/ipConfigurations/cfg1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALNETWORKGATEWAYS/gw1/IPCONFIGURATIONS/cfg1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1/ipConfigurations/cfg1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVirtualNetworkGatewayIpConfigurationID("12345678-1234-9876-4563-123456789012", "resGroup1", "gw1", "cfg1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVirtualNetworkGatewayIpConfigurationID(t *testing.T) {
 	testData := []struct {
 		Input    string
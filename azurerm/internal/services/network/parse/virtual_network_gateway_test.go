@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VirtualNetworkGatewayId{}
+var _ resourceid.SegmentedID = VirtualNetworkGatewayId{}
 
 func TestVirtualNetworkGatewayIDFormatter(t *testing.T) {
 	actual := NewVirtualNetworkGatewayID("12345678-1234-9876-4563-123456789012", "resGroup1", "gw1").ID()
@@ -18,6 +19,43 @@ func TestVirtualNetworkGatewayIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualNetworkGatewayIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALNETWORKGATEWAYS/gw1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualNetworkGateways/gw1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVirtualNetworkGatewayID("12345678-1234-9876-4563-123456789012", "resGroup1", "gw1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVirtualNetworkGatewayID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VirtualWanId{}
+var _ resourceid.SegmentedID = VirtualWanId{}
 
 func TestVirtualWanIDFormatter(t *testing.T) {
 	actual := NewVirtualWanID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualWan1").ID()
@@ -18,6 +19,43 @@ func TestVirtualWanIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVirtualWanIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualWans/virtualWan1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALWANS/virtualWan1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/virtualWans/virtualWan1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVirtualWanID("12345678-1234-9876-4563-123456789012", "resGroup1", "virtualWan1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVirtualWanID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VpnConnectionId struct {
@@ -25,6 +25,16 @@ func NewVpnConnectionID(subscriptionId, resourceGroup, vpnGatewayName, name stri
 	}
 }
 
+// NewVpnConnectionIDFromParent returns a VpnConnectionId for the VpnConnection within the specified VpnGateway
+func NewVpnConnectionIDFromParent(parent VpnGatewayId, name string) VpnConnectionId {
+	return VpnConnectionId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		VpnGatewayName: parent.Name,
+		Name:           name,
+	}
+}
+
 func (id VpnConnectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id VpnConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VpnGatewayName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this VpnConnection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id VpnConnectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this VpnConnection ID
+func (id VpnConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticVpnGateways", "vpnGateways", false),
+		resourceid.UserSpecifiedSegment("vpnGatewayName"),
+		resourceid.StaticSegment("staticVpnConnections", "vpnConnections", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // VpnConnectionID parses a VpnConnection ID into an VpnConnectionId struct
 func VpnConnectionID(input string) (*VpnConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, VpnConnectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VpnConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VpnGatewayName, err = id.PopSegment("vpnGateways"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("vpnConnections"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		VpnGatewayName: values["vpnGatewayName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VpnSiteLinkId struct {
@@ -25,6 +25,16 @@ func NewVpnSiteLinkID(subscriptionId, resourceGroup, vpnSiteName, name string) V
 	}
 }
 
+// NewVpnSiteLinkIDFromParent returns a VpnSiteLinkId for the VpnSiteLink within the specified VpnSite
+func NewVpnSiteLinkIDFromParent(parent VpnSiteId, name string) VpnSiteLinkId {
+	return VpnSiteLinkId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		VpnSiteName:    parent.Name,
+		Name:           name,
+	}
+}
+
 func (id VpnSiteLinkId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id VpnSiteLinkId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.VpnSiteName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this VpnSiteLink
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id VpnSiteLinkId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this VpnSiteLink ID
+func (id VpnSiteLinkId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticVpnSites", "vpnSites", false),
+		resourceid.UserSpecifiedSegment("vpnSiteName"),
+		resourceid.StaticSegment("staticVpnSiteLinks", "vpnSiteLinks", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // VpnSiteLinkID parses a VpnSiteLink ID into an VpnSiteLinkId struct
 func VpnSiteLinkID(input string) (*VpnSiteLinkId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, VpnSiteLinkId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VpnSiteLinkId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.VpnSiteName, err = id.PopSegment("vpnSites"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("vpnSiteLinks"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		VpnSiteName:    values["vpnSiteName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
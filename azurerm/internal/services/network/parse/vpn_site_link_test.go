@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VpnSiteLinkId{}
+var _ resourceid.SegmentedID = VpnSiteLinkId{}
 
 func TestVpnSiteLinkIDFormatter(t *testing.T) {
 	actual := NewVpnSiteLinkID("12345678-1234-9876-4563-123456789012", "resGroup1", "vpnSite1", "vpnSiteLink1").ID()
@@ -18,6 +19,52 @@ func TestVpnSiteLinkIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVpnSiteLinkIDFromParent(t *testing.T) {
+	parent := NewVpnSiteID("12345678-1234-9876-4563-123456789012", "resGroup1", "vpnSite1")
+	actual := NewVpnSiteLinkIDFromParent(parent, "vpnSiteLink1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/vpnSites/vpnSite1/vpnSiteLinks/vpnSiteLink1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestVpnSiteLinkIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/vpnSites/vpnSite1/vpnSiteLinks/vpnSiteLink1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VPNSITES/vpnSite1/VPNSITELINKS/vpnSiteLink1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/vpnSites/vpnSite1/vpnSiteLinks/vpnSiteLink1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVpnSiteLinkID("12345678-1234-9876-4563-123456789012", "resGroup1", "vpnSite1", "vpnSiteLink1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVpnSiteLinkID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = VpnSiteId{}
+var _ resourceid.SegmentedID = VpnSiteId{}
 
 func TestVpnSiteIDFormatter(t *testing.T) {
 	actual := NewVpnSiteID("12345678-1234-9876-4563-123456789012", "resGroup1", "vpnSite1").ID()
@@ -18,6 +19,43 @@ func TestVpnSiteIDFormatter(t *testing.T) {
 	}
 }
 
+func TestVpnSiteIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/vpnSites/vpnSite1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/VPNSITES/vpnSite1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/vpnSites/vpnSite1other",
+			Expected: false,
+		},
+	}
+
+	id := NewVpnSiteID("12345678-1234-9876-4563-123456789012", "resGroup1", "vpnSite1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestVpnSiteID(t *testing.T) {
 	testData := []struct {
 		Input    string
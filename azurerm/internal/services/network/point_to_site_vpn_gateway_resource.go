@@ -203,7 +203,7 @@ func resourcePointToSiteVPNGatewayCreateUpdate(d *schema.ResourceData, meta inte
 			},
 			VpnGatewayScaleUnit: utils.Int32(int32(scaleUnit)),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 	customDNSServers := utils.ExpandStringSlice(d.Get("dns_servers").([]interface{}))
 	if len(*customDNSServers) != 0 {
@@ -283,7 +283,7 @@ func resourcePointToSiteVPNGatewayRead(d *schema.ResourceData, meta interface{})
 		d.Set("vpn_server_configuration_id", vpnServerConfigurationId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourcePointToSiteVPNGatewayDelete(d *schema.ResourceData, meta interface{}) error {
@@ -149,7 +149,7 @@ func dataSourcePrivateLinkServiceRead(d *schema.ResourceData, meta interface{})
 	}
 	d.SetId(*resp.ID)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func dataSourceFlattenPrivateLinkServiceFrontendIPConfiguration(input *[]network.FrontendIPConfiguration) []string {
@@ -79,5 +79,5 @@ func dataSourcePublicIpPrefixRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("prefix_length", props.PrefixLength)
 		d.Set("ip_prefix", props.IPPrefix)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
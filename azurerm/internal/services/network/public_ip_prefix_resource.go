@@ -98,7 +98,7 @@ func resourcePublicIpPrefixCreateUpdate(d *schema.ResourceData, meta interface{}
 		PublicIPPrefixPropertiesFormat: &network.PublicIPPrefixPropertiesFormat{
 			PrefixLength: utils.Int32(int32(prefix_length)),
 		},
-		Tags:  tags.Expand(t),
+		Tags:  tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		Zones: zones,
 	}
 
@@ -162,7 +162,7 @@ func resourcePublicIpPrefixRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("ip_prefix", props.IPPrefix)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourcePublicIpPrefixDelete(d *schema.ResourceData, meta interface{}) error {
@@ -180,7 +180,7 @@ func resourcePublicIpCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 			PublicIPAddressVersion:   ipVersion,
 			IdleTimeoutInMinutes:     utils.Int32(int32(idleTimeout)),
 		},
-		Tags:  tags.Expand(t),
+		Tags:  tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		Zones: zones,
 	}
 
@@ -271,7 +271,7 @@ func resourcePublicIpRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("idle_timeout_in_minutes", props.IdleTimeoutInMinutes)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourcePublicIpDelete(d *schema.ResourceData, meta interface{}) error {
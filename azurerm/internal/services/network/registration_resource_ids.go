@@ -0,0 +1,224 @@
+package network
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Network/applicationGateways",
+			TerraformResourceType: "azurerm_application_gateway",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApplicationGatewayID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/bastionHosts",
+			TerraformResourceType: "azurerm_bastion_host",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BastionHostID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/ipGroups",
+			TerraformResourceType: "azurerm_ip_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IpGroupID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/natGateways",
+			TerraformResourceType: "azurerm_nat_gateway",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NatGatewayID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/networkWatchers/connectionMonitors",
+			TerraformResourceType: "azurerm_network_connection_monitor",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ConnectionMonitorID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/networkInterfaces",
+			TerraformResourceType: "azurerm_network_interface",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NetworkInterfaceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/networkWatchers/packetCaptures",
+			TerraformResourceType: "azurerm_network_packet_capture",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PacketCaptureID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/networkSecurityGroups",
+			TerraformResourceType: "azurerm_network_security_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NetworkSecurityGroupID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/networkWatchers",
+			TerraformResourceType: "azurerm_network_watcher",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NetworkWatcherID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/p2sVpnGateways",
+			TerraformResourceType: "azurerm_point_to_site_vpn_gateway",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PointToSiteVpnGatewayID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/privateEndpoints",
+			TerraformResourceType: "azurerm_private_endpoint",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PrivateEndpointID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/publicIPAddresses",
+			TerraformResourceType: "azurerm_public_ip",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PublicIpAddressID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/routeTables/routes",
+			TerraformResourceType: "azurerm_route",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.RouteID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/routeFilters",
+			TerraformResourceType: "azurerm_route_filter",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.RouteFilterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/routeTables",
+			TerraformResourceType: "azurerm_route_table",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.RouteTableID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualNetworks/subnets",
+			TerraformResourceType: "azurerm_subnet",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SubnetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/serviceEndpointPolicies",
+			TerraformResourceType: "azurerm_subnet_service_endpoint_storage_policy",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SubnetServiceEndpointStoragePolicyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualHubs",
+			TerraformResourceType: "azurerm_virtual_hub",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualHubID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualHubs/bgpConnections",
+			TerraformResourceType: "azurerm_virtual_hub_bgp_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.BgpConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualHubs/hubVirtualNetworkConnections",
+			TerraformResourceType: "azurerm_virtual_hub_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.HubVirtualNetworkConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualHubs/ipConfigurations",
+			TerraformResourceType: "azurerm_virtual_hub_ip",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualHubIpConfigurationID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualHubs/hubRouteTables",
+			TerraformResourceType: "azurerm_virtual_hub_route_table",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.HubRouteTableID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/securityPartnerProviders",
+			TerraformResourceType: "azurerm_virtual_hub_security_partner_provider",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SecurityPartnerProviderID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualNetworks",
+			TerraformResourceType: "azurerm_virtual_network",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualNetworkID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualNetworkGateways",
+			TerraformResourceType: "azurerm_virtual_network_gateway",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualNetworkGatewayID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/virtualWans",
+			TerraformResourceType: "azurerm_virtual_wan",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualWanID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/vpnGateways",
+			TerraformResourceType: "azurerm_vpn_gateway",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VpnGatewayID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/vpnGateways/vpnConnections",
+			TerraformResourceType: "azurerm_vpn_gateway_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VpnConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/vpnServerConfigurations",
+			TerraformResourceType: "azurerm_vpn_server_configuration",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VpnServerConfigurationID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/vpnSites",
+			TerraformResourceType: "azurerm_vpn_site",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VpnSiteID(input)
+			},
+		},
+	}
+}
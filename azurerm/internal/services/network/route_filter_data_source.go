@@ -98,7 +98,7 @@ func dataSourceRouteFilterRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenRouteFilterDataSourceRules(input *[]network.RouteFilterRule) []interface{} {
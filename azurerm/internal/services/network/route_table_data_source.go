@@ -107,7 +107,7 @@ func dataSourceRouteTableRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenRouteTableDataSourceRoutes(input *[]network.Route) []interface{} {
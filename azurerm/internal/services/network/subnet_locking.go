@@ -0,0 +1,35 @@
+package network
+
+import (
+	"context"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+)
+
+// lockVirtualNetworksAndSubnets locks the specified Virtual Networks and then the specified Subnets - which
+// must be locked in this order wherever both are locked, to avoid deadlocking with another resource
+func lockVirtualNetworksAndSubnets(virtualNetworkNames *[]string, subnetNames *[]string) {
+	locks.MultipleByName(virtualNetworkNames, VirtualNetworkResourceName)
+	locks.MultipleByName(subnetNames, SubnetResourceName)
+}
+
+// lockVirtualNetworksAndSubnetsWithContext locks the specified Virtual Networks and then the specified Subnets,
+// returning an error if the context is cancelled (or times out) before all of the locks are acquired
+func lockVirtualNetworksAndSubnetsWithContext(ctx context.Context, virtualNetworkNames *[]string, subnetNames *[]string) error {
+	if err := locks.MultipleByNameWithContext(ctx, virtualNetworkNames, VirtualNetworkResourceName); err != nil {
+		return err
+	}
+
+	if err := locks.MultipleByNameWithContext(ctx, subnetNames, SubnetResourceName); err != nil {
+		locks.UnlockMultipleByName(virtualNetworkNames, VirtualNetworkResourceName)
+		return err
+	}
+
+	return nil
+}
+
+// unlockVirtualNetworksAndSubnets unlocks the specified Subnets and then the specified Virtual Networks
+func unlockVirtualNetworksAndSubnets(virtualNetworkNames *[]string, subnetNames *[]string) {
+	locks.UnlockMultipleByName(subnetNames, SubnetResourceName)
+	locks.UnlockMultipleByName(virtualNetworkNames, VirtualNetworkResourceName)
+}
@@ -0,0 +1,50 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-05-01/network"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestSubnetLockingOrderIsConsistent(t *testing.T) {
+	detector := locks.StartInversionDetection()
+
+	// Network Interfaces
+	details, err := determineResourcesToLockFromIPConfiguration(&[]network.InterfaceIPConfiguration{
+		{
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				Subnet: &network.Subnet{
+					ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"),
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("determining the resources to lock: %+v", err)
+	}
+	if err := details.lock(context.TODO()); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+	details.unlock()
+
+	// Subnets, Subnet Associations and Network Profiles
+	lockVirtualNetworksAndSubnets(&[]string{"network1"}, &[]string{"subnet1"})
+	unlockVirtualNetworksAndSubnets(&[]string{"network1"}, &[]string{"subnet1"})
+
+	if inversions := detector.Inversions(); len(inversions) != 0 {
+		t.Fatalf("expected no inversions but got %+v", inversions)
+	}
+
+	// locking these in the opposite order should be detected
+	locks.ByName("subnet1", SubnetResourceName)
+	locks.ByName("network1", VirtualNetworkResourceName)
+	locks.UnlockByName("network1", VirtualNetworkResourceName)
+	locks.UnlockByName("subnet1", SubnetResourceName)
+
+	if inversions := detector.Stop(); len(inversions) != 1 {
+		t.Fatalf("expected 1 inversion but got %+v", inversions)
+	}
+}
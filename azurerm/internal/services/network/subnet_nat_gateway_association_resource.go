@@ -75,10 +75,8 @@ func resourceSubnetNatGatewayAssociationCreate(d *schema.ResourceData, meta inte
 
 	locks.ByName(gatewayName, natGatewayResourceName)
 	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	lockVirtualNetworksAndSubnets(&[]string{virtualNetworkName}, &[]string{subnetName})
+	defer unlockVirtualNetworksAndSubnets(&[]string{virtualNetworkName}, &[]string{subnetName})
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
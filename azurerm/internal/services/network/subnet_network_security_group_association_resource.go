@@ -77,11 +77,8 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *schema.ResourceData,
 	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	lockVirtualNetworksAndSubnets(&[]string{virtualNetworkName}, &[]string{subnetName})
+	defer unlockVirtualNetworksAndSubnets(&[]string{virtualNetworkName}, &[]string{subnetName})
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -208,11 +205,8 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *schema.ResourceData,
 	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	lockVirtualNetworksAndSubnets(&[]string{virtualNetworkName}, &[]string{subnetName})
+	defer unlockVirtualNetworksAndSubnets(&[]string{virtualNetworkName}, &[]string{subnetName})
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
@@ -397,11 +397,8 @@ func resourceSubnetDelete(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	lockVirtualNetworksAndSubnets(&[]string{id.VirtualNetworkName}, &[]string{id.Name})
+	defer unlockVirtualNetworksAndSubnets(&[]string{id.VirtualNetworkName}, &[]string{id.Name})
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 	if err != nil {
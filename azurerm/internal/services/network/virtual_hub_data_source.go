@@ -79,5 +79,5 @@ func dataSourceVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("virtual_wan_id", virtualWanId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -141,7 +141,7 @@ func resourceVirtualHubCreateUpdate(d *schema.ResourceData, meta interface{}) er
 		VirtualHubProperties: &network.VirtualHubProperties{
 			RouteTable: expandVirtualHubRoute(route),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if v, ok := d.GetOk("address_prefix"); ok {
@@ -235,7 +235,7 @@ func resourceVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("virtual_wan_id", virtualWanId)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualHubDelete(d *schema.ResourceData, meta interface{}) error {
@@ -442,7 +442,7 @@ func resourceVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	gateway := network.VirtualNetworkGateway{
 		Name:                                  &name,
 		Location:                              &location,
-		Tags:                                  tags.Expand(t),
+		Tags:                                  tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		VirtualNetworkGatewayPropertiesFormat: properties,
 	}
 
@@ -525,7 +525,7 @@ func resourceVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualNetworkGatewayDelete(d *schema.ResourceData, meta interface{}) error {
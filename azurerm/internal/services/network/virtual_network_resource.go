@@ -181,7 +181,7 @@ func resourceVirtualNetworkCreateUpdate(d *schema.ResourceData, meta interface{}
 		Name:                           utils.String(id.Name),
 		Location:                       utils.String(location),
 		VirtualNetworkPropertiesFormat: vnetProperties,
-		Tags:                           tags.Expand(t),
+		Tags:                           tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	networkSecurityGroupNames := make([]string, 0)
@@ -273,7 +273,7 @@ func resourceVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("vm_protection_enabled", props.EnableVMProtection)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
@@ -113,7 +113,7 @@ func dataSourceVirtualWanRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenVirtualWanProperties(input *[]network.SubResource) []interface{} {
@@ -493,7 +493,7 @@ func resourceVPNServerConfigurationCreateUpdate(d *schema.ResourceData, meta int
 	parameters := network.VpnServerConfiguration{
 		Location:                         utils.String(location),
 		VpnServerConfigurationProperties: &props,
-		Tags:                             tags.Expand(t),
+		Tags:                             tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
@@ -593,7 +593,7 @@ func resourceVPNServerConfigurationRead(d *schema.ResourceData, meta interface{}
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVPNServerConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
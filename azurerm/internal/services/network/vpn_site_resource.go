@@ -177,7 +177,7 @@ func resourceVpnSiteCreateUpdate(d *schema.ResourceData, meta interface{}) error
 			AddressSpace:     expandVpnSiteAddressSpace(d.Get("address_cidrs").(*schema.Set).List()),
 			VpnSiteLinks:     expandVpnSiteLinks(d.Get("link").([]interface{})),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, param)
@@ -250,7 +250,7 @@ func resourceVpnSiteRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceVpnSiteDelete(d *schema.ResourceData, meta interface{}) error {
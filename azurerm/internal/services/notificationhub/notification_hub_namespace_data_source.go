@@ -100,7 +100,7 @@ func resourceArmDataSourceNotificationHubNamespaceRead(d *schema.ResourceData, m
 		d.Set("servicebus_endpoint", props.ServiceBusEndpoint)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func flattenNotificationHubDataSourceNamespacesSku(input *notificationhubs.Sku) []interface{} {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = NamespaceId{}
+var _ resourceid.SegmentedID = NamespaceId{}
 
 func TestNamespaceIDFormatter(t *testing.T) {
 	actual := NewNamespaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1").ID()
@@ -18,6 +19,43 @@ func TestNamespaceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNamespaceIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NOTIFICATIONHUBS/NAMESPACES/namespace1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.NotificationHubs/namespaces/namespace1other",
+			Expected: false,
+		},
+	}
+
+	id := NewNamespaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestNamespaceID(t *testing.T) {
 	testData := []struct {
 		Input    string
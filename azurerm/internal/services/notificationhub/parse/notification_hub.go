@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NotificationHubId struct {
@@ -25,6 +25,16 @@ func NewNotificationHubID(subscriptionId, resourceGroup, namespaceName, name str
 	}
 }
 
+// NewNotificationHubIDFromParent returns a NotificationHubId for the NotificationHub within the specified Namespace
+func NewNotificationHubIDFromParent(parent NamespaceId, name string) NotificationHubId {
+	return NotificationHubId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		NamespaceName:  parent.Name,
+		Name:           name,
+	}
+}
+
 func (id NotificationHubId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id NotificationHubId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this NotificationHub
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id NotificationHubId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this NotificationHub ID
+func (id NotificationHubId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNotificationHubs", "Microsoft.NotificationHubs"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticNotificationHubs", "notificationHubs", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // NotificationHubID parses a NotificationHub ID into an NotificationHubId struct
 func NotificationHubID(input string) (*NotificationHubId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, NotificationHubId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NotificationHubId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("notificationHubs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		NamespaceName:  values["namespaceName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
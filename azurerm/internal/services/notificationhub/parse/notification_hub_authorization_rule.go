@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NotificationHubAuthorizationRuleId struct {
@@ -27,6 +27,17 @@ func NewNotificationHubAuthorizationRuleID(subscriptionId, resourceGroup, namesp
 	}
 }
 
+// NewNotificationHubAuthorizationRuleIDFromParent returns a NotificationHubAuthorizationRuleId for the NotificationHubAuthorizationRule within the specified NotificationHub
+func NewNotificationHubAuthorizationRuleIDFromParent(parent NotificationHubId, authorizationRuleName string) NotificationHubAuthorizationRuleId {
+	return NotificationHubAuthorizationRuleId{
+		SubscriptionId:        parent.SubscriptionId,
+		ResourceGroup:         parent.ResourceGroup,
+		NamespaceName:         parent.NamespaceName,
+		NotificationHubName:   parent.Name,
+		AuthorizationRuleName: authorizationRuleName,
+	}
+}
+
 func (id NotificationHubAuthorizationRuleId) String() string {
 	segments := []string{
 		fmt.Sprintf("Authorization Rule Name %q", id.AuthorizationRuleName),
@@ -43,38 +54,43 @@ func (id NotificationHubAuthorizationRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.NotificationHubName, id.AuthorizationRuleName)
 }
 
+// Matches returns whether the specified Resource ID refers to this NotificationHubAuthorizationRule
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id NotificationHubAuthorizationRuleId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this NotificationHubAuthorizationRule ID
+func (id NotificationHubAuthorizationRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNotificationHubs", "Microsoft.NotificationHubs"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticNotificationHubs", "notificationHubs", false),
+		resourceid.UserSpecifiedSegment("notificationHubName"),
+		resourceid.StaticSegment("staticAuthorizationRules", "AuthorizationRules", false),
+		resourceid.UserSpecifiedSegment("authorizationRuleName"),
+	}
+}
+
 // NotificationHubAuthorizationRuleID parses a NotificationHubAuthorizationRule ID into an NotificationHubAuthorizationRuleId struct
 func NotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, NotificationHubAuthorizationRuleId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NotificationHubAuthorizationRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.NotificationHubName, err = id.PopSegment("notificationHubs"); err != nil {
-		return nil, err
-	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment("AuthorizationRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        values["subscriptionId"],
+		ResourceGroup:         values["resourceGroup"],
+		NamespaceName:         values["namespaceName"],
+		NotificationHubName:   values["notificationHubName"],
+		AuthorizationRuleName: values["authorizationRuleName"],
 	}
 
 	return &resourceId, nil
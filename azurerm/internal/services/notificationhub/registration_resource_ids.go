@@ -0,0 +1,35 @@
+package notificationhub
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/notificationhub/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.NotificationHubs/namespaces/notificationHubs",
+			TerraformResourceType: "azurerm_notification_hub",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NotificationHubID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.NotificationHubs/namespaces/notificationHubs/AuthorizationRules",
+			TerraformResourceType: "azurerm_notification_hub_authorization_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NotificationHubAuthorizationRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.NotificationHubs/namespaces",
+			TerraformResourceType: "azurerm_notification_hub_namespace",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NamespaceID(input)
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DashboardId{}
+var _ resourceid.SegmentedID = DashboardId{}
 
 func TestDashboardIDFormatter(t *testing.T) {
 	actual := NewDashboardID("12345678-1234-9876-4563-123456789012", "group1", "dashboard1").ID()
@@ -18,6 +19,43 @@ func TestDashboardIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDashboardIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Portal/dashboards/dashboard1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.PORTAL/DASHBOARDS/dashboard1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Portal/dashboards/dashboard1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDashboardID("12345678-1234-9876-4563-123456789012", "group1", "dashboard1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDashboardID(t *testing.T) {
 	testData := []struct {
 		Input    string
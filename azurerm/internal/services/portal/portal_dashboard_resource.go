@@ -73,7 +73,7 @@ func resourceDashboardCreateUpdate(d *schema.ResourceData, meta interface{}) err
 
 	dashboard := portal.Dashboard{
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	var dashboardProperties portal.DashboardProperties
@@ -123,7 +123,7 @@ func resourceDashboardRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.Set("dashboard_properties", string(props))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceDashboardDelete(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,21 @@
+package portal
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/portal/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Portal/dashboards",
+			TerraformResourceType: "azurerm_dashboard",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DashboardID(input)
+			},
+		},
+	}
+}
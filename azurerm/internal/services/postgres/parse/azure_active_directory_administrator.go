@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AzureActiveDirectoryAdministratorId struct {
@@ -25,6 +25,16 @@ func NewAzureActiveDirectoryAdministratorID(subscriptionId, resourceGroup, serve
 	}
 }
 
+// NewAzureActiveDirectoryAdministratorIDFromParent returns a AzureActiveDirectoryAdministratorId for the AzureActiveDirectoryAdministrator within the specified Server
+func NewAzureActiveDirectoryAdministratorIDFromParent(parent ServerId, administratorName string) AzureActiveDirectoryAdministratorId {
+	return AzureActiveDirectoryAdministratorId{
+		SubscriptionId:    parent.SubscriptionId,
+		ResourceGroup:     parent.ResourceGroup,
+		ServerName:        parent.Name,
+		AdministratorName: administratorName,
+	}
+}
+
 func (id AzureActiveDirectoryAdministratorId) String() string {
 	segments := []string{
 		fmt.Sprintf("Administrator Name %q", id.AdministratorName),
@@ -40,35 +50,40 @@ func (id AzureActiveDirectoryAdministratorId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.AdministratorName)
 }
 
+// Matches returns whether the specified Resource ID refers to this AzureActiveDirectoryAdministrator
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id AzureActiveDirectoryAdministratorId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this AzureActiveDirectoryAdministrator ID
+func (id AzureActiveDirectoryAdministratorId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDBforPostgreSQL", "Microsoft.DBforPostgreSQL"),
+		resourceid.StaticSegment("staticServers", "servers", false),
+		resourceid.UserSpecifiedSegment("serverName"),
+		resourceid.StaticSegment("staticAdministrators", "administrators", false),
+		resourceid.UserSpecifiedSegment("administratorName"),
+	}
+}
+
 // AzureActiveDirectoryAdministratorID parses a AzureActiveDirectoryAdministrator ID into an AzureActiveDirectoryAdministratorId struct
 func AzureActiveDirectoryAdministratorID(input string) (*AzureActiveDirectoryAdministratorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, AzureActiveDirectoryAdministratorId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AzureActiveDirectoryAdministratorId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.AdministratorName, err = id.PopSegment("administrators"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:    values["subscriptionId"],
+		ResourceGroup:     values["resourceGroup"],
+		ServerName:        values["serverName"],
+		AdministratorName: values["administratorName"],
 	}
 
 	return &resourceId, nil
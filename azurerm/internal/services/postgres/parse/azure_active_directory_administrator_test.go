@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AzureActiveDirectoryAdministratorId{}
+var _ resourceid.SegmentedID = AzureActiveDirectoryAdministratorId{}
 
 func TestAzureActiveDirectoryAdministratorIDFormatter(t *testing.T) {
 	actual := NewAzureActiveDirectoryAdministratorID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1", "activeDirectory").ID()
@@ -18,6 +19,52 @@ func TestAzureActiveDirectoryAdministratorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAzureActiveDirectoryAdministratorIDFromParent(t *testing.T) {
+	parent := NewServerID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1")
+	actual := NewAzureActiveDirectoryAdministratorIDFromParent(parent, "activeDirectory").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/administrators/activeDirectory"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestAzureActiveDirectoryAdministratorIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/administrators/activeDirectory",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.DBFORPOSTGRESQL/SERVERS/server1/ADMINISTRATORS/activeDirectory",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.DBforPostgreSQL/servers/server1/administrators/activeDirectoryother",
+			Expected: false,
+		},
+	}
+
+	id := NewAzureActiveDirectoryAdministratorID("12345678-1234-9876-4563-123456789012", "resGroup1", "server1", "activeDirectory")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAzureActiveDirectoryAdministratorID(t *testing.T) {
 	testData := []struct {
 		Input    string
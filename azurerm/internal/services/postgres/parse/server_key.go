@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ServerKeyId struct {
@@ -25,6 +25,16 @@ func NewServerKeyID(subscriptionId, resourceGroup, serverName, keyName string) S
 	}
 }
 
+// NewServerKeyIDFromParent returns a ServerKeyId for the ServerKey within the specified Server
+func NewServerKeyIDFromParent(parent ServerId, keyName string) ServerKeyId {
+	return ServerKeyId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		ServerName:     parent.Name,
+		KeyName:        keyName,
+	}
+}
+
 func (id ServerKeyId) String() string {
 	segments := []string{
 		fmt.Sprintf("Key Name %q", id.KeyName),
@@ -40,35 +50,40 @@ func (id ServerKeyId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServerName, id.KeyName)
 }
 
+// Matches returns whether the specified Resource ID refers to this ServerKey
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ServerKeyId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ServerKey ID
+func (id ServerKeyId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftDBforPostgreSQL", "Microsoft.DBforPostgreSQL"),
+		resourceid.StaticSegment("staticServers", "servers", false),
+		resourceid.UserSpecifiedSegment("serverName"),
+		resourceid.StaticSegment("staticKeys", "keys", false),
+		resourceid.UserSpecifiedSegment("keyName"),
+	}
+}
+
 // ServerKeyID parses a ServerKey ID into an ServerKeyId struct
 func ServerKeyID(input string) (*ServerKeyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ServerKeyId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ServerKeyId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
-		return nil, err
-	}
-	if resourceId.KeyName, err = id.PopSegment("keys"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		ServerName:     values["serverName"],
+		KeyName:        values["keyName"],
 	}
 
 	return &resourceId, nil
@@ -122,5 +122,5 @@ func dataSourcePostgreSqlServerRead(d *schema.ResourceData, meta interface{}) er
 		d.Set("sku_name", sku.Name)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
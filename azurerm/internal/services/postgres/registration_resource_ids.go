@@ -0,0 +1,63 @@
+package postgres
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/postgres/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.DBforPostgreSQL/servers/administrators",
+			TerraformResourceType: "azurerm_postgresql_active_directory_administrator",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AzureActiveDirectoryAdministratorID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DBforPostgreSQL/servers/configurations",
+			TerraformResourceType: "azurerm_postgresql_configuration",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ConfigurationID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DBforPostgreSQL/servers/databases",
+			TerraformResourceType: "azurerm_postgresql_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabaseID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DBforPostgreSQL/servers/firewallRules",
+			TerraformResourceType: "azurerm_postgresql_firewall_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DBforPostgreSQL/servers",
+			TerraformResourceType: "azurerm_postgresql_server",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServerID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DBforPostgreSQL/servers/keys",
+			TerraformResourceType: "azurerm_postgresql_server_key",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServerKeyID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.DBforPostgreSQL/servers/virtualNetworkRules",
+			TerraformResourceType: "azurerm_postgresql_virtual_network_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualNetworkRuleID(input)
+			},
+		},
+	}
+}
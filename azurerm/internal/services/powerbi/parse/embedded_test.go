@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = EmbeddedId{}
+var _ resourceid.SegmentedID = EmbeddedId{}
 
 func TestEmbeddedIDFormatter(t *testing.T) {
 	actual := NewEmbeddedID("12345678-1234-9876-4563-123456789012", "resGroup1", "capacity1").ID()
@@ -18,6 +19,43 @@ func TestEmbeddedIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEmbeddedIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PowerBIDedicated/capacities/capacity1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.POWERBIDEDICATED/CAPACITIES/capacity1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.PowerBIDedicated/capacities/capacity1other",
+			Expected: false,
+		},
+	}
+
+	id := NewEmbeddedID("12345678-1234-9876-4563-123456789012", "resGroup1", "capacity1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestEmbeddedID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AaaaRecordId struct {
@@ -25,6 +25,16 @@ func NewAaaaRecordID(subscriptionId, resourceGroup, privateDnsZoneName, aAAAName
 	}
 }
 
+// NewAaaaRecordIDFromParent returns a AaaaRecordId for the AaaaRecord within the specified PrivateDnsZone
+func NewAaaaRecordIDFromParent(parent PrivateDnsZoneId, aAAAName string) AaaaRecordId {
+	return AaaaRecordId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		PrivateDnsZoneName: parent.Name,
+		AAAAName:           aAAAName,
+	}
+}
+
 func (id AaaaRecordId) String() string {
 	segments := []string{
 		fmt.Sprintf("A A A A Name %q", id.AAAAName),
@@ -40,35 +50,40 @@ func (id AaaaRecordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateDnsZoneName, id.AAAAName)
 }
 
+// Matches returns whether the specified Resource ID refers to this AaaaRecord
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id AaaaRecordId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this AaaaRecord ID
+func (id AaaaRecordId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticPrivateDnsZones", "privateDnsZones", false),
+		resourceid.UserSpecifiedSegment("privateDnsZoneName"),
+		resourceid.StaticSegment("staticAAAA", "AAAA", false),
+		resourceid.UserSpecifiedSegment("aAAAName"),
+	}
+}
+
 // AaaaRecordID parses a AaaaRecord ID into an AaaaRecordId struct
 func AaaaRecordID(input string) (*AaaaRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, AaaaRecordId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AaaaRecordId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.PrivateDnsZoneName, err = id.PopSegment("privateDnsZones"); err != nil {
-		return nil, err
-	}
-	if resourceId.AAAAName, err = id.PopSegment("AAAA"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		PrivateDnsZoneName: values["privateDnsZoneName"],
+		AAAAName:           values["aAAAName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PtrRecordId struct {
@@ -25,6 +25,16 @@ func NewPtrRecordID(subscriptionId, resourceGroup, privateDnsZoneName, pTRName s
 	}
 }
 
+// NewPtrRecordIDFromParent returns a PtrRecordId for the PtrRecord within the specified PrivateDnsZone
+func NewPtrRecordIDFromParent(parent PrivateDnsZoneId, pTRName string) PtrRecordId {
+	return PtrRecordId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		PrivateDnsZoneName: parent.Name,
+		PTRName:            pTRName,
+	}
+}
+
 func (id PtrRecordId) String() string {
 	segments := []string{
 		fmt.Sprintf("P T R Name %q", id.PTRName),
@@ -40,35 +50,40 @@ func (id PtrRecordId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.PrivateDnsZoneName, id.PTRName)
 }
 
+// Matches returns whether the specified Resource ID refers to this PtrRecord
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id PtrRecordId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this PtrRecord ID
+func (id PtrRecordId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticPrivateDnsZones", "privateDnsZones", false),
+		resourceid.UserSpecifiedSegment("privateDnsZoneName"),
+		resourceid.StaticSegment("staticPTR", "PTR", false),
+		resourceid.UserSpecifiedSegment("pTRName"),
+	}
+}
+
 // PtrRecordID parses a PtrRecord ID into an PtrRecordId struct
 func PtrRecordID(input string) (*PtrRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, PtrRecordId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := PtrRecordId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.PrivateDnsZoneName, err = id.PopSegment("privateDnsZones"); err != nil {
-		return nil, err
-	}
-	if resourceId.PTRName, err = id.PopSegment("PTR"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		PrivateDnsZoneName: values["privateDnsZoneName"],
+		PTRName:            values["pTRName"],
 	}
 
 	return &resourceId, nil
@@ -97,7 +97,7 @@ func resourcePrivateDnsAaaaRecordCreateUpdate(d *schema.ResourceData, meta inter
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.AAAAName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata:    tags.Expand(t),
+			Metadata:    tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:         &ttl,
 			AaaaRecords: expandAzureRmPrivateDnsAaaaRecords(d),
 		},
@@ -142,7 +142,7 @@ func resourcePrivateDnsAaaaRecordRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("records", flattenAzureRmPrivateDnsAaaaRecords(resp.AaaaRecords)); err != nil {
 		return err
 	}
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourcePrivateDnsAaaaRecordDelete(d *schema.ResourceData, meta interface{}) error {
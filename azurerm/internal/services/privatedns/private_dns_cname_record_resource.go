@@ -104,7 +104,7 @@ func resourcePrivateDnsCNameRecordCreateUpdate(d *schema.ResourceData, meta inte
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.CNAMEName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata: tags.Expand(t),
+			Metadata: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:      &ttl,
 			CnameRecord: &privatedns.CnameRecord{
 				Cname: &record,
@@ -155,7 +155,7 @@ func resourcePrivateDnsCNameRecordRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourcePrivateDnsCNameRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -104,7 +104,7 @@ func resourcePrivateDnsPtrRecordCreateUpdate(d *schema.ResourceData, meta interf
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.PTRName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:        &ttl,
 			PtrRecords: expandAzureRmPrivateDnsPtrRecords(d),
 		},
@@ -152,7 +152,7 @@ func resourcePrivateDnsPtrRecordRead(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourcePrivateDnsPtrRecordDelete(d *schema.ResourceData, meta interface{}) error {
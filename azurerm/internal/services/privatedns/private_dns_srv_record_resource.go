@@ -129,7 +129,7 @@ func resourcePrivateDnsSrvRecordCreateUpdate(d *schema.ResourceData, meta interf
 	parameters := privatedns.RecordSet{
 		Name: utils.String(resourceId.SRVName),
 		RecordSetProperties: &privatedns.RecordSetProperties{
-			Metadata:   tags.Expand(t),
+			Metadata:   tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 			TTL:        &ttl,
 			SrvRecords: expandAzureRmPrivateDnsSrvRecords(d),
 		},
@@ -173,7 +173,7 @@ func resourcePrivateDnsSrvRecordRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Metadata)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Metadata)
 }
 
 func resourcePrivateDnsSrvRecordDelete(d *schema.ResourceData, meta interface{}) error {
@@ -108,7 +108,7 @@ func dataSourcePrivateDnsZoneRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("max_number_of_virtual_network_links_with_registration", props.MaxNumberOfVirtualNetworkLinksWithRegistration)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 type privateDnsZone struct {
@@ -0,0 +1,77 @@
+package privatedns
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Network/privateDnsZones/A",
+			TerraformResourceType: "azurerm_private_dns_a_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ARecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/privateDnsZones/AAAA",
+			TerraformResourceType: "azurerm_private_dns_aaaa_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AaaaRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/privateDnsZones/CNAME",
+			TerraformResourceType: "azurerm_private_dns_cname_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CnameRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/privateDnsZones/MX",
+			TerraformResourceType: "azurerm_private_dns_mx_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.MxRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/privateDnsZones/PTR",
+			TerraformResourceType: "azurerm_private_dns_ptr_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PtrRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/privateDnsZones/SRV",
+			TerraformResourceType: "azurerm_private_dns_srv_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SrvRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/privateDnsZones/TXT",
+			TerraformResourceType: "azurerm_private_dns_txt_record",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.TxtRecordID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/privateDnsZones",
+			TerraformResourceType: "azurerm_private_dns_zone",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.PrivateDnsZoneID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Network/privateDnsZones/virtualNetworkLinks",
+			TerraformResourceType: "azurerm_private_dns_zone_virtual_network_link",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualNetworkLinkID(input)
+			},
+		},
+	}
+}
@@ -64,5 +64,5 @@ func dataSourceBackupPolicyVmRead(d *schema.ResourceData, meta interface{}) erro
 	id := strings.Replace(*protectionPolicy.ID, "Subscriptions", "subscriptions", 1)
 	d.SetId(id)
 
-	return tags.FlattenAndSet(d, protectionPolicy.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, protectionPolicy.Tags)
 }
@@ -344,7 +344,7 @@ func resourceBackupProtectionPolicyVMCreateUpdate(d *schema.ResourceData, meta i
 	}
 
 	policy := backup.ProtectionPolicyResource{
-		Tags:       tags.Expand(t),
+		Tags:       tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		Properties: vmProtectionPolicyProperties,
 	}
 
@@ -438,7 +438,7 @@ func resourceBackupProtectionPolicyVMRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceBackupProtectionPolicyVMDelete(d *schema.ResourceData, meta interface{}) error {
@@ -69,5 +69,5 @@ func dataSourceRecoveryServicesVaultRead(d *schema.ResourceData, meta interface{
 		d.Set("sku", string(sku.Name))
 	}
 
-	return tags.FlattenAndSet(d, vault.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, vault.Tags)
 }
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = CacheId{}
+var _ resourceid.SegmentedID = CacheId{}
 
 func TestCacheIDFormatter(t *testing.T) {
 	actual := NewCacheID("12345678-1234-9876-4563-123456789012", "resGroup1", "redis1").ID()
@@ -18,6 +19,43 @@ func TestCacheIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCacheIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/redis1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.CACHE/REDIS/redis1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/redis1other",
+			Expected: false,
+		},
+	}
+
+	id := NewCacheID("12345678-1234-9876-4563-123456789012", "resGroup1", "redis1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestCacheID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = FirewallRuleId{}
+var _ resourceid.SegmentedID = FirewallRuleId{}
 
 func TestFirewallRuleIDFormatter(t *testing.T) {
 	actual := NewFirewallRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "redis1", "firewallRule1").ID()
@@ -18,6 +19,52 @@ func TestFirewallRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFirewallRuleIDFromParent(t *testing.T) {
+	parent := NewCacheID("12345678-1234-9876-4563-123456789012", "resGroup1", "redis1")
+	actual := NewFirewallRuleIDFromParent(parent, "firewallRule1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/redis1/firewallRules/firewallRule1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestFirewallRuleIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/redis1/firewallRules/firewallRule1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.CACHE/REDIS/redis1/FIREWALLRULES/firewallRule1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/redis1/firewallRules/firewallRule1other",
+			Expected: false,
+		},
+	}
+
+	id := NewFirewallRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "redis1", "firewallRule1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestFirewallRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type LinkedServerId struct {
@@ -25,6 +25,16 @@ func NewLinkedServerID(subscriptionId, resourceGroup, rediName, name string) Lin
 	}
 }
 
+// NewLinkedServerIDFromParent returns a LinkedServerId for the LinkedServer within the specified Cache
+func NewLinkedServerIDFromParent(parent CacheId, name string) LinkedServerId {
+	return LinkedServerId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		RediName:       parent.RediName,
+		Name:           name,
+	}
+}
+
 func (id LinkedServerId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id LinkedServerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RediName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this LinkedServer
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id LinkedServerId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this LinkedServer ID
+func (id LinkedServerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftCache", "Microsoft.Cache"),
+		resourceid.StaticSegment("staticRedis", "Redis", false),
+		resourceid.UserSpecifiedSegment("rediName"),
+		resourceid.StaticSegment("staticLinkedServers", "linkedServers", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // LinkedServerID parses a LinkedServer ID into an LinkedServerId struct
 func LinkedServerID(input string) (*LinkedServerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, LinkedServerId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := LinkedServerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.RediName, err = id.PopSegment("Redis"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("linkedServers"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		RediName:       values["rediName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
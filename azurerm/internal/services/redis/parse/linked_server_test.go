@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = LinkedServerId{}
+var _ resourceid.SegmentedID = LinkedServerId{}
 
 func TestLinkedServerIDFormatter(t *testing.T) {
 	actual := NewLinkedServerID("12345678-1234-9876-4563-123456789012", "resGroup1", "redis1", "linkedServer1").ID()
@@ -18,6 +19,52 @@ func TestLinkedServerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestLinkedServerIDFromParent(t *testing.T) {
+	parent := NewCacheID("12345678-1234-9876-4563-123456789012", "resGroup1", "redis1")
+	actual := NewLinkedServerIDFromParent(parent, "linkedServer1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/redis1/linkedServers/linkedServer1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestLinkedServerIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/redis1/linkedServers/linkedServer1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.CACHE/REDIS/redis1/LINKEDSERVERS/linkedServer1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Cache/Redis/redis1/linkedServers/linkedServer1other",
+			Expected: false,
+		},
+	}
+
+	id := NewLinkedServerID("12345678-1234-9876-4563-123456789012", "resGroup1", "redis1", "linkedServer1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestLinkedServerID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,35 @@
+package redis
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redis/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Cache/Redis",
+			TerraformResourceType: "azurerm_redis_cache",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CacheID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Cache/Redis/firewallRules",
+			TerraformResourceType: "azurerm_redis_firewall_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Cache/Redis/linkedServers",
+			TerraformResourceType: "azurerm_redis_linked_server",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.LinkedServerID(input)
+			},
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = RedisEnterpriseClusterId{}
+var _ resourceid.SegmentedID = RedisEnterpriseClusterId{}
 
 func TestRedisEnterpriseClusterIDFormatter(t *testing.T) {
 	actual := NewRedisEnterpriseClusterID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "cluster1").ID()
@@ -18,6 +19,43 @@ func TestRedisEnterpriseClusterIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRedisEnterpriseClusterIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Cache/redisEnterprise/cluster1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resourceGroup1/PROVIDERS/MICROSOFT.CACHE/REDISENTERPRISE/cluster1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Cache/redisEnterprise/cluster1other",
+			Expected: false,
+		},
+	}
+
+	id := NewRedisEnterpriseClusterID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "cluster1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestRedisEnterpriseClusterID(t *testing.T) {
 	testData := []struct {
 		Input    string
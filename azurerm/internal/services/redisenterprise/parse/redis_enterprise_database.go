@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RedisEnterpriseDatabaseId struct {
@@ -25,6 +25,16 @@ func NewRedisEnterpriseDatabaseID(subscriptionId, resourceGroup, redisEnterprise
 	}
 }
 
+// NewRedisEnterpriseDatabaseIDFromParent returns a RedisEnterpriseDatabaseId for the RedisEnterpriseDatabase within the specified RedisEnterpriseCluster
+func NewRedisEnterpriseDatabaseIDFromParent(parent RedisEnterpriseClusterId, databaseName string) RedisEnterpriseDatabaseId {
+	return RedisEnterpriseDatabaseId{
+		SubscriptionId:      parent.SubscriptionId,
+		ResourceGroup:       parent.ResourceGroup,
+		RedisEnterpriseName: parent.RedisEnterpriseName,
+		DatabaseName:        databaseName,
+	}
+}
+
 func (id RedisEnterpriseDatabaseId) String() string {
 	segments := []string{
 		fmt.Sprintf("Database Name %q", id.DatabaseName),
@@ -40,35 +50,40 @@ func (id RedisEnterpriseDatabaseId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.RedisEnterpriseName, id.DatabaseName)
 }
 
+// Matches returns whether the specified Resource ID refers to this RedisEnterpriseDatabase
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id RedisEnterpriseDatabaseId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this RedisEnterpriseDatabase ID
+func (id RedisEnterpriseDatabaseId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftCache", "Microsoft.Cache"),
+		resourceid.StaticSegment("staticRedisEnterprise", "redisEnterprise", false),
+		resourceid.UserSpecifiedSegment("redisEnterpriseName"),
+		resourceid.StaticSegment("staticDatabases", "databases", false),
+		resourceid.UserSpecifiedSegment("databaseName"),
+	}
+}
+
 // RedisEnterpriseDatabaseID parses a RedisEnterpriseDatabase ID into an RedisEnterpriseDatabaseId struct
 func RedisEnterpriseDatabaseID(input string) (*RedisEnterpriseDatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, RedisEnterpriseDatabaseId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := RedisEnterpriseDatabaseId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.RedisEnterpriseName, err = id.PopSegment("redisEnterprise"); err != nil {
-		return nil, err
-	}
-	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:      values["subscriptionId"],
+		ResourceGroup:       values["resourceGroup"],
+		RedisEnterpriseName: values["redisEnterpriseName"],
+		DatabaseName:        values["databaseName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = RedisEnterpriseDatabaseId{}
+var _ resourceid.SegmentedID = RedisEnterpriseDatabaseId{}
 
 func TestRedisEnterpriseDatabaseIDFormatter(t *testing.T) {
 	actual := NewRedisEnterpriseDatabaseID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "cluster1", "database1").ID()
@@ -18,6 +19,52 @@ func TestRedisEnterpriseDatabaseIDFormatter(t *testing.T) {
 	}
 }
 
+func TestRedisEnterpriseDatabaseIDFromParent(t *testing.T) {
+	parent := NewRedisEnterpriseClusterID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "cluster1")
+	actual := NewRedisEnterpriseDatabaseIDFromParent(parent, "database1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Cache/redisEnterprise/cluster1/databases/database1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestRedisEnterpriseDatabaseIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Cache/redisEnterprise/cluster1/databases/database1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resourceGroup1/PROVIDERS/MICROSOFT.CACHE/REDISENTERPRISE/cluster1/DATABASES/database1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resourceGroup1/providers/Microsoft.Cache/redisEnterprise/cluster1/databases/database1other",
+			Expected: false,
+		},
+	}
+
+	id := NewRedisEnterpriseDatabaseID("12345678-1234-9876-4563-123456789012", "resourceGroup1", "cluster1", "database1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestRedisEnterpriseDatabaseID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -144,7 +144,7 @@ func resourceRedisEnterpriseClusterCreate(d *schema.ResourceData, meta interface
 		Name:     utils.String(d.Get("name").(string)),
 		Location: utils.String(location),
 		Sku:      sku,
-		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	if v, ok := d.GetOk("zones"); ok {
@@ -228,7 +228,7 @@ func resourceRedisEnterpriseClusterRead(d *schema.ResourceData, meta interface{}
 		// d.Set("minimum_tls_version", string(props.MinimumTLSVersion))
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceRedisEnterpriseClusterDelete(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,28 @@
+package redisenterprise
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/redisenterprise/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Cache/redisEnterprise",
+			TerraformResourceType: "azurerm_redis_enterprise_cluster",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.RedisEnterpriseClusterID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Cache/redisEnterprise/databases",
+			TerraformResourceType: "azurerm_redis_enterprise_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.RedisEnterpriseDatabaseID(input)
+			},
+		},
+	}
+}
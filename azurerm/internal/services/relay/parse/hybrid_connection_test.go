@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = HybridConnectionId{}
+var _ resourceid.SegmentedID = HybridConnectionId{}
 
 func TestHybridConnectionIDFormatter(t *testing.T) {
 	actual := NewHybridConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1", "hybridConnection1").ID()
@@ -18,6 +19,52 @@ func TestHybridConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHybridConnectionIDFromParent(t *testing.T) {
+	parent := NewNamespaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1")
+	actual := NewHybridConnectionIDFromParent(parent, "hybridConnection1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Relay/namespaces/namespace1/hybridConnections/hybridConnection1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestHybridConnectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Relay/namespaces/namespace1/hybridConnections/hybridConnection1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.RELAY/NAMESPACES/namespace1/HYBRIDCONNECTIONS/hybridConnection1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Relay/namespaces/namespace1/hybridConnections/hybridConnection1other",
+			Expected: false,
+		},
+	}
+
+	id := NewHybridConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1", "hybridConnection1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestHybridConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
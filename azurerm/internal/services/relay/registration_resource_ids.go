@@ -0,0 +1,28 @@
+package relay
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Relay/namespaces/hybridConnections",
+			TerraformResourceType: "azurerm_relay_hybrid_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.HybridConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Relay/namespaces",
+			TerraformResourceType: "azurerm_relay_namespace",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NamespaceID(input)
+			},
+		},
+	}
+}
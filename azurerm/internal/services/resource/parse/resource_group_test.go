@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ResourceGroupId{}
+var _ resourceid.SegmentedID = ResourceGroupId{}
 
 func TestResourceGroupIDFormatter(t *testing.T) {
 	actual := NewResourceGroupID("12345678-1234-9876-4563-123456789012", "group1").ID()
@@ -18,6 +19,43 @@ func TestResourceGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestResourceGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1other",
+			Expected: false,
+		},
+	}
+
+	id := NewResourceGroupID("12345678-1234-9876-4563-123456789012", "group1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestResourceGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubscriptionTemplateDeploymentId struct {
@@ -34,27 +34,34 @@ func (id SubscriptionTemplateDeploymentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.DeploymentName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SubscriptionTemplateDeployment
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SubscriptionTemplateDeploymentId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SubscriptionTemplateDeployment ID
+func (id SubscriptionTemplateDeploymentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftResources", "Microsoft.Resources"),
+		resourceid.StaticSegment("staticDeployments", "deployments", false),
+		resourceid.UserSpecifiedSegment("deploymentName"),
+	}
+}
+
 // SubscriptionTemplateDeploymentID parses a SubscriptionTemplateDeployment ID into an SubscriptionTemplateDeploymentId struct
 func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploymentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SubscriptionTemplateDeploymentId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubscriptionTemplateDeploymentId{
-		SubscriptionId: id.SubscriptionID,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.DeploymentName, err = id.PopSegment("deployments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		DeploymentName: values["deploymentName"],
 	}
 
 	return &resourceId, nil
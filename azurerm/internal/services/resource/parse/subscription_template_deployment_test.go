@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SubscriptionTemplateDeploymentId{}
+var _ resourceid.SegmentedID = SubscriptionTemplateDeploymentId{}
 
 func TestSubscriptionTemplateDeploymentIDFormatter(t *testing.T) {
 	actual := NewSubscriptionTemplateDeploymentID("12345678-1234-9876-4563-123456789012", "deploy1").ID()
@@ -18,6 +19,43 @@ func TestSubscriptionTemplateDeploymentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSubscriptionTemplateDeploymentIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/deploy1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.RESOURCES/DEPLOYMENTS/deploy1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/deploy1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSubscriptionTemplateDeploymentID("12345678-1234-9876-4563-123456789012", "deploy1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSubscriptionTemplateDeploymentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,35 @@
+package resource
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Resources/resourceGroups",
+			TerraformResourceType: "azurerm_resource_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ResourceGroupID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Resources/deployments",
+			TerraformResourceType: "azurerm_resource_group_template_deployment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ResourceGroupTemplateDeploymentID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Resources/deployments",
+			TerraformResourceType: "azurerm_subscription_template_deployment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SubscriptionTemplateDeploymentID(input)
+			},
+		},
+	}
+}
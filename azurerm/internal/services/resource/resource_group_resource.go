@@ -71,7 +71,7 @@ func resourceResourceGroupCreateUpdate(d *schema.ResourceData, meta interface{})
 
 	parameters := resources.Group{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.CreateOrUpdate(ctx, name, parameters); err != nil {
@@ -111,7 +111,7 @@ func resourceResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("name", resp.Name)
 	d.Set("location", location.NormalizeNilable(resp.Location))
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
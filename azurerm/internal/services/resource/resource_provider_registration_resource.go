@@ -92,7 +92,7 @@ func (r ResourceProviderRegistrationResource) Create() sdk.ResourceFunc {
 			stateConf := &resource.StateChangeConf{
 				Pending:      []string{"Processing"},
 				Target:       []string{"Registered"},
-				Refresh:      r.registerRefreshFunc(ctx, client, resourceId.ResourceProvider),
+				Refresh:      metadata.RefreshFuncWithAttempts(r.registerRefreshFunc(ctx, client, resourceId.ResourceProvider)),
 				MinTimeout:   15 * time.Second,
 				PollInterval: 30 * time.Second,
 				Timeout:      metadata.ResourceData.Timeout(schema.TimeoutCreate),
@@ -170,7 +170,7 @@ func (r ResourceProviderRegistrationResource) Delete() sdk.ResourceFunc {
 			stateConf := &resource.StateChangeConf{
 				Pending:    []string{"Processing"},
 				Target:     []string{"Unregistered"},
-				Refresh:    r.unregisterRefreshFunc(ctx, client, id.ResourceProvider),
+				Refresh:    metadata.RefreshFuncWithAttempts(r.unregisterRefreshFunc(ctx, client, id.ResourceProvider)),
 				MinTimeout: 15 * time.Second,
 				Timeout:    metadata.ResourceData.Timeout(schema.TimeoutDelete),
 			}
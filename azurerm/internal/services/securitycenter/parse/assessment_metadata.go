@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AssessmentMetadataId struct {
@@ -34,27 +34,34 @@ func (id AssessmentMetadataId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.AssessmentMetadataName)
 }
 
+// Matches returns whether the specified Resource ID refers to this AssessmentMetadata
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id AssessmentMetadataId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this AssessmentMetadata ID
+func (id AssessmentMetadataId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftSecurity", "Microsoft.Security"),
+		resourceid.StaticSegment("staticAssessmentMetadata", "assessmentMetadata", false),
+		resourceid.UserSpecifiedSegment("assessmentMetadataName"),
+	}
+}
+
 // AssessmentMetadataID parses a AssessmentMetadata ID into an AssessmentMetadataId struct
 func AssessmentMetadataID(input string) (*AssessmentMetadataId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, AssessmentMetadataId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AssessmentMetadataId{
-		SubscriptionId: id.SubscriptionID,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.AssessmentMetadataName, err = id.PopSegment("assessmentMetadata"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         values["subscriptionId"],
+		AssessmentMetadataName: values["assessmentMetadataName"],
 	}
 
 	return &resourceId, nil
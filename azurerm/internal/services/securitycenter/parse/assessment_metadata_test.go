@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AssessmentMetadataId{}
+var _ resourceid.SegmentedID = AssessmentMetadataId{}
 
 func TestAssessmentMetadataIDFormatter(t *testing.T) {
 	actual := NewAssessmentMetadataID("12345678-1234-9876-4563-123456789012", "metadata1").ID()
@@ -18,6 +19,43 @@ func TestAssessmentMetadataIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAssessmentMetadataIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/assessmentMetadata/metadata1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.SECURITY/ASSESSMENTMETADATA/metadata1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/assessmentMetadata/metadata1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAssessmentMetadataID("12345678-1234-9876-4563-123456789012", "metadata1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAssessmentMetadataID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = IotSecuritySolutionId{}
+var _ resourceid.SegmentedID = IotSecuritySolutionId{}
 
 func TestIotSecuritySolutionIDFormatter(t *testing.T) {
 	actual := NewIotSecuritySolutionID("12345678-1234-9876-4563-123456789012", "resGroup1", "solution1").ID()
@@ -18,6 +19,43 @@ func TestIotSecuritySolutionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestIotSecuritySolutionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Security/IoTSecuritySolutions/solution1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SECURITY/IOTSECURITYSOLUTIONS/solution1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Security/IoTSecuritySolutions/solution1other",
+			Expected: false,
+		},
+	}
+
+	id := NewIotSecuritySolutionID("12345678-1234-9876-4563-123456789012", "resGroup1", "solution1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestIotSecuritySolutionID(t *testing.T) {
 	testData := []struct {
 		Input    string
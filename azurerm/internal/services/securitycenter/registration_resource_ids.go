@@ -0,0 +1,28 @@
+package securitycenter
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/securitycenter/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Security/IoTSecuritySolutions",
+			TerraformResourceType: "azurerm_iot_security_solution",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.IotSecuritySolutionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Security/assessmentMetadata",
+			TerraformResourceType: "azurerm_security_center_assessment_metadata",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AssessmentMetadataID(input)
+			},
+		},
+	}
+}
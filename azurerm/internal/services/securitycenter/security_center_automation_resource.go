@@ -226,7 +226,7 @@ func resourceSecurityCenterAutomationCreateUpdate(d *schema.ResourceData, meta i
 			Description: utils.String(d.Get("description").(string)),
 			IsEnabled:   &enabled,
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	automation.AutomationProperties.Scopes = expandSecurityCenterAutomationScopes(d.Get("scopes").([]interface{}))
@@ -311,7 +311,7 @@ func resourceSecurityCenterAutomationRead(d *schema.ResourceData, meta interface
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSecurityCenterAutomationDelete(d *schema.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = DataConnectorId{}
+var _ resourceid.SegmentedID = DataConnectorId{}
 
 func TestDataConnectorIDFormatter(t *testing.T) {
 	actual := NewDataConnectorID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "dc1").ID()
@@ -18,6 +19,43 @@ func TestDataConnectorIDFormatter(t *testing.T) {
 	}
 }
 
+func TestDataConnectorIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/dataConnectors/dc1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/workspace1/PROVIDERS/MICROSOFT.SECURITYINSIGHTS/DATACONNECTORS/dc1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.OperationalInsights/workspaces/workspace1/providers/Microsoft.SecurityInsights/dataConnectors/dc1other",
+			Expected: false,
+		},
+	}
+
+	id := NewDataConnectorID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "dc1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestDataConnectorID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,63 @@
+package sentinel
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sentinel/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.SecurityInsights/alertRules",
+			TerraformResourceType: "azurerm_sentinel_alert_rule_fusion",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AlertRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.SecurityInsights/alertRules",
+			TerraformResourceType: "azurerm_sentinel_alert_rule_ms_security_incident",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AlertRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.SecurityInsights/alertRules",
+			TerraformResourceType: "azurerm_sentinel_alert_rule_scheduled",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AlertRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.SecurityInsights/dataConnectors",
+			TerraformResourceType: "azurerm_sentinel_data_connector_aws_cloud_trail",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataConnectorID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.SecurityInsights/dataConnectors",
+			TerraformResourceType: "azurerm_sentinel_data_connector_azure_active_directory",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataConnectorID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.SecurityInsights/dataConnectors",
+			TerraformResourceType: "azurerm_sentinel_data_connector_office_365",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataConnectorID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.SecurityInsights/dataConnectors",
+			TerraformResourceType: "azurerm_sentinel_data_connector_threat_intelligence",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DataConnectorID(input)
+			},
+		},
+	}
+}
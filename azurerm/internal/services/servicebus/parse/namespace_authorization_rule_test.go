@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = NamespaceAuthorizationRuleId{}
+var _ resourceid.SegmentedID = NamespaceAuthorizationRuleId{}
 
 func TestNamespaceAuthorizationRuleIDFormatter(t *testing.T) {
 	actual := NewNamespaceAuthorizationRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1", "authorizationRule1").ID()
@@ -18,6 +19,52 @@ func TestNamespaceAuthorizationRuleIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNamespaceAuthorizationRuleIDFromParent(t *testing.T) {
+	parent := NewNamespaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1")
+	actual := NewNamespaceAuthorizationRuleIDFromParent(parent, "authorizationRule1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/AuthorizationRules/authorizationRule1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNamespaceAuthorizationRuleIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/AuthorizationRules/authorizationRule1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SERVICEBUS/NAMESPACES/namespace1/AUTHORIZATIONRULES/authorizationRule1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/AuthorizationRules/authorizationRule1other",
+			Expected: false,
+		},
+	}
+
+	id := NewNamespaceAuthorizationRuleID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1", "authorizationRule1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestNamespaceAuthorizationRuleID(t *testing.T) {
 	testData := []struct {
 		Input    string
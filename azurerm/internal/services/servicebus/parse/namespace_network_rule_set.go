@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NamespaceNetworkRuleSetId struct {
@@ -25,6 +25,16 @@ func NewNamespaceNetworkRuleSetID(subscriptionId, resourceGroup, namespaceName,
 	}
 }
 
+// NewNamespaceNetworkRuleSetIDFromParent returns a NamespaceNetworkRuleSetId for the NamespaceNetworkRuleSet within the specified Namespace
+func NewNamespaceNetworkRuleSetIDFromParent(parent NamespaceId, networkrulesetName string) NamespaceNetworkRuleSetId {
+	return NamespaceNetworkRuleSetId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		NamespaceName:      parent.Name,
+		NetworkrulesetName: networkrulesetName,
+	}
+}
+
 func (id NamespaceNetworkRuleSetId) String() string {
 	segments := []string{
 		fmt.Sprintf("Networkruleset Name %q", id.NetworkrulesetName),
@@ -40,35 +50,40 @@ func (id NamespaceNetworkRuleSetId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.NetworkrulesetName)
 }
 
+// Matches returns whether the specified Resource ID refers to this NamespaceNetworkRuleSet
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id NamespaceNetworkRuleSetId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this NamespaceNetworkRuleSet ID
+func (id NamespaceNetworkRuleSetId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftServiceBus", "Microsoft.ServiceBus"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticNetworkrulesets", "networkrulesets", false),
+		resourceid.UserSpecifiedSegment("networkrulesetName"),
+	}
+}
+
 // NamespaceNetworkRuleSetID parses a NamespaceNetworkRuleSet ID into an NamespaceNetworkRuleSetId struct
 func NamespaceNetworkRuleSetID(input string) (*NamespaceNetworkRuleSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, NamespaceNetworkRuleSetId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NamespaceNetworkRuleSetId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.NetworkrulesetName, err = id.PopSegment("networkrulesets"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		NamespaceName:      values["namespaceName"],
+		NetworkrulesetName: values["networkrulesetName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = NamespaceNetworkRuleSetId{}
+var _ resourceid.SegmentedID = NamespaceNetworkRuleSetId{}
 
 func TestNamespaceNetworkRuleSetIDFormatter(t *testing.T) {
 	actual := NewNamespaceNetworkRuleSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1", "networkRuleSet1").ID()
@@ -18,6 +19,52 @@ func TestNamespaceNetworkRuleSetIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNamespaceNetworkRuleSetIDFromParent(t *testing.T) {
+	parent := NewNamespaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1")
+	actual := NewNamespaceNetworkRuleSetIDFromParent(parent, "networkRuleSet1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/networkrulesets/networkRuleSet1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestNamespaceNetworkRuleSetIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/networkrulesets/networkRuleSet1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SERVICEBUS/NAMESPACES/namespace1/NETWORKRULESETS/networkRuleSet1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/networkrulesets/networkRuleSet1other",
+			Expected: false,
+		},
+	}
+
+	id := NewNamespaceNetworkRuleSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1", "networkRuleSet1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestNamespaceNetworkRuleSetID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type QueueAuthorizationRuleId struct {
@@ -27,6 +27,17 @@ func NewQueueAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, q
 	}
 }
 
+// NewQueueAuthorizationRuleIDFromParent returns a QueueAuthorizationRuleId for the QueueAuthorizationRule within the specified Queue
+func NewQueueAuthorizationRuleIDFromParent(parent QueueId, authorizationRuleName string) QueueAuthorizationRuleId {
+	return QueueAuthorizationRuleId{
+		SubscriptionId:        parent.SubscriptionId,
+		ResourceGroup:         parent.ResourceGroup,
+		NamespaceName:         parent.NamespaceName,
+		QueueName:             parent.Name,
+		AuthorizationRuleName: authorizationRuleName,
+	}
+}
+
 func (id QueueAuthorizationRuleId) String() string {
 	segments := []string{
 		fmt.Sprintf("Authorization Rule Name %q", id.AuthorizationRuleName),
@@ -43,38 +54,43 @@ func (id QueueAuthorizationRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.QueueName, id.AuthorizationRuleName)
 }
 
+// Matches returns whether the specified Resource ID refers to this QueueAuthorizationRule
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id QueueAuthorizationRuleId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this QueueAuthorizationRule ID
+func (id QueueAuthorizationRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftServiceBus", "Microsoft.ServiceBus"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticQueues", "queues", false),
+		resourceid.UserSpecifiedSegment("queueName"),
+		resourceid.StaticSegment("staticAuthorizationRules", "authorizationRules", false),
+		resourceid.UserSpecifiedSegment("authorizationRuleName"),
+	}
+}
+
 // QueueAuthorizationRuleID parses a QueueAuthorizationRule ID into an QueueAuthorizationRuleId struct
 func QueueAuthorizationRuleID(input string) (*QueueAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, QueueAuthorizationRuleId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := QueueAuthorizationRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.QueueName, err = id.PopSegment("queues"); err != nil {
-		return nil, err
-	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment("authorizationRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        values["subscriptionId"],
+		ResourceGroup:         values["resourceGroup"],
+		NamespaceName:         values["namespaceName"],
+		QueueName:             values["queueName"],
+		AuthorizationRuleName: values["authorizationRuleName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubscriptionId struct {
@@ -27,6 +27,17 @@ func NewSubscriptionID(subscriptionId, resourceGroup, namespaceName, topicName,
 	}
 }
 
+// NewSubscriptionIDFromParent returns a SubscriptionId for the Subscription within the specified Topic
+func NewSubscriptionIDFromParent(parent TopicId, name string) SubscriptionId {
+	return SubscriptionId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		NamespaceName:  parent.NamespaceName,
+		TopicName:      parent.Name,
+		Name:           name,
+	}
+}
+
 func (id SubscriptionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -43,38 +54,43 @@ func (id SubscriptionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Subscription
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SubscriptionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Subscription ID
+func (id SubscriptionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftServiceBus", "Microsoft.ServiceBus"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticTopics", "topics", false),
+		resourceid.UserSpecifiedSegment("topicName"),
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // SubscriptionID parses a Subscription ID into an SubscriptionId struct
 func SubscriptionID(input string) (*SubscriptionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SubscriptionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubscriptionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("subscriptions"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		NamespaceName:  values["namespaceName"],
+		TopicName:      values["topicName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
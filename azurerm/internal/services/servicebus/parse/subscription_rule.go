@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SubscriptionRuleId struct {
@@ -29,6 +29,18 @@ func NewSubscriptionRuleID(subscriptionId, resourceGroup, namespaceName, topicNa
 	}
 }
 
+// NewSubscriptionRuleIDFromParent returns a SubscriptionRuleId for the SubscriptionRule within the specified Subscription
+func NewSubscriptionRuleIDFromParent(parent SubscriptionId, ruleName string) SubscriptionRuleId {
+	return SubscriptionRuleId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		NamespaceName:    parent.NamespaceName,
+		TopicName:        parent.TopicName,
+		SubscriptionName: parent.Name,
+		RuleName:         ruleName,
+	}
+}
+
 func (id SubscriptionRuleId) String() string {
 	segments := []string{
 		fmt.Sprintf("Rule Name %q", id.RuleName),
@@ -46,41 +58,46 @@ func (id SubscriptionRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.SubscriptionName, id.RuleName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SubscriptionRule
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SubscriptionRuleId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SubscriptionRule ID
+func (id SubscriptionRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftServiceBus", "Microsoft.ServiceBus"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticTopics", "topics", false),
+		resourceid.UserSpecifiedSegment("topicName"),
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.UserSpecifiedSegment("subscriptionName"),
+		resourceid.StaticSegment("staticRules", "rules", false),
+		resourceid.UserSpecifiedSegment("ruleName"),
+	}
+}
+
 // SubscriptionRuleID parses a SubscriptionRule ID into an SubscriptionRuleId struct
 func SubscriptionRuleID(input string) (*SubscriptionRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SubscriptionRuleId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SubscriptionRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
-		return nil, err
-	}
-	if resourceId.SubscriptionName, err = id.PopSegment("subscriptions"); err != nil {
-		return nil, err
-	}
-	if resourceId.RuleName, err = id.PopSegment("rules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		NamespaceName:    values["namespaceName"],
+		TopicName:        values["topicName"],
+		SubscriptionName: values["subscriptionName"],
+		RuleName:         values["ruleName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type TopicAuthorizationRuleId struct {
@@ -27,6 +27,17 @@ func NewTopicAuthorizationRuleID(subscriptionId, resourceGroup, namespaceName, t
 	}
 }
 
+// NewTopicAuthorizationRuleIDFromParent returns a TopicAuthorizationRuleId for the TopicAuthorizationRule within the specified Topic
+func NewTopicAuthorizationRuleIDFromParent(parent TopicId, authorizationRuleName string) TopicAuthorizationRuleId {
+	return TopicAuthorizationRuleId{
+		SubscriptionId:        parent.SubscriptionId,
+		ResourceGroup:         parent.ResourceGroup,
+		NamespaceName:         parent.NamespaceName,
+		TopicName:             parent.Name,
+		AuthorizationRuleName: authorizationRuleName,
+	}
+}
+
 func (id TopicAuthorizationRuleId) String() string {
 	segments := []string{
 		fmt.Sprintf("Authorization Rule Name %q", id.AuthorizationRuleName),
@@ -43,38 +54,43 @@ func (id TopicAuthorizationRuleId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.NamespaceName, id.TopicName, id.AuthorizationRuleName)
 }
 
+// Matches returns whether the specified Resource ID refers to this TopicAuthorizationRule
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id TopicAuthorizationRuleId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this TopicAuthorizationRule ID
+func (id TopicAuthorizationRuleId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftServiceBus", "Microsoft.ServiceBus"),
+		resourceid.StaticSegment("staticNamespaces", "namespaces", false),
+		resourceid.UserSpecifiedSegment("namespaceName"),
+		resourceid.StaticSegment("staticTopics", "topics", false),
+		resourceid.UserSpecifiedSegment("topicName"),
+		resourceid.StaticSegment("staticAuthorizationRules", "authorizationRules", false),
+		resourceid.UserSpecifiedSegment("authorizationRuleName"),
+	}
+}
+
 // TopicAuthorizationRuleID parses a TopicAuthorizationRule ID into an TopicAuthorizationRuleId struct
 func TopicAuthorizationRuleID(input string) (*TopicAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, TopicAuthorizationRuleId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := TopicAuthorizationRuleId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
-		return nil, err
-	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment("authorizationRules"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:        values["subscriptionId"],
+		ResourceGroup:         values["resourceGroup"],
+		NamespaceName:         values["namespaceName"],
+		TopicName:             values["topicName"],
+		AuthorizationRuleName: values["authorizationRuleName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = TopicId{}
+var _ resourceid.SegmentedID = TopicId{}
 
 func TestTopicIDFormatter(t *testing.T) {
 	actual := NewTopicID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1", "topic1").ID()
@@ -18,6 +19,52 @@ func TestTopicIDFormatter(t *testing.T) {
 	}
 }
 
+func TestTopicIDFromParent(t *testing.T) {
+	parent := NewNamespaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1")
+	actual := NewTopicIDFromParent(parent, "topic1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestTopicIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SERVICEBUS/NAMESPACES/namespace1/TOPICS/topic1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1other",
+			Expected: false,
+		},
+	}
+
+	id := NewTopicID("12345678-1234-9876-4563-123456789012", "resGroup1", "namespace1", "topic1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestTopicID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,77 @@
+package servicebus
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicebus/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.ServiceBus/namespaces",
+			TerraformResourceType: "azurerm_servicebus_namespace",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NamespaceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceBus/namespaces/AuthorizationRules",
+			TerraformResourceType: "azurerm_servicebus_namespace_authorization_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NamespaceAuthorizationRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceBus/namespaces/networkrulesets",
+			TerraformResourceType: "azurerm_servicebus_namespace_network_rule_set",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NamespaceNetworkRuleSetID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceBus/namespaces/queues",
+			TerraformResourceType: "azurerm_servicebus_queue",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.QueueID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceBus/namespaces/queues/authorizationRules",
+			TerraformResourceType: "azurerm_servicebus_queue_authorization_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.QueueAuthorizationRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceBus/namespaces/topics/subscriptions",
+			TerraformResourceType: "azurerm_servicebus_subscription",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SubscriptionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceBus/namespaces/topics/subscriptions/rules",
+			TerraformResourceType: "azurerm_servicebus_subscription_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SubscriptionRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceBus/namespaces/topics",
+			TerraformResourceType: "azurerm_servicebus_topic",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.TopicID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceBus/namespaces/topics/authorizationRules",
+			TerraformResourceType: "azurerm_servicebus_topic_authorization_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.TopicAuthorizationRuleID(input)
+			},
+		},
+	}
+}
@@ -582,7 +582,7 @@ func resourceServiceFabricClusterCreateUpdate(d *schema.ResourceData, meta inter
 
 	cluster := servicefabric.Cluster{
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		ClusterProperties: &servicefabric.ClusterProperties{
 			AddOnFeatures:                      addOnFeatures,
 			AzureActiveDirectory:               azureActiveDirectory,
@@ -741,7 +741,7 @@ func resourceServiceFabricClusterRead(d *schema.ResourceData, meta interface{})
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceServiceFabricClusterDelete(d *schema.ResourceData, meta interface{}) error {
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = NetworkId{}
+var _ resourceid.SegmentedID = NetworkId{}
 
 func TestNetworkIDFormatter(t *testing.T) {
 	actual := NewNetworkID("12345678-1234-9876-4563-123456789012", "resGroup1", "network1").ID()
@@ -18,6 +19,43 @@ func TestNetworkIDFormatter(t *testing.T) {
 	}
 }
 
+func TestNetworkIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/networks/network1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SERVICEFABRICMESH/NETWORKS/network1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/networks/network1other",
+			Expected: false,
+		},
+	}
+
+	id := NewNetworkID("12345678-1234-9876-4563-123456789012", "resGroup1", "network1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestNetworkID(t *testing.T) {
 	testData := []struct {
 		Input    string
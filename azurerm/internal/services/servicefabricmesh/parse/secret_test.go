@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SecretId{}
+var _ resourceid.SegmentedID = SecretId{}
 
 func TestSecretIDFormatter(t *testing.T) {
 	actual := NewSecretID("12345678-1234-9876-4563-123456789012", "resGroup1", "secret1").ID()
@@ -18,6 +19,43 @@ func TestSecretIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSecretIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SERVICEFABRICMESH/SECRETS/secret1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSecretID("12345678-1234-9876-4563-123456789012", "resGroup1", "secret1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSecretID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SecretValueId struct {
@@ -25,6 +25,16 @@ func NewSecretValueID(subscriptionId, resourceGroup, secretName, valueName strin
 	}
 }
 
+// NewSecretValueIDFromParent returns a SecretValueId for the SecretValue within the specified Secret
+func NewSecretValueIDFromParent(parent SecretId, valueName string) SecretValueId {
+	return SecretValueId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		SecretName:     parent.Name,
+		ValueName:      valueName,
+	}
+}
+
 func (id SecretValueId) String() string {
 	segments := []string{
 		fmt.Sprintf("Value Name %q", id.ValueName),
@@ -40,35 +50,40 @@ func (id SecretValueId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SecretName, id.ValueName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SecretValue
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SecretValueId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SecretValue ID
+func (id SecretValueId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftServiceFabricMesh", "Microsoft.ServiceFabricMesh"),
+		resourceid.StaticSegment("staticSecrets", "secrets", false),
+		resourceid.UserSpecifiedSegment("secretName"),
+		resourceid.StaticSegment("staticValues", "values", false),
+		resourceid.UserSpecifiedSegment("valueName"),
+	}
+}
+
 // SecretValueID parses a SecretValue ID into an SecretValueId struct
 func SecretValueID(input string) (*SecretValueId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SecretValueId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SecretValueId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SecretName, err = id.PopSegment("secrets"); err != nil {
-		return nil, err
-	}
-	if resourceId.ValueName, err = id.PopSegment("values"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		SecretName:     values["secretName"],
+		ValueName:      values["valueName"],
 	}
 
 	return &resourceId, nil
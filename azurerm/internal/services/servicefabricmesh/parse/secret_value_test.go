@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SecretValueId{}
+var _ resourceid.SegmentedID = SecretValueId{}
 
 func TestSecretValueIDFormatter(t *testing.T) {
 	actual := NewSecretValueID("12345678-1234-9876-4563-123456789012", "resGroup1", "secret1", "value1").ID()
@@ -18,6 +19,52 @@ func TestSecretValueIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSecretValueIDFromParent(t *testing.T) {
+	parent := NewSecretID("12345678-1234-9876-4563-123456789012", "resGroup1", "secret1")
+	actual := NewSecretValueIDFromParent(parent, "value1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1/values/value1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSecretValueIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1/values/value1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SERVICEFABRICMESH/SECRETS/secret1/VALUES/value1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.ServiceFabricMesh/secrets/secret1/values/value1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSecretValueID("12345678-1234-9876-4563-123456789012", "resGroup1", "secret1", "value1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSecretValueID(t *testing.T) {
 	testData := []struct {
 		Input    string
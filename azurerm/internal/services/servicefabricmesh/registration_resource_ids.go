@@ -0,0 +1,42 @@
+package servicefabricmesh
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/servicefabricmesh/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.ServiceFabricMesh/applications",
+			TerraformResourceType: "azurerm_service_fabric_mesh_application",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ApplicationID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceFabricMesh/networks",
+			TerraformResourceType: "azurerm_service_fabric_mesh_local_network",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.NetworkID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceFabricMesh/secrets",
+			TerraformResourceType: "azurerm_service_fabric_mesh_secret",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SecretID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.ServiceFabricMesh/secrets/values",
+			TerraformResourceType: "azurerm_service_fabric_mesh_secret_value",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SecretValueID(input)
+			},
+		},
+	}
+}
@@ -101,7 +101,7 @@ func resourceServiceFabricMeshSecretCreateUpdate(d *schema.ResourceData, meta in
 			Kind:        servicefabricmesh.KindInlinedValue,
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Create(ctx, resourceGroup, name, parameters); err != nil {
@@ -154,7 +154,7 @@ func resourceServiceFabricMeshSecretRead(d *schema.ResourceData, meta interface{
 	d.Set("content_type", props.ContentType)
 	d.Set("description", props.Description)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceServiceFabricMeshSecretDelete(d *schema.ResourceData, meta interface{}) error {
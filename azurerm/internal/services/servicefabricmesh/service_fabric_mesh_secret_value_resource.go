@@ -106,7 +106,7 @@ func resourceServiceFabricMeshSecretValueCreateUpdate(d *schema.ResourceData, me
 			Value: utils.String(d.Get("value").(string)),
 		},
 		Location: utils.String(location),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if _, err := client.Create(ctx, secretID.ResourceGroup, secretID.Name, name, parameters); err != nil {
@@ -164,7 +164,7 @@ func resourceServiceFabricMeshSecretValueRead(d *schema.ResourceData, meta inter
 	d.Set("service_fabric_mesh_secret_id", secret.ID)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceServiceFabricMeshSecretValueDelete(d *schema.ResourceData, meta interface{}) error {
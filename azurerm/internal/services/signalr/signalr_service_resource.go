@@ -231,7 +231,7 @@ func resourceArmSignalRServiceCreate(d *schema.ResourceData, meta interface{}) e
 	t := d.Get("tags").(map[string]interface{})
 	featureFlags := d.Get("features").(*schema.Set).List()
 	cors := d.Get("cors").([]interface{})
-	expandedTags := tags.Expand(t)
+	expandedTags := tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 	upstreamSettings := d.Get("upstream_endpoint").(*schema.Set).List()
 
 	expandedFeatures := expandSignalRFeatures(featureFlags)
@@ -334,7 +334,7 @@ func resourceArmSignalRServiceRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("secondary_access_key", keys.SecondaryKey)
 	d.Set("secondary_connection_string", keys.SecondaryConnectionString)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceArmSignalRServiceUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -373,9 +373,9 @@ func resourceArmSignalRServiceUpdate(d *schema.ResourceData, meta interface{}) e
 		resourceType.Sku = expandSignalRServiceSku(sku)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		tagsRaw := d.Get("tags").(map[string]interface{})
-		resourceType.Tags = tags.Expand(tagsRaw)
+		resourceType.Tags = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, tagsRaw)
 	}
 
 	future, err := client.Update(ctx, id.ResourceGroup, id.SignalRName, resourceType)
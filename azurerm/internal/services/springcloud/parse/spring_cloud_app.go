@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudAppId struct {
@@ -25,6 +25,16 @@ func NewSpringCloudAppID(subscriptionId, resourceGroup, springName, appName stri
 	}
 }
 
+// NewSpringCloudAppIDFromParent returns a SpringCloudAppId for the SpringCloudApp within the specified SpringCloudService
+func NewSpringCloudAppIDFromParent(parent SpringCloudServiceId, appName string) SpringCloudAppId {
+	return SpringCloudAppId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		SpringName:     parent.SpringName,
+		AppName:        appName,
+	}
+}
+
 func (id SpringCloudAppId) String() string {
 	segments := []string{
 		fmt.Sprintf("App Name %q", id.AppName),
@@ -40,35 +50,40 @@ func (id SpringCloudAppId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.AppName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SpringCloudApp
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SpringCloudAppId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SpringCloudApp ID
+func (id SpringCloudAppId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform"),
+		resourceid.StaticSegment("staticSpring", "Spring", false),
+		resourceid.UserSpecifiedSegment("springName"),
+		resourceid.StaticSegment("staticApps", "apps", false),
+		resourceid.UserSpecifiedSegment("appName"),
+	}
+}
+
 // SpringCloudAppID parses a SpringCloudApp ID into an SpringCloudAppId struct
 func SpringCloudAppID(input string) (*SpringCloudAppId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SpringCloudAppId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SpringCloudAppId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
-		return nil, err
-	}
-	if resourceId.AppName, err = id.PopSegment("apps"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		SpringName:     values["springName"],
+		AppName:        values["appName"],
 	}
 
 	return &resourceId, nil
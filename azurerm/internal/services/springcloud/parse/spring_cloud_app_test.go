@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SpringCloudAppId{}
+var _ resourceid.SegmentedID = SpringCloudAppId{}
 
 func TestSpringCloudAppIDFormatter(t *testing.T) {
 	actual := NewSpringCloudAppID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "app1").ID()
@@ -18,6 +19,52 @@ func TestSpringCloudAppIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudAppIDFromParent(t *testing.T) {
+	parent := NewSpringCloudServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1")
+	actual := NewSpringCloudAppIDFromParent(parent, "app1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSpringCloudAppIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APPPLATFORM/SPRING/spring1/APPS/app1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSpringCloudAppID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "app1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSpringCloudAppID(t *testing.T) {
 	testData := []struct {
 		Input    string
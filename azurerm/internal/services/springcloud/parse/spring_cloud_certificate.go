@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudCertificateId struct {
@@ -25,6 +25,16 @@ func NewSpringCloudCertificateID(subscriptionId, resourceGroup, springName, cert
 	}
 }
 
+// NewSpringCloudCertificateIDFromParent returns a SpringCloudCertificateId for the SpringCloudCertificate within the specified SpringCloudService
+func NewSpringCloudCertificateIDFromParent(parent SpringCloudServiceId, certificateName string) SpringCloudCertificateId {
+	return SpringCloudCertificateId{
+		SubscriptionId:  parent.SubscriptionId,
+		ResourceGroup:   parent.ResourceGroup,
+		SpringName:      parent.SpringName,
+		CertificateName: certificateName,
+	}
+}
+
 func (id SpringCloudCertificateId) String() string {
 	segments := []string{
 		fmt.Sprintf("Certificate Name %q", id.CertificateName),
@@ -40,35 +50,40 @@ func (id SpringCloudCertificateId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.CertificateName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SpringCloudCertificate
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SpringCloudCertificateId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SpringCloudCertificate ID
+func (id SpringCloudCertificateId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform"),
+		resourceid.StaticSegment("staticSpring", "Spring", false),
+		resourceid.UserSpecifiedSegment("springName"),
+		resourceid.StaticSegment("staticCertificates", "certificates", false),
+		resourceid.UserSpecifiedSegment("certificateName"),
+	}
+}
+
 // SpringCloudCertificateID parses a SpringCloudCertificate ID into an SpringCloudCertificateId struct
 func SpringCloudCertificateID(input string) (*SpringCloudCertificateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SpringCloudCertificateId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SpringCloudCertificateId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
-		return nil, err
-	}
-	if resourceId.CertificateName, err = id.PopSegment("certificates"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:  values["subscriptionId"],
+		ResourceGroup:   values["resourceGroup"],
+		SpringName:      values["springName"],
+		CertificateName: values["certificateName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SpringCloudCertificateId{}
+var _ resourceid.SegmentedID = SpringCloudCertificateId{}
 
 func TestSpringCloudCertificateIDFormatter(t *testing.T) {
 	actual := NewSpringCloudCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "cert1").ID()
@@ -18,6 +19,52 @@ func TestSpringCloudCertificateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudCertificateIDFromParent(t *testing.T) {
+	parent := NewSpringCloudServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1")
+	actual := NewSpringCloudCertificateIDFromParent(parent, "cert1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/certificates/cert1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSpringCloudCertificateIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/certificates/cert1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APPPLATFORM/SPRING/spring1/CERTIFICATES/cert1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/certificates/cert1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSpringCloudCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "cert1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSpringCloudCertificateID(t *testing.T) {
 	testData := []struct {
 		Input    string
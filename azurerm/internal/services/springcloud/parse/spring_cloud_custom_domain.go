@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudCustomDomainId struct {
@@ -27,6 +27,17 @@ func NewSpringCloudCustomDomainID(subscriptionId, resourceGroup, springName, app
 	}
 }
 
+// NewSpringCloudCustomDomainIDFromParent returns a SpringCloudCustomDomainId for the SpringCloudCustomDomain within the specified SpringCloudApp
+func NewSpringCloudCustomDomainIDFromParent(parent SpringCloudAppId, domainName string) SpringCloudCustomDomainId {
+	return SpringCloudCustomDomainId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		SpringName:     parent.SpringName,
+		AppName:        parent.AppName,
+		DomainName:     domainName,
+	}
+}
+
 func (id SpringCloudCustomDomainId) String() string {
 	segments := []string{
 		fmt.Sprintf("Domain Name %q", id.DomainName),
@@ -43,38 +54,43 @@ func (id SpringCloudCustomDomainId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.AppName, id.DomainName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SpringCloudCustomDomain
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SpringCloudCustomDomainId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SpringCloudCustomDomain ID
+func (id SpringCloudCustomDomainId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform"),
+		resourceid.StaticSegment("staticSpring", "Spring", false),
+		resourceid.UserSpecifiedSegment("springName"),
+		resourceid.StaticSegment("staticApps", "apps", false),
+		resourceid.UserSpecifiedSegment("appName"),
+		resourceid.StaticSegment("staticDomains", "domains", false),
+		resourceid.UserSpecifiedSegment("domainName"),
+	}
+}
+
 // SpringCloudCustomDomainID parses a SpringCloudCustomDomain ID into an SpringCloudCustomDomainId struct
 func SpringCloudCustomDomainID(input string) (*SpringCloudCustomDomainId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SpringCloudCustomDomainId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SpringCloudCustomDomainId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
-		return nil, err
-	}
-	if resourceId.AppName, err = id.PopSegment("apps"); err != nil {
-		return nil, err
-	}
-	if resourceId.DomainName, err = id.PopSegment("domains"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		SpringName:     values["springName"],
+		AppName:        values["appName"],
+		DomainName:     values["domainName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SpringCloudCustomDomainId{}
+var _ resourceid.SegmentedID = SpringCloudCustomDomainId{}
 
 func TestSpringCloudCustomDomainIDFormatter(t *testing.T) {
 	actual := NewSpringCloudCustomDomainID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "app1", "domain.com").ID()
@@ -18,6 +19,52 @@ func TestSpringCloudCustomDomainIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudCustomDomainIDFromParent(t *testing.T) {
+	parent := NewSpringCloudAppID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "app1")
+	actual := NewSpringCloudCustomDomainIDFromParent(parent, "domain.com").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1/domains/domain.com"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSpringCloudCustomDomainIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1/domains/domain.com",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APPPLATFORM/SPRING/spring1/APPS/app1/DOMAINS/domain.com",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1/apps/app1/domains/domain.comother",
+			Expected: false,
+		},
+	}
+
+	id := NewSpringCloudCustomDomainID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1", "app1", "domain.com")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSpringCloudCustomDomainID(t *testing.T) {
 	testData := []struct {
 		Input    string
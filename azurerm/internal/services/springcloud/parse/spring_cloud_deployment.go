@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SpringCloudDeploymentId struct {
@@ -27,6 +27,17 @@ func NewSpringCloudDeploymentID(subscriptionId, resourceGroup, springName, appNa
 	}
 }
 
+// NewSpringCloudDeploymentIDFromParent returns a SpringCloudDeploymentId for the SpringCloudDeployment within the specified SpringCloudApp
+func NewSpringCloudDeploymentIDFromParent(parent SpringCloudAppId, deploymentName string) SpringCloudDeploymentId {
+	return SpringCloudDeploymentId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		SpringName:     parent.SpringName,
+		AppName:        parent.AppName,
+		DeploymentName: deploymentName,
+	}
+}
+
 func (id SpringCloudDeploymentId) String() string {
 	segments := []string{
 		fmt.Sprintf("Deployment Name %q", id.DeploymentName),
@@ -43,38 +54,43 @@ func (id SpringCloudDeploymentId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SpringName, id.AppName, id.DeploymentName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SpringCloudDeployment
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SpringCloudDeploymentId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SpringCloudDeployment ID
+func (id SpringCloudDeploymentId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftAppPlatform", "Microsoft.AppPlatform"),
+		resourceid.StaticSegment("staticSpring", "Spring", false),
+		resourceid.UserSpecifiedSegment("springName"),
+		resourceid.StaticSegment("staticApps", "apps", false),
+		resourceid.UserSpecifiedSegment("appName"),
+		resourceid.StaticSegment("staticDeployments", "deployments", false),
+		resourceid.UserSpecifiedSegment("deploymentName"),
+	}
+}
+
 // SpringCloudDeploymentID parses a SpringCloudDeployment ID into an SpringCloudDeploymentId struct
 func SpringCloudDeploymentID(input string) (*SpringCloudDeploymentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SpringCloudDeploymentId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SpringCloudDeploymentId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
-		return nil, err
-	}
-	if resourceId.AppName, err = id.PopSegment("apps"); err != nil {
-		return nil, err
-	}
-	if resourceId.DeploymentName, err = id.PopSegment("deployments"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		SpringName:     values["springName"],
+		AppName:        values["appName"],
+		DeploymentName: values["deploymentName"],
 	}
 
 	return &resourceId, nil
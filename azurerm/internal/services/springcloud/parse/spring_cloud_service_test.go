@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SpringCloudServiceId{}
+var _ resourceid.SegmentedID = SpringCloudServiceId{}
 
 func TestSpringCloudServiceIDFormatter(t *testing.T) {
 	actual := NewSpringCloudServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1").ID()
@@ -18,6 +19,43 @@ func TestSpringCloudServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSpringCloudServiceIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.APPPLATFORM/SPRING/spring1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.AppPlatform/Spring/spring1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSpringCloudServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "spring1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSpringCloudServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
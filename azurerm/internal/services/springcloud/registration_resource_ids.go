@@ -0,0 +1,49 @@
+package springcloud
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/springcloud/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.AppPlatform/Spring/apps",
+			TerraformResourceType: "azurerm_spring_cloud_app",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SpringCloudAppID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.AppPlatform/Spring/certificates",
+			TerraformResourceType: "azurerm_spring_cloud_certificate",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SpringCloudCertificateID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.AppPlatform/Spring/apps/domains",
+			TerraformResourceType: "azurerm_spring_cloud_custom_domain",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SpringCloudCustomDomainID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.AppPlatform/Spring/apps/deployments",
+			TerraformResourceType: "azurerm_spring_cloud_java_deployment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SpringCloudDeploymentID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.AppPlatform/Spring",
+			TerraformResourceType: "azurerm_spring_cloud_service",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SpringCloudServiceID(input)
+			},
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package sql
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.SQL/servers/administrators",
+			TerraformResourceType: "azurerm_sql_active_directory_administrator",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AzureActiveDirectoryAdministratorID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/databases",
+			TerraformResourceType: "azurerm_sql_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabaseID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/elasticPools",
+			TerraformResourceType: "azurerm_sql_elasticpool",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ElasticPoolID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/failoverGroups",
+			TerraformResourceType: "azurerm_sql_failover_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FailoverGroupID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/firewallRules",
+			TerraformResourceType: "azurerm_sql_firewall_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers",
+			TerraformResourceType: "azurerm_sql_server",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServerID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Sql/servers/virtualNetworkRules",
+			TerraformResourceType: "azurerm_sql_virtual_network_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualNetworkRuleID(input)
+			},
+		},
+	}
+}
@@ -109,5 +109,5 @@ func dataSourceArmSqlServerRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error setting `identity`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -134,7 +134,7 @@ func resourceSqlServerCreateUpdate(d *schema.ResourceData, meta interface{}) err
 	version := d.Get("version").(string)
 
 	t := d.Get("tags").(map[string]interface{})
-	metadata := tags.Expand(t)
+	metadata := tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t)
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
@@ -264,7 +264,7 @@ func resourceSqlServerRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("setting `extended_auditing_policy`: %+v", err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSqlServerDelete(d *schema.ResourceData, meta interface{}) error {
@@ -3,11 +3,13 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"os"
 	"runtime"
 	"strings"
@@ -28,6 +30,7 @@ type BlobUpload struct {
 	ContainerName string
 
 	BlobType      string
+	BlockSize     int
 	ContentType   string
 	ContentMD5    string
 	MetaData      map[string]string
@@ -151,6 +154,20 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	// files larger than a single block are uploaded in blocks, which allows these to be uploaded in parallel
+	if info.Size() > sbu.blockSize() {
+		if err := sbu.blockUploadFromSource(ctx, sbu.Client, file, info.Size()); err != nil {
+			return fmt.Errorf("Error creating storage blob on Azure: %s", err)
+		}
+
+		return nil
+	}
+
 	input := blobs.PutBlockBlobInput{
 		ContentType: utils.String(sbu.ContentType),
 		MetaData:    sbu.MetaData,
@@ -364,6 +381,267 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+const (
+	defaultBlockSize int64 = 4 * 1024 * 1024
+
+	// a Block Blob can contain at most 50,000 blocks
+	maxBlockCount = 50000
+
+	// the number of times a block is attempted to be uploaded before giving up
+	maxBlockUploadAttempts = 5
+
+	// the maximum amount of memory used to buffer the blocks being uploaded at once - although at least
+	// one block is always uploaded at a time, regardless of the block size
+	maxBlockUploadMemory int64 = 256 * 1024 * 1024
+)
+
+// blockUploadRetryInterval is the base interval between attempts to upload a block, which is doubled for each attempt
+var blockUploadRetryInterval = 2 * time.Second
+
+// blockBlobClient is the subset of the Blobs Client used to upload a Block Blob in blocks
+type blockBlobClient interface {
+	GetBlockList(ctx context.Context, accountName, containerName, blobName string, input blobs.GetBlockListInput) (blobs.GetBlockListResult, error)
+	PutBlock(ctx context.Context, accountName, containerName, blobName string, input blobs.PutBlockInput) (blobs.PutBlockResult, error)
+	PutBlockList(ctx context.Context, accountName, containerName, blobName string, input blobs.PutBlockListInput) (blobs.PutBlockListResult, error)
+}
+
+type storageBlobBlock struct {
+	offset int64
+
+	// id is the Base64 encoded ID of this block, which is derived from the index and contents of the block
+	id string
+
+	// md5 is the Base64 encoded MD5 of the contents of this block
+	md5 string
+
+	section *io.SectionReader
+}
+
+func (sbu BlobUpload) blockSize() int64 {
+	if sbu.BlockSize > 0 {
+		return int64(sbu.BlockSize)
+	}
+
+	return defaultBlockSize
+}
+
+// blockUploadWorkerCount returns the number of workers used to upload the specified number of blocks - since
+// each worker buffers an entire block in memory this is bounded by the number of blocks and maxBlockUploadMemory
+func (sbu BlobUpload) blockUploadWorkerCount(blockCount int) int {
+	workerCount := sbu.Parallelism * runtime.NumCPU()
+	if workerCount > blockCount {
+		workerCount = blockCount
+	}
+	if limit := int(maxBlockUploadMemory / sbu.blockSize()); workerCount > limit {
+		workerCount = limit
+	}
+	if workerCount < 1 {
+		workerCount = 1
+	}
+
+	return workerCount
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, client blockBlobClient, file io.ReaderAt, fileSize int64) error {
+	// first we chunk the file into blocks
+	blockList, err := sbu.storageBlobBlockSplit(file, fileSize)
+	if err != nil {
+		return fmt.Errorf("Error splitting source file %q into blocks: %s", sbu.Source, err)
+	}
+
+	// then determine which of these blocks have been uploaded by a previous (interrupted) upload - since
+	// the ID of each block includes the MD5 of its contents, these can be committed without uploading them again
+	uploaded, err := sbu.uncommittedBlocks(ctx, client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the uncommitted blocks: %s", err)
+	}
+
+	pendingBlocks := make([]storageBlobBlock, 0)
+	for _, block := range blockList {
+		if size, ok := uploaded[block.id]; ok && size == block.section.Size() {
+			continue
+		}
+
+		pendingBlocks = append(pendingBlocks, block)
+	}
+	log.Printf("[DEBUG] Uploading %d of %d blocks for Blob %q (%d blocks have already been uploaded)..", len(pendingBlocks), len(blockList), sbu.BlobName, len(blockList)-len(pendingBlocks))
+
+	// then we upload the remaining blocks
+	blocks := make(chan storageBlobBlock, len(pendingBlocks))
+	errors := make(chan error, len(pendingBlocks))
+	wg := &sync.WaitGroup{}
+	wg.Add(len(pendingBlocks))
+
+	for _, block := range pendingBlocks {
+		blocks <- block
+	}
+	close(blocks)
+
+	workerCount := sbu.blockUploadWorkerCount(len(pendingBlocks))
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blobBlockUploadContext{
+			client: client,
+			blocks: blocks,
+			errors: errors,
+			wg:     wg,
+		})
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("Error while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	// finally we commit the blocks, in order
+	blockIds := make([]blobs.BlockID, 0)
+	for _, block := range blockList {
+		blockIds = append(blockIds, blobs.BlockID{
+			Value: block.id,
+		})
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentType: utils.String(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if sbu.ContentMD5 != "" {
+		input.ContentMD5 = utils.String(sbu.ContentMD5)
+	}
+	if _, err := client.PutBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("Error committing the blocks for file %q: %s", sbu.Source, err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) storageBlobBlockSplit(file io.ReaderAt, fileSize int64) ([]storageBlobBlock, error) {
+	blockSize := sbu.blockSize()
+
+	blockCount := fileSize / blockSize
+	if fileSize%blockSize != 0 {
+		blockCount++
+	}
+	if blockCount > maxBlockCount {
+		return nil, fmt.Errorf("the file would be split into %d blocks of %d bytes but a Block Blob can contain at most %d blocks - the block size must be increased", blockCount, blockSize, maxBlockCount)
+	}
+
+	blocks := make([]storageBlobBlock, 0)
+	for offset := int64(0); offset < fileSize; offset += blockSize {
+		length := blockSize
+		if offset+length > fileSize {
+			length = fileSize - offset
+		}
+
+		section := io.NewSectionReader(file, offset, length)
+		hash := md5.New()
+		if _, err := io.Copy(hash, section); err != nil {
+			return nil, fmt.Errorf("Could not read block at %d: %s", offset, err)
+		}
+		checksum := hash.Sum(nil)
+
+		// the Block ID's must all be the same length, which is guaranteed since the index is padded
+		index := len(blocks)
+		blockId := fmt.Sprintf("%06d-%s", index, hex.EncodeToString(checksum))
+
+		blocks = append(blocks, storageBlobBlock{
+			offset:  offset,
+			id:      base64.StdEncoding.EncodeToString([]byte(blockId)),
+			md5:     base64.StdEncoding.EncodeToString(checksum),
+			section: io.NewSectionReader(file, offset, length),
+		})
+	}
+
+	return blocks, nil
+}
+
+// uncommittedBlocks returns the ID's (and sizes) of the blocks which have been uploaded but not committed
+func (sbu BlobUpload) uncommittedBlocks(ctx context.Context, client blockBlobClient) (map[string]int64, error) {
+	output := make(map[string]int64)
+
+	input := blobs.GetBlockListInput{
+		BlockListType: blobs.Uncommitted,
+	}
+	result, err := client.GetBlockList(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+	if err != nil {
+		// the Blob won't exist if there's been no previous upload
+		if utils.ResponseWasNotFound(result.Response) {
+			return output, nil
+		}
+
+		return nil, err
+	}
+
+	for _, block := range result.UncommittedBlocks.Blocks {
+		output[block.Name] = block.Size
+	}
+
+	return output, nil
+}
+
+type blobBlockUploadContext struct {
+	client blockBlobClient
+	blocks chan storageBlobBlock
+	errors chan error
+	wg     *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blobBlockUploadContext) {
+	for block := range uploadCtx.blocks {
+		if err := sbu.uploadBlock(ctx, uploadCtx.client, block); err != nil {
+			uploadCtx.errors <- err
+		}
+
+		uploadCtx.wg.Done()
+	}
+}
+
+// uploadBlock uploads the specified block, retrying when this fails (or the MD5 of the block received by Azure doesn't match)
+func (sbu BlobUpload) uploadBlock(ctx context.Context, client blockBlobClient, block storageBlobBlock) error {
+	offset := block.offset
+
+	chunk := make([]byte, block.section.Size())
+	if _, err := block.section.ReadAt(chunk, 0); err != nil && err != io.EOF {
+		return fmt.Errorf("Error reading source file %q at offset %d: %s", sbu.Source, offset, err)
+	}
+
+	// Azure verifies the contents of the block against the MD5 (rejecting the block when it doesn't match)
+	input := blobs.PutBlockInput{
+		BlockID:    block.id,
+		Content:    chunk,
+		ContentMD5: utils.String(block.md5),
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxBlockUploadAttempts; attempt++ {
+		var result blobs.PutBlockResult
+		result, err = client.PutBlock(ctx, sbu.AccountName, sbu.ContainerName, sbu.BlobName, input)
+		if err == nil && result.ContentMD5 != "" && result.ContentMD5 != block.md5 {
+			err = fmt.Errorf("the MD5 of the block received by Azure (%q) didn't match the MD5 of the block (%q)", result.ContentMD5, block.md5)
+		}
+		if err == nil {
+			return nil
+		}
+
+		if attempt == maxBlockUploadAttempts {
+			break
+		}
+
+		delay := blockUploadRetryInterval * time.Duration(1<<(attempt-1))
+		log.Printf("[DEBUG] Error writing block at offset %d for file %q (attempt %d of %d) - retrying in %s: %s", offset, sbu.Source, attempt, maxBlockUploadAttempts, delay, err)
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Error writing block at offset %d for file %q: %s", offset, sbu.Source, ctx.Err())
+		case <-time.After(delay):
+		}
+	}
+
+	return fmt.Errorf("Error writing block at offset %d for file %q after %d attempts: %s", offset, sbu.Source, maxBlockUploadAttempts, err)
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
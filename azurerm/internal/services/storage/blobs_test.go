@@ -0,0 +1,310 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/blobs"
+)
+
+type fakeBlockBlobClient struct {
+	lock sync.Mutex
+
+	// uncommitted is a map of Block ID to the contents of the uncommitted blocks
+	uncommitted map[string][]byte
+
+	// failures is the number of times uploading each block (by index) should fail
+	failures map[int]int
+
+	// corrupt is the number of times the MD5 returned for each block (by index) should be wrong
+	corrupt map[int]int
+
+	uploaded  []string
+	committed []byte
+}
+
+func (c *fakeBlockBlobClient) GetBlockList(_ context.Context, _, _, _ string, _ blobs.GetBlockListInput) (result blobs.GetBlockListResult, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if len(c.uncommitted) == 0 {
+		result.Response = autorest.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}
+		return result, fmt.Errorf("the blob was not found")
+	}
+
+	for id, contents := range c.uncommitted {
+		result.UncommittedBlocks.Blocks = append(result.UncommittedBlocks.Blocks, blobs.Block{
+			Name: id,
+			Size: int64(len(contents)),
+		})
+	}
+	return result, nil
+}
+
+func (c *fakeBlockBlobClient) PutBlock(_ context.Context, _, _, _ string, input blobs.PutBlockInput) (result blobs.PutBlockResult, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	index := blockIndex(input.BlockID)
+	if input.ContentMD5 == nil {
+		return result, fmt.Errorf("the MD5 of block %d wasn't specified", index)
+	}
+	if checksum := md5.Sum(input.Content); *input.ContentMD5 != base64.StdEncoding.EncodeToString(checksum[:]) {
+		return result, fmt.Errorf("the MD5 of block %d didn't match its contents", index)
+	}
+
+	if c.failures[index] > 0 {
+		c.failures[index]--
+		return result, fmt.Errorf("uploading block %d failed", index)
+	}
+
+	checksum := md5.Sum(input.Content)
+	result.ContentMD5 = base64.StdEncoding.EncodeToString(checksum[:])
+	if c.corrupt[index] > 0 {
+		c.corrupt[index]--
+		result.ContentMD5 = base64.StdEncoding.EncodeToString([]byte("corrupt"))
+	}
+
+	if c.uncommitted == nil {
+		c.uncommitted = make(map[string][]byte)
+	}
+	c.uncommitted[input.BlockID] = input.Content
+	c.uploaded = append(c.uploaded, input.BlockID)
+	return result, nil
+}
+
+func (c *fakeBlockBlobClient) PutBlockList(_ context.Context, _, _, _ string, input blobs.PutBlockListInput) (result blobs.PutBlockListResult, err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	contents := make([]byte, 0)
+	for _, id := range input.BlockList.LatestBlockIDs {
+		block, ok := c.uncommitted[id.Value]
+		if !ok {
+			return result, fmt.Errorf("the block %q hasn't been uploaded", id.Value)
+		}
+		contents = append(contents, block...)
+	}
+
+	c.committed = contents
+	c.uncommitted = nil
+	return result, nil
+}
+
+func blockIndex(blockId string) int {
+	decoded, _ := base64.StdEncoding.DecodeString(blockId)
+	var index int
+	_, _ = fmt.Sscanf(string(decoded), "%06d-", &index)
+	return index
+}
+
+func testBlobUpload() BlobUpload {
+	return BlobUpload{
+		AccountName:   "account1",
+		ContainerName: "container1",
+		BlobName:      "blob1",
+		BlockSize:     10,
+		Parallelism:   2,
+		Source:        "example.vhd",
+	}
+}
+
+func testBlobContents(length int) []byte {
+	contents := make([]byte, length)
+	for i := range contents {
+		contents[i] = byte(i % 251)
+	}
+	return contents
+}
+
+func TestBlockUploadFromSource(t *testing.T) {
+	blockUploadRetryInterval = 0
+	contents := testBlobContents(95)
+	client := &fakeBlockBlobClient{}
+
+	if err := testBlobUpload().blockUploadFromSource(context.TODO(), client, bytes.NewReader(contents), int64(len(contents))); err != nil {
+		t.Fatalf("uploading: %+v", err)
+	}
+
+	if len(client.uploaded) != 10 {
+		t.Fatalf("expected 10 blocks to be uploaded but got %d", len(client.uploaded))
+	}
+	if !bytes.Equal(client.committed, contents) {
+		t.Fatalf("expected the committed blob to match the source file")
+	}
+}
+
+func TestBlockUploadFromSourceResumesInterruptedUpload(t *testing.T) {
+	blockUploadRetryInterval = 0
+	contents := testBlobContents(95)
+
+	// the first upload fails to upload the 4th block
+	client := &fakeBlockBlobClient{
+		failures: map[int]int{
+			3: maxBlockUploadAttempts,
+		},
+	}
+	upload := testBlobUpload()
+	upload.Parallelism = 0
+	if err := upload.blockUploadFromSource(context.TODO(), client, bytes.NewReader(contents), int64(len(contents))); err == nil {
+		t.Fatalf("expected the upload to fail but it didn't")
+	}
+	if client.committed != nil {
+		t.Fatalf("expected no blocks to be committed")
+	}
+	if len(client.uploaded) != 9 {
+		t.Fatalf("expected 9 blocks to be uploaded but got %d", len(client.uploaded))
+	}
+
+	// the second upload should only upload the missing block
+	client.uploaded = nil
+	if err := upload.blockUploadFromSource(context.TODO(), client, bytes.NewReader(contents), int64(len(contents))); err != nil {
+		t.Fatalf("uploading: %+v", err)
+	}
+	if len(client.uploaded) != 1 || blockIndex(client.uploaded[0]) != 3 {
+		t.Fatalf("expected only the 4th block to be uploaded but got %+v", client.uploaded)
+	}
+	if !bytes.Equal(client.committed, contents) {
+		t.Fatalf("expected the committed blob to match the source file")
+	}
+}
+
+func TestBlockUploadFromSourceReuploadsChangedBlocks(t *testing.T) {
+	blockUploadRetryInterval = 0
+	original := testBlobContents(95)
+	client := &fakeBlockBlobClient{
+		failures: map[int]int{
+			9: maxBlockUploadAttempts,
+		},
+	}
+	upload := testBlobUpload()
+	if err := upload.blockUploadFromSource(context.TODO(), client, bytes.NewReader(original), int64(len(original))); err == nil {
+		t.Fatalf("expected the upload to fail but it didn't")
+	}
+
+	// the first block of the file has since changed, so must be uploaded again
+	updated := testBlobContents(95)
+	updated[0] = 255
+	client.uploaded = nil
+	if err := upload.blockUploadFromSource(context.TODO(), client, bytes.NewReader(updated), int64(len(updated))); err != nil {
+		t.Fatalf("uploading: %+v", err)
+	}
+	if len(client.uploaded) != 2 {
+		t.Fatalf("expected 2 blocks to be uploaded but got %d", len(client.uploaded))
+	}
+	if !bytes.Equal(client.committed, updated) {
+		t.Fatalf("expected the committed blob to match the updated source file")
+	}
+}
+
+func TestBlockUploadRetriesFailedBlocks(t *testing.T) {
+	blockUploadRetryInterval = 0
+	contents := testBlobContents(30)
+	client := &fakeBlockBlobClient{
+		failures: map[int]int{
+			0: maxBlockUploadAttempts - 1,
+		},
+		corrupt: map[int]int{
+			2: 1,
+		},
+	}
+
+	if err := testBlobUpload().blockUploadFromSource(context.TODO(), client, bytes.NewReader(contents), int64(len(contents))); err != nil {
+		t.Fatalf("uploading: %+v", err)
+	}
+	if !bytes.Equal(client.committed, contents) {
+		t.Fatalf("expected the committed blob to match the source file")
+	}
+
+	// the corrupt block is uploaded twice
+	if len(client.uploaded) != 4 {
+		t.Fatalf("expected 4 blocks to be uploaded but got %d", len(client.uploaded))
+	}
+}
+
+func TestStorageBlobBlockSplit(t *testing.T) {
+	upload := testBlobUpload()
+
+	contents := testBlobContents(25)
+	blocks, err := upload.storageBlobBlockSplit(bytes.NewReader(contents), int64(len(contents)))
+	if err != nil {
+		t.Fatalf("splitting: %+v", err)
+	}
+
+	expectedSizes := []int64{10, 10, 5}
+	if len(blocks) != len(expectedSizes) {
+		t.Fatalf("expected %d blocks but got %d", len(expectedSizes), len(blocks))
+	}
+	for i, block := range blocks {
+		if block.section.Size() != expectedSizes[i] {
+			t.Fatalf("expected block %d to be %d bytes but got %d", i, expectedSizes[i], block.section.Size())
+		}
+		if len(block.id) != len(blocks[0].id) {
+			t.Fatalf("expected all of the Block ID's to be the same length")
+		}
+	}
+
+	// a Block Blob can contain at most 50,000 blocks
+	upload.BlockSize = 1
+	if _, err := upload.storageBlobBlockSplit(bytes.NewReader(nil), maxBlockCount+1); err == nil {
+		t.Fatalf("expected an error when the file would be split into too many blocks")
+	}
+}
+
+func TestBlockUploadWorkerCount(t *testing.T) {
+	testData := []struct {
+		Name        string
+		BlockSize   int
+		Parallelism int
+		BlockCount  int
+		Expected    int
+	}{
+		{
+			Name:        "Bounded by the number of Blocks",
+			BlockSize:   1024 * 1024,
+			Parallelism: 8,
+			BlockCount:  2,
+			Expected:    2,
+		},
+		{
+			Name:        "Bounded by the Memory Budget",
+			BlockSize:   64 * 1024 * 1024,
+			Parallelism: 8,
+			BlockCount:  1000,
+			Expected:    4,
+		},
+		{
+			Name:        "Block larger than the Memory Budget",
+			BlockSize:   4000 * 1024 * 1024,
+			Parallelism: 8,
+			BlockCount:  1000,
+			Expected:    1,
+		},
+		{
+			Name:        "No Parallelism",
+			BlockSize:   1024 * 1024,
+			Parallelism: 0,
+			BlockCount:  1000,
+			Expected:    1,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		upload := testBlobUpload()
+		upload.BlockSize = v.BlockSize
+		upload.Parallelism = v.Parallelism
+
+		if actual := upload.blockUploadWorkerCount(v.BlockCount); actual != v.Expected {
+			t.Fatalf("expected %d workers but got %d", v.Expected, actual)
+		}
+	}
+}
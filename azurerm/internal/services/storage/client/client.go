@@ -36,6 +36,7 @@ type Client struct {
 	SyncGroupsClient         *storagesync.SyncGroupsClient
 	SubscriptionId           string
 
+	readOnly                  bool
 	resourceManagerAuthorizer autorest.Authorizer
 	storageAdAuth             *autorest.Authorizer
 }
@@ -83,6 +84,7 @@ func NewClient(options *common.ClientOptions) *Client {
 		SyncServiceClient:        &syncServiceClient,
 		SyncGroupsClient:         &syncGroupsClient,
 
+		readOnly:                  options.ReadOnly,
 		resourceManagerAuthorizer: options.ResourceManagerAuthorizer,
 	}
 
@@ -93,10 +95,19 @@ func NewClient(options *common.ClientOptions) *Client {
 	return &client
 }
 
+// configureDataPlaneClient configures the specified Data Plane client, which (unlike the Resource Manager
+// clients) isn't configured using the ClientOptions since the Authorizer is specific to the Storage Account
+func (client Client) configureDataPlaneClient(c *autorest.Client) {
+	if client.readOnly {
+		c.RequestInspector = common.ReadOnlyRequestInspector(client.Environment.ResourceManagerEndpoint)
+	}
+}
+
 func (client Client) AccountsDataPlaneClient(ctx context.Context, account accountDetails) (*accounts.Client, error) {
 	if client.storageAdAuth != nil {
 		accountsClient := accounts.NewWithEnvironment(client.Environment)
 		accountsClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(&accountsClient.Client)
 		return &accountsClient, nil
 	}
 
@@ -112,6 +123,7 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 
 	accountsClient := accounts.NewWithEnvironment(client.Environment)
 	accountsClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&accountsClient.Client)
 	return &accountsClient, nil
 }
 
@@ -119,6 +131,7 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 	if client.storageAdAuth != nil {
 		blobsClient := blobs.NewWithEnvironment(client.Environment)
 		blobsClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(&blobsClient.Client)
 		return &blobsClient, nil
 	}
 
@@ -134,6 +147,7 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 
 	blobsClient := blobs.NewWithEnvironment(client.Environment)
 	blobsClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&blobsClient.Client)
 	return &blobsClient, nil
 }
 
@@ -141,6 +155,7 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 	if client.storageAdAuth != nil {
 		containersClient := containers.NewWithEnvironment(client.Environment)
 		containersClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(&containersClient.Client)
 		shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
 		return shim, nil
 	}
@@ -157,6 +172,7 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 
 	containersClient := containers.NewWithEnvironment(client.Environment)
 	containersClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&containersClient.Client)
 
 	shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
 	return shim, nil
@@ -177,6 +193,7 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, account acc
 
 	directoriesClient := directories.NewWithEnvironment(client.Environment)
 	directoriesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&directoriesClient.Client)
 	return &directoriesClient, nil
 }
 
@@ -195,6 +212,7 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 
 	filesClient := files.NewWithEnvironment(client.Environment)
 	filesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&filesClient.Client)
 	return &filesClient, nil
 }
 
@@ -213,6 +231,7 @@ func (client Client) FileSharesClient(ctx context.Context, account accountDetail
 
 	sharesClient := shares.NewWithEnvironment(client.Environment)
 	sharesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&sharesClient.Client)
 	shim := shim.NewDataPlaneStorageShareWrapper(&sharesClient)
 	return shim, nil
 }
@@ -221,6 +240,7 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 	if client.storageAdAuth != nil {
 		queueClient := queues.NewWithEnvironment(client.Environment)
 		queueClient.Client.Authorizer = *client.storageAdAuth
+		client.configureDataPlaneClient(&queueClient.Client)
 		return shim.NewDataPlaneStorageQueueWrapper(&queueClient), nil
 	}
 
@@ -236,6 +256,7 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 
 	queuesClient := queues.NewWithEnvironment(client.Environment)
 	queuesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&queuesClient.Client)
 	return shim.NewDataPlaneStorageQueueWrapper(&queuesClient), nil
 }
 
@@ -254,6 +275,7 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 
 	entitiesClient := entities.NewWithEnvironment(client.Environment)
 	entitiesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&entitiesClient.Client)
 	return &entitiesClient, nil
 }
 
@@ -272,6 +294,7 @@ func (client Client) TablesClient(ctx context.Context, account accountDetails) (
 
 	tablesClient := tables.NewWithEnvironment(client.Environment)
 	tablesClient.Client.Authorizer = storageAuth
+	client.configureDataPlaneClient(&tablesClient.Client)
 	shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
 	return shim, nil
 }
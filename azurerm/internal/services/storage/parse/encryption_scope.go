@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type EncryptionScopeId struct {
@@ -25,6 +25,16 @@ func NewEncryptionScopeID(subscriptionId, resourceGroup, storageAccountName, nam
 	}
 }
 
+// NewEncryptionScopeIDFromParent returns a EncryptionScopeId for the EncryptionScope within the specified StorageAccount
+func NewEncryptionScopeIDFromParent(parent StorageAccountId, name string) EncryptionScopeId {
+	return EncryptionScopeId{
+		SubscriptionId:     parent.SubscriptionId,
+		ResourceGroup:      parent.ResourceGroup,
+		StorageAccountName: parent.Name,
+		Name:               name,
+	}
+}
+
 func (id EncryptionScopeId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id EncryptionScopeId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this EncryptionScope
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id EncryptionScopeId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this EncryptionScope ID
+func (id EncryptionScopeId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftStorage", "Microsoft.Storage"),
+		resourceid.StaticSegment("staticStorageAccounts", "storageAccounts", false),
+		resourceid.UserSpecifiedSegment("storageAccountName"),
+		resourceid.StaticSegment("staticEncryptionScopes", "encryptionScopes", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // EncryptionScopeID parses a EncryptionScope ID into an EncryptionScopeId struct
 func EncryptionScopeID(input string) (*EncryptionScopeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, EncryptionScopeId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := EncryptionScopeId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("encryptionScopes"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		StorageAccountName: values["storageAccountName"],
+		Name:               values["name"],
 	}
 
 	return &resourceId, nil
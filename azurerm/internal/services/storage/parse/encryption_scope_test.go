@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = EncryptionScopeId{}
+var _ resourceid.SegmentedID = EncryptionScopeId{}
 
 func TestEncryptionScopeIDFormatter(t *testing.T) {
 	actual := NewEncryptionScopeID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "encryptionScope1").ID()
@@ -18,6 +19,52 @@ func TestEncryptionScopeIDFormatter(t *testing.T) {
 	}
 }
 
+func TestEncryptionScopeIDFromParent(t *testing.T) {
+	parent := NewStorageAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1")
+	actual := NewEncryptionScopeIDFromParent(parent, "encryptionScope1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/encryptionScopes/encryptionScope1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestEncryptionScopeIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/encryptionScopes/encryptionScope1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.STORAGE/STORAGEACCOUNTS/storageAccount1/ENCRYPTIONSCOPES/encryptionScope1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/encryptionScopes/encryptionScope1other",
+			Expected: false,
+		},
+	}
+
+	id := NewEncryptionScopeID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "encryptionScope1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestEncryptionScopeID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = StorageAccountId{}
+var _ resourceid.SegmentedID = StorageAccountId{}
 
 func TestStorageAccountIDFormatter(t *testing.T) {
 	actual := NewStorageAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1").ID()
@@ -18,6 +19,43 @@ func TestStorageAccountIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageAccountIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.STORAGE/STORAGEACCOUNTS/storageAccount1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1other",
+			Expected: false,
+		},
+	}
+
+	id := NewStorageAccountID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestStorageAccountID(t *testing.T) {
 	testData := []struct {
 		Input    string
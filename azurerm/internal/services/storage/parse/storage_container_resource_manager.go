@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageContainerResourceManagerId struct {
@@ -43,38 +43,43 @@ func (id StorageContainerResourceManagerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.BlobServiceName, id.ContainerName)
 }
 
+// Matches returns whether the specified Resource ID refers to this StorageContainerResourceManager
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id StorageContainerResourceManagerId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this StorageContainerResourceManager ID
+func (id StorageContainerResourceManagerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftStorage", "Microsoft.Storage"),
+		resourceid.StaticSegment("staticStorageAccounts", "storageAccounts", false),
+		resourceid.UserSpecifiedSegment("storageAccountName"),
+		resourceid.StaticSegment("staticBlobServices", "blobServices", false),
+		resourceid.UserSpecifiedSegment("blobServiceName"),
+		resourceid.StaticSegment("staticContainers", "containers", false),
+		resourceid.UserSpecifiedSegment("containerName"),
+	}
+}
+
 // StorageContainerResourceManagerID parses a StorageContainerResourceManager ID into an StorageContainerResourceManagerId struct
 func StorageContainerResourceManagerID(input string) (*StorageContainerResourceManagerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, StorageContainerResourceManagerId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageContainerResourceManagerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.BlobServiceName, err = id.PopSegment("blobServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		StorageAccountName: values["storageAccountName"],
+		BlobServiceName:    values["blobServiceName"],
+		ContainerName:      values["containerName"],
 	}
 
 	return &resourceId, nil
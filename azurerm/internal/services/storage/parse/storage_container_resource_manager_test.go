@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = StorageContainerResourceManagerId{}
+var _ resourceid.SegmentedID = StorageContainerResourceManagerId{}
 
 func TestStorageContainerResourceManagerIDFormatter(t *testing.T) {
 	actual := NewStorageContainerResourceManagerID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "default", "container1").ID()
@@ -18,6 +19,43 @@ func TestStorageContainerResourceManagerIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageContainerResourceManagerIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.STORAGE/STORAGEACCOUNTS/storageAccount1/BLOBSERVICES/default/CONTAINERS/container1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Storage/storageAccounts/storageAccount1/blobServices/default/containers/container1other",
+			Expected: false,
+		},
+	}
+
+	id := NewStorageContainerResourceManagerID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageAccount1", "default", "container1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestStorageContainerResourceManagerID(t *testing.T) {
 	testData := []struct {
 		Input    string
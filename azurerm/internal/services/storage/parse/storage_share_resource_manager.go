@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageShareResourceManagerId struct {
@@ -43,38 +43,43 @@ func (id StorageShareResourceManagerId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.FileServiceName, id.ShareName)
 }
 
+// Matches returns whether the specified Resource ID refers to this StorageShareResourceManager
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id StorageShareResourceManagerId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this StorageShareResourceManager ID
+func (id StorageShareResourceManagerId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftStorage", "Microsoft.Storage"),
+		resourceid.StaticSegment("staticStorageAccounts", "storageAccounts", false),
+		resourceid.UserSpecifiedSegment("storageAccountName"),
+		resourceid.StaticSegment("staticFileServices", "fileServices", false),
+		resourceid.UserSpecifiedSegment("fileServiceName"),
+		resourceid.StaticSegment("staticShares", "shares", false),
+		resourceid.UserSpecifiedSegment("shareName"),
+	}
+}
+
 // StorageShareResourceManagerID parses a StorageShareResourceManager ID into an StorageShareResourceManagerId struct
 func StorageShareResourceManagerID(input string) (*StorageShareResourceManagerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, StorageShareResourceManagerId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageShareResourceManagerId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
-		return nil, err
-	}
-	if resourceId.FileServiceName, err = id.PopSegment("fileServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.ShareName, err = id.PopSegment("shares"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:     values["subscriptionId"],
+		ResourceGroup:      values["resourceGroup"],
+		StorageAccountName: values["storageAccountName"],
+		FileServiceName:    values["fileServiceName"],
+		ShareName:          values["shareName"],
 	}
 
 	return &resourceId, nil
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageSyncCloudEndpointId struct {
@@ -27,6 +27,17 @@ func NewStorageSyncCloudEndpointID(subscriptionId, resourceGroup, storageSyncSer
 	}
 }
 
+// NewStorageSyncCloudEndpointIDFromParent returns a StorageSyncCloudEndpointId for the StorageSyncCloudEndpoint within the specified StorageSyncGroup
+func NewStorageSyncCloudEndpointIDFromParent(parent StorageSyncGroupId, cloudEndpointName string) StorageSyncCloudEndpointId {
+	return StorageSyncCloudEndpointId{
+		SubscriptionId:         parent.SubscriptionId,
+		ResourceGroup:          parent.ResourceGroup,
+		StorageSyncServiceName: parent.StorageSyncServiceName,
+		SyncGroupName:          parent.SyncGroupName,
+		CloudEndpointName:      cloudEndpointName,
+	}
+}
+
 func (id StorageSyncCloudEndpointId) String() string {
 	segments := []string{
 		fmt.Sprintf("Cloud Endpoint Name %q", id.CloudEndpointName),
@@ -43,38 +54,43 @@ func (id StorageSyncCloudEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName, id.CloudEndpointName)
 }
 
+// Matches returns whether the specified Resource ID refers to this StorageSyncCloudEndpoint
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id StorageSyncCloudEndpointId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this StorageSyncCloudEndpoint ID
+func (id StorageSyncCloudEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftStorageSync", "Microsoft.StorageSync"),
+		resourceid.StaticSegment("staticStorageSyncServices", "storageSyncServices", false),
+		resourceid.UserSpecifiedSegment("storageSyncServiceName"),
+		resourceid.StaticSegment("staticSyncGroups", "syncGroups", false),
+		resourceid.UserSpecifiedSegment("syncGroupName"),
+		resourceid.StaticSegment("staticCloudEndpoints", "cloudEndpoints", false),
+		resourceid.UserSpecifiedSegment("cloudEndpointName"),
+	}
+}
+
 // StorageSyncCloudEndpointID parses a StorageSyncCloudEndpoint ID into an StorageSyncCloudEndpointId struct
 func StorageSyncCloudEndpointID(input string) (*StorageSyncCloudEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, StorageSyncCloudEndpointId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageSyncCloudEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageSyncServiceName, err = id.PopSegment("storageSyncServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.SyncGroupName, err = id.PopSegment("syncGroups"); err != nil {
-		return nil, err
-	}
-	if resourceId.CloudEndpointName, err = id.PopSegment("cloudEndpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         values["subscriptionId"],
+		ResourceGroup:          values["resourceGroup"],
+		StorageSyncServiceName: values["storageSyncServiceName"],
+		SyncGroupName:          values["syncGroupName"],
+		CloudEndpointName:      values["cloudEndpointName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = StorageSyncCloudEndpointId{}
+var _ resourceid.SegmentedID = StorageSyncCloudEndpointId{}
 
 func TestStorageSyncCloudEndpointIDFormatter(t *testing.T) {
 	actual := NewStorageSyncCloudEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageSyncService1", "syncGroup1", "cloudEndpoint1").ID()
@@ -18,6 +19,52 @@ func TestStorageSyncCloudEndpointIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageSyncCloudEndpointIDFromParent(t *testing.T) {
+	parent := NewStorageSyncGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageSyncService1", "syncGroup1")
+	actual := NewStorageSyncCloudEndpointIDFromParent(parent, "cloudEndpoint1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StorageSync/storageSyncServices/storageSyncService1/syncGroups/syncGroup1/cloudEndpoints/cloudEndpoint1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageSyncCloudEndpointIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StorageSync/storageSyncServices/storageSyncService1/syncGroups/syncGroup1/cloudEndpoints/cloudEndpoint1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/storageSyncService1/SYNCGROUPS/syncGroup1/CLOUDENDPOINTS/cloudEndpoint1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StorageSync/storageSyncServices/storageSyncService1/syncGroups/syncGroup1/cloudEndpoints/cloudEndpoint1other",
+			Expected: false,
+		},
+	}
+
+	id := NewStorageSyncCloudEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageSyncService1", "syncGroup1", "cloudEndpoint1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestStorageSyncCloudEndpointID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StorageSyncGroupId struct {
@@ -25,6 +25,16 @@ func NewStorageSyncGroupID(subscriptionId, resourceGroup, storageSyncServiceName
 	}
 }
 
+// NewStorageSyncGroupIDFromParent returns a StorageSyncGroupId for the StorageSyncGroup within the specified StorageSyncService
+func NewStorageSyncGroupIDFromParent(parent StorageSyncServiceId, syncGroupName string) StorageSyncGroupId {
+	return StorageSyncGroupId{
+		SubscriptionId:         parent.SubscriptionId,
+		ResourceGroup:          parent.ResourceGroup,
+		StorageSyncServiceName: parent.Name,
+		SyncGroupName:          syncGroupName,
+	}
+}
+
 func (id StorageSyncGroupId) String() string {
 	segments := []string{
 		fmt.Sprintf("Sync Group Name %q", id.SyncGroupName),
@@ -40,35 +50,40 @@ func (id StorageSyncGroupId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageSyncServiceName, id.SyncGroupName)
 }
 
+// Matches returns whether the specified Resource ID refers to this StorageSyncGroup
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id StorageSyncGroupId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this StorageSyncGroup ID
+func (id StorageSyncGroupId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftStorageSync", "Microsoft.StorageSync"),
+		resourceid.StaticSegment("staticStorageSyncServices", "storageSyncServices", false),
+		resourceid.UserSpecifiedSegment("storageSyncServiceName"),
+		resourceid.StaticSegment("staticSyncGroups", "syncGroups", false),
+		resourceid.UserSpecifiedSegment("syncGroupName"),
+	}
+}
+
 // StorageSyncGroupID parses a StorageSyncGroup ID into an StorageSyncGroupId struct
 func StorageSyncGroupID(input string) (*StorageSyncGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, StorageSyncGroupId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StorageSyncGroupId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StorageSyncServiceName, err = id.PopSegment("storageSyncServices"); err != nil {
-		return nil, err
-	}
-	if resourceId.SyncGroupName, err = id.PopSegment("syncGroups"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:         values["subscriptionId"],
+		ResourceGroup:          values["resourceGroup"],
+		StorageSyncServiceName: values["storageSyncServiceName"],
+		SyncGroupName:          values["syncGroupName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = StorageSyncGroupId{}
+var _ resourceid.SegmentedID = StorageSyncGroupId{}
 
 func TestStorageSyncGroupIDFormatter(t *testing.T) {
 	actual := NewStorageSyncGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageSyncService1", "syncGroup1").ID()
@@ -18,6 +19,52 @@ func TestStorageSyncGroupIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageSyncGroupIDFromParent(t *testing.T) {
+	parent := NewStorageSyncServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageSyncService1")
+	actual := NewStorageSyncGroupIDFromParent(parent, "syncGroup1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StorageSync/storageSyncServices/storageSyncService1/syncGroups/syncGroup1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestStorageSyncGroupIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StorageSync/storageSyncServices/storageSyncService1/syncGroups/syncGroup1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/storageSyncService1/SYNCGROUPS/syncGroup1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StorageSync/storageSyncServices/storageSyncService1/syncGroups/syncGroup1other",
+			Expected: false,
+		},
+	}
+
+	id := NewStorageSyncGroupID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageSyncService1", "syncGroup1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestStorageSyncGroupID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = StorageSyncServiceId{}
+var _ resourceid.SegmentedID = StorageSyncServiceId{}
 
 func TestStorageSyncServiceIDFormatter(t *testing.T) {
 	actual := NewStorageSyncServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageSyncService1").ID()
@@ -18,6 +19,43 @@ func TestStorageSyncServiceIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStorageSyncServiceIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StorageSync/storageSyncServices/storageSyncService1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/storageSyncService1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StorageSync/storageSyncServices/storageSyncService1other",
+			Expected: false,
+		},
+	}
+
+	id := NewStorageSyncServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "storageSyncService1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestStorageSyncServiceID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,42 @@
+package storage
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Storage/storageAccounts/encryptionScopes",
+			TerraformResourceType: "azurerm_storage_encryption_scope",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.EncryptionScopeID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StorageSync/storageSyncServices",
+			TerraformResourceType: "azurerm_storage_sync",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StorageSyncServiceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StorageSync/storageSyncServices/syncGroups/cloudEndpoints",
+			TerraformResourceType: "azurerm_storage_sync_cloud_endpoint",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StorageSyncCloudEndpointID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StorageSync/storageSyncServices/syncGroups",
+			TerraformResourceType: "azurerm_storage_sync_group",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StorageSyncGroupID(input)
+			},
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package storage
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// sourceMD5MetaDataKey is the MetaData key used to store the MD5 hash of the `source` which was
+// uploaded, so that changes to the contents of the local file can be detected
+const sourceMD5MetaDataKey = "terraform_source_md5"
+
+func sourceMD5Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+}
+
+// sourceMD5CustomizeDiff calculates the MD5 hash of the local file specified in `source` at plan time,
+// so that a change to the contents of this file (rather than just it's path) updates the resource
+func sourceMD5CustomizeDiff(d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("source") {
+		return d.SetNewComputed("source_md5")
+	}
+
+	if d.Id() != "" && d.HasChange("source") {
+		// since the existing contents are otherwise left as-is, removing the `source` recreates the resource
+		if d.Get("source").(string) == "" {
+			return d.ForceNew("source")
+		}
+	}
+
+	source := d.Get("source").(string)
+	if source == "" {
+		return nil
+	}
+
+	sourceMD5, err := fileMD5(source)
+	if err != nil {
+		if os.IsNotExist(err) {
+			// the file may be created during the apply (for example by a `local_file` resource)
+			log.Printf("[DEBUG] Source %q doesn't exist yet - the MD5 hash will be calculated during the apply", source)
+			return d.SetNewComputed("source_md5")
+		}
+
+		return fmt.Errorf("calculating the MD5 hash of the `source` %q: %+v", source, err)
+	}
+
+	// when no hash was recorded when this was uploaded (e.g. by an older version of the Provider) and Azure
+	// doesn't have a Content MD5 to fall back to, the hash is recorded without uploading it again - see
+	// `sourceMD5IsBeingRecorded`
+	if existing := d.Get("source_md5").(string); existing != sourceMD5 {
+		return d.SetNew("source_md5", sourceMD5)
+	}
+
+	return nil
+}
+
+// sourceMD5IsBeingRecorded returns whether the MD5 hash of the `source` is being recorded for the first time
+// for an existing resource - in which case there's no previous hash to compare the contents against, so the
+// hash is recorded in the MetaData without uploading the `source` again
+func sourceMD5IsBeingRecorded(d *schema.ResourceData) bool {
+	if d.IsNewResource() || d.HasChange("source") {
+		return false
+	}
+
+	old, _ := d.GetChange("source_md5")
+	return old.(string) == ""
+}
+
+type cachedFileMD5 struct {
+	size    int64
+	modTime time.Time
+	md5     string
+}
+
+// fileMD5Cache caches the MD5 hash of each file which has been hashed, since hashing a large file is expensive
+// and the same file is otherwise hashed during both the plan and the apply
+var fileMD5Cache = struct {
+	lock   sync.Mutex
+	hashes map[string]cachedFileMD5
+}{
+	hashes: make(map[string]cachedFileMD5),
+}
+
+// fileMD5 returns the hex encoded MD5 hash of the contents of the specified file - which is only calculated
+// again when the size or modification time of the file have changed since it was last calculated
+func fileMD5(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return "", fmt.Errorf("retrieving information for %q: %+v", path, err)
+	}
+
+	fileMD5Cache.lock.Lock()
+	cached, ok := fileMD5Cache.hashes[path]
+	fileMD5Cache.lock.Unlock()
+	if ok && cached.size == info.Size() && cached.modTime.Equal(info.ModTime()) {
+		return cached.md5, nil
+	}
+
+	hash := md5.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", fmt.Errorf("reading %q: %+v", path, err)
+	}
+	output := hex.EncodeToString(hash.Sum(nil))
+
+	fileMD5Cache.lock.Lock()
+	fileMD5Cache.hashes[path] = cachedFileMD5{
+		size:    info.Size(),
+		modTime: info.ModTime(),
+		md5:     output,
+	}
+	fileMD5Cache.lock.Unlock()
+
+	return output, nil
+}
+
+// metaDataWithSourceMD5 returns a copy of the specified MetaData containing the MD5 hash of the `source`
+func metaDataWithSourceMD5(input map[string]string, sourceMD5 string) map[string]string {
+	output := make(map[string]string)
+	for k, v := range input {
+		output[k] = v
+	}
+
+	if sourceMD5 != "" {
+		output[sourceMD5MetaDataKey] = sourceMD5
+	}
+
+	return output
+}
+
+// metaDataWithoutSourceMD5 returns a copy of the specified MetaData without the MD5 hash of the `source`,
+// alongside the MD5 hash of the `source` (if any)
+func metaDataWithoutSourceMD5(input map[string]string) (map[string]string, string) {
+	output := make(map[string]string)
+	sourceMD5 := ""
+	for k, v := range input {
+		if k == sourceMD5MetaDataKey {
+			sourceMD5 = v
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output, sourceMD5
+}
@@ -0,0 +1,215 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func writeTestSource(t *testing.T, contents string) string {
+	dir, err := ioutil.TempDir("", "source-md5-")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	path := filepath.Join(dir, "source.txt")
+	if err := ioutil.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatalf("writing source: %+v", err)
+	}
+
+	return path
+}
+
+func TestFileMD5(t *testing.T) {
+	path := writeTestSource(t, "hello world")
+
+	actual, err := fileMD5(path)
+	if err != nil {
+		t.Fatalf("calculating MD5: %+v", err)
+	}
+
+	expected := "5eb63bbbe01eeed093cb22bb8f5acdc3"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+
+	// the cached hash is only used whilst the file is unmodified
+	if err := ioutil.WriteFile(path, []byte("hello world!"), 0600); err != nil {
+		t.Fatalf("writing source: %+v", err)
+	}
+	actual, err = fileMD5(path)
+	if err != nil {
+		t.Fatalf("calculating MD5: %+v", err)
+	}
+
+	expected = "fc3ff98e8c6a0d3087d515c0473f8677"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestMetaDataSourceMD5(t *testing.T) {
+	input := map[string]string{
+		"hello": "world",
+	}
+
+	withHash := metaDataWithSourceMD5(input, "abc123")
+	if len(input) != 1 {
+		t.Fatalf("Expected the input MetaData not to be modified but got %+v", input)
+	}
+	if withHash[sourceMD5MetaDataKey] != "abc123" || withHash["hello"] != "world" {
+		t.Fatalf("Expected the MetaData to contain the hash but got %+v", withHash)
+	}
+
+	withoutHash, sourceMD5 := metaDataWithoutSourceMD5(withHash)
+	if sourceMD5 != "abc123" {
+		t.Fatalf("Expected the hash %q but got %q", "abc123", sourceMD5)
+	}
+	if len(withoutHash) != 1 || withoutHash["hello"] != "world" {
+		t.Fatalf("Expected the MetaData not to contain the hash but got %+v", withoutHash)
+	}
+
+	if empty := metaDataWithSourceMD5(input, ""); len(empty) != 1 {
+		t.Fatalf("Expected no hash to be added but got %+v", empty)
+	}
+}
+
+func TestSourceMD5CustomizeDiff(t *testing.T) {
+	path := writeTestSource(t, "hello world")
+	hash := "5eb63bbbe01eeed093cb22bb8f5acdc3"
+
+	testData := []struct {
+		Name           string
+		State          map[string]string
+		Source         string
+		ExpectedMD5    string
+		ExpectComputed bool
+		ExpectForceNew bool
+		ExpectNoDiff   bool
+	}{
+		{
+			Name:        "New Resource",
+			Source:      path,
+			ExpectedMD5: hash,
+		},
+		{
+			Name:           "New Resource with a Source which doesn't exist yet",
+			Source:         path + ".missing",
+			ExpectComputed: true,
+		},
+		{
+			Name: "Unchanged",
+			State: map[string]string{
+				"source":     path,
+				"source_md5": hash,
+			},
+			Source:       path,
+			ExpectNoDiff: true,
+		},
+		{
+			Name: "Contents Changed",
+			State: map[string]string{
+				"source":     path,
+				"source_md5": "00000000000000000000000000000000",
+			},
+			Source:      path,
+			ExpectedMD5: hash,
+		},
+		{
+			Name: "No Hash Recorded",
+			State: map[string]string{
+				"source": path,
+			},
+			Source:      path,
+			ExpectedMD5: hash,
+		},
+		{
+			Name: "Source Removed",
+			State: map[string]string{
+				"source":     path,
+				"source_md5": hash,
+			},
+			ExpectForceNew: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		resource := resourceStorageShareFile()
+
+		var state *terraform.InstanceState
+		if v.State != nil {
+			attributes := map[string]string{
+				"name":                "file.txt",
+				"storage_share_id":    "https://account1.file.core.windows.net/share1",
+				"path":                "",
+				"content_type":        "application/octet-stream",
+				"metadata.%":          "0",
+				"id":                  "https://account1.file.core.windows.net/share1/file.txt",
+				"content_disposition": "",
+			}
+			for k, val := range v.State {
+				attributes[k] = val
+			}
+			state = &terraform.InstanceState{
+				ID:         attributes["id"],
+				Attributes: attributes,
+			}
+		}
+
+		raw := map[string]interface{}{
+			"name":             "file.txt",
+			"storage_share_id": "https://account1.file.core.windows.net/share1",
+		}
+		if v.Source != "" {
+			raw["source"] = v.Source
+		}
+
+		diff, err := resource.Diff(state, terraform.NewResourceConfigRaw(raw), nil)
+		if err != nil {
+			t.Fatalf("diffing: %+v", err)
+		}
+
+		if v.ExpectNoDiff {
+			if diff != nil && diff.Attributes["source_md5"] != nil {
+				t.Fatalf("Expected no diff for `source_md5` but got %+v", diff.Attributes["source_md5"])
+			}
+			continue
+		}
+
+		if diff == nil {
+			t.Fatalf("Expected a diff but didn't get one")
+		}
+
+		if v.ExpectForceNew {
+			if !diff.RequiresNew() {
+				t.Fatalf("Expected the resource to be recreated but got %+v", diff)
+			}
+			continue
+		}
+		if diff.RequiresNew() && state != nil {
+			t.Fatalf("Expected the resource to be updated in-place but got %+v", diff)
+		}
+
+		attr := diff.Attributes["source_md5"]
+		if attr == nil {
+			t.Fatalf("Expected a diff for `source_md5` but didn't get one")
+		}
+		if v.ExpectComputed {
+			if !attr.NewComputed {
+				t.Fatalf("Expected `source_md5` to be computed but got %+v", attr)
+			}
+			continue
+		}
+		if attr.New != v.ExpectedMD5 {
+			t.Fatalf("Expected `source_md5` to be %q but got %q", v.ExpectedMD5, attr.New)
+		}
+	}
+}
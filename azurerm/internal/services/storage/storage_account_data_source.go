@@ -375,5 +375,5 @@ func dataSourceStorageAccountRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("secondary_access_key", storageAccountKeys[1].Value)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -656,7 +656,7 @@ func resourceStorageAccountCreate(d *schema.ResourceData, meta interface{}) erro
 		Sku: &storage.Sku{
 			Name: storage.SkuName(storageType),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		Kind: storage.Kind(accountKind),
 		AccountPropertiesCreateParameters: &storage.AccountPropertiesCreateParameters{
 			EnableHTTPSTrafficOnly: &enableHTTPSTrafficOnly,
@@ -880,11 +880,11 @@ func resourceStorageAccountUpdate(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChanges("tags", "tags_all") {
 		t := d.Get("tags").(map[string]interface{})
 
 		opts := storage.AccountUpdateParameters{
-			Tags: tags.Expand(t),
+			Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		}
 
 		if _, err := client.Update(ctx, resourceGroupName, storageAccountName, opts); err != nil {
@@ -1301,7 +1301,7 @@ func resourceStorageAccountRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error setting `static_website `for AzureRM Storage Account %q: %+v", name, err)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceStorageAccountDelete(d *schema.ResourceData, meta interface{}) error {
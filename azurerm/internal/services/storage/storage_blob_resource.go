@@ -94,12 +94,14 @@ func resourceStorageBlob() *schema.Resource {
 			},
 
 			"source": {
+				// changes to the `source` (or it's contents) upload the Blob again, see `sourceMD5CustomizeDiff`
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"source_uri", "source_content"},
 			},
 
+			"source_md5": sourceMD5Schema(),
+
 			"source_content": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -127,7 +129,6 @@ func resourceStorageBlob() *schema.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         schema.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -135,8 +136,17 @@ func resourceStorageBlob() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 
+			"block_size_in_mb": {
+				// this is only used when uploading the Blob, so there's no need to force a new resource
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 4000),
+			},
+
 			"metadata": MetaDataComputedSchema(),
 		},
+
+		CustomizeDiff: sourceMD5CustomizeDiff,
 	}
 }
 
@@ -186,6 +196,14 @@ func resourceStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	sourceMD5 := ""
+	if source := d.Get("source").(string); source != "" {
+		sourceMD5, err = fileMD5(source)
+		if err != nil {
+			return fmt.Errorf("calculating the MD5 hash of the `source` %q: %+v", source, err)
+		}
+	}
+
 	log.Printf("[DEBUG] Creating Blob %q in Container %q within Storage Account %q..", name, containerName, accountName)
 	metaDataRaw := d.Get("metadata").(map[string]interface{})
 	blobInput := BlobUpload{
@@ -195,9 +213,10 @@ func resourceStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 		Client:        blobsClient,
 
 		BlobType:      d.Get("type").(string),
+		BlockSize:     d.Get("block_size_in_mb").(int) * 1024 * 1024,
 		ContentType:   d.Get("content_type").(string),
 		ContentMD5:    contentMD5,
-		MetaData:      ExpandMetaData(metaDataRaw),
+		MetaData:      metaDataWithSourceMD5(ExpandMetaData(metaDataRaw), sourceMD5),
 		Parallelism:   d.Get("parallelism").(int),
 		Size:          d.Get("size").(int),
 		Source:        d.Get("source").(string),
@@ -210,6 +229,7 @@ func resourceStorageBlobCreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Created Blob %q in Container %q within Storage Account %q.", name, containerName, accountName)
 
 	d.SetId(id)
+	d.Set("source_md5", sourceMD5)
 
 	return resourceStorageBlobUpdate(d, meta)
 }
@@ -237,7 +257,60 @@ func resourceStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error building Blobs Client: %s", err)
 	}
 
-	if d.HasChange("access_tier") {
+	// when the Blob's being created it's been uploaded already
+	uploaded := false
+	if sourceMD5IsBeingRecorded(d) && d.HasChange("source_md5") {
+		log.Printf("[DEBUG] Recording the MD5 hash of the Source for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
+		input := blobs.SetMetaDataInput{
+			MetaData: metaDataWithSourceMD5(ExpandMetaData(d.Get("metadata").(map[string]interface{})), d.Get("source_md5").(string)),
+		}
+		if _, err := blobsClient.SetMetaData(ctx, id.AccountName, id.ContainerName, id.BlobName, input); err != nil {
+			return fmt.Errorf("Error recording the MD5 hash of the Source for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+		log.Printf("[DEBUG] Recorded the MD5 hash of the Source for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
+	} else if !d.IsNewResource() && (d.HasChange("source") || d.HasChange("source_md5")) {
+		source := d.Get("source").(string)
+		log.Printf("[DEBUG] Uploading Source %q for Blob %q (Container %q / Account %q)...", source, id.BlobName, id.ContainerName, id.AccountName)
+		sourceMD5, err := fileMD5(source)
+		if err != nil {
+			return fmt.Errorf("calculating the MD5 hash of the `source` %q: %+v", source, err)
+		}
+
+		contentMD5 := ""
+		if contentMD5Raw := d.Get("content_md5").(string); contentMD5Raw != "" {
+			contentMD5, err = convertHexToBase64Encoding(contentMD5Raw)
+			if err != nil {
+				return fmt.Errorf("failed to base64 encode `content_md5` value: %s", err)
+			}
+		}
+
+		metaDataRaw := d.Get("metadata").(map[string]interface{})
+		blobInput := BlobUpload{
+			AccountName:   id.AccountName,
+			ContainerName: id.ContainerName,
+			BlobName:      id.BlobName,
+			Client:        blobsClient,
+
+			BlobType:    d.Get("type").(string),
+			BlockSize:   d.Get("block_size_in_mb").(int) * 1024 * 1024,
+			ContentType: d.Get("content_type").(string),
+			ContentMD5:  contentMD5,
+			MetaData:    metaDataWithSourceMD5(ExpandMetaData(metaDataRaw), sourceMD5),
+			Parallelism: d.Get("parallelism").(int),
+			Size:        d.Get("size").(int),
+			Source:      source,
+		}
+		if err := blobInput.Create(ctx); err != nil {
+			return fmt.Errorf("Error uploading Source %q for Blob %q (Container %q / Account %q): %s", source, id.BlobName, id.ContainerName, id.AccountName, err)
+		}
+
+		d.Set("source_md5", sourceMD5)
+		uploaded = true
+		log.Printf("[DEBUG] Uploaded Source %q for Blob %q (Container %q / Account %q).", source, id.BlobName, id.ContainerName, id.AccountName)
+	}
+
+	// uploading the Blob again resets the Access Tier, so this needs to be set again
+	if d.HasChange("access_tier") || (uploaded && d.Get("access_tier").(string) != "") {
 		// this is only applicable for Gen2/BlobStorage accounts
 		log.Printf("[DEBUG] Updating Access Tier for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		accessTier := blobs.AccessTier(d.Get("access_tier").(string))
@@ -249,7 +322,7 @@ func resourceStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Updated Access Tier for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
 	}
 
-	if d.HasChange("content_type") {
+	if d.HasChange("content_type") && !uploaded {
 		log.Printf("[DEBUG] Updating Properties for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		// `content_md5` is `ForceNew` but must be included in the `SetPropertiesInput` update payload or it will be zeroed on the blob.
 		input := blobs.SetPropertiesInput{
@@ -271,11 +344,12 @@ func resourceStorageBlobUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] Updated Properties for Blob %q (Container %q / Account %q).", id.BlobName, id.ContainerName, id.AccountName)
 	}
 
-	if d.HasChange("metadata") {
+	if d.HasChange("metadata") && !uploaded {
 		log.Printf("[DEBUG] Updating MetaData for Blob %q (Container %q / Account %q)...", id.BlobName, id.ContainerName, id.AccountName)
 		metaDataRaw := d.Get("metadata").(map[string]interface{})
 		input := blobs.SetMetaDataInput{
-			MetaData: ExpandMetaData(metaDataRaw),
+			// the MD5 hash of the `source` is stored in the MetaData, so needs to be retained
+			MetaData: metaDataWithSourceMD5(ExpandMetaData(metaDataRaw), d.Get("source_md5").(string)),
 		}
 		if _, err := blobsClient.SetMetaData(ctx, id.AccountName, id.ContainerName, id.BlobName, input); err != nil {
 			return fmt.Errorf("Error updating MetaData for Blob %q (Container %q / Account %q): %s", id.BlobName, id.ContainerName, id.AccountName, err)
@@ -344,9 +418,17 @@ func resourceStorageBlobRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("type", strings.TrimSuffix(string(props.BlobType), "Blob"))
 	d.Set("url", d.Id())
 
-	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
+	metaData, sourceMD5 := metaDataWithoutSourceMD5(props.MetaData)
+	if err := d.Set("metadata", FlattenMetaData(metaData)); err != nil {
 		return fmt.Errorf("Error setting `metadata`: %+v", err)
 	}
+
+	// Blobs uploaded without the MD5 hash of the `source` in the MetaData (e.g. by an older version of the
+	// Provider) fall back to the Content MD5, which is calculated by Azure for Blobs uploaded in one go
+	if sourceMD5 == "" {
+		sourceMD5 = contentMD5
+	}
+	d.Set("source_md5", sourceMD5)
 	// The CopySource is only returned if the blob hasn't been modified (e.g. metadata configured etc)
 	// as such, we need to conditionally set this to ensure it's trackable if possible
 	if props.CopySource != "" {
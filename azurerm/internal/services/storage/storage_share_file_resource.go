@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -79,14 +80,18 @@ func resourceStorageShareFile() *schema.Resource {
 			},
 
 			"source": {
+				// changes to the `source` (or it's contents) upload the File again, see `sourceMD5CustomizeDiff`
 				Type:         schema.TypeString,
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
-				ForceNew:     true,
 			},
 
+			"source_md5": sourceMD5Schema(),
+
 			"metadata": MetaDataSchema(),
 		},
+
+		CustomizeDiff: sourceMD5CustomizeDiff,
 	}
 }
 
@@ -141,44 +146,14 @@ func resourceStorageShareFileCreate(d *schema.ResourceData, meta interface{}) er
 		return tf.ImportAsExistsError("azurerm_storage_share_file", id)
 	}
 
-	input := files.CreateInput{
-		MetaData:           ExpandMetaData(d.Get("metadata").(map[string]interface{})),
-		ContentType:        utils.String(d.Get("content_type").(string)),
-		ContentEncoding:    utils.String(d.Get("content_encoding").(string)),
-		ContentDisposition: utils.String(d.Get("content_disposition").(string)),
-	}
-
-	if v, ok := d.GetOk("content_md5"); ok {
-		input.ContentMD5 = utils.String(v.(string))
-	}
-
-	var file *os.File
-	if v, ok := d.GetOk("source"); ok {
-		file, err = os.Open(v.(string))
-		if err != nil {
-			return fmt.Errorf("opening file : %s", err)
-		}
-
-		info, err := file.Stat()
-		if err != nil {
-			return fmt.Errorf("'stat'-ing File %q (File Share %q / Account %q): %+v", fileName, storageShareID.Name, storageShareID.AccountName, err)
-		}
-
-		input.ContentLength = info.Size()
-	}
-
-	if _, err := client.Create(ctx, storageShareID.AccountName, storageShareID.Name, path, fileName, input); err != nil {
-		return fmt.Errorf("creating File %q (File Share %q / Account %q): %+v", fileName, storageShareID.Name, storageShareID.AccountName, err)
-	}
-
-	if file != nil {
-		if err := client.PutFile(ctx, storageShareID.AccountName, storageShareID.Name, path, fileName, file, 4); err != nil {
-			return fmt.Errorf("uploading File: %q (File Share %q / Account %q): %+v", fileName, storageShareID.Name, storageShareID.AccountName, err)
-		}
+	sourceMD5, err := createStorageShareFile(ctx, d, client, storageShareID.AccountName, storageShareID.Name, path, fileName)
+	if err != nil {
+		return err
 	}
 
 	resourceID := client.GetResourceID(storageShareID.AccountName, storageShareID.Name, path, fileName)
 	d.SetId(resourceID)
+	d.Set("source_md5", sourceMD5)
 
 	return resourceStorageShareFileRead(d, meta)
 }
@@ -226,12 +201,31 @@ func resourceStorageShareFileUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if d.HasChange("content_type") || d.HasChange("content_encoding") || d.HasChange("content_disposition") || d.HasChange("content_md5") {
+	if sourceMD5IsBeingRecorded(d) && d.HasChange("source_md5") {
+		log.Printf("[DEBUG] Recording the MD5 hash of the Source for File %q (File Share %q / Account %q)...", id.FileName, id.ShareName, id.AccountName)
+		metaData := metaDataWithSourceMD5(ExpandMetaData(d.Get("metadata").(map[string]interface{})), d.Get("source_md5").(string))
+		if _, err := client.SetMetaData(ctx, id.AccountName, id.ShareName, id.DirectoryName, id.FileName, metaData); err != nil {
+			return fmt.Errorf("recording the MD5 hash of the Source for File %q (File Share %q / Account %q): %+v", id.FileName, id.ShareName, id.AccountName, err)
+		}
+		log.Printf("[DEBUG] Recorded the MD5 hash of the Source for File %q (File Share %q / Account %q).", id.FileName, id.ShareName, id.AccountName)
+	}
+
+	if d.HasChange("source") || (d.HasChange("source_md5") && !sourceMD5IsBeingRecorded(d)) {
+		// creating the File again replaces the existing File, including it's properties
+		log.Printf("[DEBUG] Uploading Source for File %q (File Share %q / Account %q)...", id.FileName, id.ShareName, id.AccountName)
+		sourceMD5, err := createStorageShareFile(ctx, d, client, id.AccountName, id.ShareName, id.DirectoryName, id.FileName)
+		if err != nil {
+			return err
+		}
+		d.Set("source_md5", sourceMD5)
+		log.Printf("[DEBUG] Uploaded Source for File %q (File Share %q / Account %q).", id.FileName, id.ShareName, id.AccountName)
+	} else if d.HasChange("content_type") || d.HasChange("content_encoding") || d.HasChange("content_disposition") || d.HasChange("content_md5") {
 		input := files.SetPropertiesInput{
 			ContentType:        utils.String(d.Get("content_type").(string)),
 			ContentEncoding:    utils.String(d.Get("content_encoding").(string)),
 			ContentDisposition: utils.String(d.Get("content_disposition").(string)),
-			MetaData:           ExpandMetaData(d.Get("metadata").(map[string]interface{})),
+			// the MD5 hash of the `source` is stored in the MetaData, so needs to be retained
+			MetaData: metaDataWithSourceMD5(ExpandMetaData(d.Get("metadata").(map[string]interface{})), d.Get("source_md5").(string)),
 		}
 
 		if v, ok := d.GetOk("content_md5"); ok {
@@ -295,9 +289,19 @@ func resourceStorageShareFileRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("path", id.DirectoryName)
 	d.Set("storage_share_id", parse.NewStorageShareDataPlaneId(id.AccountName, storageClient.Environment.StorageEndpointSuffix, id.ShareName).ID())
 
-	if err := d.Set("metadata", FlattenMetaData(props.MetaData)); err != nil {
+	metaData, sourceMD5 := metaDataWithoutSourceMD5(props.MetaData)
+	if err := d.Set("metadata", FlattenMetaData(metaData)); err != nil {
 		return fmt.Errorf("setting `metadata`: %s", err)
 	}
+
+	// Files uploaded without the MD5 hash of the `source` in the MetaData (e.g. by an older version of the
+	// Provider) fall back to the Content MD5, when one's been set for the File
+	if sourceMD5 == "" && props.ContentMD5 != "" {
+		if contentMD5, err := convertBase64ToHexEncoding(props.ContentMD5); err == nil {
+			sourceMD5 = contentMD5
+		}
+	}
+	d.Set("source_md5", sourceMD5)
 	d.Set("content_type", props.ContentType)
 	d.Set("content_encoding", props.ContentEncoding)
 	d.Set("content_md5", props.ContentMD5)
@@ -335,3 +339,53 @@ func resourceStorageShareFileDelete(d *schema.ResourceData, meta interface{}) er
 
 	return nil
 }
+
+// createStorageShareFile creates (or replaces) the File, uploading the contents of the `source` if specified - returning
+// the MD5 hash of the `source` which was uploaded
+func createStorageShareFile(ctx context.Context, d *schema.ResourceData, client *files.Client, accountName, shareName, path, fileName string) (string, error) {
+	input := files.CreateInput{
+		ContentType:        utils.String(d.Get("content_type").(string)),
+		ContentEncoding:    utils.String(d.Get("content_encoding").(string)),
+		ContentDisposition: utils.String(d.Get("content_disposition").(string)),
+	}
+
+	if v, ok := d.GetOk("content_md5"); ok {
+		input.ContentMD5 = utils.String(v.(string))
+	}
+
+	var file *os.File
+	sourceMD5 := ""
+	if v, ok := d.GetOk("source"); ok {
+		var err error
+		sourceMD5, err = fileMD5(v.(string))
+		if err != nil {
+			return "", fmt.Errorf("calculating the MD5 hash of the `source` %q: %+v", v.(string), err)
+		}
+
+		file, err = os.Open(v.(string))
+		if err != nil {
+			return "", fmt.Errorf("opening file : %s", err)
+		}
+		defer file.Close()
+
+		info, err := file.Stat()
+		if err != nil {
+			return "", fmt.Errorf("'stat'-ing File %q (File Share %q / Account %q): %+v", fileName, shareName, accountName, err)
+		}
+
+		input.ContentLength = info.Size()
+	}
+	input.MetaData = metaDataWithSourceMD5(ExpandMetaData(d.Get("metadata").(map[string]interface{})), sourceMD5)
+
+	if _, err := client.Create(ctx, accountName, shareName, path, fileName, input); err != nil {
+		return "", fmt.Errorf("creating File %q (File Share %q / Account %q): %+v", fileName, shareName, accountName, err)
+	}
+
+	if file != nil {
+		if err := client.PutFile(ctx, accountName, shareName, path, fileName, file, 4); err != nil {
+			return "", fmt.Errorf("uploading File: %q (File Share %q / Account %q): %+v", fileName, shareName, accountName, err)
+		}
+	}
+
+	return sourceMD5, nil
+}
@@ -89,7 +89,7 @@ func resourceStorageSyncCreate(d *schema.ResourceData, meta interface{}) error {
 		ServiceCreateParametersProperties: &storagesync.ServiceCreateParametersProperties{
 			IncomingTrafficPolicy: storagesync.IncomingTrafficPolicy(d.Get("incoming_traffic_policy").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 
 	future, err := client.Create(ctx, resourceGroupName, name, parameters)
@@ -138,7 +138,7 @@ func resourceStorageSyncRead(d *schema.ResourceData, meta interface{}) error {
 	if props := resp.ServiceProperties; props != nil {
 		d.Set("incoming_traffic_policy", props.IncomingTrafficPolicy)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceStorageSyncUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -153,8 +153,8 @@ func resourceStorageSyncUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	update := storagesync.ServiceUpdateParameters{}
 
-	if d.HasChange("tags") {
-		update.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
+	if d.HasChanges("tags", "tags_all") {
+		update.Tags = tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{}))
 	}
 
 	if d.HasChange("incoming_traffic_policy") {
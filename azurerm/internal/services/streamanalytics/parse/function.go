@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FunctionId struct {
@@ -25,6 +25,16 @@ func NewFunctionID(subscriptionId, resourceGroup, streamingjobName, name string)
 	}
 }
 
+// NewFunctionIDFromParent returns a FunctionId for the Function within the specified StreamingJob
+func NewFunctionIDFromParent(parent StreamingJobId, name string) FunctionId {
+	return FunctionId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		StreamingjobName: parent.Name,
+		Name:             name,
+	}
+}
+
 func (id FunctionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id FunctionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StreamingjobName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Function
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id FunctionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Function ID
+func (id FunctionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftStreamAnalytics", "Microsoft.StreamAnalytics"),
+		resourceid.StaticSegment("staticStreamingjobs", "streamingjobs", false),
+		resourceid.UserSpecifiedSegment("streamingjobName"),
+		resourceid.StaticSegment("staticFunctions", "functions", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // FunctionID parses a Function ID into an FunctionId struct
 func FunctionID(input string) (*FunctionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, FunctionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FunctionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("functions"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		StreamingjobName: values["streamingjobName"],
+		Name:             values["name"],
 	}
 
 	return &resourceId, nil
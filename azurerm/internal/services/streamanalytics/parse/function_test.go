@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = FunctionId{}
+var _ resourceid.SegmentedID = FunctionId{}
 
 func TestFunctionIDFormatter(t *testing.T) {
 	actual := NewFunctionID("12345678-1234-9876-4563-123456789012", "resGroup1", "streamingJob1", "function1").ID()
@@ -18,6 +19,52 @@ func TestFunctionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFunctionIDFromParent(t *testing.T) {
+	parent := NewStreamingJobID("12345678-1234-9876-4563-123456789012", "resGroup1", "streamingJob1")
+	actual := NewFunctionIDFromParent(parent, "function1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1/functions/function1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestFunctionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1/functions/function1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/streamingJob1/FUNCTIONS/function1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1/functions/function1other",
+			Expected: false,
+		},
+	}
+
+	id := NewFunctionID("12345678-1234-9876-4563-123456789012", "resGroup1", "streamingJob1", "function1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestFunctionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type OutputId struct {
@@ -25,6 +25,16 @@ func NewOutputID(subscriptionId, resourceGroup, streamingjobName, name string) O
 	}
 }
 
+// NewOutputIDFromParent returns a OutputId for the Output within the specified StreamingJob
+func NewOutputIDFromParent(parent StreamingJobId, name string) OutputId {
+	return OutputId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		StreamingjobName: parent.Name,
+		Name:             name,
+	}
+}
+
 func (id OutputId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id OutputId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StreamingjobName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this Output
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id OutputId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this Output ID
+func (id OutputId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftStreamAnalytics", "Microsoft.StreamAnalytics"),
+		resourceid.StaticSegment("staticStreamingjobs", "streamingjobs", false),
+		resourceid.UserSpecifiedSegment("streamingjobName"),
+		resourceid.StaticSegment("staticOutputs", "outputs", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // OutputID parses a Output ID into an OutputId struct
 func OutputID(input string) (*OutputId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, OutputId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := OutputId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("outputs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		StreamingjobName: values["streamingjobName"],
+		Name:             values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = OutputId{}
+var _ resourceid.SegmentedID = OutputId{}
 
 func TestOutputIDFormatter(t *testing.T) {
 	actual := NewOutputID("12345678-1234-9876-4563-123456789012", "resGroup1", "streamingJob1", "output1").ID()
@@ -18,6 +19,52 @@ func TestOutputIDFormatter(t *testing.T) {
 	}
 }
 
+func TestOutputIDFromParent(t *testing.T) {
+	parent := NewStreamingJobID("12345678-1234-9876-4563-123456789012", "resGroup1", "streamingJob1")
+	actual := NewOutputIDFromParent(parent, "output1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1/outputs/output1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestOutputIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1/outputs/output1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/streamingJob1/OUTPUTS/output1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1/outputs/output1other",
+			Expected: false,
+		},
+	}
+
+	id := NewOutputID("12345678-1234-9876-4563-123456789012", "resGroup1", "streamingJob1", "output1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestOutputID(t *testing.T) {
 	testData := []struct {
 		Input    string
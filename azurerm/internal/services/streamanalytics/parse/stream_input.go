@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type StreamInputId struct {
@@ -25,6 +25,16 @@ func NewStreamInputID(subscriptionId, resourceGroup, streamingjobName, inputName
 	}
 }
 
+// NewStreamInputIDFromParent returns a StreamInputId for the StreamInput within the specified StreamingJob
+func NewStreamInputIDFromParent(parent StreamingJobId, inputName string) StreamInputId {
+	return StreamInputId{
+		SubscriptionId:   parent.SubscriptionId,
+		ResourceGroup:    parent.ResourceGroup,
+		StreamingjobName: parent.Name,
+		InputName:        inputName,
+	}
+}
+
 func (id StreamInputId) String() string {
 	segments := []string{
 		fmt.Sprintf("Input Name %q", id.InputName),
@@ -40,35 +50,40 @@ func (id StreamInputId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StreamingjobName, id.InputName)
 }
 
+// Matches returns whether the specified Resource ID refers to this StreamInput
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id StreamInputId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this StreamInput ID
+func (id StreamInputId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftStreamAnalytics", "Microsoft.StreamAnalytics"),
+		resourceid.StaticSegment("staticStreamingjobs", "streamingjobs", false),
+		resourceid.UserSpecifiedSegment("streamingjobName"),
+		resourceid.StaticSegment("staticInputs", "inputs", false),
+		resourceid.UserSpecifiedSegment("inputName"),
+	}
+}
+
 // StreamInputID parses a StreamInput ID into an StreamInputId struct
 func StreamInputID(input string) (*StreamInputId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, StreamInputId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := StreamInputId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
-		return nil, err
-	}
-	if resourceId.InputName, err = id.PopSegment("inputs"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   values["subscriptionId"],
+		ResourceGroup:    values["resourceGroup"],
+		StreamingjobName: values["streamingjobName"],
+		InputName:        values["inputName"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = StreamingJobId{}
+var _ resourceid.SegmentedID = StreamingJobId{}
 
 func TestStreamingJobIDFormatter(t *testing.T) {
 	actual := NewStreamingJobID("12345678-1234-9876-4563-123456789012", "resGroup1", "streamingJob1").ID()
@@ -18,6 +19,43 @@ func TestStreamingJobIDFormatter(t *testing.T) {
 	}
 }
 
+func TestStreamingJobIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/streamingJob1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.StreamAnalytics/streamingjobs/streamingJob1other",
+			Expected: false,
+		},
+	}
+
+	id := NewStreamingJobID("12345678-1234-9876-4563-123456789012", "resGroup1", "streamingJob1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestStreamingJobID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,91 @@
+package streamanalytics
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/streamanalytics/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/functions",
+			TerraformResourceType: "azurerm_stream_analytics_function_javascript_udf",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FunctionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs",
+			TerraformResourceType: "azurerm_stream_analytics_job",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StreamingJobID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/outputs",
+			TerraformResourceType: "azurerm_stream_analytics_output_blob",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.OutputID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/outputs",
+			TerraformResourceType: "azurerm_stream_analytics_output_eventhub",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.OutputID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/outputs",
+			TerraformResourceType: "azurerm_stream_analytics_output_mssql",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.OutputID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/outputs",
+			TerraformResourceType: "azurerm_stream_analytics_output_servicebus_queue",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.OutputID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/outputs",
+			TerraformResourceType: "azurerm_stream_analytics_output_servicebus_topic",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.OutputID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/inputs",
+			TerraformResourceType: "azurerm_stream_analytics_reference_input_blob",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StreamInputID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/inputs",
+			TerraformResourceType: "azurerm_stream_analytics_stream_input_blob",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StreamInputID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/inputs",
+			TerraformResourceType: "azurerm_stream_analytics_stream_input_eventhub",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StreamInputID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.StreamAnalytics/streamingjobs/inputs",
+			TerraformResourceType: "azurerm_stream_analytics_stream_input_iothub",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.StreamInputID(input)
+			},
+		},
+	}
+}
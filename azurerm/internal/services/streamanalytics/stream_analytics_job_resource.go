@@ -184,7 +184,7 @@ func resourceStreamAnalyticsJobCreateUpdate(d *schema.ResourceData, meta interfa
 			EventsOutOfOrderPolicy:             streamanalytics.EventsOutOfOrderPolicy(eventsOutOfOrderPolicy),
 			OutputErrorPolicy:                  streamanalytics.OutputErrorPolicy(outputErrorPolicy),
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if dataLocale, ok := d.GetOk("data_locale"); ok {
@@ -283,7 +283,7 @@ func resourceStreamAnalyticsJobRead(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceStreamAnalyticsJobDelete(d *schema.ResourceData, meta interface{}) error {
@@ -90,5 +90,5 @@ func dataSourceSubscriptionRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("spending_limit", resp.SubscriptionPolicies.SpendingLimit)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ManagedPrivateEndpointId{}
+var _ resourceid.SegmentedID = ManagedPrivateEndpointId{}
 
 func TestManagedPrivateEndpointIDFormatter(t *testing.T) {
 	actual := NewManagedPrivateEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "default", "endpoint1").ID()
@@ -18,6 +19,43 @@ func TestManagedPrivateEndpointIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedPrivateEndpointIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/managedVirtualNetworks/default/managedPrivateEndpoints/endpoint1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SYNAPSE/WORKSPACES/workspace1/MANAGEDVIRTUALNETWORKS/default/MANAGEDPRIVATEENDPOINTS/endpoint1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/managedVirtualNetworks/default/managedPrivateEndpoints/endpoint1other",
+			Expected: false,
+		},
+	}
+
+	id := NewManagedPrivateEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "default", "endpoint1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestManagedPrivateEndpointID(t *testing.T) {
 	testData := []struct {
 		Input    string
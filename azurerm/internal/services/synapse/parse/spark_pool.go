@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SparkPoolId struct {
@@ -25,6 +25,16 @@ func NewSparkPoolID(subscriptionId, resourceGroup, workspaceName, bigDataPoolNam
 	}
 }
 
+// NewSparkPoolIDFromParent returns a SparkPoolId for the SparkPool within the specified Workspace
+func NewSparkPoolIDFromParent(parent WorkspaceId, bigDataPoolName string) SparkPoolId {
+	return SparkPoolId{
+		SubscriptionId:  parent.SubscriptionId,
+		ResourceGroup:   parent.ResourceGroup,
+		WorkspaceName:   parent.Name,
+		BigDataPoolName: bigDataPoolName,
+	}
+}
+
 func (id SparkPoolId) String() string {
 	segments := []string{
 		fmt.Sprintf("Big Data Pool Name %q", id.BigDataPoolName),
@@ -40,35 +50,40 @@ func (id SparkPoolId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.WorkspaceName, id.BigDataPoolName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SparkPool
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SparkPoolId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SparkPool ID
+func (id SparkPoolId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftSynapse", "Microsoft.Synapse"),
+		resourceid.StaticSegment("staticWorkspaces", "workspaces", false),
+		resourceid.UserSpecifiedSegment("workspaceName"),
+		resourceid.StaticSegment("staticBigDataPools", "bigDataPools", false),
+		resourceid.UserSpecifiedSegment("bigDataPoolName"),
+	}
+}
+
 // SparkPoolID parses a SparkPool ID into an SparkPoolId struct
 func SparkPoolID(input string) (*SparkPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SparkPoolId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SparkPoolId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
-		return nil, err
-	}
-	if resourceId.BigDataPoolName, err = id.PopSegment("bigDataPools"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:  values["subscriptionId"],
+		ResourceGroup:   values["resourceGroup"],
+		WorkspaceName:   values["workspaceName"],
+		BigDataPoolName: values["bigDataPoolName"],
 	}
 
 	return &resourceId, nil
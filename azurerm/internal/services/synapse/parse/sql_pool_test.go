@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SqlPoolId{}
+var _ resourceid.SegmentedID = SqlPoolId{}
 
 func TestSqlPoolIDFormatter(t *testing.T) {
 	actual := NewSqlPoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "sqlPool1").ID()
@@ -18,6 +19,52 @@ func TestSqlPoolIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSqlPoolIDFromParent(t *testing.T) {
+	parent := NewWorkspaceID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1")
+	actual := NewSqlPoolIDFromParent(parent, "sqlPool1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/sqlPool1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSqlPoolIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/sqlPool1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.SYNAPSE/WORKSPACES/workspace1/SQLPOOLS/sqlPool1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Synapse/workspaces/workspace1/sqlPools/sqlPool1other",
+			Expected: false,
+		},
+	}
+
+	id := NewSqlPoolID("12345678-1234-9876-4563-123456789012", "resGroup1", "workspace1", "sqlPool1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSqlPoolID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -0,0 +1,49 @@
+package synapse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/synapse/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Synapse/workspaces/firewallRules",
+			TerraformResourceType: "azurerm_synapse_firewall_rule",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FirewallRuleID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Synapse/workspaces/managedVirtualNetworks/managedPrivateEndpoints",
+			TerraformResourceType: "azurerm_synapse_managed_private_endpoint",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ManagedPrivateEndpointID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Synapse/workspaces/bigDataPools",
+			TerraformResourceType: "azurerm_synapse_spark_pool",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SparkPoolID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Synapse/workspaces/sqlPools",
+			TerraformResourceType: "azurerm_synapse_sql_pool",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SqlPoolID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Synapse/workspaces",
+			TerraformResourceType: "azurerm_synapse_workspace",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.WorkspaceID(input)
+			},
+		},
+	}
+}
@@ -198,7 +198,7 @@ func resourceSynapseSparkPoolCreateUpdate(d *schema.ResourceData, meta interface
 			SparkEventsFolder:     utils.String(d.Get("spark_events_folder").(string)),
 			SparkVersion:          utils.String(d.Get("spark_version").(string)),
 		},
-		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, d.Get("tags").(map[string]interface{})),
 	}
 	if !*autoScale.Enabled {
 		bigDataPoolInfo.NodeCount = utils.Int32(int32(d.Get("node_count").(int)))
@@ -265,7 +265,7 @@ func resourceSynapseSparkPoolRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("node_size_family", string(props.NodeSizeFamily))
 		d.Set("spark_version", props.SparkVersion)
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceSynapseSparkPoolDelete(d *schema.ResourceData, meta interface{}) error {
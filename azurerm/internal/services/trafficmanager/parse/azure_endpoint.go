@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AzureEndpointId struct {
@@ -25,6 +25,16 @@ func NewAzureEndpointID(subscriptionId, resourceGroup, trafficManagerProfileName
 	}
 }
 
+// NewAzureEndpointIDFromParent returns a AzureEndpointId for the AzureEndpoint within the specified TrafficManagerProfile
+func NewAzureEndpointIDFromParent(parent TrafficManagerProfileId, name string) AzureEndpointId {
+	return AzureEndpointId{
+		SubscriptionId:            parent.SubscriptionId,
+		ResourceGroup:             parent.ResourceGroup,
+		TrafficManagerProfileName: parent.Name,
+		Name:                      name,
+	}
+}
+
 func (id AzureEndpointId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id AzureEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this AzureEndpoint
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id AzureEndpointId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this AzureEndpoint ID
+func (id AzureEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticTrafficManagerProfiles", "trafficManagerProfiles", false),
+		resourceid.UserSpecifiedSegment("trafficManagerProfileName"),
+		resourceid.StaticSegment("staticAzureEndpoints", "azureEndpoints", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // AzureEndpointID parses a AzureEndpoint ID into an AzureEndpointId struct
 func AzureEndpointID(input string) (*AzureEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, AzureEndpointId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := AzureEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.TrafficManagerProfileName, err = id.PopSegment("trafficManagerProfiles"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("azureEndpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:            values["subscriptionId"],
+		ResourceGroup:             values["resourceGroup"],
+		TrafficManagerProfileName: values["trafficManagerProfileName"],
+		Name:                      values["name"],
 	}
 
 	return &resourceId, nil
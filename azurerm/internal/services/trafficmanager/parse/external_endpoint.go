@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ExternalEndpointId struct {
@@ -25,6 +25,16 @@ func NewExternalEndpointID(subscriptionId, resourceGroup, trafficManagerProfileN
 	}
 }
 
+// NewExternalEndpointIDFromParent returns a ExternalEndpointId for the ExternalEndpoint within the specified TrafficManagerProfile
+func NewExternalEndpointIDFromParent(parent TrafficManagerProfileId, name string) ExternalEndpointId {
+	return ExternalEndpointId{
+		SubscriptionId:            parent.SubscriptionId,
+		ResourceGroup:             parent.ResourceGroup,
+		TrafficManagerProfileName: parent.Name,
+		Name:                      name,
+	}
+}
+
 func (id ExternalEndpointId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id ExternalEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this ExternalEndpoint
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id ExternalEndpointId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this ExternalEndpoint ID
+func (id ExternalEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticTrafficManagerProfiles", "trafficManagerProfiles", false),
+		resourceid.UserSpecifiedSegment("trafficManagerProfileName"),
+		resourceid.StaticSegment("staticExternalEndpoints", "externalEndpoints", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // ExternalEndpointID parses a ExternalEndpoint ID into an ExternalEndpointId struct
 func ExternalEndpointID(input string) (*ExternalEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, ExternalEndpointId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ExternalEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.TrafficManagerProfileName, err = id.PopSegment("trafficManagerProfiles"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("externalEndpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:            values["subscriptionId"],
+		ResourceGroup:             values["resourceGroup"],
+		TrafficManagerProfileName: values["trafficManagerProfileName"],
+		Name:                      values["name"],
 	}
 
 	return &resourceId, nil
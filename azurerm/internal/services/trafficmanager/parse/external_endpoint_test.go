@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ExternalEndpointId{}
+var _ resourceid.SegmentedID = ExternalEndpointId{}
 
 func TestExternalEndpointIDFormatter(t *testing.T) {
 	actual := NewExternalEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "trafficManagerProfile1", "externalEndpoint1").ID()
@@ -18,6 +19,52 @@ func TestExternalEndpointIDFormatter(t *testing.T) {
 	}
 }
 
+func TestExternalEndpointIDFromParent(t *testing.T) {
+	parent := NewTrafficManagerProfileID("12345678-1234-9876-4563-123456789012", "resGroup1", "trafficManagerProfile1")
+	actual := NewExternalEndpointIDFromParent(parent, "externalEndpoint1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/trafficManagerProfile1/externalEndpoints/externalEndpoint1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestExternalEndpointIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/trafficManagerProfile1/externalEndpoints/externalEndpoint1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES/trafficManagerProfile1/EXTERNALENDPOINTS/externalEndpoint1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Network/trafficManagerProfiles/trafficManagerProfile1/externalEndpoints/externalEndpoint1other",
+			Expected: false,
+		},
+	}
+
+	id := NewExternalEndpointID("12345678-1234-9876-4563-123456789012", "resGroup1", "trafficManagerProfile1", "externalEndpoint1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestExternalEndpointID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type NestedEndpointId struct {
@@ -25,6 +25,16 @@ func NewNestedEndpointID(subscriptionId, resourceGroup, trafficManagerProfileNam
 	}
 }
 
+// NewNestedEndpointIDFromParent returns a NestedEndpointId for the NestedEndpoint within the specified TrafficManagerProfile
+func NewNestedEndpointIDFromParent(parent TrafficManagerProfileId, name string) NestedEndpointId {
+	return NestedEndpointId{
+		SubscriptionId:            parent.SubscriptionId,
+		ResourceGroup:             parent.ResourceGroup,
+		TrafficManagerProfileName: parent.Name,
+		Name:                      name,
+	}
+}
+
 func (id NestedEndpointId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id NestedEndpointId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.TrafficManagerProfileName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this NestedEndpoint
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id NestedEndpointId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this NestedEndpoint ID
+func (id NestedEndpointId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftNetwork", "Microsoft.Network"),
+		resourceid.StaticSegment("staticTrafficManagerProfiles", "trafficManagerProfiles", false),
+		resourceid.UserSpecifiedSegment("trafficManagerProfileName"),
+		resourceid.StaticSegment("staticNestedEndpoints", "nestedEndpoints", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // NestedEndpointID parses a NestedEndpoint ID into an NestedEndpointId struct
 func NestedEndpointID(input string) (*NestedEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, NestedEndpointId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := NestedEndpointId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.TrafficManagerProfileName, err = id.PopSegment("trafficManagerProfiles"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("nestedEndpoints"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:            values["subscriptionId"],
+		ResourceGroup:             values["resourceGroup"],
+		TrafficManagerProfileName: values["trafficManagerProfileName"],
+		Name:                      values["name"],
 	}
 
 	return &resourceId, nil
@@ -168,5 +168,5 @@ func dataSourceArmTrafficManagerProfileRead(d *schema.ResourceData, meta interfa
 			d.Set("fqdn", dns.Fqdn)
 		}
 	}
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
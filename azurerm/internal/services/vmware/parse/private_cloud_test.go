@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = PrivateCloudId{}
+var _ resourceid.SegmentedID = PrivateCloudId{}
 
 func TestPrivateCloudIDFormatter(t *testing.T) {
 	actual := NewPrivateCloudID("12345678-1234-9876-4563-123456789012", "group1", "privateCloud1").ID()
@@ -18,6 +19,43 @@ func TestPrivateCloudIDFormatter(t *testing.T) {
 	}
 }
 
+func TestPrivateCloudIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AVS/privateClouds/privateCloud1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/group1/PROVIDERS/MICROSOFT.AVS/PRIVATECLOUDS/privateCloud1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.AVS/privateClouds/privateCloud1other",
+			Expected: false,
+		},
+	}
+
+	id := NewPrivateCloudID("12345678-1234-9876-4563-123456789012", "group1", "privateCloud1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestPrivateCloudID(t *testing.T) {
 	testData := []struct {
 		Input    string
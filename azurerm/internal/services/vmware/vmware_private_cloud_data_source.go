@@ -193,5 +193,5 @@ func dataSourceVmwarePrivateCloudRead(d *schema.ResourceData, meta interface{})
 		d.Set("sku_name", sku.Name)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -111,5 +111,5 @@ func dataSourceAppServiceCertificateRead(d *schema.ResourceData, meta interface{
 		d.Set("thumbprint", props.Thumbprint)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
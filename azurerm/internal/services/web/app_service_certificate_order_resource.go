@@ -221,7 +221,7 @@ func resourceAppServiceCertificateOrderCreateUpdate(d *schema.ResourceData, meta
 	certificateOrder := web.AppServiceCertificateOrder{
 		AppServiceCertificateOrderProperties: &properties,
 		Location:                             utils.String(location),
-		Tags:                                 tags.Expand(t),
+		Tags:                                 tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, certificateOrder)
@@ -310,7 +310,7 @@ func resourceAppServiceCertificateOrderRead(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceAppServiceCertificateOrderDelete(d *schema.ResourceData, meta interface{}) error {
@@ -246,5 +246,5 @@ func dataSourceAppServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -131,5 +131,5 @@ func dataSourceAppServiceEnvironmentRead(d *schema.ResourceData, meta interface{
 	d.Set("service_ip_address", vipInfo.ServiceIPAddress)
 	d.Set("outbound_ip_addresses", vipInfo.OutboundIPAddresses)
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -232,7 +232,7 @@ func resourceAppServiceEnvironmentCreate(d *schema.ResourceData, meta interface{
 			// TODO: remove this hack once https://github.com/Azure/azure-rest-api-specs/pull/8433 has been merged
 			WorkerPools: &[]web.WorkerPool{{}},
 		},
-		Tags: tags.Expand(t),
+		Tags: tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if clusterSettingsRaw, ok := d.GetOk("cluster_setting"); ok {
@@ -388,7 +388,7 @@ func resourceAppServiceEnvironmentRead(d *schema.ResourceData, meta interface{})
 		d.Set("cluster_setting", flattenClusterSettings(props.ClusterSettings))
 	}
 
-	return tags.FlattenAndSet(d, existing.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, existing.Tags)
 }
 
 func resourceAppServiceEnvironmentDelete(d *schema.ResourceData, meta interface{}) error {
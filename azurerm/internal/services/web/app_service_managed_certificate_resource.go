@@ -151,7 +151,7 @@ func resourceAppServiceManagedCertificateCreateUpdate(d *schema.ResourceData, me
 			Password:      new(string),
 		},
 		Location: utils.String(appServiceLocation),
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 	}
 
 	if resp, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.CertificateName, certificate); err != nil {
@@ -229,7 +229,7 @@ func resourceAppServiceManagedCertificateRead(d *schema.ResourceData, meta inter
 		d.Set("thumbprint", props.Thumbprint)
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceAppServiceManagedCertificateDelete(d *schema.ResourceData, meta interface{}) error {
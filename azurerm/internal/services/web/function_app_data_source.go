@@ -252,5 +252,5 @@ func dataSourceFunctionAppRead(d *schema.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetDataSource(meta.(*clients.Client).Tags, d, resp.Tags)
 }
@@ -277,7 +277,7 @@ func resourceFunctionAppSlotCreate(d *schema.ResourceData, meta interface{}) err
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:          utils.String(appServicePlanID),
 			Enabled:               utils.Bool(enabled),
@@ -372,7 +372,7 @@ func resourceFunctionAppSlotUpdate(d *schema.ResourceData, meta interface{}) err
 	siteEnvelope := web.Site{
 		Kind:     &kind,
 		Location: &location,
-		Tags:     tags.Expand(t),
+		Tags:     tags.ExpandWithDefaults(meta.(*clients.Client).Tags, t),
 		SiteProperties: &web.SiteProperties{
 			ServerFarmID:          utils.String(appServicePlanID),
 			Enabled:               utils.Bool(enabled),
@@ -596,7 +596,7 @@ func resourceFunctionAppSlotRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	return tags.FlattenAndSet(d, resp.Tags)
+	return tags.FlattenAndSetWithDefaults(meta.(*clients.Client).Tags, d, resp.Tags)
 }
 
 func resourceFunctionAppSlotDelete(d *schema.ResourceData, meta interface{}) error {
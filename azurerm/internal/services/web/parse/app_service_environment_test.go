@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AppServiceEnvironmentId{}
+var _ resourceid.SegmentedID = AppServiceEnvironmentId{}
 
 func TestAppServiceEnvironmentIDFormatter(t *testing.T) {
 	actual := NewAppServiceEnvironmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "hostingEnvironment1").ID()
@@ -18,6 +19,43 @@ func TestAppServiceEnvironmentIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAppServiceEnvironmentIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/hostingEnvironments/hostingEnvironment1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.WEB/HOSTINGENVIRONMENTS/hostingEnvironment1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/hostingEnvironments/hostingEnvironment1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAppServiceEnvironmentID("12345678-1234-9876-4563-123456789012", "resGroup1", "hostingEnvironment1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAppServiceEnvironmentID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AppServicePlanId{}
+var _ resourceid.SegmentedID = AppServicePlanId{}
 
 func TestAppServicePlanIDFormatter(t *testing.T) {
 	actual := NewAppServicePlanID("12345678-1234-9876-4563-123456789012", "resGroup1", "farm1").ID()
@@ -18,6 +19,43 @@ func TestAppServicePlanIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAppServicePlanIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/serverfarms/farm1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.WEB/SERVERFARMS/farm1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/serverfarms/farm1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAppServicePlanID("12345678-1234-9876-4563-123456789012", "resGroup1", "farm1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAppServicePlanID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = AppServiceSlotId{}
+var _ resourceid.SegmentedID = AppServiceSlotId{}
 
 func TestAppServiceSlotIDFormatter(t *testing.T) {
 	actual := NewAppServiceSlotID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "slot1").ID()
@@ -18,6 +19,52 @@ func TestAppServiceSlotIDFormatter(t *testing.T) {
 	}
 }
 
+func TestAppServiceSlotIDFromParent(t *testing.T) {
+	parent := NewAppServiceID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1")
+	actual := NewAppServiceSlotIDFromParent(parent, "slot1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestAppServiceSlotIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.WEB/SITES/site1/SLOTS/slot1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1other",
+			Expected: false,
+		},
+	}
+
+	id := NewAppServiceSlotID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "slot1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestAppServiceSlotID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = CertificateOrderId{}
+var _ resourceid.SegmentedID = CertificateOrderId{}
 
 func TestCertificateOrderIDFormatter(t *testing.T) {
 	actual := NewCertificateOrderID("12345678-1234-9876-4563-123456789012", "resGroup1", "order1").ID()
@@ -18,6 +19,43 @@ func TestCertificateOrderIDFormatter(t *testing.T) {
 	}
 }
 
+func TestCertificateOrderIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/certificateOrders/order1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.WEB/CERTIFICATEORDERS/order1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/certificateOrders/order1other",
+			Expected: false,
+		},
+	}
+
+	id := NewCertificateOrderID("12345678-1234-9876-4563-123456789012", "resGroup1", "order1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestCertificateOrderID(t *testing.T) {
 	testData := []struct {
 		Input    string
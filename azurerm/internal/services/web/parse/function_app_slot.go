@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type FunctionAppSlotId struct {
@@ -25,6 +25,16 @@ func NewFunctionAppSlotID(subscriptionId, resourceGroup, siteName, slotName stri
 	}
 }
 
+// NewFunctionAppSlotIDFromParent returns a FunctionAppSlotId for the FunctionAppSlot within the specified AppService
+func NewFunctionAppSlotIDFromParent(parent AppServiceId, slotName string) FunctionAppSlotId {
+	return FunctionAppSlotId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		SiteName:       parent.SiteName,
+		SlotName:       slotName,
+	}
+}
+
 func (id FunctionAppSlotId) String() string {
 	segments := []string{
 		fmt.Sprintf("Slot Name %q", id.SlotName),
@@ -40,35 +50,40 @@ func (id FunctionAppSlotId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName)
 }
 
+// Matches returns whether the specified Resource ID refers to this FunctionAppSlot
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id FunctionAppSlotId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this FunctionAppSlot ID
+func (id FunctionAppSlotId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web"),
+		resourceid.StaticSegment("staticSites", "sites", false),
+		resourceid.UserSpecifiedSegment("siteName"),
+		resourceid.StaticSegment("staticSlots", "slots", false),
+		resourceid.UserSpecifiedSegment("slotName"),
+	}
+}
+
 // FunctionAppSlotID parses a FunctionAppSlot ID into an FunctionAppSlotId struct
 func FunctionAppSlotID(input string) (*FunctionAppSlotId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, FunctionAppSlotId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := FunctionAppSlotId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
-		return nil, err
-	}
-	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		SiteName:       values["siteName"],
+		SlotName:       values["slotName"],
 	}
 
 	return &resourceId, nil
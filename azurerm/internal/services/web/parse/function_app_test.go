@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = FunctionAppId{}
+var _ resourceid.SegmentedID = FunctionAppId{}
 
 func TestFunctionAppIDFormatter(t *testing.T) {
 	actual := NewFunctionAppID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1").ID()
@@ -18,6 +19,43 @@ func TestFunctionAppIDFormatter(t *testing.T) {
 	}
 }
 
+func TestFunctionAppIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.WEB/SITES/site1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1other",
+			Expected: false,
+		},
+	}
+
+	id := NewFunctionAppID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestFunctionAppID(t *testing.T) {
 	testData := []struct {
 		Input    string
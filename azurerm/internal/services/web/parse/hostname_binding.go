@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type HostnameBindingId struct {
@@ -25,6 +25,16 @@ func NewHostnameBindingID(subscriptionId, resourceGroup, siteName, name string)
 	}
 }
 
+// NewHostnameBindingIDFromParent returns a HostnameBindingId for the HostnameBinding within the specified AppService
+func NewHostnameBindingIDFromParent(parent AppServiceId, name string) HostnameBindingId {
+	return HostnameBindingId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		SiteName:       parent.SiteName,
+		Name:           name,
+	}
+}
+
 func (id HostnameBindingId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
@@ -40,35 +50,40 @@ func (id HostnameBindingId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.Name)
 }
 
+// Matches returns whether the specified Resource ID refers to this HostnameBinding
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id HostnameBindingId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this HostnameBinding ID
+func (id HostnameBindingId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web"),
+		resourceid.StaticSegment("staticSites", "sites", false),
+		resourceid.UserSpecifiedSegment("siteName"),
+		resourceid.StaticSegment("staticHostNameBindings", "hostNameBindings", false),
+		resourceid.UserSpecifiedSegment("name"),
+	}
+}
+
 // HostnameBindingID parses a HostnameBinding ID into an HostnameBindingId struct
 func HostnameBindingID(input string) (*HostnameBindingId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, HostnameBindingId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := HostnameBindingId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
-		return nil, err
-	}
-	if resourceId.Name, err = id.PopSegment("hostNameBindings"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		SiteName:       values["siteName"],
+		Name:           values["name"],
 	}
 
 	return &resourceId, nil
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = HostnameBindingId{}
+var _ resourceid.SegmentedID = HostnameBindingId{}
 
 func TestHostnameBindingIDFormatter(t *testing.T) {
 	actual := NewHostnameBindingID("12345678-1234-9876-4563-123456789012", "mygroup1", "site1", "binding1").ID()
@@ -18,6 +19,52 @@ func TestHostnameBindingIDFormatter(t *testing.T) {
 	}
 }
 
+func TestHostnameBindingIDFromParent(t *testing.T) {
+	parent := NewAppServiceID("12345678-1234-9876-4563-123456789012", "mygroup1", "site1")
+	actual := NewHostnameBindingIDFromParent(parent, "binding1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/mygroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/binding1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestHostnameBindingIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/mygroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/binding1",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/mygroup1/PROVIDERS/MICROSOFT.WEB/SITES/site1/HOSTNAMEBINDINGS/binding1",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/mygroup1/providers/Microsoft.Web/sites/site1/hostNameBindings/binding1other",
+			Expected: false,
+		},
+	}
+
+	id := NewHostnameBindingID("12345678-1234-9876-4563-123456789012", "mygroup1", "site1", "binding1")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestHostnameBindingID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = ManagedCertificateId{}
+var _ resourceid.SegmentedID = ManagedCertificateId{}
 
 func TestManagedCertificateIDFormatter(t *testing.T) {
 	actual := NewManagedCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "customhost.contoso.com").ID()
@@ -18,6 +19,43 @@ func TestManagedCertificateIDFormatter(t *testing.T) {
 	}
 }
 
+func TestManagedCertificateIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/certificates/customhost.contoso.com",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.WEB/CERTIFICATES/customhost.contoso.com",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/certificates/customhost.contoso.comother",
+			Expected: false,
+		},
+	}
+
+	id := NewManagedCertificateID("12345678-1234-9876-4563-123456789012", "resGroup1", "customhost.contoso.com")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestManagedCertificateID(t *testing.T) {
 	testData := []struct {
 		Input    string
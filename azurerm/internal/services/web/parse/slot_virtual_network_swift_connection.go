@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type SlotVirtualNetworkSwiftConnectionId struct {
@@ -27,6 +27,17 @@ func NewSlotVirtualNetworkSwiftConnectionID(subscriptionId, resourceGroup, siteN
 	}
 }
 
+// NewSlotVirtualNetworkSwiftConnectionIDFromParent returns a SlotVirtualNetworkSwiftConnectionId for the SlotVirtualNetworkSwiftConnection within the specified AppServiceSlot
+func NewSlotVirtualNetworkSwiftConnectionIDFromParent(parent AppServiceSlotId, configName string) SlotVirtualNetworkSwiftConnectionId {
+	return SlotVirtualNetworkSwiftConnectionId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		SiteName:       parent.SiteName,
+		SlotName:       parent.SlotName,
+		ConfigName:     configName,
+	}
+}
+
 func (id SlotVirtualNetworkSwiftConnectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Config Name %q", id.ConfigName),
@@ -43,38 +54,43 @@ func (id SlotVirtualNetworkSwiftConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.SlotName, id.ConfigName)
 }
 
+// Matches returns whether the specified Resource ID refers to this SlotVirtualNetworkSwiftConnection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id SlotVirtualNetworkSwiftConnectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this SlotVirtualNetworkSwiftConnection ID
+func (id SlotVirtualNetworkSwiftConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web"),
+		resourceid.StaticSegment("staticSites", "sites", false),
+		resourceid.UserSpecifiedSegment("siteName"),
+		resourceid.StaticSegment("staticSlots", "slots", false),
+		resourceid.UserSpecifiedSegment("slotName"),
+		resourceid.StaticSegment("staticConfig", "config", false),
+		resourceid.UserSpecifiedSegment("configName"),
+	}
+}
+
 // SlotVirtualNetworkSwiftConnectionID parses a SlotVirtualNetworkSwiftConnection ID into an SlotVirtualNetworkSwiftConnectionId struct
 func SlotVirtualNetworkSwiftConnectionID(input string) (*SlotVirtualNetworkSwiftConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, SlotVirtualNetworkSwiftConnectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := SlotVirtualNetworkSwiftConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
-		return nil, err
-	}
-	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
-		return nil, err
-	}
-	if resourceId.ConfigName, err = id.PopSegment("config"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		SiteName:       values["siteName"],
+		SlotName:       values["slotName"],
+		ConfigName:     values["configName"],
 	}
 
 	return &resourceId, nil
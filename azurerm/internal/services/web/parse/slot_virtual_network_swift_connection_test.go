@@ -9,6 +9,7 @@ import (
 )
 
 var _ resourceid.Formatter = SlotVirtualNetworkSwiftConnectionId{}
+var _ resourceid.SegmentedID = SlotVirtualNetworkSwiftConnectionId{}
 
 func TestSlotVirtualNetworkSwiftConnectionIDFormatter(t *testing.T) {
 	actual := NewSlotVirtualNetworkSwiftConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "slot1", "virtualNetwork").ID()
@@ -18,6 +19,52 @@ func TestSlotVirtualNetworkSwiftConnectionIDFormatter(t *testing.T) {
 	}
 }
 
+func TestSlotVirtualNetworkSwiftConnectionIDFromParent(t *testing.T) {
+	parent := NewAppServiceSlotID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "slot1")
+	actual := NewSlotVirtualNetworkSwiftConnectionIDFromParent(parent, "virtualNetwork").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/config/virtualNetwork"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestSlotVirtualNetworkSwiftConnectionIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/config/virtualNetwork",
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/resGroup1/PROVIDERS/MICROSOFT.WEB/SITES/site1/SLOTS/slot1/CONFIG/virtualNetwork",
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Web/sites/site1/slots/slot1/config/virtualNetworkother",
+			Expected: false,
+		},
+	}
+
+	id := NewSlotVirtualNetworkSwiftConnectionID("12345678-1234-9876-4563-123456789012", "resGroup1", "site1", "slot1", "virtualNetwork")
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
 func TestSlotVirtualNetworkSwiftConnectionID(t *testing.T) {
 	testData := []struct {
 		Input    string
@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type VirtualNetworkSwiftConnectionId struct {
@@ -25,6 +25,16 @@ func NewVirtualNetworkSwiftConnectionID(subscriptionId, resourceGroup, siteName,
 	}
 }
 
+// NewVirtualNetworkSwiftConnectionIDFromParent returns a VirtualNetworkSwiftConnectionId for the VirtualNetworkSwiftConnection within the specified AppService
+func NewVirtualNetworkSwiftConnectionIDFromParent(parent AppServiceId, configName string) VirtualNetworkSwiftConnectionId {
+	return VirtualNetworkSwiftConnectionId{
+		SubscriptionId: parent.SubscriptionId,
+		ResourceGroup:  parent.ResourceGroup,
+		SiteName:       parent.SiteName,
+		ConfigName:     configName,
+	}
+}
+
 func (id VirtualNetworkSwiftConnectionId) String() string {
 	segments := []string{
 		fmt.Sprintf("Config Name %q", id.ConfigName),
@@ -40,35 +50,40 @@ func (id VirtualNetworkSwiftConnectionId) ID() string {
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.SiteName, id.ConfigName)
 }
 
+// Matches returns whether the specified Resource ID refers to this VirtualNetworkSwiftConnection
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id VirtualNetworkSwiftConnectionId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+
+// Segments returns the list of Segments which make up this VirtualNetworkSwiftConnection ID
+func (id VirtualNetworkSwiftConnectionId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions", false),
+		resourceid.SubscriptionIdSegment("subscriptionId"),
+		resourceid.StaticSegment("staticResourceGroups", "resourceGroups", true),
+		resourceid.ResourceGroupSegment("resourceGroup"),
+		resourceid.StaticSegment("staticProviders", "providers", false),
+		resourceid.ResourceProviderSegment("staticMicrosoftWeb", "Microsoft.Web"),
+		resourceid.StaticSegment("staticSites", "sites", false),
+		resourceid.UserSpecifiedSegment("siteName"),
+		resourceid.StaticSegment("staticConfig", "config", false),
+		resourceid.UserSpecifiedSegment("configName"),
+	}
+}
+
 // VirtualNetworkSwiftConnectionID parses a VirtualNetworkSwiftConnection ID into an VirtualNetworkSwiftConnectionId struct
 func VirtualNetworkSwiftConnectionID(input string) (*VirtualNetworkSwiftConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, VirtualNetworkSwiftConnectionId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := VirtualNetworkSwiftConnectionId{
-		SubscriptionId: id.SubscriptionID,
-		ResourceGroup:  id.ResourceGroup,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceGroup == "" {
-		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
-	}
-
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
-		return nil, err
-	}
-	if resourceId.ConfigName, err = id.PopSegment("config"); err != nil {
-		return nil, err
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId: values["subscriptionId"],
+		ResourceGroup:  values["resourceGroup"],
+		SiteName:       values["siteName"],
+		ConfigName:     values["configName"],
 	}
 
 	return &resourceId, nil
@@ -0,0 +1,98 @@
+package web
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+		{
+			ResourceType:          "Microsoft.Web/sites",
+			TerraformResourceType: "azurerm_app_service",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AppServiceID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/certificates",
+			TerraformResourceType: "azurerm_app_service_certificate",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CertificateID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/certificateOrders",
+			TerraformResourceType: "azurerm_app_service_certificate_order",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.CertificateOrderID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/hostingEnvironments",
+			TerraformResourceType: "azurerm_app_service_environment",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AppServiceEnvironmentID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/sites/hybridConnectionNamespaces/relays",
+			TerraformResourceType: "azurerm_app_service_hybrid_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.HybridConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/certificates",
+			TerraformResourceType: "azurerm_app_service_managed_certificate",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ManagedCertificateID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/serverfarms",
+			TerraformResourceType: "azurerm_app_service_plan",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AppServicePlanID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/sites/slots",
+			TerraformResourceType: "azurerm_app_service_slot",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.AppServiceSlotID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/sites/slots/config",
+			TerraformResourceType: "azurerm_app_service_slot_virtual_network_swift_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.SlotVirtualNetworkSwiftConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/sites/config",
+			TerraformResourceType: "azurerm_app_service_virtual_network_swift_connection",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.VirtualNetworkSwiftConnectionID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/sites",
+			TerraformResourceType: "azurerm_function_app",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FunctionAppID(input)
+			},
+		},
+		{
+			ResourceType:          "Microsoft.Web/sites/slots",
+			TerraformResourceType: "azurerm_function_app_slot",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.FunctionAppSlotID(input)
+			},
+		},
+	}
+}
@@ -11,3 +11,24 @@ func Expand(tagsMap map[string]interface{}) map[string]*string {
 
 	return output
 }
+
+// ExpandWithDefaults expands the Tags for a resource, merging in any Default Tags configured at the Provider level
+func ExpandWithDefaults(config ProviderConfiguration, tagsMap map[string]interface{}) map[string]*string {
+	output := Expand(tagsMap)
+	return config.mergeDefaults(output)
+}
+
+// ExpandAllWithDefaults returns all of the Tags which will be assigned to a resource - that is, the Tags defined on
+// the resource merged with any Default Tags, excluding any Tags which are ignored at the Provider level
+func ExpandAllWithDefaults(config ProviderConfiguration, tagsMap map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range ExpandWithDefaults(config, tagsMap) {
+		if config.isIgnored(k) {
+			continue
+		}
+
+		output[k] = *v
+	}
+
+	return output
+}
@@ -29,3 +29,57 @@ func FlattenAndSet(d *schema.ResourceData, tagMap map[string]*string) error {
 
 	return nil
 }
+
+// FlattenAndSetWithDefaults sets the `tags` field for a Resource, removing any Tags which are ignored at the Provider
+// level - and any Default Tags with the Default value, unless they're also defined on the Resource. The `tags_all`
+// field is set to all of the Tags which aren't ignored, so that changes to the Default Tags are shown in the diff
+func FlattenAndSetWithDefaults(config ProviderConfiguration, d *schema.ResourceData, tagMap map[string]*string) error {
+	existing := make(map[string]*string)
+	if v, ok := d.Get("tags").(map[string]interface{}); ok {
+		existing = Expand(v)
+	}
+
+	flattened := make(map[string]interface{})
+	all := make(map[string]interface{})
+	for k, v := range Flatten(tagMap) {
+		if config.isIgnored(k) {
+			continue
+		}
+
+		all[k] = v
+		if _, definedOnResource := findKey(existing, k); !definedOnResource && config.isUnchangedDefault(k, v.(string)) {
+			continue
+		}
+
+		flattened[k] = v
+	}
+
+	if err := d.Set("tags", flattened); err != nil {
+		return fmt.Errorf("Error setting `tags`: %s", err)
+	}
+
+	if err := d.Set("tags_all", all); err != nil {
+		return fmt.Errorf("Error setting `tags_all`: %s", err)
+	}
+
+	return nil
+}
+
+// FlattenAndSetDataSource sets the `tags` field for a Data Source, removing any Tags which are ignored
+// at the Provider level - Default Tags are returned since Data Sources don't define Tags
+func FlattenAndSetDataSource(config ProviderConfiguration, d *schema.ResourceData, tagMap map[string]*string) error {
+	flattened := make(map[string]interface{})
+	for k, v := range Flatten(tagMap) {
+		if config.isIgnored(k) {
+			continue
+		}
+
+		flattened[k] = v
+	}
+
+	if err := d.Set("tags", flattened); err != nil {
+		return fmt.Errorf("Error setting `tags`: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package tags
+
+import (
+	"strings"
+)
+
+// ProviderConfiguration defines the Tags which are configured at the Provider level
+// and applied to (or ignored on) every resource which supports Tags
+type ProviderConfiguration struct {
+	// DefaultTags are assigned to every resource which supports Tags - unless the
+	// resource defines a Tag with the same key, in which case that value is used
+	DefaultTags map[string]string
+
+	// IgnoreKeys are Tag keys which are ignored when reading Tags from Azure
+	// (for example Tags assigned by Azure Policy)
+	IgnoreKeys []string
+
+	// IgnoreKeyPrefixes are prefixes of Tag keys which are ignored when reading Tags from Azure
+	IgnoreKeyPrefixes []string
+}
+
+// mergeDefaults returns the Default Tags merged with the specified Tags, where Tags defined
+// on the resource take precedence over Default Tags with the same (case-insensitive) key
+func (c ProviderConfiguration) mergeDefaults(input map[string]*string) map[string]*string {
+	if len(c.DefaultTags) == 0 {
+		return input
+	}
+
+	for k, v := range c.DefaultTags {
+		if _, exists := findKey(input, k); exists {
+			continue
+		}
+
+		value := v
+		input[k] = &value
+	}
+
+	return input
+}
+
+// isIgnored returns whether the specified Tag key should be ignored
+func (c ProviderConfiguration) isIgnored(key string) bool {
+	for _, v := range c.IgnoreKeys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range c.IgnoreKeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isUnchangedDefault returns whether the specified Tag is a Default Tag whose value matches
+// the Default - Default Tags whose values differ are returned so that a diff is shown
+func (c ProviderConfiguration) isUnchangedDefault(key string, value string) bool {
+	for k, v := range c.DefaultTags {
+		if strings.EqualFold(k, key) {
+			return v == value
+		}
+	}
+
+	return false
+}
+
+func findKey(input map[string]*string, key string) (string, bool) {
+	for k := range input {
+		if strings.EqualFold(k, key) {
+			return k, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,185 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestExpandWithDefaultTags(t *testing.T) {
+	config := ProviderConfiguration{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+			"Owner":       "platform",
+		},
+	}
+
+	testData := []struct {
+		Name     string
+		Input    map[string]interface{}
+		Expected map[string]*string
+	}{
+		{
+			Name:  "Empty",
+			Input: map[string]interface{}{},
+			Expected: map[string]*string{
+				"cost-center": utils.String("1234"),
+				"Owner":       utils.String("platform"),
+			},
+		},
+		{
+			Name: "Additional Tag",
+			Input: map[string]interface{}{
+				"environment": "production",
+			},
+			Expected: map[string]*string{
+				"cost-center": utils.String("1234"),
+				"environment": utils.String("production"),
+				"Owner":       utils.String("platform"),
+			},
+		},
+		{
+			Name: "Overridden Tag",
+			Input: map[string]interface{}{
+				"owner": "databases",
+			},
+			Expected: map[string]*string{
+				"cost-center": utils.String("1234"),
+				"owner":       utils.String("databases"),
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		actual := ExpandWithDefaults(config, v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+
+	actual := Expand(map[string]interface{}{})
+	if len(actual) != 0 {
+		t.Fatalf("Expected no tags when expanding without defaults but got %+v", actual)
+	}
+}
+
+func TestExpandAllWithDefaultTags(t *testing.T) {
+	config := ProviderConfiguration{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+			"Owner":       "platform",
+		},
+		IgnoreKeyPrefixes: []string{"policy:"},
+	}
+
+	actual := ExpandAllWithDefaults(config, map[string]interface{}{
+		"owner":         "databases",
+		"policy:source": "azure",
+	})
+	expected := map[string]interface{}{
+		"cost-center": "1234",
+		"owner":       "databases",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFlattenAndSetWithProviderConfiguration(t *testing.T) {
+	config := ProviderConfiguration{
+		DefaultTags: map[string]string{
+			"cost-center": "1234",
+			"owner":       "platform",
+		},
+		IgnoreKeys:        []string{"CreatedBy"},
+		IgnoreKeyPrefixes: []string{"policy:"},
+	}
+
+	input := map[string]*string{
+		"cost-center":   utils.String("1234"),
+		"createdby":     utils.String("someone"),
+		"environment":   utils.String("production"),
+		"owner":         utils.String("changed-outside-terraform"),
+		"Policy:Source": utils.String("azure"),
+	}
+
+	testData := []struct {
+		Name        string
+		DataSource  bool
+		Config      map[string]interface{}
+		Expected    map[string]interface{}
+		ExpectedAll map[string]interface{}
+	}{
+		{
+			Name: "Resource",
+			Expected: map[string]interface{}{
+				"environment": "production",
+				"owner":       "changed-outside-terraform",
+			},
+			ExpectedAll: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+				"owner":       "changed-outside-terraform",
+			},
+		},
+		{
+			Name: "Resource defining a Default Tag",
+			Config: map[string]interface{}{
+				"tags": map[string]interface{}{
+					"cost-center": "1234",
+				},
+			},
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+				"owner":       "changed-outside-terraform",
+			},
+			ExpectedAll: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+				"owner":       "changed-outside-terraform",
+			},
+		},
+		{
+			Name:       "Data Source",
+			DataSource: true,
+			Expected: map[string]interface{}{
+				"cost-center": "1234",
+				"environment": "production",
+				"owner":       "changed-outside-terraform",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": Schema(), "tags_all": SchemaAll()}, v.Config)
+
+		flatten := FlattenAndSetWithDefaults
+		if v.DataSource {
+			flatten = FlattenAndSetDataSource
+		}
+		if err := flatten(config, d, input); err != nil {
+			t.Fatalf("flattening: %+v", err)
+		}
+
+		actual := d.Get("tags").(map[string]interface{})
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+
+		if v.DataSource {
+			continue
+		}
+
+		actualAll := d.Get("tags_all").(map[string]interface{})
+		if !reflect.DeepEqual(actualAll, v.ExpectedAll) {
+			t.Fatalf("Expected `tags_all` to be %+v but got %+v", v.ExpectedAll, actualAll)
+		}
+	}
+}
@@ -52,3 +52,15 @@ func SchemaEnforceLowerCaseKeys() *schema.Schema {
 		},
 	}
 }
+
+// SchemaAll returns the Schema used for all of the Tags assigned to a Resource, including any
+// Default Tags configured at the Provider level
+func SchemaAll() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
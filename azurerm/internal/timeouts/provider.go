@@ -0,0 +1,66 @@
+package timeouts
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// ResourceTimeouts defines the Timeouts configured at the Provider level for a Resource Type,
+// which are used when the Timeout isn't specified in the `timeouts` block for the Resource
+type ResourceTimeouts struct {
+	Create *time.Duration
+	Read   *time.Duration
+	Update *time.Duration
+	Delete *time.Duration
+}
+
+// ConfigureResources overrides the default Timeouts of each of the specified Resources with the Timeouts
+// configured at the Provider level. Since Terraform merges the `timeouts` block for a Resource into these
+// defaults, a Timeout specified in the `timeouts` block continues to take precedence.
+//
+// NOTE: the Resources are modified in-place, so must belong to the instance of the Provider being configured
+func ConfigureResources(resources map[string]*schema.Resource, input map[string]ResourceTimeouts) error {
+	for resourceType, overrides := range input {
+		resource, ok := resources[resourceType]
+		if !ok {
+			return fmt.Errorf("`timeouts` were specified for %q which isn't a Resource supported by this Provider", resourceType)
+		}
+
+		// the defaults are copied since these could be shared with another instance of the Provider
+		existing := resource.Timeouts
+		if existing == nil {
+			existing = &schema.ResourceTimeout{}
+		}
+		updated := *existing
+
+		for key, v := range map[string]struct {
+			override *time.Duration
+			target   **time.Duration
+		}{
+			schema.TimeoutCreate: {override: overrides.Create, target: &updated.Create},
+			schema.TimeoutRead:   {override: overrides.Read, target: &updated.Read},
+			schema.TimeoutUpdate: {override: overrides.Update, target: &updated.Update},
+			schema.TimeoutDelete: {override: overrides.Delete, target: &updated.Delete},
+		} {
+			if v.override == nil {
+				continue
+			}
+
+			// Terraform only allows the Timeouts which the Resource defines to be configured
+			if *v.target == nil {
+				return fmt.Errorf("a `%s` timeout was specified for %q which doesn't support a `%s` timeout", key, resourceType, key)
+			}
+
+			log.Printf("[DEBUG] Using the Provider-level %s Timeout of %s for %q", key, *v.override, resourceType)
+			override := *v.override
+			*v.target = &override
+		}
+
+		resource.Timeouts = &updated
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package timeouts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func testResourceForTimeouts(actual *time.Duration) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+		Create: func(d *schema.ResourceData, _ interface{}) error {
+			ctx, cancel := ForCreate(context.TODO(), d)
+			defer cancel()
+
+			deadline, _ := ctx.Deadline()
+			*actual = time.Until(deadline).Round(time.Minute)
+			d.SetId("example")
+			return nil
+		},
+		Read: func(d *schema.ResourceData, _ interface{}) error {
+			return nil
+		},
+		Delete: func(d *schema.ResourceData, _ interface{}) error {
+			return nil
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+	}
+}
+
+func TestConfigureResources(t *testing.T) {
+	twoHours := 2 * time.Hour
+	input := map[string]ResourceTimeouts{
+		"azurerm_example": {
+			Create: &twoHours,
+		},
+	}
+
+	testData := []struct {
+		Name         string
+		ResourceType string
+		Config       map[string]interface{}
+		Expected     time.Duration
+	}{
+		{
+			Name:         "Resource Default",
+			ResourceType: "azurerm_other",
+			Expected:     30 * time.Minute,
+		},
+		{
+			Name:         "Provider Level",
+			ResourceType: "azurerm_example",
+			Expected:     2 * time.Hour,
+		},
+		{
+			Name:         "Resource Level",
+			ResourceType: "azurerm_example",
+			Config: map[string]interface{}{
+				"timeouts": []interface{}{
+					map[string]interface{}{
+						"create": "45m",
+					},
+				},
+			},
+			Expected: 45 * time.Minute,
+		},
+		{
+			Name:         "Resource Level matching the Resource Default",
+			ResourceType: "azurerm_example",
+			Config: map[string]interface{}{
+				"timeouts": []interface{}{
+					map[string]interface{}{
+						"create": "30m",
+					},
+				},
+			},
+			Expected: 30 * time.Minute,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		var actual time.Duration
+		resources := map[string]*schema.Resource{
+			"azurerm_example": testResourceForTimeouts(&actual),
+			"azurerm_other":   testResourceForTimeouts(&actual),
+		}
+		if err := ConfigureResources(resources, input); err != nil {
+			t.Fatalf("configuring: %+v", err)
+		}
+		resource := resources[v.ResourceType]
+
+		raw := map[string]interface{}{
+			"name": "example",
+		}
+		for k, val := range v.Config {
+			raw[k] = val
+		}
+		config := terraform.NewResourceConfigRaw(raw)
+		diff, err := resource.Diff(&terraform.InstanceState{}, config, nil)
+		if err != nil {
+			t.Fatalf("diffing: %+v", err)
+		}
+		if _, err := resource.Apply(&terraform.InstanceState{}, diff, nil); err != nil {
+			t.Fatalf("applying: %+v", err)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected the timeout to be %s but got %s", v.Expected, actual)
+		}
+	}
+}
+
+func TestConfigureResourcesIsolatedBetweenProviders(t *testing.T) {
+	var actual time.Duration
+	defaults := testResourceForTimeouts(&actual).Timeouts
+
+	// aliased Providers each have their own Resources, which share the same defaults
+	first := testResourceForTimeouts(&actual)
+	first.Timeouts = defaults
+	second := testResourceForTimeouts(&actual)
+	second.Timeouts = defaults
+
+	oneHour := time.Hour
+	twoHours := 2 * time.Hour
+	if err := ConfigureResources(map[string]*schema.Resource{"azurerm_example": first}, map[string]ResourceTimeouts{"azurerm_example": {Create: &oneHour}}); err != nil {
+		t.Fatalf("configuring the first Provider: %+v", err)
+	}
+	if err := ConfigureResources(map[string]*schema.Resource{"azurerm_example": second}, map[string]ResourceTimeouts{"azurerm_example": {Create: &twoHours}}); err != nil {
+		t.Fatalf("configuring the second Provider: %+v", err)
+	}
+
+	if v := *first.Timeouts.Create; v != oneHour {
+		t.Fatalf("expected the first Provider's Create timeout to be %s but got %s", oneHour, v)
+	}
+	if v := *second.Timeouts.Create; v != twoHours {
+		t.Fatalf("expected the second Provider's Create timeout to be %s but got %s", twoHours, v)
+	}
+	if v := *defaults.Create; v != 30*time.Minute {
+		t.Fatalf("expected the defaults to be unchanged but got %s", v)
+	}
+}
+
+func TestConfigureResourcesUnsupportedTimeout(t *testing.T) {
+	var actual time.Duration
+	resources := map[string]*schema.Resource{
+		"azurerm_example": testResourceForTimeouts(&actual),
+	}
+
+	oneHour := time.Hour
+	if err := ConfigureResources(resources, map[string]ResourceTimeouts{"azurerm_example": {Update: &oneHour}}); err == nil {
+		t.Fatalf("expected an error for an Update timeout on a Resource which doesn't support one")
+	}
+	if err := ConfigureResources(resources, map[string]ResourceTimeouts{"azurerm_does_not_exist": {Create: &oneHour}}); err == nil {
+		t.Fatalf("expected an error for a Resource which doesn't exist")
+	}
+}
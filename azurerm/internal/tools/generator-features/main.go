@@ -0,0 +1,125 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+const sectionHeader = "## Features\n"
+
+func main() {
+	filePath := flag.String("path", "", "The relative path to the root directory")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	outputFile := fmt.Sprintf("%s/website/docs/index.html.markdown", *filePath)
+	if err := run(outputFile); err != nil {
+		panic(err)
+	}
+}
+
+func run(outputFileName string) error {
+	outputPath, err := filepath.Abs(outputFileName)
+	if err != nil {
+		return err
+	}
+
+	contents, err := ioutil.ReadFile(outputPath)
+	if err != nil {
+		return fmt.Errorf("reading %q: %+v", outputPath, err)
+	}
+
+	// the Features section runs until the next section (or the end of the file)
+	existing := string(contents)
+	start := strings.Index(existing, sectionHeader)
+	if start == -1 {
+		return fmt.Errorf("the section %q was not found in %q", strings.TrimSpace(sectionHeader), outputPath)
+	}
+	end := len(existing)
+	if next := strings.Index(existing[start+len(sectionHeader):], "\n## "); next != -1 {
+		end = start + len(sectionHeader) + next + 1
+	}
+
+	updated := existing[:start] + documentation() + existing[end:]
+	return ioutil.WriteFile(outputPath, []byte(updated), 0644)
+}
+
+func documentation() string {
+	lines := []string{
+		strings.TrimSpace(sectionHeader),
+		"",
+		"It's possible to configure the behaviour of certain resources using the `features` block - more details can be found below.",
+		"",
+		"The `features` block supports the following:",
+		"",
+	}
+
+	blocks := features.Blocks()
+	for _, block := range blocks {
+		lines = append(lines, fmt.Sprintf("* `%s` - (Optional) A `%s` block as defined below.", block.Name, block.Name), "")
+	}
+
+	for _, block := range blocks {
+		lines = append(lines, "---", "", fmt.Sprintf("The `%s` block supports the following:", block.Name), "")
+
+		// the field is Required when it's the only field within the block
+		requirement := "Optional"
+		if len(block.Features) == 1 {
+			requirement = "Required"
+		}
+
+		for _, feature := range block.Features {
+			description := featureDescription(feature)
+			if requirement == "Optional" {
+				description = fmt.Sprintf("%s Defaults to `%t`.", description, feature.Default)
+			}
+			lines = append(lines, fmt.Sprintf("* `%s` - (%s) %s", feature.Name, requirement, description), "")
+			lines = append(lines, featureNotes(feature)...)
+		}
+	}
+
+	if environmentVariables := features.EnvironmentVariableFeatures(); len(environmentVariables) > 0 {
+		lines = append(lines, "---", "", "The following Features can be configured using Environment Variables:", "")
+
+		for _, feature := range environmentVariables {
+			lines = append(lines, fmt.Sprintf("* `%s` - %s Defaults to `%t`.", feature.EnvironmentVariable, featureDescription(feature), feature.Default), "")
+			lines = append(lines, featureNotes(feature)...)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func featureDescription(feature features.Feature) string {
+	description := feature.Description
+	if feature.Block != "" && feature.EnvironmentVariable != "" {
+		description += fmt.Sprintf(" This can also be sourced from the `%s` Environment Variable.", feature.EnvironmentVariable)
+	}
+
+	return description
+}
+
+func featureNotes(feature features.Feature) []string {
+	lines := make([]string, 0)
+
+	if feature.Deprecated != "" {
+		lines = append(lines, fmt.Sprintf("~> **Note:** This Feature is deprecated: %s", feature.Deprecated), "")
+	}
+
+	for _, note := range feature.Notes {
+		lines = append(lines, fmt.Sprintf("~> **Note:** %s", note), "")
+	}
+
+	return lines
+}
@@ -0,0 +1,536 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+const outputFileName = "registration_resource_ids.go"
+
+func main() {
+	servicePackagePath := flag.String("path", "", "The relative path to the service package")
+	exclude := flag.String("exclude", "", "A comma-separated list of the Terraform Resource Types which shouldn't be registered, since their Resource ID is the ID of another Resource (e.g. `azurerm_subnet_route_table_association`)")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if err := run(*servicePackagePath, splitResourceTypes(*exclude)); err != nil {
+		panic(err)
+	}
+}
+
+func splitResourceTypes(input string) map[string]struct{} {
+	out := make(map[string]struct{})
+	for _, v := range strings.Split(input, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			out[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+func run(servicePackagePath string, exclude map[string]struct{}) error {
+	files, err := parseServicePackage(servicePackagePath)
+	if err != nil {
+		return fmt.Errorf("parsing the Service Package at %q: %+v", servicePackagePath, err)
+	}
+
+	contents, err := files.generate(exclude)
+	if err != nil {
+		return fmt.Errorf("generating the Resource ID Registrations for %q: %+v", servicePackagePath, err)
+	}
+
+	outputPath := filepath.Join(servicePackagePath, outputFileName)
+	if contents == nil {
+		// nothing to register, so remove any previously generated registrations
+		if err := os.Remove(outputPath); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("removing %q: %+v", outputPath, err)
+		}
+		return nil
+	}
+
+	if err := ioutil.WriteFile(outputPath, contents, 0644); err != nil {
+		return fmt.Errorf("writing %q: %+v", outputPath, err)
+	}
+
+	return nil
+}
+
+// servicePackage is the parsed source code for a Service Package
+type servicePackage struct {
+	// directoryName is the name of the directory containing this Service Package, e.g. `eventhub`
+	directoryName string
+
+	// packageName is the name of this Go package, e.g. `eventhub`
+	packageName string
+
+	// files is the parsed source code for each (non-test) file, keyed by the file name
+	files map[string]*ast.File
+}
+
+func parseServicePackage(servicePackagePath string) (*servicePackage, error) {
+	absolutePath, err := filepath.Abs(servicePackagePath)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSet := token.NewFileSet()
+	filter := func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != outputFileName
+	}
+	packages, err := parser.ParseDir(fileSet, absolutePath, filter, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	if len(packages) != 1 {
+		return nil, fmt.Errorf("expected a single package but got %d", len(packages))
+	}
+
+	out := servicePackage{
+		directoryName: filepath.Base(absolutePath),
+		files:         make(map[string]*ast.File),
+	}
+	for name, pkg := range packages {
+		out.packageName = name
+		for fileName, file := range pkg.Files {
+			out.files[filepath.Base(fileName)] = file
+		}
+	}
+	return &out, nil
+}
+
+// registration is a mapping of a Terraform Resource Type to the generated Parser for it's Resource ID
+type registration struct {
+	resourceType          string
+	terraformResourceType string
+	parserName            string
+}
+
+func (p servicePackage) generate(exclude map[string]struct{}) ([]byte, error) {
+	resourceIds, err := p.resourceIds()
+	if err != nil {
+		return nil, err
+	}
+	if len(resourceIds) == 0 {
+		return nil, nil
+	}
+
+	parsers := make(map[string]string)
+	for terraformResourceType, parserName := range p.untypedResourceParsers(resourceIds) {
+		parsers[terraformResourceType] = parserName
+	}
+	for terraformResourceType, parserName := range p.typedResourceParsers(resourceIds) {
+		parsers[terraformResourceType] = parserName
+	}
+
+	registrations := make([]registration, 0)
+	for terraformResourceType, parserName := range parsers {
+		if _, ok := exclude[terraformResourceType]; ok {
+			continue
+		}
+
+		registrations = append(registrations, registration{
+			resourceType:          resourceIds[parserName],
+			terraformResourceType: terraformResourceType,
+			parserName:            parserName,
+		})
+	}
+	if len(registrations) == 0 {
+		return nil, nil
+	}
+	sort.Slice(registrations, func(i, j int) bool {
+		return registrations[i].terraformResourceType < registrations[j].terraformResourceType
+	})
+
+	parseImportPath := p.importPathFor("parse")
+	if parseImportPath == "" {
+		return nil, fmt.Errorf("unable to determine the import path for the `parse` package")
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `package %s
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	%q
+)
+
+// ResourceIDs returns a list of the Resource ID Parsers for the Resources supported by this Service
+func (r Registration) ResourceIDs() []resourceid.Registration {
+	return []resourceid.Registration{
+`, p.packageName, parseImportPath)
+	for _, v := range registrations {
+		fmt.Fprintf(&buf, `		{
+			ResourceType:          %q,
+			TerraformResourceType: %q,
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.%sID(input)
+			},
+		},
+`, v.resourceType, v.terraformResourceType, v.parserName)
+	}
+	buf.WriteString(`	}
+}
+`)
+
+	return format.Source(buf.Bytes())
+}
+
+var generateResourceIdRegex = regexp.MustCompile(`^//go:generate go run .*generator-resource-id/main\.go (.*)$`)
+
+// resourceIds returns a map of the name of each generated Resource ID (e.g. `Cluster`) to the
+// Resource Manager Resource Type (e.g. `Microsoft.EventHub/clusters`) from the `go:generate` comments
+func (p servicePackage) resourceIds() (map[string]string, error) {
+	out := make(map[string]string)
+	for _, file := range p.files {
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				matches := generateResourceIdRegex.FindStringSubmatch(comment.Text)
+				if len(matches) != 2 {
+					continue
+				}
+
+				name, id, err := parseGenerateResourceIdArguments(strings.Fields(matches[1]))
+				if err != nil {
+					return nil, fmt.Errorf("parsing %q: %+v", comment.Text, err)
+				}
+
+				resourceType, err := resourceid.ResourceTypeFromID(id)
+				if err != nil {
+					return nil, fmt.Errorf("determining the Resource Type for %q: %+v", name, err)
+				}
+				out[name] = resourceType
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func parseGenerateResourceIdArguments(args []string) (string, string, error) {
+	flags := flag.NewFlagSet("generator-resource-id", flag.ContinueOnError)
+	flags.SetOutput(ioutil.Discard)
+	flags.String("path", "", "")
+	name := flags.String("name", "", "")
+	id := flags.String("id", "", "")
+	flags.Bool("rewrite", false, "")
+	flags.String("insensitive-segments", "", "")
+	if err := flags.Parse(args); err != nil {
+		return "", "", err
+	}
+
+	if *name == "" || *id == "" {
+		return "", "", fmt.Errorf("both `name` and `id` must be specified")
+	}
+
+	return *name, *id, nil
+}
+
+// importPathFor returns the import path of the specified package within this Service Package (e.g. `parse`)
+func (p servicePackage) importPathFor(packageName string) string {
+	suffix := fmt.Sprintf("/services/%s/%s", p.directoryName, packageName)
+	for _, file := range p.files {
+		for _, spec := range file.Imports {
+			path, err := strconv.Unquote(spec.Path.Value)
+			if err == nil && strings.HasSuffix(path, suffix) {
+				return path
+			}
+		}
+	}
+
+	return ""
+}
+
+// importAliasFor returns the name which the specified package within this Service Package is imported as within this file
+func (p servicePackage) importAliasFor(file *ast.File, packageName string) string {
+	suffix := fmt.Sprintf("/services/%s/%s", p.directoryName, packageName)
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || !strings.HasSuffix(path, suffix) {
+			continue
+		}
+
+		if spec.Name != nil {
+			return spec.Name.Name
+		}
+		return packageName
+	}
+
+	return ""
+}
+
+// generatedIdFunction returns the name of the generated Resource ID used in the specified expression, which
+// is a call to (or a reference to) a function in the specified package, e.g. `parse.ClusterID`
+func generatedIdFunction(node ast.Node, packageAlias string, resourceIds map[string]string) string {
+	out := ""
+	ast.Inspect(node, func(n ast.Node) bool {
+		if out != "" {
+			return false
+		}
+
+		selector, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		ident, ok := selector.X.(*ast.Ident)
+		if !ok || ident.Name != packageAlias || !strings.HasSuffix(selector.Sel.Name, "ID") {
+			return true
+		}
+
+		name := strings.TrimSuffix(selector.Sel.Name, "ID")
+		if _, ok := resourceIds[name]; ok {
+			out = name
+		}
+		return true
+	})
+
+	return out
+}
+
+// resourceIdParsedFromState returns the name of the generated Resource ID which is parsed from the ID in the
+// State within the specified function, e.g. `parse.ClusterID(d.Id())`
+func resourceIdParsedFromState(body *ast.BlockStmt, packageAlias string, resourceIds map[string]string) string {
+	out := ""
+	ast.Inspect(body, func(n ast.Node) bool {
+		if out != "" {
+			return false
+		}
+
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+
+		// the argument must be `d.Id()`
+		arg, ok := call.Args[0].(*ast.CallExpr)
+		if !ok || len(arg.Args) != 0 {
+			return true
+		}
+		if selector, ok := arg.Fun.(*ast.SelectorExpr); !ok || selector.Sel.Name != "Id" {
+			return true
+		}
+
+		out = generatedIdFunction(call.Fun, packageAlias, resourceIds)
+		return true
+	})
+
+	return out
+}
+
+// untypedResourceParsers returns a map of the Terraform Resource Type to the name of the generated Resource ID
+// for each Untyped Resource which parses the Resource ID using the generated Parser, either when it's
+// imported or (for Resources which import the Resource ID as-is) when it's read
+func (p servicePackage) untypedResourceParsers(resourceIds map[string]string) map[string]string {
+	// first find the Parser used in the Importer (or otherwise the Read function) for each function returning a Resource
+	importerParsers := make(map[string]string)
+	readFunctions := make(map[string]string)
+	readParsers := make(map[string]string)
+	for _, file := range p.files {
+		parseAlias := p.importAliasFor(file, "parse")
+
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Recv != nil || function.Body == nil {
+				continue
+			}
+
+			if parseAlias != "" {
+				if name := resourceIdParsedFromState(function.Body, parseAlias, resourceIds); name != "" {
+					readParsers[function.Name.Name] = name
+				}
+			}
+
+			ast.Inspect(function.Body, func(n ast.Node) bool {
+				keyValue, ok := n.(*ast.KeyValueExpr)
+				if !ok {
+					return true
+				}
+				key, ok := keyValue.Key.(*ast.Ident)
+				if !ok {
+					return true
+				}
+
+				switch key.Name {
+				case "Importer":
+					if parseAlias == "" {
+						return false
+					}
+					if name := generatedIdFunction(keyValue.Value, parseAlias, resourceIds); name != "" {
+						importerParsers[function.Name.Name] = name
+					}
+					return false
+
+				case "Read":
+					if read, ok := keyValue.Value.(*ast.Ident); ok {
+						readFunctions[function.Name.Name] = read.Name
+					}
+					return false
+				}
+
+				return true
+			})
+		}
+	}
+
+	functionParsers := make(map[string]string)
+	for function, read := range readFunctions {
+		if name, ok := readParsers[read]; ok {
+			functionParsers[function] = name
+		}
+	}
+	for function, name := range importerParsers {
+		functionParsers[function] = name
+	}
+
+	// then map these to the Terraform Resource Types from the Service Registration
+	out := make(map[string]string)
+	p.inspectRegistrationMethod("SupportedResources", func(n ast.Node) bool {
+		keyValue, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+
+		terraformResourceType := stringLiteral(keyValue.Key)
+		call, ok := keyValue.Value.(*ast.CallExpr)
+		if terraformResourceType == "" || !ok {
+			return true
+		}
+		if function, ok := call.Fun.(*ast.Ident); ok {
+			if name, ok := functionParsers[function.Name]; ok {
+				out[terraformResourceType] = name
+			}
+		}
+		return false
+	})
+
+	return out
+}
+
+// typedResourceParsers returns a map of the Terraform Resource Type to the name of the generated Resource ID
+// for each Typed Resource which validates the Resource ID using the generated Validator
+func (p servicePackage) typedResourceParsers(resourceIds map[string]string) map[string]string {
+	// first find the Resource Type and Resource ID used by each type
+	resourceTypes := make(map[string]string)
+	validators := make(map[string]string)
+	for _, file := range p.files {
+		validateAlias := p.importAliasFor(file, "validate")
+
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Body == nil {
+				continue
+			}
+			receiver := receiverTypeName(function)
+			if receiver == "" {
+				continue
+			}
+
+			switch function.Name.Name {
+			case "ResourceType":
+				ast.Inspect(function.Body, func(n ast.Node) bool {
+					if ret, ok := n.(*ast.ReturnStmt); ok && len(ret.Results) == 1 {
+						if v := stringLiteral(ret.Results[0]); v != "" {
+							resourceTypes[receiver] = v
+						}
+						return false
+					}
+					return true
+				})
+
+			case "IDValidationFunc":
+				if validateAlias == "" {
+					continue
+				}
+				if name := generatedIdFunction(function.Body, validateAlias, resourceIds); name != "" {
+					validators[receiver] = name
+				}
+			}
+		}
+	}
+
+	// then map these to the Typed Resources from the Service Registration
+	out := make(map[string]string)
+	p.inspectRegistrationMethod("Resources", func(n ast.Node) bool {
+		literal, ok := n.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+		typeName, ok := literal.Type.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		terraformResourceType, hasResourceType := resourceTypes[typeName.Name]
+		name, hasValidator := validators[typeName.Name]
+		if hasResourceType && hasValidator {
+			out[terraformResourceType] = name
+		}
+		return false
+	})
+
+	return out
+}
+
+// inspectRegistrationMethod inspects the body of the specified method on the Service Registration
+func (p servicePackage) inspectRegistrationMethod(methodName string, f func(n ast.Node) bool) {
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			function, ok := decl.(*ast.FuncDecl)
+			if !ok || function.Body == nil || function.Name.Name != methodName || receiverTypeName(function) != "Registration" {
+				continue
+			}
+
+			ast.Inspect(function.Body, f)
+		}
+	}
+}
+
+func receiverTypeName(function *ast.FuncDecl) string {
+	if function.Recv == nil || len(function.Recv.List) != 1 {
+		return ""
+	}
+
+	expr := function.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+func stringLiteral(expr ast.Expr) string {
+	literal, ok := expr.(*ast.BasicLit)
+	if !ok || literal.Kind != token.STRING {
+		return ""
+	}
+
+	v, err := strconv.Unquote(literal.Value)
+	if err != nil {
+		return ""
+	}
+	return v
+}
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testResourceIds = `package example
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Server -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Database -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/servers/server1/databases/db1 -rewrite=true
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Widget -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Example/widgets/widget1
+`
+
+const testRegistration = `package example
+
+type Registration struct{}
+
+func (r Registration) SupportedResources() map[string]*schema.Resource {
+	return map[string]*schema.Resource{
+		"azurerm_example_server":                      resourceExampleServer(),
+		"azurerm_example_database":                    resourceExampleDatabase(),
+		"azurerm_example_server_customer_managed_key": resourceExampleServerCustomerManagedKey(),
+		"azurerm_example_legacy":                      resourceExampleLegacy(),
+	}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		WidgetResource{},
+	}
+}
+`
+
+const testResources = `package example
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/example/parse"
+	exampleValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/example/validate"
+)
+
+func resourceExampleServer() *schema.Resource {
+	return &schema.Resource{
+		Read: resourceExampleServerRead,
+		Importer: azSchema.ValidateResourceIDPriorToImport(func(id string) error {
+			_, err := parse.ServerID(id)
+			return err
+		}),
+	}
+}
+
+func resourceExampleServerRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parse.ServerID(d.Id())
+	return err
+}
+
+func resourceExampleDatabase() *schema.Resource {
+	return &schema.Resource{
+		Read: resourceExampleDatabaseRead,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceExampleDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	serverId, err := parse.ServerID(d.Get("server_id").(string))
+	if err != nil {
+		return err
+	}
+	id, err := parse.DatabaseID(d.Id())
+	return err
+}
+
+func resourceExampleServerCustomerManagedKey() *schema.Resource {
+	return &schema.Resource{
+		Read: resourceExampleServerCustomerManagedKeyRead,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+func resourceExampleServerCustomerManagedKeyRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := parse.ServerID(d.Id())
+	return err
+}
+
+func resourceExampleLegacy() *schema.Resource {
+	return &schema.Resource{
+		Read: resourceExampleLegacyRead,
+	}
+}
+
+func resourceExampleLegacyRead(d *schema.ResourceData, meta interface{}) error {
+	id, err := azure.ParseAzureResourceID(d.Id())
+	return err
+}
+
+type WidgetResource struct{}
+
+func (WidgetResource) ResourceType() string {
+	return "azurerm_example_widget"
+}
+
+func (WidgetResource) IDValidationFunc() schema.SchemaValidateFunc {
+	return exampleValidate.WidgetID
+}
+`
+
+func TestGenerate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generator-resource-id-registrations-")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	servicePath := filepath.Join(dir, "services", "example")
+	if err := os.MkdirAll(servicePath, 0755); err != nil {
+		t.Fatalf("creating service dir: %+v", err)
+	}
+	files := map[string]string{
+		"resourceids.go":  testResourceIds,
+		"registration.go": testRegistration,
+		"resources.go":    testResources,
+	}
+	for name, contents := range files {
+		if err := ioutil.WriteFile(filepath.Join(servicePath, name), []byte(contents), 0644); err != nil {
+			t.Fatalf("writing %q: %+v", name, err)
+		}
+	}
+
+	exclude := splitResourceTypes("azurerm_example_server_customer_managed_key")
+	if err := run(servicePath, exclude); err != nil {
+		t.Fatalf("generating: %+v", err)
+	}
+
+	output, err := ioutil.ReadFile(filepath.Join(servicePath, outputFileName))
+	if err != nil {
+		t.Fatalf("reading output: %+v", err)
+	}
+	actual := string(output)
+
+	expected := []string{
+		`ResourceType:          "Microsoft.Example/servers/databases",
+			TerraformResourceType: "azurerm_example_database",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.DatabaseID(input)
+			},`,
+		`ResourceType:          "Microsoft.Example/servers",
+			TerraformResourceType: "azurerm_example_server",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.ServerID(input)
+			},`,
+		`ResourceType:          "Microsoft.Example/widgets",
+			TerraformResourceType: "azurerm_example_widget",
+			Parser: func(input string) (resourceid.Formatter, error) {
+				return parse.WidgetID(input)
+			},`,
+		`"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/example/parse"`,
+	}
+	for _, v := range expected {
+		if !strings.Contains(actual, v) {
+			t.Fatalf("expected the output to contain:\n\n%s\n\nbut got:\n\n%s", v, actual)
+		}
+	}
+
+	// these should be sorted by the Terraform Resource Type
+	if strings.Index(actual, "azurerm_example_database") > strings.Index(actual, "azurerm_example_server") {
+		t.Fatalf("expected the registrations to be sorted but got:\n\n%s", actual)
+	}
+
+	for _, v := range []string{"azurerm_example_legacy", "azurerm_example_server_customer_managed_key"} {
+		if strings.Contains(actual, v) {
+			t.Fatalf("expected %q not to be registered but got:\n\n%s", v, actual)
+		}
+	}
+}
+
+func TestGenerateNoResourceIds(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generator-resource-id-registrations-")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "registration.go"), []byte(testRegistration), 0644); err != nil {
+		t.Fatalf("writing registration: %+v", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, outputFileName), []byte("package example\n"), 0644); err != nil {
+		t.Fatalf("writing previous output: %+v", err)
+	}
+
+	if err := run(dir, nil); err != nil {
+		t.Fatalf("generating: %+v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, outputFileName)); !os.IsNotExist(err) {
+		t.Fatalf("expected the previously generated file to be removed but got: %+v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 	"unicode"
@@ -18,6 +19,7 @@ func main() {
 	name := flag.String("name", "", "The name of this Resource Type")
 	id := flag.String("id", "", "An example of this Resource ID")
 	rewrite := flag.Bool("rewrite", false, "Should this Resource ID be parsed insensitively, to workaround an API bug?")
+	insensitiveSegments := flag.String("insensitive-segments", "", "A comma-separated list of the fixed segments which should always be parsed case-insensitively (e.g. `eventhubs,consumergroups`)")
 	showHelp := flag.Bool("help", false, "Display this message")
 
 	flag.Parse()
@@ -27,12 +29,22 @@ func main() {
 		return
 	}
 
-	if err := run(*servicePackagePath, *name, *id, *rewrite); err != nil {
+	if err := run(*servicePackagePath, *name, *id, *rewrite, splitSegmentNames(*insensitiveSegments)); err != nil {
 		panic(err)
 	}
 }
 
-func run(servicePackagePath, name, id string, shouldRewrite bool) error {
+func splitSegmentNames(input string) []string {
+	out := make([]string, 0)
+	for _, v := range strings.Split(input, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+func run(servicePackagePath, name, id string, shouldRewrite bool, insensitiveSegments []string) error {
 	servicePackage, err := parseServicePackageName(servicePackagePath)
 	if err != nil {
 		return fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
@@ -54,14 +66,20 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		// e.g. "webtest" in applicationInsights
 		fileName += "_id"
 	}
-	resourceId, err := NewResourceID(name, *servicePackage, id)
+	resourceId, err := NewResourceID(name, *servicePackage, id, insensitiveSegments...)
 	if err != nil {
 		return err
 	}
 
+	parentResourceId, err := findParentResourceId(servicePackagePath, *resourceId)
+	if err != nil {
+		return fmt.Errorf("finding the Parent Resource ID for %q: %+v", name, err)
+	}
+
 	generator := ResourceIdGenerator{
-		ResourceId:    *resourceId,
-		ShouldRewrite: shouldRewrite,
+		ResourceId:       *resourceId,
+		ParentResourceId: parentResourceId,
+		ShouldRewrite:    shouldRewrite,
 	}
 
 	parserFilePath := fmt.Sprintf("%s/%s.go", parsersPath, fileName)
@@ -168,6 +186,39 @@ type ResourceIdSegment struct {
 	SegmentValue string
 }
 
+type ParseSegmentType string
+
+const (
+	ResourceGroupParseSegmentType    ParseSegmentType = "ResourceGroup"
+	ResourceProviderParseSegmentType ParseSegmentType = "ResourceProvider"
+	StaticParseSegmentType           ParseSegmentType = "Static"
+	SubscriptionIdParseSegmentType   ParseSegmentType = "SubscriptionId"
+	UserSpecifiedParseSegmentType    ParseSegmentType = "UserSpecified"
+)
+
+// ParseSegment is a single segment within the Resource ID used when parsing it, unlike ResourceIdSegment
+// this contains every segment in the Resource ID (including the fixed segments) in order
+type ParseSegment struct {
+	// Type is the type of Segment, which maps to a `resourceid.Segment` constructor
+	Type ParseSegmentType
+
+	// Name is the name of this segment - for non-fixed segments this is the ArgumentName
+	Name string
+
+	// FieldName is the name of the Field this segment is assigned to, for non-fixed segments
+	FieldName string
+
+	// Value is the fixed value for this segment - or the example value for non-fixed segments
+	Value string
+
+	// CaseInsensitive specifies whether this (fixed) segment should always be parsed case-insensitively
+	CaseInsensitive bool
+}
+
+func (s ParseSegment) isFixed() bool {
+	return s.Type == StaticParseSegmentType || s.Type == ResourceProviderParseSegmentType
+}
+
 type ResourceId struct {
 	TypeName string
 	IDFmt    string
@@ -178,9 +229,10 @@ type ResourceId struct {
 	HasResourceGroup  bool
 	HasSubscriptionId bool
 	Segments          []ResourceIdSegment // this has to be a slice not a map since we care about the order
+	ParseSegments     []ParseSegment
 }
 
-func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId, error) {
+func NewResourceID(typeName, servicePackageName, resourceId string, insensitiveSegments ...string) (*ResourceId, error) {
 	// split the string, but remove the prefix of `/` since it's an empty segment
 	split := strings.Split(strings.TrimPrefix(resourceId, "/"), "/")
 	if len(split)%2 != 0 {
@@ -276,6 +328,54 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 		segments = append(segments, segment)
 	}
 
+	// then build up the list of segments used to parse this Resource ID, which also contains the fixed segments
+	parseSegments := make([]ParseSegment, 0)
+	fieldIndex := 0
+	for i := 0; i < len(split); i += 2 {
+		key := split[i]
+		value := split[i+1]
+
+		// the Resource Group segment has historically been parsed insensitively, since some API's return this lower-cased
+		caseInsensitive := strings.EqualFold(key, "resourceGroups")
+		for _, v := range insensitiveSegments {
+			if strings.EqualFold(v, key) {
+				caseInsensitive = true
+			}
+		}
+		parseSegments = append(parseSegments, ParseSegment{
+			Type:            StaticParseSegmentType,
+			Name:            fmt.Sprintf("static%s", strings.Title(key)),
+			Value:           key,
+			CaseInsensitive: caseInsensitive,
+		})
+
+		if key == "providers" {
+			parseSegments = append(parseSegments, ParseSegment{
+				Type:  ResourceProviderParseSegmentType,
+				Name:  fmt.Sprintf("static%s", strings.ReplaceAll(value, ".", "")),
+				Value: value,
+			})
+			continue
+		}
+
+		segment := segments[fieldIndex]
+		fieldIndex++
+
+		segmentType := UserSpecifiedParseSegmentType
+		switch segment.FieldName {
+		case "SubscriptionId":
+			segmentType = SubscriptionIdParseSegmentType
+		case "ResourceGroup":
+			segmentType = ResourceGroupParseSegmentType
+		}
+		parseSegments = append(parseSegments, ParseSegment{
+			Type:      segmentType,
+			Name:      segment.ArgumentName,
+			FieldName: segment.FieldName,
+			Value:     value,
+		})
+	}
+
 	// finally build up the format string based on this information
 	fmtString := resourceId
 	hasResourceGroup := false
@@ -298,14 +398,89 @@ func NewResourceID(typeName, servicePackageName, resourceId string) (*ResourceId
 		HasResourceGroup:   hasResourceGroup,
 		HasSubscriptionId:  hasSubscriptionId,
 		Segments:           segments,
+		ParseSegments:      parseSegments,
 		ServicePackageName: servicePackageName,
 		TypeName:           typeName,
 	}, nil
 }
 
+// structure returns the (lower-cased) keys and resource providers within this Resource ID
+// which allows Resource ID's to be compared irrespective of the example values used
+func (id ResourceId) structure() []string {
+	out := make([]string, 0)
+	for _, segment := range id.ParseSegments {
+		if segment.isFixed() {
+			out = append(out, strings.ToLower(segment.Value))
+		}
+	}
+	return out
+}
+
+// withTransformedFixedSegments returns the example Resource ID with each of the fixed segments transformed
+func (id ResourceId) withTransformedFixedSegments(transform func(in string) string) string {
+	out := make([]string, 0)
+	for _, segment := range id.ParseSegments {
+		if segment.isFixed() {
+			out = append(out, transform(segment.Value))
+			continue
+		}
+
+		out = append(out, segment.Value)
+	}
+	return fmt.Sprintf("/%s", strings.Join(out, "/"))
+}
+
+var generatorNameRegex = regexp.MustCompile(`-name=(\S+)`)
+var generatorIdRegex = regexp.MustCompile(`-id=(\S+)`)
+
+// findParentResourceId looks through the other Resource ID's defined in `resourceids.go` within this Service Package
+// for the Resource ID of the Parent Resource - which is used to generate the FromParent constructor
+func findParentResourceId(servicePackagePath string, resourceId ResourceId) (*ResourceId, error) {
+	contents, err := ioutil.ReadFile(path.Join(servicePackagePath, "resourceids.go"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	structure := resourceId.structure()
+	if len(structure) <= 1 || structure[len(structure)-1] == "providers" {
+		return nil, nil
+	}
+	parentStructure := strings.Join(structure[0:len(structure)-1], "/")
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		if !strings.Contains(line, "generator-resource-id") {
+			continue
+		}
+
+		nameMatch := generatorNameRegex.FindStringSubmatch(line)
+		idMatch := generatorIdRegex.FindStringSubmatch(line)
+		if len(nameMatch) != 2 || len(idMatch) != 2 || nameMatch[1] == resourceId.TypeName {
+			continue
+		}
+
+		candidate, err := NewResourceID(nameMatch[1], resourceId.ServicePackageName, idMatch[1])
+		if err != nil {
+			return nil, fmt.Errorf("parsing Resource ID %q: %+v", nameMatch[1], err)
+		}
+
+		if strings.Join(candidate.structure(), "/") == parentStructure {
+			return candidate, nil
+		}
+	}
+
+	return nil, nil
+}
+
 type ResourceIdGenerator struct {
 	ResourceId
 
+	// ParentResourceId is the Resource ID of the Parent Resource, if one exists within this Service Package
+	ParentResourceId *ResourceId
+
 	ShouldRewrite bool
 }
 
@@ -319,7 +494,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 %s
@@ -328,7 +503,10 @@ import (
 %s
 %s
 %s
-`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForParser(), id.codeForParserInsensitive())
+%s
+%s
+%s
+`, id.codeForType(), id.codeForConstructor(), id.codeForParentConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForMatches(), id.codeForSegments(), id.codeForParser(), id.codeForParserInsensitive())
 }
 
 func (id ResourceIdGenerator) codeForType() string {
@@ -364,6 +542,33 @@ func New%[1]sID(%[2]s string) %[1]sId {
 `, id.TypeName, argumentsStr, assignmentsStr)
 }
 
+func (id ResourceIdGenerator) codeForParentConstructor() string {
+	if id.ParentResourceId == nil {
+		return ""
+	}
+
+	assignments := make([]string, 0)
+	for i, segment := range id.Segments {
+		if i < len(id.ParentResourceId.Segments) {
+			assignments = append(assignments, fmt.Sprintf("\t\t%s:\tparent.%s,", segment.FieldName, id.ParentResourceId.Segments[i].FieldName))
+			continue
+		}
+
+		assignments = append(assignments, fmt.Sprintf("\t\t%s:\t%s,", segment.FieldName, segment.ArgumentName))
+	}
+
+	lastSegment := id.Segments[len(id.Segments)-1]
+	assignmentsStr := strings.Join(assignments, "\n")
+	return fmt.Sprintf(`
+// New%[1]sIDFromParent returns a %[1]sId for the %[1]s within the specified %[2]s
+func New%[1]sIDFromParent(parent %[2]sId, %[3]s string) %[1]sId {
+	return %[1]sId{
+%[4]s
+	}
+}
+`, id.TypeName, id.ParentResourceId.TypeName, lastSegment.ArgumentName, assignmentsStr)
+}
+
 func (id ResourceIdGenerator) codeForDescription() string {
 	var makeHumanReadable = func(input string) string {
 		chars := make([]rune, 0)
@@ -419,37 +624,60 @@ func (id %[1]sId) ID() string {
 `, id.TypeName, id.IDFmt, formatKeysString)
 }
 
-func (id ResourceIdGenerator) codeForParser() string {
-	directAssignments := make([]string, 0)
-	if id.HasSubscriptionId {
-		directAssignments = append(directAssignments, "\t\tSubscriptionId: id.SubscriptionID,")
+func (id ResourceIdGenerator) codeForMatches() string {
+	return fmt.Sprintf(`
+// Matches returns whether the specified Resource ID refers to this %[1]s
+// the fixed segments, Subscription ID and Resource Group are compared case-insensitively
+func (id %[1]sId) Matches(input string) bool {
+	return resourceid.SegmentsMatch(id.ID(), input, id.Segments())
+}
+`, id.TypeName)
+}
+
+func (id ResourceIdGenerator) codeForSegments() string {
+	segments := make([]string, 0)
+	for _, segment := range id.ParseSegments {
+		switch segment.Type {
+		case ResourceGroupParseSegmentType:
+			segments = append(segments, fmt.Sprintf("\t\tresourceid.ResourceGroupSegment(%q),", segment.Name))
+		case ResourceProviderParseSegmentType:
+			segments = append(segments, fmt.Sprintf("\t\tresourceid.ResourceProviderSegment(%q, %q),", segment.Name, segment.Value))
+		case StaticParseSegmentType:
+			segments = append(segments, fmt.Sprintf("\t\tresourceid.StaticSegment(%q, %q, %t),", segment.Name, segment.Value, segment.CaseInsensitive))
+		case SubscriptionIdParseSegmentType:
+			segments = append(segments, fmt.Sprintf("\t\tresourceid.SubscriptionIdSegment(%q),", segment.Name))
+		case UserSpecifiedParseSegmentType:
+			segments = append(segments, fmt.Sprintf("\t\tresourceid.UserSpecifiedSegment(%q),", segment.Name))
+		}
 	}
-	if id.HasResourceGroup {
-		directAssignments = append(directAssignments, "\t\tResourceGroup: id.ResourceGroup,")
+	segmentsStr := strings.Join(segments, "\n")
+	return fmt.Sprintf(`
+// Segments returns the list of Segments which make up this %[1]s ID
+func (id %[1]sId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+%[2]s
 	}
-	directAssignmentsStr := strings.Join(directAssignments, "\n")
+}
+`, id.TypeName, segmentsStr)
+}
 
-	parserStatements := make([]string, 0)
-	for _, segment := range id.Segments {
-		isSubscription := strings.EqualFold(segment.FieldName, "SubscriptionId") && id.HasSubscriptionId
-		isResourceGroup := strings.EqualFold(segment.FieldName, "ResourceGroup") && id.HasResourceGroup
-		if isSubscription || isResourceGroup {
-			parserStatements = append(parserStatements, fmt.Sprintf(`
-	if resourceId.%[1]s == "" {
-		return nil, fmt.Errorf("ID was missing the '%[2]s' element")
-	}
-`, segment.FieldName, segment.SegmentKey))
+func (id ResourceIdGenerator) codeForParserAssignments() string {
+	assignments := make([]string, 0)
+	for _, segment := range id.ParseSegments {
+		if segment.isFixed() {
 			continue
 		}
 
-		fmtString := "\tif resourceId.%[1]s, err = id.PopSegment(\"%[2]s\"); err != nil {\n\t\treturn nil, err\n\t}"
-		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
+		assignments = append(assignments, fmt.Sprintf("\t\t%s:\tvalues[%q],", segment.FieldName, segment.Name))
 	}
-	parserStatementsStr := strings.Join(parserStatements, "\n")
+	return strings.Join(assignments, "\n")
+}
+
+func (id ResourceIdGenerator) codeForParser() string {
 	return fmt.Sprintf(`
-// %[1]sID parses a %[1]s ID into an %[1]sId struct 
+// %[1]sID parses a %[1]s ID into an %[1]sId struct
 func %[1]sID(input string) (*%[1]sId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, %[1]sId{}.Segments(), false)
 	if err != nil {
 		return nil, err
 	}
@@ -458,15 +686,9 @@ func %[1]sID(input string) (*%[1]sId, error) {
 %[2]s
 	}
 
-%[3]s
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
-	}
-
 	return &resourceId, nil
 }
-`, id.TypeName, directAssignmentsStr, parserStatementsStr)
+`, id.TypeName, id.codeForParserAssignments())
 }
 
 func (id ResourceIdGenerator) codeForParserInsensitive() string {
@@ -475,46 +697,6 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 		return ""
 	}
 
-	directAssignments := make([]string, 0)
-	if id.HasSubscriptionId {
-		directAssignments = append(directAssignments, "\t\tSubscriptionId: id.SubscriptionID,")
-	}
-	if id.HasResourceGroup {
-		directAssignments = append(directAssignments, "\t\tResourceGroup: id.ResourceGroup,")
-	}
-	directAssignmentsStr := strings.Join(directAssignments, "\n")
-
-	parserStatements := make([]string, 0)
-	for _, segment := range id.Segments {
-		isSubscription := strings.EqualFold(segment.FieldName, "SubscriptionId") && id.HasSubscriptionId
-		isResourceGroup := strings.EqualFold(segment.FieldName, "ResourceGroup") && id.HasResourceGroup
-		if isSubscription || isResourceGroup {
-			parserStatements = append(parserStatements, fmt.Sprintf(`
-	if resourceId.%[1]s == "" {
-		return nil, fmt.Errorf("ID was missing the '%[2]s' element")
-	}
-`, segment.FieldName, segment.SegmentKey))
-			continue
-		}
-
-		// NOTE: This becomes dramatically simpler long-term - but for now has to be long-winded
-		// to avoid subtle changes to resources until this is threaded through everywhere
-		fmtString := `
-  // find the correct casing for the '%[2]s' segment
-  %[2]sKey := "%[2]s"
-  for key := range id.Path {
-  	if strings.EqualFold(key, %[2]sKey) {
-  		%[2]sKey = key
-  		break
-  	}
-  }
-  if resourceId.%[1]s, err = id.PopSegment(%[2]sKey); err != nil {
-    return nil, err
-  }
-`
-		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
-	}
-	parserStatementsStr := strings.Join(parserStatements, "\n")
 	return fmt.Sprintf(`
 // %[1]sIDInsensitively parses an %[1]s ID into an %[1]sId struct, insensitively
 // This should only be used to parse an ID for rewriting, the %[1]sID
@@ -523,7 +705,7 @@ func (id ResourceIdGenerator) codeForParserInsensitive() string {
 // Whilst this may seem strange, this enables Terraform have consistent casing
 // which works around issues in Core, whilst handling broken API responses.
 func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	values, err := resourceid.ParseSegments(input, %[1]sId{}.Segments(), true)
 	if err != nil {
 		return nil, err
 	}
@@ -532,15 +714,9 @@ func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
 %[2]s
 	}
 
-%[3]s
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
-	}
-
 	return &resourceId, nil
 }
-`, id.TypeName, directAssignmentsStr, parserStatementsStr)
+`, id.TypeName, id.codeForParserAssignments())
 }
 
 func (id ResourceIdGenerator) TestCode() string {
@@ -558,7 +734,9 @@ import (
 %s
 %s
 %s
-`, id.testCodeForFormatter(), id.testCodeForParser(), id.testCodeForParserInsensitive())
+%s
+%s
+`, id.testCodeForFormatter(), id.testCodeForParentConstructor(), id.testCodeForMatches(), id.testCodeForParser(), id.testCodeForParserInsensitive())
 }
 
 func (id ResourceIdGenerator) testCodeForFormatter() string {
@@ -569,6 +747,7 @@ func (id ResourceIdGenerator) testCodeForFormatter() string {
 	arguementsStr := strings.Join(arguments, ", ")
 	return fmt.Sprintf(`
 var _ resourceid.Formatter = %[1]sId{}
+var _ resourceid.SegmentedID = %[1]sId{}
 
 func Test%[1]sIDFormatter(t *testing.T) {
 	actual := New%[1]sID(%[2]s).ID()
@@ -580,6 +759,75 @@ func Test%[1]sIDFormatter(t *testing.T) {
 `, id.TypeName, arguementsStr, id.IDRaw)
 }
 
+func (id ResourceIdGenerator) testCodeForParentConstructor() string {
+	if id.ParentResourceId == nil {
+		return ""
+	}
+
+	parentArguments := make([]string, 0)
+	for _, segment := range id.Segments[0:len(id.ParentResourceId.Segments)] {
+		parentArguments = append(parentArguments, fmt.Sprintf("%q", segment.SegmentValue))
+	}
+	lastSegment := id.Segments[len(id.Segments)-1]
+	return fmt.Sprintf(`
+func Test%[1]sIDFromParent(t *testing.T) {
+	parent := New%[2]sID(%[3]s)
+	actual := New%[1]sIDFromParent(parent, %[4]q).ID()
+	expected := %[5]q
+	if actual != expected {
+		t.Fatalf("Expected %%q but got %%q", expected, actual)
+	}
+}
+`, id.TypeName, id.ParentResourceId.TypeName, strings.Join(parentArguments, ", "), lastSegment.SegmentValue, id.IDRaw)
+}
+
+func (id ResourceIdGenerator) testCodeForMatches() string {
+	arguments := make([]string, 0)
+	for _, segment := range id.Segments {
+		arguments = append(arguments, fmt.Sprintf("%q", segment.SegmentValue))
+	}
+	argumentsStr := strings.Join(arguments, ", ")
+
+	return fmt.Sprintf(`
+func Test%[1]sIDMatches(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: false,
+		},
+		{
+			// same
+			Input:    %[3]q,
+			Expected: true,
+		},
+		{
+			// upper-cased fixed segments
+			Input:    %[4]q,
+			Expected: true,
+		},
+		{
+			// different name
+			Input:    %[5]q,
+			Expected: false,
+		},
+	}
+
+	id := New%[1]sID(%[2]s)
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Input)
+
+		if actual := id.Matches(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %%t but got %%t", v.Expected, actual)
+		}
+	}
+}
+`, id.TypeName, argumentsStr, id.IDRaw, id.withTransformedFixedSegments(strings.ToUpper), fmt.Sprintf("%sother", id.IDRaw))
+}
+
 func (id ResourceIdGenerator) testCodeForParser() string {
 	testCases := make([]string, 0)
 	testCases = append(testCases, `
@@ -1,6 +1,10 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 )
 
@@ -54,3 +58,88 @@ func Test(t *testing.T) {
 		}
 	}
 }
+
+func TestNewResourceIDParseSegments(t *testing.T) {
+	id, err := NewResourceID("Subnet", "network", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1", "subnets")
+	if err != nil {
+		t.Fatalf("parsing: %+v", err)
+	}
+
+	expected := []ParseSegment{
+		{Type: StaticParseSegmentType, Name: "staticSubscriptions", Value: "subscriptions"},
+		{Type: SubscriptionIdParseSegmentType, Name: "subscriptionId", FieldName: "SubscriptionId", Value: "12345678-1234-9876-4563-123456789012"},
+		{Type: StaticParseSegmentType, Name: "staticResourceGroups", Value: "resourceGroups", CaseInsensitive: true},
+		{Type: ResourceGroupParseSegmentType, Name: "resourceGroup", FieldName: "ResourceGroup", Value: "group1"},
+		{Type: StaticParseSegmentType, Name: "staticProviders", Value: "providers"},
+		{Type: ResourceProviderParseSegmentType, Name: "staticMicrosoftNetwork", Value: "Microsoft.Network"},
+		{Type: StaticParseSegmentType, Name: "staticVirtualNetworks", Value: "virtualNetworks"},
+		{Type: UserSpecifiedParseSegmentType, Name: "virtualNetworkName", FieldName: "VirtualNetworkName", Value: "network1"},
+		{Type: StaticParseSegmentType, Name: "staticSubnets", Value: "subnets", CaseInsensitive: true},
+		{Type: UserSpecifiedParseSegmentType, Name: "name", FieldName: "Name", Value: "subnet1"},
+	}
+	if !reflect.DeepEqual(id.ParseSegments, expected) {
+		t.Fatalf("expected %+v but got %+v", expected, id.ParseSegments)
+	}
+}
+
+func TestFindParentResourceId(t *testing.T) {
+	dir, err := ioutil.TempDir("", "generator-resource-id")
+	if err != nil {
+		t.Fatalf("creating temp dir: %+v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	contents := `package network
+
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=VirtualNetwork -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=Subnet -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/vnet1/subnets/subnet1
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=NetworkInterface -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "resourceids.go"), []byte(contents), 0644); err != nil {
+		t.Fatalf("writing resourceids.go: %+v", err)
+	}
+
+	testData := []struct {
+		Name     string
+		Id       string
+		Expected string
+	}{
+		{
+			Name:     "Subnet",
+			Id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: "VirtualNetwork",
+		},
+		{
+			Name:     "VirtualNetwork",
+			Id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: "",
+		},
+		{
+			Name:     "IPConfiguration",
+			Id:       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1/ipConfigurations/config1",
+			Expected: "NetworkInterface",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.Name)
+
+		id, err := NewResourceID(v.Name, "network", v.Id)
+		if err != nil {
+			t.Fatalf("parsing: %+v", err)
+		}
+
+		parent, err := findParentResourceId(dir, *id)
+		if err != nil {
+			t.Fatalf("finding parent: %+v", err)
+		}
+
+		actual := ""
+		if parent != nil {
+			actual = parent.TypeName
+		}
+		if actual != v.Expected {
+			t.Fatalf("expected the parent to be %q but got %q", v.Expected, actual)
+		}
+	}
+}